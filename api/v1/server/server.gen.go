@@ -9,6 +9,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 
@@ -21,13 +22,46 @@ const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for AuditStatus.
+const (
+	Failure AuditStatus = "failure"
+	Success AuditStatus = "success"
+)
+
+// Defines values for DatabaseNodeStatus.
+const (
+	DatabaseNodeStatusActive         DatabaseNodeStatus = "active"
+	DatabaseNodeStatusDecommissioned DatabaseNodeStatus = "decommissioned"
+	DatabaseNodeStatusDraining       DatabaseNodeStatus = "draining"
+	DatabaseNodeStatusMaintenance    DatabaseNodeStatus = "maintenance"
+	DatabaseNodeStatusOffline        DatabaseNodeStatus = "offline"
+	DatabaseNodeStatusProvisioning   DatabaseNodeStatus = "provisioning"
+)
+
+// Defines values for DatabaseNodeType.
+const (
+	DatabaseNodeTypeCoordinator DatabaseNodeType = "coordinator"
+	DatabaseNodeTypeHighMemory  DatabaseNodeType = "high-memory"
+	DatabaseNodeTypeIsolated    DatabaseNodeType = "isolated"
+	DatabaseNodeTypeStandard    DatabaseNodeType = "standard"
+)
+
+// Defines values for OperationEventType.
+const (
+	StatusChanged   OperationEventType = "status_changed"
+	StepCompensated OperationEventType = "step_compensated"
+	StepFinished    OperationEventType = "step_finished"
+	StepStarted     OperationEventType = "step_started"
+)
+
 // Defines values for OperationStatus.
 const (
-	Cancelled  OperationStatus = "cancelled"
-	Completed  OperationStatus = "completed"
-	Failed     OperationStatus = "failed"
-	InProgress OperationStatus = "in_progress"
-	Pending    OperationStatus = "pending"
+	OperationStatusCancelled  OperationStatus = "cancelled"
+	OperationStatusCompleted  OperationStatus = "completed"
+	OperationStatusFailed     OperationStatus = "failed"
+	OperationStatusInProgress OperationStatus = "in_progress"
+	OperationStatusPaused     OperationStatus = "paused"
+	OperationStatusPending    OperationStatus = "pending"
 )
 
 // Defines values for Region.
@@ -56,6 +90,83 @@ const (
 	TenantCreateTierPro        TenantCreateTier = "pro"
 )
 
+// Defines values for TenantResourceStatus.
+const (
+	TenantResourceStatusActive       TenantResourceStatus = "active"
+	TenantResourceStatusDeleting     TenantResourceStatus = "deleting"
+	TenantResourceStatusError        TenantResourceStatus = "error"
+	TenantResourceStatusProvisioning TenantResourceStatus = "provisioning"
+	TenantResourceStatusSuspended    TenantResourceStatus = "suspended"
+)
+
+// Defines values for TenantResourceType.
+const (
+	DatabaseSchema      TenantResourceType = "database_schema"
+	GkeDeployment       TenantResourceType = "gke_deployment"
+	KubernetesNamespace TenantResourceType = "kubernetes_namespace"
+	PubsubTopic         TenantResourceType = "pubsub_topic"
+	Secret              TenantResourceType = "secret"
+)
+
+// Defines values for TenantResponseTier.
+const (
+	TenantResponseTierEnterprise TenantResponseTier = "enterprise"
+	TenantResponseTierFree       TenantResponseTier = "free"
+	TenantResponseTierPro        TenantResponseTier = "pro"
+)
+
+// Defines values for TenantStatus.
+const (
+	TenantStatusActive       TenantStatus = "active"
+	TenantStatusDeleting     TenantStatus = "deleting"
+	TenantStatusError        TenantStatus = "error"
+	TenantStatusIsolated     TenantStatus = "isolated"
+	TenantStatusProvisioning TenantStatus = "provisioning"
+	TenantStatusSuspended    TenantStatus = "suspended"
+)
+
+// Defines values for TenantTierChangeTier.
+const (
+	TenantTierChangeTierEnterprise TenantTierChangeTier = "enterprise"
+	TenantTierChangeTierFree       TenantTierChangeTier = "free"
+	TenantTierChangeTierPro        TenantTierChangeTier = "pro"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	WebhookDeliveryStatusDeadLetter WebhookDeliveryStatus = "dead_letter"
+	WebhookDeliveryStatusPending    WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusSucceeded  WebhookDeliveryStatus = "succeeded"
+)
+
+// Defines values for WebhookEventType.
+const (
+	OperationCompleted WebhookEventType = "operation.completed"
+	OperationFailed    WebhookEventType = "operation.failed"
+	OperationStarted   WebhookEventType = "operation.started"
+	TenantActivated    WebhookEventType = "tenant.activated"
+	TenantDeleted      WebhookEventType = "tenant.deleted"
+)
+
+// Defines values for ListTenantsParamsTier.
+const (
+	Enterprise ListTenantsParamsTier = "enterprise"
+	Free       ListTenantsParamsTier = "free"
+	Pro        ListTenantsParamsTier = "pro"
+)
+
+// Defines values for ListTenantsParamsSort.
+const (
+	CreatedAt ListTenantsParamsSort = "created_at"
+	Name      ListTenantsParamsSort = "name"
+)
+
+// Defines values for ListTenantsParamsOrder.
+const (
+	Asc  ListTenantsParamsOrder = "asc"
+	Desc ListTenantsParamsOrder = "desc"
+)
+
 // AsyncOperation defines model for AsyncOperation.
 type AsyncOperation struct {
 	// Links HATEOAS links to related resources
@@ -71,6 +182,129 @@ type AsyncOperation struct {
 	TenantId *int64 `json:"tenant_id"`
 }
 
+// AuditLogEntry defines model for AuditLogEntry.
+type AuditLogEntry struct {
+	// Action Action performed, such as create_tenant or execute_operation
+	Action string `json:"action"`
+
+	// Actor Who performed the action
+	Actor string `json:"actor"`
+
+	// ActorIp Client address the action was requested from
+	ActorIp *string `json:"actor_ip"`
+
+	// Details Action-specific details
+	Details map[string]interface{} `json:"details"`
+
+	// DurationMs How long the action took
+	DurationMs *int64 `json:"duration_ms"`
+
+	// Error Why the action failed
+	Error *string `json:"error"`
+
+	// Id Unique identifier of the entry
+	Id int64 `json:"id"`
+
+	// OperationId Related operation, if any
+	OperationId *int64 `json:"operation_id"`
+
+	// ResourceId Affected resource, if any
+	ResourceId *int64      `json:"resource_id"`
+	Status     AuditStatus `json:"status"`
+
+	// TenantId Affected tenant, if any
+	TenantId *int64 `json:"tenant_id"`
+
+	// Timestamp When the action completed
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// AuditLogList defines model for AuditLogList.
+type AuditLogList struct {
+	// Links HATEOAS links to related resources
+	Links   Links           `json:"_links"`
+	Entries []AuditLogEntry `json:"entries"`
+
+	// NextCursor Opaque cursor for the next page; absent on the last page
+	NextCursor *string `json:"next_cursor"`
+}
+
+// AuditStatus defines model for AuditStatus.
+type AuditStatus string
+
+// DatabaseNodeList defines model for DatabaseNodeList.
+type DatabaseNodeList struct {
+	// Links HATEOAS links to related resources
+	Links         Links                  `json:"_links"`
+	DatabaseNodes []DatabaseNodeResponse `json:"database_nodes"`
+}
+
+// DatabaseNodeRegister defines model for DatabaseNodeRegister.
+type DatabaseNodeRegister struct {
+	// Hostname Full hostname of the node
+	Hostname string `json:"hostname"`
+
+	// MaxTenants Tenants the node can host; defaults to 10000
+	MaxTenants *int32 `json:"max_tenants,omitempty"`
+
+	// NodeType Workloads a database node is sized for
+	NodeType DatabaseNodeType `json:"node_type"`
+
+	// Port PostgreSQL port
+	Port *int32 `json:"port,omitempty"`
+
+	// Region Deployment regions across GCP
+	Region Region `json:"region"`
+}
+
+// DatabaseNodeResponse defines model for DatabaseNodeResponse.
+type DatabaseNodeResponse struct {
+	// Links HATEOAS links to related resources
+	Links Links `json:"_links"`
+
+	// CreatedAt Registration timestamp
+	CreatedAt time.Time `json:"created_at"`
+
+	// CreatedBy Who registered the node
+	CreatedBy string `json:"created_by"`
+
+	// Hostname Full hostname of the node
+	Hostname string `json:"hostname"`
+
+	// Id Unique database node ID
+	Id int64 `json:"id"`
+
+	// MaxTenants Tenants the node can host
+	MaxTenants int32 `json:"max_tenants"`
+
+	// NodeType Workloads a database node is sized for
+	NodeType DatabaseNodeType `json:"node_type"`
+
+	// Port PostgreSQL port
+	Port int32 `json:"port"`
+
+	// Region Deployment regions across GCP
+	Region Region `json:"region"`
+
+	// Status Operational status of a database node
+	Status DatabaseNodeStatus `json:"status"`
+
+	// TenantCount Tenants whose primary node this is
+	TenantCount int32 `json:"tenant_count"`
+
+	// UpdatedAt Last update timestamp
+	UpdatedAt *time.Time `json:"updated_at"`
+
+	// UtilizationPercent Tenant count as a percentage of capacity
+	UtilizationPercent int32 `json:"utilization_percent"`
+}
+
+// DatabaseNodeStatus Operational status of a database node
+type DatabaseNodeStatus string
+
+// DatabaseNodeType Workloads a database node is sized for
+type DatabaseNodeType string
+
 // Error defines model for Error.
 type Error struct {
 	// Details Additional error details
@@ -83,14 +317,106 @@ type Error struct {
 	Message string `json:"message"`
 }
 
+// IsolationGroupCreate defines model for IsolationGroupCreate.
+type IsolationGroupCreate struct {
+	// Name Unique name for the group (lowercase letters, numbers, hyphens)
+	Name string `json:"name"`
+
+	// Region Deployment regions across GCP
+	Region Region `json:"region"`
+}
+
+// IsolationGroupList defines model for IsolationGroupList.
+type IsolationGroupList struct {
+	// Links HATEOAS links to related resources
+	Links           Links                    `json:"_links"`
+	IsolationGroups []IsolationGroupResponse `json:"isolation_groups"`
+}
+
+// IsolationGroupResponse defines model for IsolationGroupResponse.
+type IsolationGroupResponse struct {
+	// Links HATEOAS links to related resources
+	Links Links `json:"_links"`
+
+	// CreatedAt Creation timestamp
+	CreatedAt time.Time `json:"created_at"`
+
+	// CreatedBy Who created the group
+	CreatedBy string `json:"created_by"`
+
+	// Id Unique isolation group ID
+	Id int64 `json:"id"`
+
+	// Name Unique isolation group name
+	Name string `json:"name"`
+
+	// Region Deployment regions across GCP
+	Region Region `json:"region"`
+
+	// UpdatedAt Last update timestamp
+	UpdatedAt *time.Time `json:"updated_at"`
+}
+
 // Links HATEOAS links to related resources
 type Links map[string]string
 
+// OperationCancel defines model for OperationCancel.
+type OperationCancel struct {
+	// Reason Why the operation is being cancelled
+	Reason string `json:"reason"`
+}
+
+// OperationEvent Entry in the timeline of an operation, sent as the data of a stream event
+type OperationEvent struct {
+	// ErrorMessage Why the operation or step failed
+	ErrorMessage *string `json:"error_message"`
+
+	// Id Position of the event in the operation's timeline
+	Id int64 `json:"id"`
+
+	// OccurredAt When the change happened
+	OccurredAt time.Time `json:"occurred_at"`
+
+	// OperationId Operation the event belongs to
+	OperationId int64 `json:"operation_id"`
+
+	// Status New operation status, or the step's status for step events
+	Status string `json:"status"`
+
+	// StepIndex Position of the step within the workflow, for step start and finish events
+	StepIndex *int `json:"step_index"`
+
+	// StepName Name of the step, for step events
+	StepName *string `json:"step_name"`
+
+	// Type Kind of change the event records
+	Type OperationEventType `json:"type"`
+}
+
+// OperationEventType Kind of change the event records
+type OperationEventType string
+
+// OperationList defines model for OperationList.
+type OperationList struct {
+	// Links HATEOAS links to related resources
+	Links Links `json:"_links"`
+
+	// NextCursor Opaque cursor for the next page; absent on the last page
+	NextCursor *string             `json:"next_cursor"`
+	Operations []OperationResponse `json:"operations"`
+}
+
 // OperationResponse defines model for OperationResponse.
 type OperationResponse struct {
 	// Links HATEOAS links to related resources
 	Links Links `json:"_links"`
 
+	// Attempt 1 for an original operation, incremented by each retry
+	Attempt *int `json:"attempt,omitempty"`
+
+	// CancelledBy Who cancelled the operation, if it was cancelled
+	CancelledBy *string `json:"cancelled_by"`
+
 	// CompletedAt When operation finished
 	CompletedAt *time.Time `json:"completed_at"`
 
@@ -112,6 +438,9 @@ type OperationResponse struct {
 	// Parameters Input parameters for the operation
 	Parameters *map[string]interface{} `json:"parameters,omitempty"`
 
+	// ParentOperationId Failed operation this operation retries, if it is a retry
+	ParentOperationId *int64 `json:"parent_operation_id"`
+
 	// Result Result data from completed operation
 	Result *map[string]interface{} `json:"result,omitempty"`
 
@@ -163,459 +492,5697 @@ type TenantCreate struct {
 // TenantCreateTier defines model for TenantCreate.Tier.
 type TenantCreateTier string
 
-// CreateTenantJSONRequestBody defines body for CreateTenant for application/json ContentType.
-type CreateTenantJSONRequestBody = TenantCreate
+// TenantIsolationGroupChange defines model for TenantIsolationGroupChange.
+type TenantIsolationGroupChange struct {
+	// IsolationGroupId Isolation group to move the tenant into; null removes it from its group
+	IsolationGroupId *int64 `json:"isolation_group_id"`
+}
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Get operation details
-	// (GET /api/v1/operations/{operation_id})
-	GetOperation(w http.ResponseWriter, r *http.Request, operationId int64)
-	// Create a new tenant
-	// (POST /api/v1/tenants)
-	CreateTenant(w http.ResponseWriter, r *http.Request)
-	// Delete tenant
-	// (DELETE /api/v1/tenants/{tenant_id})
-	DeleteTenant(w http.ResponseWriter, r *http.Request, tenantId int64)
+// TenantList defines model for TenantList.
+type TenantList struct {
+	// Links HATEOAS links to related resources
+	Links Links `json:"_links"`
+
+	// NextCursor Opaque cursor for the next page; absent on the last page
+	NextCursor *string          `json:"next_cursor"`
+	Tenants    []TenantResponse `json:"tenants"`
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+// TenantMigration defines model for TenantMigration.
+type TenantMigration struct {
+	// Region Deployment regions across GCP
+	Region Region `json:"region"`
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// TenantResource defines model for TenantResource.
+type TenantResource struct {
+	CreatedAt time.Time `json:"created_at"`
 
-// GetOperation operation middleware
-func (siw *ServerInterfaceWrapper) GetOperation(w http.ResponseWriter, r *http.Request) {
+	// CreatedByOperationId Operation that provisioned the resource
+	CreatedByOperationId *int64 `json:"created_by_operation_id"`
 
-	var err error
+	// ExternalId Identifier assigned by the cloud provider, if any
+	ExternalId *string `json:"external_id"`
 
-	// ------------- Path parameter "operation_id" -------------
-	var operationId int64
+	// Id Unique identifier of the resource
+	Id int64 `json:"id"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "operation_id", r.PathValue("operation_id"), &operationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "operation_id", Err: err})
-		return
-	}
+	// Metadata Resource-specific attributes
+	Metadata *map[string]interface{} `json:"metadata,omitempty"`
 
-	ctx := r.Context()
+	// Name Name of the resource
+	Name string `json:"name"`
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// ProjectId GCP project the resource is deployed in
+	ProjectId string `json:"project_id"`
 
-	r = r.WithContext(ctx)
+	// Region Deployment regions across GCP
+	Region Region `json:"region"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetOperation(w, r, operationId)
-	}))
+	// ResourceType Kind of cloud resource provisioned for a tenant
+	ResourceType TenantResourceType `json:"resource_type"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Status Lifecycle status of a tenant resource
+	Status    TenantResourceStatus `json:"status"`
+	UpdatedAt *time.Time           `json:"updated_at"`
+}
 
-	handler.ServeHTTP(w, r)
+// TenantResourceList defines model for TenantResourceList.
+type TenantResourceList struct {
+	// Links HATEOAS links to related resources
+	Links     Links            `json:"_links"`
+	Resources []TenantResource `json:"resources"`
 }
 
-// CreateTenant operation middleware
-func (siw *ServerInterfaceWrapper) CreateTenant(w http.ResponseWriter, r *http.Request) {
+// TenantResourceStatus Lifecycle status of a tenant resource
+type TenantResourceStatus string
 
-	ctx := r.Context()
+// TenantResourceType Kind of cloud resource provisioned for a tenant
+type TenantResourceType string
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// TenantResponse defines model for TenantResponse.
+type TenantResponse struct {
+	// Links HATEOAS links to related resources
+	Links Links `json:"_links"`
 
-	r = r.WithContext(ctx)
+	// CreatedAt Creation timestamp
+	CreatedAt time.Time `json:"created_at"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateTenant(w, r)
-	}))
+	// Id Unique tenant ID
+	Id int64 `json:"id"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// IsolationGroupId Isolation group the tenant belongs to, if any
+	IsolationGroupId *int64 `json:"isolation_group_id"`
 
-	handler.ServeHTTP(w, r)
-}
+	// Name Unique tenant name
+	Name string `json:"name"`
 
-// DeleteTenant operation middleware
-func (siw *ServerInterfaceWrapper) DeleteTenant(w http.ResponseWriter, r *http.Request) {
+	// Region Deployment regions across GCP
+	Region Region `json:"region"`
 
-	var err error
+	// Status Current lifecycle status of a tenant
+	Status TenantStatus       `json:"status"`
+	Tier   TenantResponseTier `json:"tier"`
 
-	// ------------- Path parameter "tenant_id" -------------
-	var tenantId int64
+	// UpdatedAt Last update timestamp
+	UpdatedAt *time.Time `json:"updated_at"`
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "tenant_id", r.PathValue("tenant_id"), &tenantId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenant_id", Err: err})
-		return
-	}
+// TenantResponseTier defines model for TenantResponse.Tier.
+type TenantResponseTier string
 
-	ctx := r.Context()
+// TenantStateChange defines model for TenantStateChange.
+type TenantStateChange struct {
+	// Reason Why the tenant is being suspended or resumed
+	Reason string `json:"reason"`
+}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// TenantStatus Current lifecycle status of a tenant
+type TenantStatus string
 
-	r = r.WithContext(ctx)
+// TenantSuspension defines model for TenantSuspension.
+type TenantSuspension struct {
+	// Id Unique suspension ID
+	Id int64 `json:"id"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteTenant(w, r, tenantId)
-	}))
+	// Reason Why the tenant was suspended
+	Reason string `json:"reason"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// ResumeOperationId Operation that resumed the tenant
+	ResumeOperationId *int64 `json:"resume_operation_id"`
 
-	handler.ServeHTTP(w, r)
-}
+	// ResumeReason Why the tenant was resumed
+	ResumeReason *string `json:"resume_reason"`
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+	// ResumedAt When the tenant was resumed; absent while it is still suspended
+	ResumedAt *time.Time `json:"resumed_at"`
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	// ResumedBy Who requested the resumption
+	ResumedBy *string `json:"resumed_by"`
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	// SuspendOperationId Operation that suspended the tenant
+	SuspendOperationId *int64 `json:"suspend_operation_id"`
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	// SuspendedAt When the tenant was suspended
+	SuspendedAt time.Time `json:"suspended_at"`
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+	// SuspendedBy Who requested the suspension
+	SuspendedBy string `json:"suspended_by"`
 }
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
+// TenantSuspensionList defines model for TenantSuspensionList.
+type TenantSuspensionList struct {
+	// Links HATEOAS links to related resources
+	Links       Links              `json:"_links"`
+	Suspensions []TenantSuspension `json:"suspensions"`
 }
 
-type RequiredParamError struct {
-	ParamName string
+// TenantTierChange defines model for TenantTierChange.
+type TenantTierChange struct {
+	// Tier Tier to move the tenant to
+	Tier TenantTierChangeTier `json:"tier"`
 }
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+// TenantTierChangeTier Tier to move the tenant to
+type TenantTierChangeTier string
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	// Links HATEOAS links to related resources
+	Links Links `json:"_links"`
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	// Attempts Number of failed attempts so far
+	Attempts int `json:"attempts"`
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+	// CreatedAt When the delivery was queued
+	CreatedAt time.Time `json:"created_at"`
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+	// DeliveredAt When the endpoint accepted the delivery
+	DeliveredAt *time.Time `json:"delivered_at"`
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+	// EventId ID of the delivered event
+	EventId int64 `json:"event_id"`
+
+	// EventType Lifecycle event type a webhook subscription can be sent
+	EventType WebhookEventType `json:"event_type"`
+
+	// Id Unique delivery ID
+	Id int64 `json:"id"`
+
+	// LastAttemptAt When the delivery was last attempted
+	LastAttemptAt *time.Time `json:"last_attempt_at"`
+
+	// LastError Why the last attempt failed
+	LastError *string `json:"last_error"`
+
+	// NextAttemptAt When the delivery is next attempted while pending
+	NextAttemptAt time.Time `json:"next_attempt_at"`
+
+	// Payload Request body posted to the endpoint
+	Payload map[string]interface{} `json:"payload"`
+
+	// ResponseStatus HTTP status the endpoint last responded with
+	ResponseStatus *int `json:"response_status"`
+
+	// Status Status of a delivery. Pending deliveries are retried with exponential
+	// backoff; deliveries that fail every attempt are dead-lettered.
+	Status WebhookDeliveryStatus `json:"status"`
+
+	// SubscriptionId Subscription the event is delivered to
+	SubscriptionId int64 `json:"subscription_id"`
+
+	// TenantId Tenant the event is about, if any
+	TenantId *int64 `json:"tenant_id"`
 }
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
+// WebhookDeliveryList defines model for WebhookDeliveryList.
+type WebhookDeliveryList struct {
+	// Links HATEOAS links to related resources
+	Links      Links             `json:"_links"`
+	Deliveries []WebhookDelivery `json:"deliveries"`
+
+	// NextCursor Opaque cursor for the next page; absent on the last page
+	NextCursor *string `json:"next_cursor"`
 }
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
+// WebhookDeliveryStatus Status of a delivery. Pending deliveries are retried with exponential
+// backoff; deliveries that fail every attempt are dead-lettered.
+type WebhookDeliveryStatus string
+
+// WebhookEventType Lifecycle event type a webhook subscription can be sent
+type WebhookEventType string
+
+// WebhookSubscriptionCreate defines model for WebhookSubscriptionCreate.
+type WebhookSubscriptionCreate struct {
+	// Description What the subscription is for
+	Description *string `json:"description,omitempty"`
+
+	// EventTypes Event types to send; all of them if empty or omitted
+	EventTypes *[]WebhookEventType `json:"event_types,omitempty"`
+
+	// Secret Key deliveries are signed with; generated if omitted
+	Secret *string `json:"secret,omitempty"`
+
+	// TenantId Only send events about this tenant; events about all tenants if omitted
+	TenantId *int64 `json:"tenant_id"`
+
+	// Url HTTP or HTTPS endpoint events are posted to. Loopback, private and link-local addresses are rejected.
+	Url string `json:"url"`
 }
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+// WebhookSubscriptionList defines model for WebhookSubscriptionList.
+type WebhookSubscriptionList struct {
+	// Links HATEOAS links to related resources
+	Links         Links                         `json:"_links"`
+	Subscriptions []WebhookSubscriptionResponse `json:"subscriptions"`
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{})
+// WebhookSubscriptionResponse defines model for WebhookSubscriptionResponse.
+type WebhookSubscriptionResponse struct {
+	// Links HATEOAS links to related resources
+	Links Links `json:"_links"`
+
+	// Active Whether events are sent to the subscription
+	Active bool `json:"active"`
+
+	// CreatedAt Creation timestamp
+	CreatedAt time.Time `json:"created_at"`
+
+	// CreatedBy Who created the subscription
+	CreatedBy string `json:"created_by"`
+
+	// Description What the subscription is for
+	Description string `json:"description"`
+
+	// EventTypes Event types sent; all of them if empty
+	EventTypes []WebhookEventType `json:"event_types"`
+
+	// Id Unique subscription ID
+	Id int64 `json:"id"`
+
+	// Secret Key deliveries are signed with. Only returned when the subscription is
+	// created.
+	Secret *string `json:"secret,omitempty"`
+
+	// TenantId Tenant events are sent for; all tenants if absent
+	TenantId *int64 `json:"tenant_id"`
+
+	// UpdatedAt Last update timestamp
+	UpdatedAt *time.Time `json:"updated_at"`
+
+	// Url Endpoint events are posted to
+	Url string `json:"url"`
 }
 
-// ServeMux is an abstraction of http.ServeMux.
-type ServeMux interface {
-	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
-	ServeHTTP(w http.ResponseWriter, r *http.Request)
+// WebhookSubscriptionUpdate defines model for WebhookSubscriptionUpdate.
+type WebhookSubscriptionUpdate struct {
+	// Active Whether events are sent to the subscription
+	Active *bool `json:"active,omitempty"`
+
+	// Description What the subscription is for
+	Description *string `json:"description,omitempty"`
+
+	// EventTypes Event types to send; all of them if empty
+	EventTypes *[]WebhookEventType `json:"event_types,omitempty"`
+
+	// Url HTTP or HTTPS endpoint events are posted to. Loopback, private and link-local addresses are rejected.
+	Url *string `json:"url,omitempty"`
 }
 
-type StdHTTPServerOptions struct {
-	BaseURL          string
-	BaseRouter       ServeMux
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+// IdempotencyKey defines model for IdempotencyKey.
+type IdempotencyKey = string
+
+// ListAuditLogsParams defines parameters for ListAuditLogs.
+type ListAuditLogsParams struct {
+	Actor       *string      `form:"actor,omitempty" json:"actor,omitempty"`
+	Action      *string      `form:"action,omitempty" json:"action,omitempty"`
+	Status      *AuditStatus `form:"status,omitempty" json:"status,omitempty"`
+	TenantId    *int64       `form:"tenant_id,omitempty" json:"tenant_id,omitempty"`
+	OperationId *int64       `form:"operation_id,omitempty" json:"operation_id,omitempty"`
+
+	// Since Only return entries recorded at or after this time
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until Only return entries recorded before this time
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+
+	// Cursor Cursor returned as next_cursor by the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseRouter: m,
-	})
+// ListDatabaseNodesParams defines parameters for ListDatabaseNodes.
+type ListDatabaseNodesParams struct {
+	// Region Filter by region
+	Region *Region `form:"region,omitempty" json:"region,omitempty"`
+
+	// NodeType Filter by node type
+	NodeType *DatabaseNodeType `form:"node_type,omitempty" json:"node_type,omitempty"`
+
+	// Status Filter by status
+	Status *DatabaseNodeStatus `form:"status,omitempty" json:"status,omitempty"`
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: m,
-	})
+// ListIsolationGroupsParams defines parameters for ListIsolationGroups.
+type ListIsolationGroupsParams struct {
+	// Region Filter by region
+	Region *Region `form:"region,omitempty" json:"region,omitempty"`
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
-	m := options.BaseRouter
+// ListOperationsParams defines parameters for ListOperations.
+type ListOperationsParams struct {
+	TenantId *int64 `form:"tenant_id,omitempty" json:"tenant_id,omitempty"`
 
-	if m == nil {
-		m = http.NewServeMux()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
+	// OperationType Only return operations of this type, e.g. tenant.create
+	OperationType *string          `form:"operation_type,omitempty" json:"operation_type,omitempty"`
+	Status        *OperationStatus `form:"status,omitempty" json:"status,omitempty"`
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+	// CreatedBy Only return operations initiated by this user
+	CreatedBy *string `form:"created_by,omitempty" json:"created_by,omitempty"`
 
-	m.HandleFunc("GET "+options.BaseURL+"/api/v1/operations/{operation_id}", wrapper.GetOperation)
-	m.HandleFunc("POST "+options.BaseURL+"/api/v1/tenants", wrapper.CreateTenant)
-	m.HandleFunc("DELETE "+options.BaseURL+"/api/v1/tenants/{tenant_id}", wrapper.DeleteTenant)
+	// CreatedAfter Only return operations created at or after this time
+	CreatedAfter *time.Time `form:"created_after,omitempty" json:"created_after,omitempty"`
 
-	return m
+	// CreatedBefore Only return operations created before this time
+	CreatedBefore *time.Time `form:"created_before,omitempty" json:"created_before,omitempty"`
+
+	// Cursor Cursor returned as next_cursor by the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
-type GetOperationRequestObject struct {
-	OperationId int64 `json:"operation_id"`
+// StreamOperationEventsParams defines parameters for StreamOperationEvents.
+type StreamOperationEventsParams struct {
+	// LastEventID ID of the last event received; the stream resumes after it
+	LastEventID *int64 `json:"Last-Event-ID,omitempty"`
 }
 
-type GetOperationResponseObject interface {
-	VisitGetOperationResponse(w http.ResponseWriter) error
+// ListTenantsParams defines parameters for ListTenants.
+type ListTenantsParams struct {
+	Region           *Region                `form:"region,omitempty" json:"region,omitempty"`
+	Tier             *ListTenantsParamsTier `form:"tier,omitempty" json:"tier,omitempty"`
+	Status           *TenantStatus          `form:"status,omitempty" json:"status,omitempty"`
+	IsolationGroupId *int64                 `form:"isolation_group_id,omitempty" json:"isolation_group_id,omitempty"`
+
+	// NamePrefix Only return tenants whose name starts with this prefix
+	NamePrefix *string                 `form:"name_prefix,omitempty" json:"name_prefix,omitempty"`
+	Sort       *ListTenantsParamsSort  `form:"sort,omitempty" json:"sort,omitempty"`
+	Order      *ListTenantsParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Cursor Cursor returned as next_cursor by the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
-type GetOperation200JSONResponse OperationResponse
+// ListTenantsParamsTier defines parameters for ListTenants.
+type ListTenantsParamsTier string
 
-func (response GetOperation200JSONResponse) VisitGetOperationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// ListTenantsParamsSort defines parameters for ListTenants.
+type ListTenantsParamsSort string
 
-	return json.NewEncoder(w).Encode(response)
-}
+// ListTenantsParamsOrder defines parameters for ListTenants.
+type ListTenantsParamsOrder string
 
-type GetOperation401Response struct {
+// CreateTenantParams defines parameters for CreateTenant.
+type CreateTenantParams struct {
+	// IdempotencyKey Client-chosen key that makes the request safe to retry. A retry with the
+	// same key and an identical request is answered with the original response
+	// instead of being carried out again. Keys expire after a server-configured
+	// time, 24 hours by default.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
 }
 
-func (response GetOperation401Response) VisitGetOperationResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+// DeleteTenantParams defines parameters for DeleteTenant.
+type DeleteTenantParams struct {
+	// IdempotencyKey Client-chosen key that makes the request safe to retry. A retry with the
+	// same key and an identical request is answered with the original response
+	// instead of being carried out again. Keys expire after a server-configured
+	// time, 24 hours by default.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
 }
 
-type GetOperation404JSONResponse Error
+// ListTenantOperationsParams defines parameters for ListTenantOperations.
+type ListTenantOperationsParams struct {
+	// OperationType Only return operations of this type, e.g. tenant.create
+	OperationType *string          `form:"operation_type,omitempty" json:"operation_type,omitempty"`
+	Status        *OperationStatus `form:"status,omitempty" json:"status,omitempty"`
 
-func (response GetOperation404JSONResponse) VisitGetOperationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	// Cursor Cursor returned as next_cursor by the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+// ListTenantResourcesParams defines parameters for ListTenantResources.
+type ListTenantResourcesParams struct {
+	// ResourceType Filter by resource type
+	ResourceType *TenantResourceType `form:"resource_type,omitempty" json:"resource_type,omitempty"`
+
+	// Status Filter by status
+	Status *TenantResourceStatus `form:"status,omitempty" json:"status,omitempty"`
 }
 
-type GetOperation500JSONResponse Error
+// ListWebhookSubscriptionsParams defines parameters for ListWebhookSubscriptions.
+type ListWebhookSubscriptionsParams struct {
+	// TenantId Only list subscriptions for this tenant
+	TenantId *int64 `form:"tenant_id,omitempty" json:"tenant_id,omitempty"`
+}
 
-func (response GetOperation500JSONResponse) VisitGetOperationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// ListWebhookDeliveriesParams defines parameters for ListWebhookDeliveries.
+type ListWebhookDeliveriesParams struct {
+	Status *WebhookDeliveryStatus `form:"status,omitempty" json:"status,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
+	// Cursor Cursor returned as next_cursor by the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
-type CreateTenantRequestObject struct {
-	Body *CreateTenantJSONRequestBody
+// RegisterDatabaseNodeJSONRequestBody defines body for RegisterDatabaseNode for application/json ContentType.
+type RegisterDatabaseNodeJSONRequestBody = DatabaseNodeRegister
+
+// CreateIsolationGroupJSONRequestBody defines body for CreateIsolationGroup for application/json ContentType.
+type CreateIsolationGroupJSONRequestBody = IsolationGroupCreate
+
+// CancelOperationJSONRequestBody defines body for CancelOperation for application/json ContentType.
+type CancelOperationJSONRequestBody = OperationCancel
+
+// CreateTenantJSONRequestBody defines body for CreateTenant for application/json ContentType.
+type CreateTenantJSONRequestBody = TenantCreate
+
+// ChangeTenantIsolationGroupJSONRequestBody defines body for ChangeTenantIsolationGroup for application/json ContentType.
+type ChangeTenantIsolationGroupJSONRequestBody = TenantIsolationGroupChange
+
+// MigrateTenantJSONRequestBody defines body for MigrateTenant for application/json ContentType.
+type MigrateTenantJSONRequestBody = TenantMigration
+
+// ResumeTenantJSONRequestBody defines body for ResumeTenant for application/json ContentType.
+type ResumeTenantJSONRequestBody = TenantStateChange
+
+// SuspendTenantJSONRequestBody defines body for SuspendTenant for application/json ContentType.
+type SuspendTenantJSONRequestBody = TenantStateChange
+
+// ChangeTenantTierJSONRequestBody defines body for ChangeTenantTier for application/json ContentType.
+type ChangeTenantTierJSONRequestBody = TenantTierChange
+
+// CreateWebhookSubscriptionJSONRequestBody defines body for CreateWebhookSubscription for application/json ContentType.
+type CreateWebhookSubscriptionJSONRequestBody = WebhookSubscriptionCreate
+
+// UpdateWebhookSubscriptionJSONRequestBody defines body for UpdateWebhookSubscription for application/json ContentType.
+type UpdateWebhookSubscriptionJSONRequestBody = WebhookSubscriptionUpdate
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// List audit log entries
+	// (GET /api/v1/audit)
+	ListAuditLogs(w http.ResponseWriter, r *http.Request, params ListAuditLogsParams)
+	// List database nodes
+	// (GET /api/v1/database-nodes)
+	ListDatabaseNodes(w http.ResponseWriter, r *http.Request, params ListDatabaseNodesParams)
+	// Register database node
+	// (POST /api/v1/database-nodes)
+	RegisterDatabaseNode(w http.ResponseWriter, r *http.Request)
+	// Get database node
+	// (GET /api/v1/database-nodes/{node_id})
+	GetDatabaseNode(w http.ResponseWriter, r *http.Request, nodeId int64)
+	// Activate database node
+	// (POST /api/v1/database-nodes/{node_id}/activate)
+	ActivateDatabaseNode(w http.ResponseWriter, r *http.Request, nodeId int64)
+	// Decommission database node
+	// (POST /api/v1/database-nodes/{node_id}/decommission)
+	DecommissionDatabaseNode(w http.ResponseWriter, r *http.Request, nodeId int64)
+	// Drain database node
+	// (POST /api/v1/database-nodes/{node_id}/drain)
+	DrainDatabaseNode(w http.ResponseWriter, r *http.Request, nodeId int64)
+	// Start database node maintenance
+	// (POST /api/v1/database-nodes/{node_id}/maintenance)
+	StartDatabaseNodeMaintenance(w http.ResponseWriter, r *http.Request, nodeId int64)
+	// List isolation groups
+	// (GET /api/v1/isolation-groups)
+	ListIsolationGroups(w http.ResponseWriter, r *http.Request, params ListIsolationGroupsParams)
+	// Create isolation group
+	// (POST /api/v1/isolation-groups)
+	CreateIsolationGroup(w http.ResponseWriter, r *http.Request)
+	// Delete isolation group
+	// (DELETE /api/v1/isolation-groups/{group_id})
+	DeleteIsolationGroup(w http.ResponseWriter, r *http.Request, groupId int64)
+	// Get isolation group
+	// (GET /api/v1/isolation-groups/{group_id})
+	GetIsolationGroup(w http.ResponseWriter, r *http.Request, groupId int64)
+	// List operations
+	// (GET /api/v1/operations)
+	ListOperations(w http.ResponseWriter, r *http.Request, params ListOperationsParams)
+	// Get operation details
+	// (GET /api/v1/operations/{operation_id})
+	GetOperation(w http.ResponseWriter, r *http.Request, operationId int64)
+	// Cancel operation
+	// (POST /api/v1/operations/{operation_id}/cancel)
+	CancelOperation(w http.ResponseWriter, r *http.Request, operationId int64)
+	// Stream operation events
+	// (GET /api/v1/operations/{operation_id}/events)
+	StreamOperationEvents(w http.ResponseWriter, r *http.Request, operationId int64, params StreamOperationEventsParams)
+	// Pause operation
+	// (POST /api/v1/operations/{operation_id}/pause)
+	PauseOperation(w http.ResponseWriter, r *http.Request, operationId int64)
+	// Resume operation
+	// (POST /api/v1/operations/{operation_id}/resume)
+	ResumeOperation(w http.ResponseWriter, r *http.Request, operationId int64)
+	// Retry operation
+	// (POST /api/v1/operations/{operation_id}/retry)
+	RetryOperation(w http.ResponseWriter, r *http.Request, operationId int64)
+	// List tenants
+	// (GET /api/v1/tenants)
+	ListTenants(w http.ResponseWriter, r *http.Request, params ListTenantsParams)
+	// Create a new tenant
+	// (POST /api/v1/tenants)
+	CreateTenant(w http.ResponseWriter, r *http.Request, params CreateTenantParams)
+	// Delete tenant
+	// (DELETE /api/v1/tenants/{tenant_id})
+	DeleteTenant(w http.ResponseWriter, r *http.Request, tenantId int64, params DeleteTenantParams)
+	// Get tenant details
+	// (GET /api/v1/tenants/{tenant_id})
+	GetTenant(w http.ResponseWriter, r *http.Request, tenantId int64)
+	// Change tenant isolation group
+	// (PUT /api/v1/tenants/{tenant_id}/isolation-group)
+	ChangeTenantIsolationGroup(w http.ResponseWriter, r *http.Request, tenantId int64)
+	// Migrate tenant
+	// (POST /api/v1/tenants/{tenant_id}/migrate)
+	MigrateTenant(w http.ResponseWriter, r *http.Request, tenantId int64)
+	// List tenant operations
+	// (GET /api/v1/tenants/{tenant_id}/operations)
+	ListTenantOperations(w http.ResponseWriter, r *http.Request, tenantId int64, params ListTenantOperationsParams)
+	// List tenant resources
+	// (GET /api/v1/tenants/{tenant_id}/resources)
+	ListTenantResources(w http.ResponseWriter, r *http.Request, tenantId int64, params ListTenantResourcesParams)
+	// Resume tenant
+	// (POST /api/v1/tenants/{tenant_id}/resume)
+	ResumeTenant(w http.ResponseWriter, r *http.Request, tenantId int64)
+	// Suspend tenant
+	// (POST /api/v1/tenants/{tenant_id}/suspend)
+	SuspendTenant(w http.ResponseWriter, r *http.Request, tenantId int64)
+	// List tenant suspensions
+	// (GET /api/v1/tenants/{tenant_id}/suspensions)
+	ListTenantSuspensions(w http.ResponseWriter, r *http.Request, tenantId int64)
+	// Change tenant tier
+	// (PATCH /api/v1/tenants/{tenant_id}/tier)
+	ChangeTenantTier(w http.ResponseWriter, r *http.Request, tenantId int64)
+	// List webhook subscriptions
+	// (GET /api/v1/webhooks)
+	ListWebhookSubscriptions(w http.ResponseWriter, r *http.Request, params ListWebhookSubscriptionsParams)
+	// Create webhook subscription
+	// (POST /api/v1/webhooks)
+	CreateWebhookSubscription(w http.ResponseWriter, r *http.Request)
+	// Delete webhook subscription
+	// (DELETE /api/v1/webhooks/{subscription_id})
+	DeleteWebhookSubscription(w http.ResponseWriter, r *http.Request, subscriptionId int64)
+	// Get webhook subscription
+	// (GET /api/v1/webhooks/{subscription_id})
+	GetWebhookSubscription(w http.ResponseWriter, r *http.Request, subscriptionId int64)
+	// Update webhook subscription
+	// (PATCH /api/v1/webhooks/{subscription_id})
+	UpdateWebhookSubscription(w http.ResponseWriter, r *http.Request, subscriptionId int64)
+	// List webhook deliveries
+	// (GET /api/v1/webhooks/{subscription_id}/deliveries)
+	ListWebhookDeliveries(w http.ResponseWriter, r *http.Request, subscriptionId int64, params ListWebhookDeliveriesParams)
+	// Redeliver webhook delivery
+	// (POST /api/v1/webhooks/{subscription_id}/deliveries/{delivery_id}/redeliver)
+	RedeliverWebhookDelivery(w http.ResponseWriter, r *http.Request, subscriptionId int64, deliveryId int64)
 }
 
-type CreateTenantResponseObject interface {
-	VisitCreateTenantResponse(w http.ResponseWriter) error
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
 }
 
-type CreateTenant202JSONResponse struct {
-	// Links HATEOAS links to related resources
-	Links Links  `json:"_links"`
-	Name  string `json:"name"`
+type MiddlewareFunc func(http.Handler) http.Handler
 
-	// OperationId ID of the created async operation
-	OperationId int64 `json:"operation_id"`
+// ListAuditLogs operation middleware
+func (siw *ServerInterfaceWrapper) ListAuditLogs(w http.ResponseWriter, r *http.Request) {
 
-	// Status Status of an asynchronous operation
-	Status   OperationStatus `json:"status"`
-	TenantId int64           `json:"tenant_id"`
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAuditLogsParams
+
+	// ------------- Optional query parameter "actor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actor", r.URL.Query(), &params.Actor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "action", r.URL.Query(), &params.Action)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "action", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tenant_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tenant_id", r.URL.Query(), &params.TenantId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenant_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "operation_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "operation_id", r.URL.Query(), &params.OperationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "operation_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", r.URL.Query(), &params.Until)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "until", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAuditLogs(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-func (response CreateTenant202JSONResponse) VisitCreateTenantResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(202)
+// ListDatabaseNodes operation middleware
+func (siw *ServerInterfaceWrapper) ListDatabaseNodes(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListDatabaseNodesParams
+
+	// ------------- Optional query parameter "region" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "region", r.URL.Query(), &params.Region)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "region", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "node_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "node_type", r.URL.Query(), &params.NodeType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "node_type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListDatabaseNodes(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RegisterDatabaseNode operation middleware
+func (siw *ServerInterfaceWrapper) RegisterDatabaseNode(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RegisterDatabaseNode(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetDatabaseNode operation middleware
+func (siw *ServerInterfaceWrapper) GetDatabaseNode(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "node_id" -------------
+	var nodeId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "node_id", r.PathValue("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "node_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDatabaseNode(w, r, nodeId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ActivateDatabaseNode operation middleware
+func (siw *ServerInterfaceWrapper) ActivateDatabaseNode(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "node_id" -------------
+	var nodeId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "node_id", r.PathValue("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "node_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ActivateDatabaseNode(w, r, nodeId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DecommissionDatabaseNode operation middleware
+func (siw *ServerInterfaceWrapper) DecommissionDatabaseNode(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "node_id" -------------
+	var nodeId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "node_id", r.PathValue("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "node_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DecommissionDatabaseNode(w, r, nodeId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DrainDatabaseNode operation middleware
+func (siw *ServerInterfaceWrapper) DrainDatabaseNode(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "node_id" -------------
+	var nodeId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "node_id", r.PathValue("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "node_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DrainDatabaseNode(w, r, nodeId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StartDatabaseNodeMaintenance operation middleware
+func (siw *ServerInterfaceWrapper) StartDatabaseNodeMaintenance(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "node_id" -------------
+	var nodeId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "node_id", r.PathValue("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "node_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StartDatabaseNodeMaintenance(w, r, nodeId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListIsolationGroups operation middleware
+func (siw *ServerInterfaceWrapper) ListIsolationGroups(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListIsolationGroupsParams
+
+	// ------------- Optional query parameter "region" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "region", r.URL.Query(), &params.Region)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "region", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListIsolationGroups(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateIsolationGroup operation middleware
+func (siw *ServerInterfaceWrapper) CreateIsolationGroup(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateIsolationGroup(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteIsolationGroup operation middleware
+func (siw *ServerInterfaceWrapper) DeleteIsolationGroup(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "group_id" -------------
+	var groupId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "group_id", r.PathValue("group_id"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "group_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteIsolationGroup(w, r, groupId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetIsolationGroup operation middleware
+func (siw *ServerInterfaceWrapper) GetIsolationGroup(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "group_id" -------------
+	var groupId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "group_id", r.PathValue("group_id"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "group_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetIsolationGroup(w, r, groupId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListOperations operation middleware
+func (siw *ServerInterfaceWrapper) ListOperations(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListOperationsParams
+
+	// ------------- Optional query parameter "tenant_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tenant_id", r.URL.Query(), &params.TenantId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenant_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "operation_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "operation_type", r.URL.Query(), &params.OperationType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "operation_type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "created_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_by", r.URL.Query(), &params.CreatedBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_by", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "created_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_after", r.URL.Query(), &params.CreatedAfter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_after", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "created_before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_before", r.URL.Query(), &params.CreatedBefore)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_before", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListOperations(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOperation operation middleware
+func (siw *ServerInterfaceWrapper) GetOperation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "operation_id" -------------
+	var operationId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "operation_id", r.PathValue("operation_id"), &operationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "operation_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOperation(w, r, operationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CancelOperation operation middleware
+func (siw *ServerInterfaceWrapper) CancelOperation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "operation_id" -------------
+	var operationId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "operation_id", r.PathValue("operation_id"), &operationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "operation_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CancelOperation(w, r, operationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StreamOperationEvents operation middleware
+func (siw *ServerInterfaceWrapper) StreamOperationEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "operation_id" -------------
+	var operationId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "operation_id", r.PathValue("operation_id"), &operationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "operation_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamOperationEventsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID int64
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Last-Event-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Last-Event-ID", Err: err})
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StreamOperationEvents(w, r, operationId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PauseOperation operation middleware
+func (siw *ServerInterfaceWrapper) PauseOperation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "operation_id" -------------
+	var operationId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "operation_id", r.PathValue("operation_id"), &operationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "operation_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PauseOperation(w, r, operationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ResumeOperation operation middleware
+func (siw *ServerInterfaceWrapper) ResumeOperation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "operation_id" -------------
+	var operationId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "operation_id", r.PathValue("operation_id"), &operationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "operation_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ResumeOperation(w, r, operationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RetryOperation operation middleware
+func (siw *ServerInterfaceWrapper) RetryOperation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "operation_id" -------------
+	var operationId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "operation_id", r.PathValue("operation_id"), &operationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "operation_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RetryOperation(w, r, operationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTenants operation middleware
+func (siw *ServerInterfaceWrapper) ListTenants(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTenantsParams
+
+	// ------------- Optional query parameter "region" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "region", r.URL.Query(), &params.Region)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "region", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tier" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tier", r.URL.Query(), &params.Tier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tier", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "isolation_group_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "isolation_group_id", r.URL.Query(), &params.IsolationGroupId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "isolation_group_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "name_prefix" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name_prefix", r.URL.Query(), &params.NamePrefix)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name_prefix", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", r.URL.Query(), &params.Order)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTenants(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateTenant operation middleware
+func (siw *ServerInterfaceWrapper) CreateTenant(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateTenantParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTenant(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteTenant operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTenant(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenant_id" -------------
+	var tenantId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant_id", r.PathValue("tenant_id"), &tenantId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenant_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTenantParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTenant(w, r, tenantId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTenant operation middleware
+func (siw *ServerInterfaceWrapper) GetTenant(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenant_id" -------------
+	var tenantId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant_id", r.PathValue("tenant_id"), &tenantId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenant_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTenant(w, r, tenantId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ChangeTenantIsolationGroup operation middleware
+func (siw *ServerInterfaceWrapper) ChangeTenantIsolationGroup(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenant_id" -------------
+	var tenantId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant_id", r.PathValue("tenant_id"), &tenantId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenant_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ChangeTenantIsolationGroup(w, r, tenantId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// MigrateTenant operation middleware
+func (siw *ServerInterfaceWrapper) MigrateTenant(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenant_id" -------------
+	var tenantId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant_id", r.PathValue("tenant_id"), &tenantId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenant_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.MigrateTenant(w, r, tenantId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTenantOperations operation middleware
+func (siw *ServerInterfaceWrapper) ListTenantOperations(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenant_id" -------------
+	var tenantId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant_id", r.PathValue("tenant_id"), &tenantId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenant_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTenantOperationsParams
+
+	// ------------- Optional query parameter "operation_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "operation_type", r.URL.Query(), &params.OperationType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "operation_type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTenantOperations(w, r, tenantId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTenantResources operation middleware
+func (siw *ServerInterfaceWrapper) ListTenantResources(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenant_id" -------------
+	var tenantId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant_id", r.PathValue("tenant_id"), &tenantId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenant_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTenantResourcesParams
+
+	// ------------- Optional query parameter "resource_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "resource_type", r.URL.Query(), &params.ResourceType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resource_type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTenantResources(w, r, tenantId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ResumeTenant operation middleware
+func (siw *ServerInterfaceWrapper) ResumeTenant(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenant_id" -------------
+	var tenantId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant_id", r.PathValue("tenant_id"), &tenantId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenant_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ResumeTenant(w, r, tenantId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SuspendTenant operation middleware
+func (siw *ServerInterfaceWrapper) SuspendTenant(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenant_id" -------------
+	var tenantId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant_id", r.PathValue("tenant_id"), &tenantId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenant_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SuspendTenant(w, r, tenantId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTenantSuspensions operation middleware
+func (siw *ServerInterfaceWrapper) ListTenantSuspensions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenant_id" -------------
+	var tenantId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant_id", r.PathValue("tenant_id"), &tenantId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenant_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTenantSuspensions(w, r, tenantId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ChangeTenantTier operation middleware
+func (siw *ServerInterfaceWrapper) ChangeTenantTier(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenant_id" -------------
+	var tenantId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant_id", r.PathValue("tenant_id"), &tenantId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenant_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ChangeTenantTier(w, r, tenantId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListWebhookSubscriptions operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhookSubscriptions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWebhookSubscriptionsParams
+
+	// ------------- Optional query parameter "tenant_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tenant_id", r.URL.Query(), &params.TenantId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenant_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWebhookSubscriptions(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateWebhookSubscription operation middleware
+func (siw *ServerInterfaceWrapper) CreateWebhookSubscription(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateWebhookSubscription(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteWebhookSubscription operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhookSubscription(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "subscription_id" -------------
+	var subscriptionId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subscription_id", r.PathValue("subscription_id"), &subscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subscription_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteWebhookSubscription(w, r, subscriptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWebhookSubscription operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhookSubscription(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "subscription_id" -------------
+	var subscriptionId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subscription_id", r.PathValue("subscription_id"), &subscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subscription_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebhookSubscription(w, r, subscriptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateWebhookSubscription operation middleware
+func (siw *ServerInterfaceWrapper) UpdateWebhookSubscription(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "subscription_id" -------------
+	var subscriptionId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subscription_id", r.PathValue("subscription_id"), &subscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subscription_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateWebhookSubscription(w, r, subscriptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListWebhookDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "subscription_id" -------------
+	var subscriptionId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subscription_id", r.PathValue("subscription_id"), &subscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subscription_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWebhookDeliveriesParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWebhookDeliveries(w, r, subscriptionId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RedeliverWebhookDelivery operation middleware
+func (siw *ServerInterfaceWrapper) RedeliverWebhookDelivery(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "subscription_id" -------------
+	var subscriptionId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subscription_id", r.PathValue("subscription_id"), &subscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subscription_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "delivery_id" -------------
+	var deliveryId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "delivery_id", r.PathValue("delivery_id"), &deliveryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "delivery_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RedeliverWebhookDelivery(w, r, subscriptionId, deliveryId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
+}
+
+// ServeMux is an abstraction of http.ServeMux.
+type ServeMux interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+}
+
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
+
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/audit", wrapper.ListAuditLogs)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/database-nodes", wrapper.ListDatabaseNodes)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/database-nodes", wrapper.RegisterDatabaseNode)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/database-nodes/{node_id}", wrapper.GetDatabaseNode)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/database-nodes/{node_id}/activate", wrapper.ActivateDatabaseNode)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/database-nodes/{node_id}/decommission", wrapper.DecommissionDatabaseNode)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/database-nodes/{node_id}/drain", wrapper.DrainDatabaseNode)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/database-nodes/{node_id}/maintenance", wrapper.StartDatabaseNodeMaintenance)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/isolation-groups", wrapper.ListIsolationGroups)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/isolation-groups", wrapper.CreateIsolationGroup)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/v1/isolation-groups/{group_id}", wrapper.DeleteIsolationGroup)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/isolation-groups/{group_id}", wrapper.GetIsolationGroup)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/operations", wrapper.ListOperations)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/operations/{operation_id}", wrapper.GetOperation)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/operations/{operation_id}/cancel", wrapper.CancelOperation)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/operations/{operation_id}/events", wrapper.StreamOperationEvents)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/operations/{operation_id}/pause", wrapper.PauseOperation)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/operations/{operation_id}/resume", wrapper.ResumeOperation)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/operations/{operation_id}/retry", wrapper.RetryOperation)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/tenants", wrapper.ListTenants)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/tenants", wrapper.CreateTenant)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/v1/tenants/{tenant_id}", wrapper.DeleteTenant)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/tenants/{tenant_id}", wrapper.GetTenant)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/v1/tenants/{tenant_id}/isolation-group", wrapper.ChangeTenantIsolationGroup)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/tenants/{tenant_id}/migrate", wrapper.MigrateTenant)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/tenants/{tenant_id}/operations", wrapper.ListTenantOperations)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/tenants/{tenant_id}/resources", wrapper.ListTenantResources)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/tenants/{tenant_id}/resume", wrapper.ResumeTenant)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/tenants/{tenant_id}/suspend", wrapper.SuspendTenant)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/tenants/{tenant_id}/suspensions", wrapper.ListTenantSuspensions)
+	m.HandleFunc("PATCH "+options.BaseURL+"/api/v1/tenants/{tenant_id}/tier", wrapper.ChangeTenantTier)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/webhooks", wrapper.ListWebhookSubscriptions)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/webhooks", wrapper.CreateWebhookSubscription)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/v1/webhooks/{subscription_id}", wrapper.DeleteWebhookSubscription)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/webhooks/{subscription_id}", wrapper.GetWebhookSubscription)
+	m.HandleFunc("PATCH "+options.BaseURL+"/api/v1/webhooks/{subscription_id}", wrapper.UpdateWebhookSubscription)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/webhooks/{subscription_id}/deliveries", wrapper.ListWebhookDeliveries)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/webhooks/{subscription_id}/deliveries/{delivery_id}/redeliver", wrapper.RedeliverWebhookDelivery)
+
+	return m
+}
+
+type ListAuditLogsRequestObject struct {
+	Params ListAuditLogsParams
+}
+
+type ListAuditLogsResponseObject interface {
+	VisitListAuditLogsResponse(w http.ResponseWriter) error
+}
+
+type ListAuditLogs200JSONResponse AuditLogList
+
+func (response ListAuditLogs200JSONResponse) VisitListAuditLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAuditLogs400JSONResponse Error
+
+func (response ListAuditLogs400JSONResponse) VisitListAuditLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAuditLogs401Response struct {
+}
+
+func (response ListAuditLogs401Response) VisitListAuditLogsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListAuditLogs403JSONResponse Error
+
+func (response ListAuditLogs403JSONResponse) VisitListAuditLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAuditLogs500JSONResponse Error
+
+func (response ListAuditLogs500JSONResponse) VisitListAuditLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDatabaseNodesRequestObject struct {
+	Params ListDatabaseNodesParams
+}
+
+type ListDatabaseNodesResponseObject interface {
+	VisitListDatabaseNodesResponse(w http.ResponseWriter) error
+}
+
+type ListDatabaseNodes200JSONResponse DatabaseNodeList
+
+func (response ListDatabaseNodes200JSONResponse) VisitListDatabaseNodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDatabaseNodes400JSONResponse Error
+
+func (response ListDatabaseNodes400JSONResponse) VisitListDatabaseNodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDatabaseNodes401Response struct {
+}
+
+func (response ListDatabaseNodes401Response) VisitListDatabaseNodesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListDatabaseNodes403JSONResponse Error
+
+func (response ListDatabaseNodes403JSONResponse) VisitListDatabaseNodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDatabaseNodes500JSONResponse Error
+
+func (response ListDatabaseNodes500JSONResponse) VisitListDatabaseNodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterDatabaseNodeRequestObject struct {
+	Body *RegisterDatabaseNodeJSONRequestBody
+}
+
+type RegisterDatabaseNodeResponseObject interface {
+	VisitRegisterDatabaseNodeResponse(w http.ResponseWriter) error
+}
+
+type RegisterDatabaseNode201JSONResponse DatabaseNodeResponse
+
+func (response RegisterDatabaseNode201JSONResponse) VisitRegisterDatabaseNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterDatabaseNode400JSONResponse Error
+
+func (response RegisterDatabaseNode400JSONResponse) VisitRegisterDatabaseNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterDatabaseNode401Response struct {
+}
+
+func (response RegisterDatabaseNode401Response) VisitRegisterDatabaseNodeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type RegisterDatabaseNode403JSONResponse Error
+
+func (response RegisterDatabaseNode403JSONResponse) VisitRegisterDatabaseNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterDatabaseNode409JSONResponse Error
+
+func (response RegisterDatabaseNode409JSONResponse) VisitRegisterDatabaseNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterDatabaseNode500JSONResponse Error
+
+func (response RegisterDatabaseNode500JSONResponse) VisitRegisterDatabaseNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDatabaseNodeRequestObject struct {
+	NodeId int64 `json:"node_id"`
+}
+
+type GetDatabaseNodeResponseObject interface {
+	VisitGetDatabaseNodeResponse(w http.ResponseWriter) error
+}
+
+type GetDatabaseNode200JSONResponse DatabaseNodeResponse
+
+func (response GetDatabaseNode200JSONResponse) VisitGetDatabaseNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDatabaseNode401Response struct {
+}
+
+func (response GetDatabaseNode401Response) VisitGetDatabaseNodeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetDatabaseNode403JSONResponse Error
+
+func (response GetDatabaseNode403JSONResponse) VisitGetDatabaseNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDatabaseNode404JSONResponse Error
+
+func (response GetDatabaseNode404JSONResponse) VisitGetDatabaseNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDatabaseNode500JSONResponse Error
+
+func (response GetDatabaseNode500JSONResponse) VisitGetDatabaseNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ActivateDatabaseNodeRequestObject struct {
+	NodeId int64 `json:"node_id"`
+}
+
+type ActivateDatabaseNodeResponseObject interface {
+	VisitActivateDatabaseNodeResponse(w http.ResponseWriter) error
+}
+
+type ActivateDatabaseNode200JSONResponse DatabaseNodeResponse
+
+func (response ActivateDatabaseNode200JSONResponse) VisitActivateDatabaseNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ActivateDatabaseNode401Response struct {
+}
+
+func (response ActivateDatabaseNode401Response) VisitActivateDatabaseNodeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ActivateDatabaseNode403JSONResponse Error
+
+func (response ActivateDatabaseNode403JSONResponse) VisitActivateDatabaseNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ActivateDatabaseNode404JSONResponse Error
+
+func (response ActivateDatabaseNode404JSONResponse) VisitActivateDatabaseNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ActivateDatabaseNode409JSONResponse Error
+
+func (response ActivateDatabaseNode409JSONResponse) VisitActivateDatabaseNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ActivateDatabaseNode500JSONResponse Error
+
+func (response ActivateDatabaseNode500JSONResponse) VisitActivateDatabaseNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DecommissionDatabaseNodeRequestObject struct {
+	NodeId int64 `json:"node_id"`
+}
+
+type DecommissionDatabaseNodeResponseObject interface {
+	VisitDecommissionDatabaseNodeResponse(w http.ResponseWriter) error
+}
+
+type DecommissionDatabaseNode200JSONResponse DatabaseNodeResponse
+
+func (response DecommissionDatabaseNode200JSONResponse) VisitDecommissionDatabaseNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DecommissionDatabaseNode401Response struct {
+}
+
+func (response DecommissionDatabaseNode401Response) VisitDecommissionDatabaseNodeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DecommissionDatabaseNode403JSONResponse Error
+
+func (response DecommissionDatabaseNode403JSONResponse) VisitDecommissionDatabaseNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DecommissionDatabaseNode404JSONResponse Error
+
+func (response DecommissionDatabaseNode404JSONResponse) VisitDecommissionDatabaseNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DecommissionDatabaseNode409JSONResponse Error
+
+func (response DecommissionDatabaseNode409JSONResponse) VisitDecommissionDatabaseNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DecommissionDatabaseNode500JSONResponse Error
+
+func (response DecommissionDatabaseNode500JSONResponse) VisitDecommissionDatabaseNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DrainDatabaseNodeRequestObject struct {
+	NodeId int64 `json:"node_id"`
+}
+
+type DrainDatabaseNodeResponseObject interface {
+	VisitDrainDatabaseNodeResponse(w http.ResponseWriter) error
+}
+
+type DrainDatabaseNode200JSONResponse DatabaseNodeResponse
+
+func (response DrainDatabaseNode200JSONResponse) VisitDrainDatabaseNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DrainDatabaseNode401Response struct {
+}
+
+func (response DrainDatabaseNode401Response) VisitDrainDatabaseNodeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DrainDatabaseNode403JSONResponse Error
+
+func (response DrainDatabaseNode403JSONResponse) VisitDrainDatabaseNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DrainDatabaseNode404JSONResponse Error
+
+func (response DrainDatabaseNode404JSONResponse) VisitDrainDatabaseNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DrainDatabaseNode409JSONResponse Error
+
+func (response DrainDatabaseNode409JSONResponse) VisitDrainDatabaseNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DrainDatabaseNode500JSONResponse Error
+
+func (response DrainDatabaseNode500JSONResponse) VisitDrainDatabaseNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartDatabaseNodeMaintenanceRequestObject struct {
+	NodeId int64 `json:"node_id"`
+}
+
+type StartDatabaseNodeMaintenanceResponseObject interface {
+	VisitStartDatabaseNodeMaintenanceResponse(w http.ResponseWriter) error
+}
+
+type StartDatabaseNodeMaintenance200JSONResponse DatabaseNodeResponse
+
+func (response StartDatabaseNodeMaintenance200JSONResponse) VisitStartDatabaseNodeMaintenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartDatabaseNodeMaintenance401Response struct {
+}
+
+func (response StartDatabaseNodeMaintenance401Response) VisitStartDatabaseNodeMaintenanceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type StartDatabaseNodeMaintenance403JSONResponse Error
+
+func (response StartDatabaseNodeMaintenance403JSONResponse) VisitStartDatabaseNodeMaintenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartDatabaseNodeMaintenance404JSONResponse Error
+
+func (response StartDatabaseNodeMaintenance404JSONResponse) VisitStartDatabaseNodeMaintenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartDatabaseNodeMaintenance409JSONResponse Error
+
+func (response StartDatabaseNodeMaintenance409JSONResponse) VisitStartDatabaseNodeMaintenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartDatabaseNodeMaintenance500JSONResponse Error
+
+func (response StartDatabaseNodeMaintenance500JSONResponse) VisitStartDatabaseNodeMaintenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListIsolationGroupsRequestObject struct {
+	Params ListIsolationGroupsParams
+}
+
+type ListIsolationGroupsResponseObject interface {
+	VisitListIsolationGroupsResponse(w http.ResponseWriter) error
+}
+
+type ListIsolationGroups200JSONResponse IsolationGroupList
+
+func (response ListIsolationGroups200JSONResponse) VisitListIsolationGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListIsolationGroups400JSONResponse Error
+
+func (response ListIsolationGroups400JSONResponse) VisitListIsolationGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListIsolationGroups401Response struct {
+}
+
+func (response ListIsolationGroups401Response) VisitListIsolationGroupsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListIsolationGroups403JSONResponse Error
+
+func (response ListIsolationGroups403JSONResponse) VisitListIsolationGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListIsolationGroups500JSONResponse Error
+
+func (response ListIsolationGroups500JSONResponse) VisitListIsolationGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateIsolationGroupRequestObject struct {
+	Body *CreateIsolationGroupJSONRequestBody
+}
+
+type CreateIsolationGroupResponseObject interface {
+	VisitCreateIsolationGroupResponse(w http.ResponseWriter) error
+}
+
+type CreateIsolationGroup201JSONResponse IsolationGroupResponse
+
+func (response CreateIsolationGroup201JSONResponse) VisitCreateIsolationGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateIsolationGroup400JSONResponse Error
+
+func (response CreateIsolationGroup400JSONResponse) VisitCreateIsolationGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateIsolationGroup401Response struct {
+}
+
+func (response CreateIsolationGroup401Response) VisitCreateIsolationGroupResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateIsolationGroup403JSONResponse Error
+
+func (response CreateIsolationGroup403JSONResponse) VisitCreateIsolationGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateIsolationGroup409JSONResponse Error
+
+func (response CreateIsolationGroup409JSONResponse) VisitCreateIsolationGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateIsolationGroup500JSONResponse Error
+
+func (response CreateIsolationGroup500JSONResponse) VisitCreateIsolationGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteIsolationGroupRequestObject struct {
+	GroupId int64 `json:"group_id"`
+}
+
+type DeleteIsolationGroupResponseObject interface {
+	VisitDeleteIsolationGroupResponse(w http.ResponseWriter) error
+}
+
+type DeleteIsolationGroup204Response struct {
+}
+
+func (response DeleteIsolationGroup204Response) VisitDeleteIsolationGroupResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteIsolationGroup401Response struct {
+}
+
+func (response DeleteIsolationGroup401Response) VisitDeleteIsolationGroupResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteIsolationGroup403JSONResponse Error
+
+func (response DeleteIsolationGroup403JSONResponse) VisitDeleteIsolationGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteIsolationGroup404JSONResponse Error
+
+func (response DeleteIsolationGroup404JSONResponse) VisitDeleteIsolationGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteIsolationGroup409JSONResponse Error
+
+func (response DeleteIsolationGroup409JSONResponse) VisitDeleteIsolationGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteIsolationGroup500JSONResponse Error
+
+func (response DeleteIsolationGroup500JSONResponse) VisitDeleteIsolationGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIsolationGroupRequestObject struct {
+	GroupId int64 `json:"group_id"`
+}
+
+type GetIsolationGroupResponseObject interface {
+	VisitGetIsolationGroupResponse(w http.ResponseWriter) error
+}
+
+type GetIsolationGroup200JSONResponse IsolationGroupResponse
+
+func (response GetIsolationGroup200JSONResponse) VisitGetIsolationGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIsolationGroup401Response struct {
+}
+
+func (response GetIsolationGroup401Response) VisitGetIsolationGroupResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetIsolationGroup403JSONResponse Error
+
+func (response GetIsolationGroup403JSONResponse) VisitGetIsolationGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIsolationGroup404JSONResponse Error
+
+func (response GetIsolationGroup404JSONResponse) VisitGetIsolationGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIsolationGroup500JSONResponse Error
+
+func (response GetIsolationGroup500JSONResponse) VisitGetIsolationGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOperationsRequestObject struct {
+	Params ListOperationsParams
+}
+
+type ListOperationsResponseObject interface {
+	VisitListOperationsResponse(w http.ResponseWriter) error
+}
+
+type ListOperations200JSONResponse OperationList
+
+func (response ListOperations200JSONResponse) VisitListOperationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOperations400JSONResponse Error
+
+func (response ListOperations400JSONResponse) VisitListOperationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOperations401Response struct {
+}
+
+func (response ListOperations401Response) VisitListOperationsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListOperations403JSONResponse Error
+
+func (response ListOperations403JSONResponse) VisitListOperationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOperations500JSONResponse Error
+
+func (response ListOperations500JSONResponse) VisitListOperationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOperationRequestObject struct {
+	OperationId int64 `json:"operation_id"`
+}
+
+type GetOperationResponseObject interface {
+	VisitGetOperationResponse(w http.ResponseWriter) error
+}
+
+type GetOperation200JSONResponse OperationResponse
+
+func (response GetOperation200JSONResponse) VisitGetOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOperation401Response struct {
+}
+
+func (response GetOperation401Response) VisitGetOperationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetOperation403JSONResponse Error
+
+func (response GetOperation403JSONResponse) VisitGetOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOperation404JSONResponse Error
+
+func (response GetOperation404JSONResponse) VisitGetOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOperation500JSONResponse Error
+
+func (response GetOperation500JSONResponse) VisitGetOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOperationRequestObject struct {
+	OperationId int64 `json:"operation_id"`
+	Body        *CancelOperationJSONRequestBody
+}
+
+type CancelOperationResponseObject interface {
+	VisitCancelOperationResponse(w http.ResponseWriter) error
+}
+
+type CancelOperation202JSONResponse AsyncOperation
+
+func (response CancelOperation202JSONResponse) VisitCancelOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOperation400JSONResponse Error
+
+func (response CancelOperation400JSONResponse) VisitCancelOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOperation401Response struct {
+}
+
+func (response CancelOperation401Response) VisitCancelOperationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CancelOperation403JSONResponse Error
+
+func (response CancelOperation403JSONResponse) VisitCancelOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOperation404JSONResponse Error
+
+func (response CancelOperation404JSONResponse) VisitCancelOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOperation409JSONResponse Error
+
+func (response CancelOperation409JSONResponse) VisitCancelOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOperation500JSONResponse Error
+
+func (response CancelOperation500JSONResponse) VisitCancelOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamOperationEventsRequestObject struct {
+	OperationId int64 `json:"operation_id"`
+	Params      StreamOperationEventsParams
+}
+
+type StreamOperationEventsResponseObject interface {
+	VisitStreamOperationEventsResponse(w http.ResponseWriter) error
+}
+
+type StreamOperationEvents200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response StreamOperationEvents200TexteventStreamResponse) VisitStreamOperationEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type StreamOperationEvents401Response struct {
+}
+
+func (response StreamOperationEvents401Response) VisitStreamOperationEventsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type StreamOperationEvents403JSONResponse Error
+
+func (response StreamOperationEvents403JSONResponse) VisitStreamOperationEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamOperationEvents404JSONResponse Error
+
+func (response StreamOperationEvents404JSONResponse) VisitStreamOperationEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamOperationEvents500JSONResponse Error
+
+func (response StreamOperationEvents500JSONResponse) VisitStreamOperationEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PauseOperationRequestObject struct {
+	OperationId int64 `json:"operation_id"`
+}
+
+type PauseOperationResponseObject interface {
+	VisitPauseOperationResponse(w http.ResponseWriter) error
+}
+
+type PauseOperation202JSONResponse AsyncOperation
+
+func (response PauseOperation202JSONResponse) VisitPauseOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PauseOperation401Response struct {
+}
+
+func (response PauseOperation401Response) VisitPauseOperationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PauseOperation403JSONResponse Error
+
+func (response PauseOperation403JSONResponse) VisitPauseOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PauseOperation404JSONResponse Error
+
+func (response PauseOperation404JSONResponse) VisitPauseOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PauseOperation409JSONResponse Error
+
+func (response PauseOperation409JSONResponse) VisitPauseOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PauseOperation500JSONResponse Error
+
+func (response PauseOperation500JSONResponse) VisitPauseOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeOperationRequestObject struct {
+	OperationId int64 `json:"operation_id"`
+}
+
+type ResumeOperationResponseObject interface {
+	VisitResumeOperationResponse(w http.ResponseWriter) error
+}
+
+type ResumeOperation202JSONResponse AsyncOperation
+
+func (response ResumeOperation202JSONResponse) VisitResumeOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeOperation401Response struct {
+}
+
+func (response ResumeOperation401Response) VisitResumeOperationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ResumeOperation403JSONResponse Error
+
+func (response ResumeOperation403JSONResponse) VisitResumeOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeOperation404JSONResponse Error
+
+func (response ResumeOperation404JSONResponse) VisitResumeOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeOperation409JSONResponse Error
+
+func (response ResumeOperation409JSONResponse) VisitResumeOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeOperation500JSONResponse Error
+
+func (response ResumeOperation500JSONResponse) VisitResumeOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryOperationRequestObject struct {
+	OperationId int64 `json:"operation_id"`
+}
+
+type RetryOperationResponseObject interface {
+	VisitRetryOperationResponse(w http.ResponseWriter) error
+}
+
+type RetryOperation202JSONResponse AsyncOperation
+
+func (response RetryOperation202JSONResponse) VisitRetryOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryOperation401Response struct {
+}
+
+func (response RetryOperation401Response) VisitRetryOperationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type RetryOperation403JSONResponse Error
+
+func (response RetryOperation403JSONResponse) VisitRetryOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryOperation404JSONResponse Error
+
+func (response RetryOperation404JSONResponse) VisitRetryOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryOperation409JSONResponse Error
+
+func (response RetryOperation409JSONResponse) VisitRetryOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryOperation500JSONResponse Error
+
+func (response RetryOperation500JSONResponse) VisitRetryOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTenantsRequestObject struct {
+	Params ListTenantsParams
+}
+
+type ListTenantsResponseObject interface {
+	VisitListTenantsResponse(w http.ResponseWriter) error
+}
+
+type ListTenants200JSONResponse TenantList
+
+func (response ListTenants200JSONResponse) VisitListTenantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTenants400JSONResponse Error
+
+func (response ListTenants400JSONResponse) VisitListTenantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTenants401Response struct {
+}
+
+func (response ListTenants401Response) VisitListTenantsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListTenants403JSONResponse Error
+
+func (response ListTenants403JSONResponse) VisitListTenantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTenants500JSONResponse Error
+
+func (response ListTenants500JSONResponse) VisitListTenantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTenantRequestObject struct {
+	Params CreateTenantParams
+	Body   *CreateTenantJSONRequestBody
+}
+
+type CreateTenantResponseObject interface {
+	VisitCreateTenantResponse(w http.ResponseWriter) error
+}
+
+type CreateTenant202JSONResponse struct {
+	// Links HATEOAS links to related resources
+	Links Links  `json:"_links"`
+	Name  string `json:"name"`
+
+	// OperationId ID of the created async operation
+	OperationId int64 `json:"operation_id"`
+
+	// Status Status of an asynchronous operation
+	Status   OperationStatus `json:"status"`
+	TenantId int64           `json:"tenant_id"`
+}
+
+func (response CreateTenant202JSONResponse) VisitCreateTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTenant400JSONResponse Error
+
+func (response CreateTenant400JSONResponse) VisitCreateTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTenant401Response struct {
+}
+
+func (response CreateTenant401Response) VisitCreateTenantResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateTenant403JSONResponse Error
+
+func (response CreateTenant403JSONResponse) VisitCreateTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTenant409JSONResponse Error
+
+func (response CreateTenant409JSONResponse) VisitCreateTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTenant422JSONResponse Error
+
+func (response CreateTenant422JSONResponse) VisitCreateTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTenant429JSONResponse Error
+
+func (response CreateTenant429JSONResponse) VisitCreateTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTenant500JSONResponse Error
+
+func (response CreateTenant500JSONResponse) VisitCreateTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTenantRequestObject struct {
+	TenantId int64 `json:"tenant_id"`
+	Params   DeleteTenantParams
+}
+
+type DeleteTenantResponseObject interface {
+	VisitDeleteTenantResponse(w http.ResponseWriter) error
+}
+
+type DeleteTenant202JSONResponse AsyncOperation
+
+func (response DeleteTenant202JSONResponse) VisitDeleteTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTenant400JSONResponse Error
+
+func (response DeleteTenant400JSONResponse) VisitDeleteTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTenant401Response struct {
+}
+
+func (response DeleteTenant401Response) VisitDeleteTenantResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteTenant403JSONResponse Error
+
+func (response DeleteTenant403JSONResponse) VisitDeleteTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTenant404JSONResponse Error
+
+func (response DeleteTenant404JSONResponse) VisitDeleteTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTenant409JSONResponse Error
+
+func (response DeleteTenant409JSONResponse) VisitDeleteTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTenant422JSONResponse Error
+
+func (response DeleteTenant422JSONResponse) VisitDeleteTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTenant500JSONResponse Error
+
+func (response DeleteTenant500JSONResponse) VisitDeleteTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTenantRequestObject struct {
+	TenantId int64 `json:"tenant_id"`
+}
+
+type GetTenantResponseObject interface {
+	VisitGetTenantResponse(w http.ResponseWriter) error
+}
+
+type GetTenant200JSONResponse TenantResponse
+
+func (response GetTenant200JSONResponse) VisitGetTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTenant401Response struct {
+}
+
+func (response GetTenant401Response) VisitGetTenantResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetTenant403JSONResponse Error
+
+func (response GetTenant403JSONResponse) VisitGetTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTenant404JSONResponse Error
+
+func (response GetTenant404JSONResponse) VisitGetTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTenant500JSONResponse Error
+
+func (response GetTenant500JSONResponse) VisitGetTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangeTenantIsolationGroupRequestObject struct {
+	TenantId int64 `json:"tenant_id"`
+	Body     *ChangeTenantIsolationGroupJSONRequestBody
+}
+
+type ChangeTenantIsolationGroupResponseObject interface {
+	VisitChangeTenantIsolationGroupResponse(w http.ResponseWriter) error
+}
+
+type ChangeTenantIsolationGroup200JSONResponse TenantResponse
+
+func (response ChangeTenantIsolationGroup200JSONResponse) VisitChangeTenantIsolationGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangeTenantIsolationGroup400JSONResponse Error
+
+func (response ChangeTenantIsolationGroup400JSONResponse) VisitChangeTenantIsolationGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangeTenantIsolationGroup401Response struct {
+}
+
+func (response ChangeTenantIsolationGroup401Response) VisitChangeTenantIsolationGroupResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ChangeTenantIsolationGroup403JSONResponse Error
+
+func (response ChangeTenantIsolationGroup403JSONResponse) VisitChangeTenantIsolationGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangeTenantIsolationGroup404JSONResponse Error
+
+func (response ChangeTenantIsolationGroup404JSONResponse) VisitChangeTenantIsolationGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangeTenantIsolationGroup409JSONResponse Error
+
+func (response ChangeTenantIsolationGroup409JSONResponse) VisitChangeTenantIsolationGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangeTenantIsolationGroup500JSONResponse Error
+
+func (response ChangeTenantIsolationGroup500JSONResponse) VisitChangeTenantIsolationGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MigrateTenantRequestObject struct {
+	TenantId int64 `json:"tenant_id"`
+	Body     *MigrateTenantJSONRequestBody
+}
+
+type MigrateTenantResponseObject interface {
+	VisitMigrateTenantResponse(w http.ResponseWriter) error
+}
+
+type MigrateTenant202JSONResponse AsyncOperation
+
+func (response MigrateTenant202JSONResponse) VisitMigrateTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MigrateTenant400JSONResponse Error
+
+func (response MigrateTenant400JSONResponse) VisitMigrateTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MigrateTenant401Response struct {
+}
+
+func (response MigrateTenant401Response) VisitMigrateTenantResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type MigrateTenant403JSONResponse Error
+
+func (response MigrateTenant403JSONResponse) VisitMigrateTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MigrateTenant404JSONResponse Error
+
+func (response MigrateTenant404JSONResponse) VisitMigrateTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MigrateTenant409JSONResponse Error
+
+func (response MigrateTenant409JSONResponse) VisitMigrateTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MigrateTenant500JSONResponse Error
+
+func (response MigrateTenant500JSONResponse) VisitMigrateTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTenantOperationsRequestObject struct {
+	TenantId int64 `json:"tenant_id"`
+	Params   ListTenantOperationsParams
+}
+
+type ListTenantOperationsResponseObject interface {
+	VisitListTenantOperationsResponse(w http.ResponseWriter) error
+}
+
+type ListTenantOperations200JSONResponse OperationList
+
+func (response ListTenantOperations200JSONResponse) VisitListTenantOperationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTenantOperations400JSONResponse Error
+
+func (response ListTenantOperations400JSONResponse) VisitListTenantOperationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTenantOperations401Response struct {
+}
+
+func (response ListTenantOperations401Response) VisitListTenantOperationsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListTenantOperations403JSONResponse Error
+
+func (response ListTenantOperations403JSONResponse) VisitListTenantOperationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTenantOperations500JSONResponse Error
+
+func (response ListTenantOperations500JSONResponse) VisitListTenantOperationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTenantResourcesRequestObject struct {
+	TenantId int64 `json:"tenant_id"`
+	Params   ListTenantResourcesParams
+}
+
+type ListTenantResourcesResponseObject interface {
+	VisitListTenantResourcesResponse(w http.ResponseWriter) error
+}
+
+type ListTenantResources200JSONResponse TenantResourceList
+
+func (response ListTenantResources200JSONResponse) VisitListTenantResourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTenantResources400JSONResponse Error
+
+func (response ListTenantResources400JSONResponse) VisitListTenantResourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTenantResources401Response struct {
+}
+
+func (response ListTenantResources401Response) VisitListTenantResourcesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListTenantResources403JSONResponse Error
+
+func (response ListTenantResources403JSONResponse) VisitListTenantResourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTenantResources404JSONResponse Error
+
+func (response ListTenantResources404JSONResponse) VisitListTenantResourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTenantResources500JSONResponse Error
+
+func (response ListTenantResources500JSONResponse) VisitListTenantResourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeTenantRequestObject struct {
+	TenantId int64 `json:"tenant_id"`
+	Body     *ResumeTenantJSONRequestBody
+}
+
+type ResumeTenantResponseObject interface {
+	VisitResumeTenantResponse(w http.ResponseWriter) error
+}
+
+type ResumeTenant202JSONResponse AsyncOperation
+
+func (response ResumeTenant202JSONResponse) VisitResumeTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeTenant400JSONResponse Error
+
+func (response ResumeTenant400JSONResponse) VisitResumeTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeTenant401Response struct {
+}
+
+func (response ResumeTenant401Response) VisitResumeTenantResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ResumeTenant403JSONResponse Error
+
+func (response ResumeTenant403JSONResponse) VisitResumeTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeTenant404JSONResponse Error
+
+func (response ResumeTenant404JSONResponse) VisitResumeTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeTenant409JSONResponse Error
+
+func (response ResumeTenant409JSONResponse) VisitResumeTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeTenant500JSONResponse Error
+
+func (response ResumeTenant500JSONResponse) VisitResumeTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SuspendTenantRequestObject struct {
+	TenantId int64 `json:"tenant_id"`
+	Body     *SuspendTenantJSONRequestBody
+}
+
+type SuspendTenantResponseObject interface {
+	VisitSuspendTenantResponse(w http.ResponseWriter) error
+}
+
+type SuspendTenant202JSONResponse AsyncOperation
+
+func (response SuspendTenant202JSONResponse) VisitSuspendTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SuspendTenant400JSONResponse Error
+
+func (response SuspendTenant400JSONResponse) VisitSuspendTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SuspendTenant401Response struct {
+}
+
+func (response SuspendTenant401Response) VisitSuspendTenantResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type SuspendTenant403JSONResponse Error
+
+func (response SuspendTenant403JSONResponse) VisitSuspendTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SuspendTenant404JSONResponse Error
+
+func (response SuspendTenant404JSONResponse) VisitSuspendTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SuspendTenant409JSONResponse Error
+
+func (response SuspendTenant409JSONResponse) VisitSuspendTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SuspendTenant500JSONResponse Error
+
+func (response SuspendTenant500JSONResponse) VisitSuspendTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTenantSuspensionsRequestObject struct {
+	TenantId int64 `json:"tenant_id"`
+}
+
+type ListTenantSuspensionsResponseObject interface {
+	VisitListTenantSuspensionsResponse(w http.ResponseWriter) error
+}
+
+type ListTenantSuspensions200JSONResponse TenantSuspensionList
+
+func (response ListTenantSuspensions200JSONResponse) VisitListTenantSuspensionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTenantSuspensions401Response struct {
+}
+
+func (response ListTenantSuspensions401Response) VisitListTenantSuspensionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListTenantSuspensions403JSONResponse Error
+
+func (response ListTenantSuspensions403JSONResponse) VisitListTenantSuspensionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTenantSuspensions404JSONResponse Error
+
+func (response ListTenantSuspensions404JSONResponse) VisitListTenantSuspensionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTenantSuspensions500JSONResponse Error
+
+func (response ListTenantSuspensions500JSONResponse) VisitListTenantSuspensionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangeTenantTierRequestObject struct {
+	TenantId int64 `json:"tenant_id"`
+	Body     *ChangeTenantTierJSONRequestBody
+}
+
+type ChangeTenantTierResponseObject interface {
+	VisitChangeTenantTierResponse(w http.ResponseWriter) error
+}
+
+type ChangeTenantTier202JSONResponse AsyncOperation
+
+func (response ChangeTenantTier202JSONResponse) VisitChangeTenantTierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangeTenantTier400JSONResponse Error
+
+func (response ChangeTenantTier400JSONResponse) VisitChangeTenantTierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangeTenantTier401Response struct {
+}
+
+func (response ChangeTenantTier401Response) VisitChangeTenantTierResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ChangeTenantTier403JSONResponse Error
+
+func (response ChangeTenantTier403JSONResponse) VisitChangeTenantTierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangeTenantTier404JSONResponse Error
+
+func (response ChangeTenantTier404JSONResponse) VisitChangeTenantTierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangeTenantTier409JSONResponse Error
+
+func (response ChangeTenantTier409JSONResponse) VisitChangeTenantTierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangeTenantTier500JSONResponse Error
+
+func (response ChangeTenantTier500JSONResponse) VisitChangeTenantTierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookSubscriptionsRequestObject struct {
+	Params ListWebhookSubscriptionsParams
+}
+
+type ListWebhookSubscriptionsResponseObject interface {
+	VisitListWebhookSubscriptionsResponse(w http.ResponseWriter) error
+}
+
+type ListWebhookSubscriptions200JSONResponse WebhookSubscriptionList
+
+func (response ListWebhookSubscriptions200JSONResponse) VisitListWebhookSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookSubscriptions401Response struct {
+}
+
+func (response ListWebhookSubscriptions401Response) VisitListWebhookSubscriptionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListWebhookSubscriptions403JSONResponse Error
+
+func (response ListWebhookSubscriptions403JSONResponse) VisitListWebhookSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookSubscriptions500JSONResponse Error
+
+func (response ListWebhookSubscriptions500JSONResponse) VisitListWebhookSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhookSubscriptionRequestObject struct {
+	Body *CreateWebhookSubscriptionJSONRequestBody
+}
+
+type CreateWebhookSubscriptionResponseObject interface {
+	VisitCreateWebhookSubscriptionResponse(w http.ResponseWriter) error
+}
+
+type CreateWebhookSubscription201JSONResponse WebhookSubscriptionResponse
+
+func (response CreateWebhookSubscription201JSONResponse) VisitCreateWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhookSubscription400JSONResponse Error
+
+func (response CreateWebhookSubscription400JSONResponse) VisitCreateWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhookSubscription401Response struct {
+}
+
+func (response CreateWebhookSubscription401Response) VisitCreateWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateWebhookSubscription403JSONResponse Error
+
+func (response CreateWebhookSubscription403JSONResponse) VisitCreateWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhookSubscription404JSONResponse Error
+
+func (response CreateWebhookSubscription404JSONResponse) VisitCreateWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhookSubscription500JSONResponse Error
+
+func (response CreateWebhookSubscription500JSONResponse) VisitCreateWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhookSubscriptionRequestObject struct {
+	SubscriptionId int64 `json:"subscription_id"`
+}
+
+type DeleteWebhookSubscriptionResponseObject interface {
+	VisitDeleteWebhookSubscriptionResponse(w http.ResponseWriter) error
+}
+
+type DeleteWebhookSubscription204Response struct {
+}
+
+func (response DeleteWebhookSubscription204Response) VisitDeleteWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteWebhookSubscription401Response struct {
+}
+
+func (response DeleteWebhookSubscription401Response) VisitDeleteWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteWebhookSubscription403JSONResponse Error
+
+func (response DeleteWebhookSubscription403JSONResponse) VisitDeleteWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhookSubscription404JSONResponse Error
+
+func (response DeleteWebhookSubscription404JSONResponse) VisitDeleteWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhookSubscription500JSONResponse Error
+
+func (response DeleteWebhookSubscription500JSONResponse) VisitDeleteWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhookSubscriptionRequestObject struct {
+	SubscriptionId int64 `json:"subscription_id"`
+}
+
+type GetWebhookSubscriptionResponseObject interface {
+	VisitGetWebhookSubscriptionResponse(w http.ResponseWriter) error
+}
+
+type GetWebhookSubscription200JSONResponse WebhookSubscriptionResponse
+
+func (response GetWebhookSubscription200JSONResponse) VisitGetWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhookSubscription401Response struct {
+}
+
+func (response GetWebhookSubscription401Response) VisitGetWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetWebhookSubscription403JSONResponse Error
+
+func (response GetWebhookSubscription403JSONResponse) VisitGetWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhookSubscription404JSONResponse Error
+
+func (response GetWebhookSubscription404JSONResponse) VisitGetWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhookSubscription500JSONResponse Error
+
+func (response GetWebhookSubscription500JSONResponse) VisitGetWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhookSubscriptionRequestObject struct {
+	SubscriptionId int64 `json:"subscription_id"`
+	Body           *UpdateWebhookSubscriptionJSONRequestBody
+}
+
+type UpdateWebhookSubscriptionResponseObject interface {
+	VisitUpdateWebhookSubscriptionResponse(w http.ResponseWriter) error
+}
+
+type UpdateWebhookSubscription200JSONResponse WebhookSubscriptionResponse
+
+func (response UpdateWebhookSubscription200JSONResponse) VisitUpdateWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhookSubscription400JSONResponse Error
+
+func (response UpdateWebhookSubscription400JSONResponse) VisitUpdateWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhookSubscription401Response struct {
+}
+
+func (response UpdateWebhookSubscription401Response) VisitUpdateWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type UpdateWebhookSubscription403JSONResponse Error
+
+func (response UpdateWebhookSubscription403JSONResponse) VisitUpdateWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhookSubscription404JSONResponse Error
+
+func (response UpdateWebhookSubscription404JSONResponse) VisitUpdateWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhookSubscription500JSONResponse Error
+
+func (response UpdateWebhookSubscription500JSONResponse) VisitUpdateWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveriesRequestObject struct {
+	SubscriptionId int64 `json:"subscription_id"`
+	Params         ListWebhookDeliveriesParams
+}
+
+type ListWebhookDeliveriesResponseObject interface {
+	VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error
+}
+
+type ListWebhookDeliveries200JSONResponse WebhookDeliveryList
+
+func (response ListWebhookDeliveries200JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries400JSONResponse Error
+
+func (response ListWebhookDeliveries400JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries401Response struct {
+}
+
+func (response ListWebhookDeliveries401Response) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListWebhookDeliveries403JSONResponse Error
+
+func (response ListWebhookDeliveries403JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries404JSONResponse Error
+
+func (response ListWebhookDeliveries404JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries500JSONResponse Error
+
+func (response ListWebhookDeliveries500JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RedeliverWebhookDeliveryRequestObject struct {
+	SubscriptionId int64 `json:"subscription_id"`
+	DeliveryId     int64 `json:"delivery_id"`
+}
+
+type RedeliverWebhookDeliveryResponseObject interface {
+	VisitRedeliverWebhookDeliveryResponse(w http.ResponseWriter) error
+}
+
+type RedeliverWebhookDelivery202JSONResponse WebhookDelivery
+
+func (response RedeliverWebhookDelivery202JSONResponse) VisitRedeliverWebhookDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RedeliverWebhookDelivery401Response struct {
+}
+
+func (response RedeliverWebhookDelivery401Response) VisitRedeliverWebhookDeliveryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type RedeliverWebhookDelivery403JSONResponse Error
+
+func (response RedeliverWebhookDelivery403JSONResponse) VisitRedeliverWebhookDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RedeliverWebhookDelivery404JSONResponse Error
+
+func (response RedeliverWebhookDelivery404JSONResponse) VisitRedeliverWebhookDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RedeliverWebhookDelivery409JSONResponse Error
+
+func (response RedeliverWebhookDelivery409JSONResponse) VisitRedeliverWebhookDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RedeliverWebhookDelivery500JSONResponse Error
+
+func (response RedeliverWebhookDelivery500JSONResponse) VisitRedeliverWebhookDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// List audit log entries
+	// (GET /api/v1/audit)
+	ListAuditLogs(ctx context.Context, request ListAuditLogsRequestObject) (ListAuditLogsResponseObject, error)
+	// List database nodes
+	// (GET /api/v1/database-nodes)
+	ListDatabaseNodes(ctx context.Context, request ListDatabaseNodesRequestObject) (ListDatabaseNodesResponseObject, error)
+	// Register database node
+	// (POST /api/v1/database-nodes)
+	RegisterDatabaseNode(ctx context.Context, request RegisterDatabaseNodeRequestObject) (RegisterDatabaseNodeResponseObject, error)
+	// Get database node
+	// (GET /api/v1/database-nodes/{node_id})
+	GetDatabaseNode(ctx context.Context, request GetDatabaseNodeRequestObject) (GetDatabaseNodeResponseObject, error)
+	// Activate database node
+	// (POST /api/v1/database-nodes/{node_id}/activate)
+	ActivateDatabaseNode(ctx context.Context, request ActivateDatabaseNodeRequestObject) (ActivateDatabaseNodeResponseObject, error)
+	// Decommission database node
+	// (POST /api/v1/database-nodes/{node_id}/decommission)
+	DecommissionDatabaseNode(ctx context.Context, request DecommissionDatabaseNodeRequestObject) (DecommissionDatabaseNodeResponseObject, error)
+	// Drain database node
+	// (POST /api/v1/database-nodes/{node_id}/drain)
+	DrainDatabaseNode(ctx context.Context, request DrainDatabaseNodeRequestObject) (DrainDatabaseNodeResponseObject, error)
+	// Start database node maintenance
+	// (POST /api/v1/database-nodes/{node_id}/maintenance)
+	StartDatabaseNodeMaintenance(ctx context.Context, request StartDatabaseNodeMaintenanceRequestObject) (StartDatabaseNodeMaintenanceResponseObject, error)
+	// List isolation groups
+	// (GET /api/v1/isolation-groups)
+	ListIsolationGroups(ctx context.Context, request ListIsolationGroupsRequestObject) (ListIsolationGroupsResponseObject, error)
+	// Create isolation group
+	// (POST /api/v1/isolation-groups)
+	CreateIsolationGroup(ctx context.Context, request CreateIsolationGroupRequestObject) (CreateIsolationGroupResponseObject, error)
+	// Delete isolation group
+	// (DELETE /api/v1/isolation-groups/{group_id})
+	DeleteIsolationGroup(ctx context.Context, request DeleteIsolationGroupRequestObject) (DeleteIsolationGroupResponseObject, error)
+	// Get isolation group
+	// (GET /api/v1/isolation-groups/{group_id})
+	GetIsolationGroup(ctx context.Context, request GetIsolationGroupRequestObject) (GetIsolationGroupResponseObject, error)
+	// List operations
+	// (GET /api/v1/operations)
+	ListOperations(ctx context.Context, request ListOperationsRequestObject) (ListOperationsResponseObject, error)
+	// Get operation details
+	// (GET /api/v1/operations/{operation_id})
+	GetOperation(ctx context.Context, request GetOperationRequestObject) (GetOperationResponseObject, error)
+	// Cancel operation
+	// (POST /api/v1/operations/{operation_id}/cancel)
+	CancelOperation(ctx context.Context, request CancelOperationRequestObject) (CancelOperationResponseObject, error)
+	// Stream operation events
+	// (GET /api/v1/operations/{operation_id}/events)
+	StreamOperationEvents(ctx context.Context, request StreamOperationEventsRequestObject) (StreamOperationEventsResponseObject, error)
+	// Pause operation
+	// (POST /api/v1/operations/{operation_id}/pause)
+	PauseOperation(ctx context.Context, request PauseOperationRequestObject) (PauseOperationResponseObject, error)
+	// Resume operation
+	// (POST /api/v1/operations/{operation_id}/resume)
+	ResumeOperation(ctx context.Context, request ResumeOperationRequestObject) (ResumeOperationResponseObject, error)
+	// Retry operation
+	// (POST /api/v1/operations/{operation_id}/retry)
+	RetryOperation(ctx context.Context, request RetryOperationRequestObject) (RetryOperationResponseObject, error)
+	// List tenants
+	// (GET /api/v1/tenants)
+	ListTenants(ctx context.Context, request ListTenantsRequestObject) (ListTenantsResponseObject, error)
+	// Create a new tenant
+	// (POST /api/v1/tenants)
+	CreateTenant(ctx context.Context, request CreateTenantRequestObject) (CreateTenantResponseObject, error)
+	// Delete tenant
+	// (DELETE /api/v1/tenants/{tenant_id})
+	DeleteTenant(ctx context.Context, request DeleteTenantRequestObject) (DeleteTenantResponseObject, error)
+	// Get tenant details
+	// (GET /api/v1/tenants/{tenant_id})
+	GetTenant(ctx context.Context, request GetTenantRequestObject) (GetTenantResponseObject, error)
+	// Change tenant isolation group
+	// (PUT /api/v1/tenants/{tenant_id}/isolation-group)
+	ChangeTenantIsolationGroup(ctx context.Context, request ChangeTenantIsolationGroupRequestObject) (ChangeTenantIsolationGroupResponseObject, error)
+	// Migrate tenant
+	// (POST /api/v1/tenants/{tenant_id}/migrate)
+	MigrateTenant(ctx context.Context, request MigrateTenantRequestObject) (MigrateTenantResponseObject, error)
+	// List tenant operations
+	// (GET /api/v1/tenants/{tenant_id}/operations)
+	ListTenantOperations(ctx context.Context, request ListTenantOperationsRequestObject) (ListTenantOperationsResponseObject, error)
+	// List tenant resources
+	// (GET /api/v1/tenants/{tenant_id}/resources)
+	ListTenantResources(ctx context.Context, request ListTenantResourcesRequestObject) (ListTenantResourcesResponseObject, error)
+	// Resume tenant
+	// (POST /api/v1/tenants/{tenant_id}/resume)
+	ResumeTenant(ctx context.Context, request ResumeTenantRequestObject) (ResumeTenantResponseObject, error)
+	// Suspend tenant
+	// (POST /api/v1/tenants/{tenant_id}/suspend)
+	SuspendTenant(ctx context.Context, request SuspendTenantRequestObject) (SuspendTenantResponseObject, error)
+	// List tenant suspensions
+	// (GET /api/v1/tenants/{tenant_id}/suspensions)
+	ListTenantSuspensions(ctx context.Context, request ListTenantSuspensionsRequestObject) (ListTenantSuspensionsResponseObject, error)
+	// Change tenant tier
+	// (PATCH /api/v1/tenants/{tenant_id}/tier)
+	ChangeTenantTier(ctx context.Context, request ChangeTenantTierRequestObject) (ChangeTenantTierResponseObject, error)
+	// List webhook subscriptions
+	// (GET /api/v1/webhooks)
+	ListWebhookSubscriptions(ctx context.Context, request ListWebhookSubscriptionsRequestObject) (ListWebhookSubscriptionsResponseObject, error)
+	// Create webhook subscription
+	// (POST /api/v1/webhooks)
+	CreateWebhookSubscription(ctx context.Context, request CreateWebhookSubscriptionRequestObject) (CreateWebhookSubscriptionResponseObject, error)
+	// Delete webhook subscription
+	// (DELETE /api/v1/webhooks/{subscription_id})
+	DeleteWebhookSubscription(ctx context.Context, request DeleteWebhookSubscriptionRequestObject) (DeleteWebhookSubscriptionResponseObject, error)
+	// Get webhook subscription
+	// (GET /api/v1/webhooks/{subscription_id})
+	GetWebhookSubscription(ctx context.Context, request GetWebhookSubscriptionRequestObject) (GetWebhookSubscriptionResponseObject, error)
+	// Update webhook subscription
+	// (PATCH /api/v1/webhooks/{subscription_id})
+	UpdateWebhookSubscription(ctx context.Context, request UpdateWebhookSubscriptionRequestObject) (UpdateWebhookSubscriptionResponseObject, error)
+	// List webhook deliveries
+	// (GET /api/v1/webhooks/{subscription_id}/deliveries)
+	ListWebhookDeliveries(ctx context.Context, request ListWebhookDeliveriesRequestObject) (ListWebhookDeliveriesResponseObject, error)
+	// Redeliver webhook delivery
+	// (POST /api/v1/webhooks/{subscription_id}/deliveries/{delivery_id}/redeliver)
+	RedeliverWebhookDelivery(ctx context.Context, request RedeliverWebhookDeliveryRequestObject) (RedeliverWebhookDeliveryResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// ListAuditLogs operation middleware
+func (sh *strictHandler) ListAuditLogs(w http.ResponseWriter, r *http.Request, params ListAuditLogsParams) {
+	var request ListAuditLogsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAuditLogs(ctx, request.(ListAuditLogsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAuditLogs")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListAuditLogsResponseObject); ok {
+		if err := validResponse.VisitListAuditLogsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListDatabaseNodes operation middleware
+func (sh *strictHandler) ListDatabaseNodes(w http.ResponseWriter, r *http.Request, params ListDatabaseNodesParams) {
+	var request ListDatabaseNodesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListDatabaseNodes(ctx, request.(ListDatabaseNodesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListDatabaseNodes")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListDatabaseNodesResponseObject); ok {
+		if err := validResponse.VisitListDatabaseNodesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RegisterDatabaseNode operation middleware
+func (sh *strictHandler) RegisterDatabaseNode(w http.ResponseWriter, r *http.Request) {
+	var request RegisterDatabaseNodeRequestObject
+
+	var body RegisterDatabaseNodeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RegisterDatabaseNode(ctx, request.(RegisterDatabaseNodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RegisterDatabaseNode")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RegisterDatabaseNodeResponseObject); ok {
+		if err := validResponse.VisitRegisterDatabaseNodeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetDatabaseNode operation middleware
+func (sh *strictHandler) GetDatabaseNode(w http.ResponseWriter, r *http.Request, nodeId int64) {
+	var request GetDatabaseNodeRequestObject
+
+	request.NodeId = nodeId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDatabaseNode(ctx, request.(GetDatabaseNodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDatabaseNode")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDatabaseNodeResponseObject); ok {
+		if err := validResponse.VisitGetDatabaseNodeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ActivateDatabaseNode operation middleware
+func (sh *strictHandler) ActivateDatabaseNode(w http.ResponseWriter, r *http.Request, nodeId int64) {
+	var request ActivateDatabaseNodeRequestObject
+
+	request.NodeId = nodeId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ActivateDatabaseNode(ctx, request.(ActivateDatabaseNodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ActivateDatabaseNode")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ActivateDatabaseNodeResponseObject); ok {
+		if err := validResponse.VisitActivateDatabaseNodeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DecommissionDatabaseNode operation middleware
+func (sh *strictHandler) DecommissionDatabaseNode(w http.ResponseWriter, r *http.Request, nodeId int64) {
+	var request DecommissionDatabaseNodeRequestObject
+
+	request.NodeId = nodeId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DecommissionDatabaseNode(ctx, request.(DecommissionDatabaseNodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DecommissionDatabaseNode")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DecommissionDatabaseNodeResponseObject); ok {
+		if err := validResponse.VisitDecommissionDatabaseNodeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DrainDatabaseNode operation middleware
+func (sh *strictHandler) DrainDatabaseNode(w http.ResponseWriter, r *http.Request, nodeId int64) {
+	var request DrainDatabaseNodeRequestObject
+
+	request.NodeId = nodeId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DrainDatabaseNode(ctx, request.(DrainDatabaseNodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DrainDatabaseNode")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DrainDatabaseNodeResponseObject); ok {
+		if err := validResponse.VisitDrainDatabaseNodeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// StartDatabaseNodeMaintenance operation middleware
+func (sh *strictHandler) StartDatabaseNodeMaintenance(w http.ResponseWriter, r *http.Request, nodeId int64) {
+	var request StartDatabaseNodeMaintenanceRequestObject
+
+	request.NodeId = nodeId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.StartDatabaseNodeMaintenance(ctx, request.(StartDatabaseNodeMaintenanceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StartDatabaseNodeMaintenance")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(StartDatabaseNodeMaintenanceResponseObject); ok {
+		if err := validResponse.VisitStartDatabaseNodeMaintenanceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListIsolationGroups operation middleware
+func (sh *strictHandler) ListIsolationGroups(w http.ResponseWriter, r *http.Request, params ListIsolationGroupsParams) {
+	var request ListIsolationGroupsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListIsolationGroups(ctx, request.(ListIsolationGroupsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListIsolationGroups")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListIsolationGroupsResponseObject); ok {
+		if err := validResponse.VisitListIsolationGroupsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateIsolationGroup operation middleware
+func (sh *strictHandler) CreateIsolationGroup(w http.ResponseWriter, r *http.Request) {
+	var request CreateIsolationGroupRequestObject
+
+	var body CreateIsolationGroupJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateIsolationGroup(ctx, request.(CreateIsolationGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateIsolationGroup")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateIsolationGroupResponseObject); ok {
+		if err := validResponse.VisitCreateIsolationGroupResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteIsolationGroup operation middleware
+func (sh *strictHandler) DeleteIsolationGroup(w http.ResponseWriter, r *http.Request, groupId int64) {
+	var request DeleteIsolationGroupRequestObject
+
+	request.GroupId = groupId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteIsolationGroup(ctx, request.(DeleteIsolationGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteIsolationGroup")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteIsolationGroupResponseObject); ok {
+		if err := validResponse.VisitDeleteIsolationGroupResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetIsolationGroup operation middleware
+func (sh *strictHandler) GetIsolationGroup(w http.ResponseWriter, r *http.Request, groupId int64) {
+	var request GetIsolationGroupRequestObject
+
+	request.GroupId = groupId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetIsolationGroup(ctx, request.(GetIsolationGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetIsolationGroup")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetIsolationGroupResponseObject); ok {
+		if err := validResponse.VisitGetIsolationGroupResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListOperations operation middleware
+func (sh *strictHandler) ListOperations(w http.ResponseWriter, r *http.Request, params ListOperationsParams) {
+	var request ListOperationsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListOperations(ctx, request.(ListOperationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListOperations")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListOperationsResponseObject); ok {
+		if err := validResponse.VisitListOperationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetOperation operation middleware
+func (sh *strictHandler) GetOperation(w http.ResponseWriter, r *http.Request, operationId int64) {
+	var request GetOperationRequestObject
+
+	request.OperationId = operationId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOperation(ctx, request.(GetOperationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOperation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetOperationResponseObject); ok {
+		if err := validResponse.VisitGetOperationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CancelOperation operation middleware
+func (sh *strictHandler) CancelOperation(w http.ResponseWriter, r *http.Request, operationId int64) {
+	var request CancelOperationRequestObject
+
+	request.OperationId = operationId
+
+	var body CancelOperationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CancelOperation(ctx, request.(CancelOperationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CancelOperation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CancelOperationResponseObject); ok {
+		if err := validResponse.VisitCancelOperationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// StreamOperationEvents operation middleware
+func (sh *strictHandler) StreamOperationEvents(w http.ResponseWriter, r *http.Request, operationId int64, params StreamOperationEventsParams) {
+	var request StreamOperationEventsRequestObject
+
+	request.OperationId = operationId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.StreamOperationEvents(ctx, request.(StreamOperationEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StreamOperationEvents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(StreamOperationEventsResponseObject); ok {
+		if err := validResponse.VisitStreamOperationEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PauseOperation operation middleware
+func (sh *strictHandler) PauseOperation(w http.ResponseWriter, r *http.Request, operationId int64) {
+	var request PauseOperationRequestObject
+
+	request.OperationId = operationId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PauseOperation(ctx, request.(PauseOperationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PauseOperation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PauseOperationResponseObject); ok {
+		if err := validResponse.VisitPauseOperationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ResumeOperation operation middleware
+func (sh *strictHandler) ResumeOperation(w http.ResponseWriter, r *http.Request, operationId int64) {
+	var request ResumeOperationRequestObject
+
+	request.OperationId = operationId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ResumeOperation(ctx, request.(ResumeOperationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ResumeOperation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ResumeOperationResponseObject); ok {
+		if err := validResponse.VisitResumeOperationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RetryOperation operation middleware
+func (sh *strictHandler) RetryOperation(w http.ResponseWriter, r *http.Request, operationId int64) {
+	var request RetryOperationRequestObject
+
+	request.OperationId = operationId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RetryOperation(ctx, request.(RetryOperationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RetryOperation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RetryOperationResponseObject); ok {
+		if err := validResponse.VisitRetryOperationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListTenants operation middleware
+func (sh *strictHandler) ListTenants(w http.ResponseWriter, r *http.Request, params ListTenantsParams) {
+	var request ListTenantsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListTenants(ctx, request.(ListTenantsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListTenants")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListTenantsResponseObject); ok {
+		if err := validResponse.VisitListTenantsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateTenant operation middleware
+func (sh *strictHandler) CreateTenant(w http.ResponseWriter, r *http.Request, params CreateTenantParams) {
+	var request CreateTenantRequestObject
+
+	request.Params = params
+
+	var body CreateTenantJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateTenant(ctx, request.(CreateTenantRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateTenant")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateTenantResponseObject); ok {
+		if err := validResponse.VisitCreateTenantResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteTenant operation middleware
+func (sh *strictHandler) DeleteTenant(w http.ResponseWriter, r *http.Request, tenantId int64, params DeleteTenantParams) {
+	var request DeleteTenantRequestObject
+
+	request.TenantId = tenantId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTenant(ctx, request.(DeleteTenantRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTenant")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteTenantResponseObject); ok {
+		if err := validResponse.VisitDeleteTenantResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTenant operation middleware
+func (sh *strictHandler) GetTenant(w http.ResponseWriter, r *http.Request, tenantId int64) {
+	var request GetTenantRequestObject
+
+	request.TenantId = tenantId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTenant(ctx, request.(GetTenantRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTenant")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTenantResponseObject); ok {
+		if err := validResponse.VisitGetTenantResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ChangeTenantIsolationGroup operation middleware
+func (sh *strictHandler) ChangeTenantIsolationGroup(w http.ResponseWriter, r *http.Request, tenantId int64) {
+	var request ChangeTenantIsolationGroupRequestObject
+
+	request.TenantId = tenantId
+
+	var body ChangeTenantIsolationGroupJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ChangeTenantIsolationGroup(ctx, request.(ChangeTenantIsolationGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ChangeTenantIsolationGroup")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ChangeTenantIsolationGroupResponseObject); ok {
+		if err := validResponse.VisitChangeTenantIsolationGroupResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// MigrateTenant operation middleware
+func (sh *strictHandler) MigrateTenant(w http.ResponseWriter, r *http.Request, tenantId int64) {
+	var request MigrateTenantRequestObject
+
+	request.TenantId = tenantId
+
+	var body MigrateTenantJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.MigrateTenant(ctx, request.(MigrateTenantRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MigrateTenant")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(MigrateTenantResponseObject); ok {
+		if err := validResponse.VisitMigrateTenantResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListTenantOperations operation middleware
+func (sh *strictHandler) ListTenantOperations(w http.ResponseWriter, r *http.Request, tenantId int64, params ListTenantOperationsParams) {
+	var request ListTenantOperationsRequestObject
+
+	request.TenantId = tenantId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListTenantOperations(ctx, request.(ListTenantOperationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListTenantOperations")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListTenantOperationsResponseObject); ok {
+		if err := validResponse.VisitListTenantOperationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListTenantResources operation middleware
+func (sh *strictHandler) ListTenantResources(w http.ResponseWriter, r *http.Request, tenantId int64, params ListTenantResourcesParams) {
+	var request ListTenantResourcesRequestObject
+
+	request.TenantId = tenantId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListTenantResources(ctx, request.(ListTenantResourcesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListTenantResources")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListTenantResourcesResponseObject); ok {
+		if err := validResponse.VisitListTenantResourcesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateTenant400JSONResponse Error
+// ResumeTenant operation middleware
+func (sh *strictHandler) ResumeTenant(w http.ResponseWriter, r *http.Request, tenantId int64) {
+	var request ResumeTenantRequestObject
 
-func (response CreateTenant400JSONResponse) VisitCreateTenantResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.TenantId = tenantId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body ResumeTenantJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type CreateTenant401Response struct {
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ResumeTenant(ctx, request.(ResumeTenantRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ResumeTenant")
+	}
 
-func (response CreateTenant401Response) VisitCreateTenantResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ResumeTenantResponseObject); ok {
+		if err := validResponse.VisitResumeTenantResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateTenant409JSONResponse Error
+// SuspendTenant operation middleware
+func (sh *strictHandler) SuspendTenant(w http.ResponseWriter, r *http.Request, tenantId int64) {
+	var request SuspendTenantRequestObject
 
-func (response CreateTenant409JSONResponse) VisitCreateTenantResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	request.TenantId = tenantId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body SuspendTenantJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type CreateTenant500JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SuspendTenant(ctx, request.(SuspendTenantRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SuspendTenant")
+	}
 
-func (response CreateTenant500JSONResponse) VisitCreateTenantResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SuspendTenantResponseObject); ok {
+		if err := validResponse.VisitSuspendTenantResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteTenantRequestObject struct {
-	TenantId int64 `json:"tenant_id"`
-}
+// ListTenantSuspensions operation middleware
+func (sh *strictHandler) ListTenantSuspensions(w http.ResponseWriter, r *http.Request, tenantId int64) {
+	var request ListTenantSuspensionsRequestObject
 
-type DeleteTenantResponseObject interface {
-	VisitDeleteTenantResponse(w http.ResponseWriter) error
-}
+	request.TenantId = tenantId
 
-type DeleteTenant202JSONResponse AsyncOperation
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListTenantSuspensions(ctx, request.(ListTenantSuspensionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListTenantSuspensions")
+	}
 
-func (response DeleteTenant202JSONResponse) VisitDeleteTenantResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(202)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListTenantSuspensionsResponseObject); ok {
+		if err := validResponse.VisitListTenantSuspensionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteTenant401Response struct {
-}
+// ChangeTenantTier operation middleware
+func (sh *strictHandler) ChangeTenantTier(w http.ResponseWriter, r *http.Request, tenantId int64) {
+	var request ChangeTenantTierRequestObject
 
-func (response DeleteTenant401Response) VisitDeleteTenantResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	request.TenantId = tenantId
 
-type DeleteTenant404JSONResponse Error
+	var body ChangeTenantTierJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func (response DeleteTenant404JSONResponse) VisitDeleteTenantResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ChangeTenantTier(ctx, request.(ChangeTenantTierRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ChangeTenantTier")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ChangeTenantTierResponseObject); ok {
+		if err := validResponse.VisitChangeTenantTierResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteTenant409JSONResponse Error
+// ListWebhookSubscriptions operation middleware
+func (sh *strictHandler) ListWebhookSubscriptions(w http.ResponseWriter, r *http.Request, params ListWebhookSubscriptionsParams) {
+	var request ListWebhookSubscriptionsRequestObject
 
-func (response DeleteTenant409JSONResponse) VisitDeleteTenantResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListWebhookSubscriptions(ctx, request.(ListWebhookSubscriptionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListWebhookSubscriptions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListWebhookSubscriptionsResponseObject); ok {
+		if err := validResponse.VisitListWebhookSubscriptionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteTenant500JSONResponse Error
+// CreateWebhookSubscription operation middleware
+func (sh *strictHandler) CreateWebhookSubscription(w http.ResponseWriter, r *http.Request) {
+	var request CreateWebhookSubscriptionRequestObject
 
-func (response DeleteTenant500JSONResponse) VisitDeleteTenantResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var body CreateWebhookSubscriptionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateWebhookSubscription(ctx, request.(CreateWebhookSubscriptionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateWebhookSubscription")
+	}
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Get operation details
-	// (GET /api/v1/operations/{operation_id})
-	GetOperation(ctx context.Context, request GetOperationRequestObject) (GetOperationResponseObject, error)
-	// Create a new tenant
-	// (POST /api/v1/tenants)
-	CreateTenant(ctx context.Context, request CreateTenantRequestObject) (CreateTenantResponseObject, error)
-	// Delete tenant
-	// (DELETE /api/v1/tenants/{tenant_id})
-	DeleteTenant(ctx context.Context, request DeleteTenantRequestObject) (DeleteTenantResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateWebhookSubscriptionResponseObject); ok {
+		if err := validResponse.VisitCreateWebhookSubscriptionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+// DeleteWebhookSubscription operation middleware
+func (sh *strictHandler) DeleteWebhookSubscription(w http.ResponseWriter, r *http.Request, subscriptionId int64) {
+	var request DeleteWebhookSubscriptionRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	request.SubscriptionId = subscriptionId
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteWebhookSubscription(ctx, request.(DeleteWebhookSubscriptionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteWebhookSubscription")
+	}
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteWebhookSubscriptionResponseObject); ok {
+		if err := validResponse.VisitDeleteWebhookSubscriptionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// GetOperation operation middleware
-func (sh *strictHandler) GetOperation(w http.ResponseWriter, r *http.Request, operationId int64) {
-	var request GetOperationRequestObject
+// GetWebhookSubscription operation middleware
+func (sh *strictHandler) GetWebhookSubscription(w http.ResponseWriter, r *http.Request, subscriptionId int64) {
+	var request GetWebhookSubscriptionRequestObject
 
-	request.OperationId = operationId
+	request.SubscriptionId = subscriptionId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetOperation(ctx, request.(GetOperationRequestObject))
+		return sh.ssi.GetWebhookSubscription(ctx, request.(GetWebhookSubscriptionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetOperation")
+		handler = middleware(handler, "GetWebhookSubscription")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetOperationResponseObject); ok {
-		if err := validResponse.VisitGetOperationResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetWebhookSubscriptionResponseObject); ok {
+		if err := validResponse.VisitGetWebhookSubscriptionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -623,11 +6190,13 @@ func (sh *strictHandler) GetOperation(w http.ResponseWriter, r *http.Request, op
 	}
 }
 
-// CreateTenant operation middleware
-func (sh *strictHandler) CreateTenant(w http.ResponseWriter, r *http.Request) {
-	var request CreateTenantRequestObject
+// UpdateWebhookSubscription operation middleware
+func (sh *strictHandler) UpdateWebhookSubscription(w http.ResponseWriter, r *http.Request, subscriptionId int64) {
+	var request UpdateWebhookSubscriptionRequestObject
 
-	var body CreateTenantJSONRequestBody
+	request.SubscriptionId = subscriptionId
+
+	var body UpdateWebhookSubscriptionJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -635,18 +6204,18 @@ func (sh *strictHandler) CreateTenant(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateTenant(ctx, request.(CreateTenantRequestObject))
+		return sh.ssi.UpdateWebhookSubscription(ctx, request.(UpdateWebhookSubscriptionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateTenant")
+		handler = middleware(handler, "UpdateWebhookSubscription")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateTenantResponseObject); ok {
-		if err := validResponse.VisitCreateTenantResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateWebhookSubscriptionResponseObject); ok {
+		if err := validResponse.VisitUpdateWebhookSubscriptionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -654,25 +6223,53 @@ func (sh *strictHandler) CreateTenant(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteTenant operation middleware
-func (sh *strictHandler) DeleteTenant(w http.ResponseWriter, r *http.Request, tenantId int64) {
-	var request DeleteTenantRequestObject
+// ListWebhookDeliveries operation middleware
+func (sh *strictHandler) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request, subscriptionId int64, params ListWebhookDeliveriesParams) {
+	var request ListWebhookDeliveriesRequestObject
 
-	request.TenantId = tenantId
+	request.SubscriptionId = subscriptionId
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteTenant(ctx, request.(DeleteTenantRequestObject))
+		return sh.ssi.ListWebhookDeliveries(ctx, request.(ListWebhookDeliveriesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteTenant")
+		handler = middleware(handler, "ListWebhookDeliveries")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteTenantResponseObject); ok {
-		if err := validResponse.VisitDeleteTenantResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListWebhookDeliveriesResponseObject); ok {
+		if err := validResponse.VisitListWebhookDeliveriesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RedeliverWebhookDelivery operation middleware
+func (sh *strictHandler) RedeliverWebhookDelivery(w http.ResponseWriter, r *http.Request, subscriptionId int64, deliveryId int64) {
+	var request RedeliverWebhookDeliveryRequestObject
+
+	request.SubscriptionId = subscriptionId
+	request.DeliveryId = deliveryId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RedeliverWebhookDelivery(ctx, request.(RedeliverWebhookDeliveryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RedeliverWebhookDelivery")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RedeliverWebhookDeliveryResponseObject); ok {
+		if err := validResponse.VisitRedeliverWebhookDeliveryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
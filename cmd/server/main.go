@@ -3,13 +3,16 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
+	"net/netip"
 	"os"
 	"os/signal"
 	"runtime"
 	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
@@ -21,15 +24,36 @@ import (
 	"github.com/jackc/pgx/v5/stdlib"
 	"go.uber.org/automaxprocs/maxprocs"
 
+	"github.com/ahrav/hoglet-hub/api/v1/server"
+	auditApp "github.com/ahrav/hoglet-hub/internal/application/audit"
+	dbNodeApp "github.com/ahrav/hoglet-hub/internal/application/dbnode"
+	idempotencyApp "github.com/ahrav/hoglet-hub/internal/application/idempotency"
+	isolationGroupApp "github.com/ahrav/hoglet-hub/internal/application/isolationgroup"
 	operationApp "github.com/ahrav/hoglet-hub/internal/application/operation"
+	outboxApp "github.com/ahrav/hoglet-hub/internal/application/outbox"
+	resourceApp "github.com/ahrav/hoglet-hub/internal/application/resource"
+	"github.com/ahrav/hoglet-hub/internal/application/sdk/auth"
+	"github.com/ahrav/hoglet-hub/internal/application/sdk/authz"
 	"github.com/ahrav/hoglet-hub/internal/application/sdk/debug"
 	"github.com/ahrav/hoglet-hub/internal/application/sdk/mux"
+	"github.com/ahrav/hoglet-hub/internal/application/security"
 	tenantApp "github.com/ahrav/hoglet-hub/internal/application/tenant"
+	webhookApp "github.com/ahrav/hoglet-hub/internal/application/webhook"
+	"github.com/ahrav/hoglet-hub/internal/domain/resource"
 	httpServer "github.com/ahrav/hoglet-hub/internal/infra/adapters/http"
 	handler "github.com/ahrav/hoglet-hub/internal/infra/adapters/http/handler"
 	"github.com/ahrav/hoglet-hub/internal/infra/metrics"
+	"github.com/ahrav/hoglet-hub/internal/infra/sink"
+	"github.com/ahrav/hoglet-hub/internal/infra/storage"
+	auditRepo "github.com/ahrav/hoglet-hub/internal/infra/storage/audit/postgres"
+	dbNodeRepo "github.com/ahrav/hoglet-hub/internal/infra/storage/dbnode/postgres"
+	idempotencyRepo "github.com/ahrav/hoglet-hub/internal/infra/storage/idempotency/postgres"
+	isolationGroupRepo "github.com/ahrav/hoglet-hub/internal/infra/storage/isolationgroup/postgres"
 	operationRepo "github.com/ahrav/hoglet-hub/internal/infra/storage/operation/postgres"
+	outboxRepo "github.com/ahrav/hoglet-hub/internal/infra/storage/outbox/postgres"
+	resourceRepo "github.com/ahrav/hoglet-hub/internal/infra/storage/resource/postgres"
 	tenantRepo "github.com/ahrav/hoglet-hub/internal/infra/storage/tenant/postgres"
+	webhookRepo "github.com/ahrav/hoglet-hub/internal/infra/storage/webhook/postgres"
 	"github.com/ahrav/hoglet-hub/pkg/common/logger"
 	"github.com/ahrav/hoglet-hub/pkg/common/otel"
 )
@@ -87,6 +111,29 @@ func main() {
 			fmt.Fprintf(os.Stderr, "Error event: %s, details: %s\n",
 				r.Message, errorAttrsJSON)
 		},
+		Warn: func(ctx context.Context, r logger.Record) {
+			// Only security anomalies are surfaced as events.
+			anomaly, ok := r.Attributes[security.EventAttr]
+			if !ok {
+				return
+			}
+
+			eventAttrs := map[string]any{
+				"event_time": r.Time.UTC().Format(time.RFC3339),
+				"trace_id":   otel.GetTraceID(ctx),
+			}
+			for k, v := range r.Attributes {
+				eventAttrs[k] = v
+			}
+
+			eventAttrsJSON, err := json.Marshal(eventAttrs)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "failed to marshal security event attributes: %v\n", err)
+				return
+			}
+
+			fmt.Fprintf(os.Stderr, "Security event: %v, details: %s\n", anomaly, eventAttrsJSON)
+		},
 	}
 
 	// Define a trace ID function that will be used by the logger
@@ -241,23 +288,157 @@ func run(ctx context.Context, log *logger.Logger, hostname string) error {
 	// Initialize repositories using the tracer.
 	tenantRepository := tenantRepo.NewTenantStore(pool, tracer)
 	operationRepository := operationRepo.NewOperationStore(pool, tracer)
+	stepRepository := operationRepo.NewStepStore(pool, tracer)
+	eventRepository := operationRepo.NewEventStore(pool, tracer)
+	isolationGroupRepository := isolationGroupRepo.NewGroupStore(pool, tracer)
+	dbNodeRepository := dbNodeRepo.NewNodeStore(pool, tracer)
+	resourceRepository := resourceRepo.NewResourceStore(pool, tracer)
+	quotaRepository := resourceRepo.NewQuotaStore(pool, tracer)
+	auditRepository := auditRepo.NewAuditStore(pool, tracer)
+	idempotencyRepository := idempotencyRepo.NewIdempotencyStore(pool, tracer)
+	outboxRepository := outboxRepo.NewOutboxStore(pool, tracer)
+	webhookRepository := webhookRepo.NewWebhookStore(pool, tracer)
+	transactor := storage.NewTransactor(pool, tracer)
 
 	// Initialize application services.
-	operationService := operationApp.NewService(operationRepository, log, tracer)
+	auditService := auditApp.NewService(auditRepository, log, tracer)
+	placer := dbNodeApp.NewPlacer(dbNodeRepository, log, tracer)
+	quotaService := resourceApp.NewQuotaService(
+		quotaRepository,
+		resource.DefaultQuotas,
+		resource.DefaultProjectsPerRegion,
+		log,
+		tracer,
+	)
 	tenantService := tenantApp.NewService(
 		tenantRepository,
 		operationRepository,
+		transactor,
+		stepRepository,
+		isolationGroupRepository,
+		resourceRepository,
+		quotaService,
+		placer,
+		auditService,
 		log,
 		tracer,
 		metricsRegistry.Tenant,
 	)
+	operationService := operationApp.NewService(
+		operationRepository,
+		stepRepository,
+		eventRepository,
+		tenantService,
+		log,
+		tracer,
+	)
+	isolationGroupService := isolationGroupApp.NewService(isolationGroupRepository, tenantRepository, log, tracer)
+	dbNodeService := dbNodeApp.NewService(dbNodeRepository, log, tracer)
+	resourceService := resourceApp.NewService(resourceRepository, tenantRepository, log, tracer)
+	webhookService := webhookApp.NewService(webhookRepository, tenantRepository, nil, log, tracer)
+
+	idempotencyTTL := idempotencyApp.DefaultTTL
+	if v := os.Getenv("IDEMPOTENCY_KEY_TTL"); v != "" {
+		if idempotencyTTL, err = time.ParseDuration(v); err != nil {
+			return fmt.Errorf("parsing idempotency key TTL: %w", err)
+		}
+	}
+	idempotencyService := idempotencyApp.NewService(idempotencyRepository, idempotencyTTL, log, tracer)
+
+	// Resume operations orphaned by a previous instance and keep watching for
+	// operations orphaned by replicas that crash mid-workflow.
+	go tenantService.RunRecovery(ctx, tenantApp.DefaultRecoveryInterval, tenantApp.DefaultStaleAfter)
+
+	// Forget idempotency keys once they expire.
+	go idempotencyService.RunExpiry(ctx, idempotencyApp.DefaultExpiryInterval)
+
+	// Relay lifecycle events recorded in the outbox to webhook subscribers and
+	// the configured sinks, and keep sending queued webhook deliveries.
+	sinks := []outboxApp.Sink{webhookService}
+	if url := os.Getenv("OUTBOX_WEBHOOK_URL"); url != "" {
+		sinks = append(sinks, sink.NewWebhookSink(url, sink.DefaultWebhookTimeout))
+	}
+	if path := os.Getenv("OUTBOX_FILE"); path != "" {
+		fileSink, err := sink.NewFileSink(path)
+		if err != nil {
+			return fmt.Errorf("opening outbox file: %w", err)
+		}
+		defer fileSink.Close()
+		sinks = append(sinks, fileSink)
+	}
+	relay := outboxApp.NewRelay(outboxRepository, sinks, log, tracer)
+	go relay.Run(ctx, outboxApp.DefaultRelayInterval)
+	go webhookService.RunDelivery(ctx, webhookApp.DefaultDeliveryInterval)
 
 	// Initialize HTTP handlers.
-	tenantHandler := handler.NewTenantHandler(tenantService)
-	operationHandler := handler.NewOperationHandler(operationService)
+	tenantHandler := handler.NewTenantHandler(tenantService, auditService, idempotencyService)
+	operationHandler := handler.NewOperationHandler(operationService, tenantService, auditService)
+	isolationGroupHandler := handler.NewIsolationGroupHandler(isolationGroupService, auditService)
+	dbNodeHandler := handler.NewDatabaseNodeHandler(dbNodeService)
+	resourceHandler := handler.NewResourceHandler(resourceService)
+	auditHandler := handler.NewAuditHandler(auditService)
+	webhookHandler := handler.NewWebhookHandler(webhookService, auditService)
 
 	// Initialize server adapter.
-	serverAdapter := httpServer.NewServerAdapter(tenantHandler, operationHandler)
+	serverAdapter := httpServer.NewServerAdapter(
+		tenantHandler,
+		operationHandler,
+		isolationGroupHandler,
+		dbNodeHandler,
+		resourceHandler,
+		auditHandler,
+		webhookHandler,
+	)
+
+	// -------------------------------------------------------------------------
+	// Authentication and Authorization
+	authenticator, err := newAuthenticator(ctx, log)
+	if err != nil {
+		return fmt.Errorf("configuring authentication: %w", err)
+	}
+
+	var strictMiddlewares []server.StrictMiddlewareFunc
+	if policyPath := os.Getenv("AUTHZ_POLICY_FILE"); policyPath != "" {
+		if authenticator == nil {
+			return errors.New("AUTHZ_POLICY_FILE requires authentication to be configured")
+		}
+		policy, err := authz.LoadPolicy(policyPath)
+		if err != nil {
+			return fmt.Errorf("loading access policy: %w", err)
+		}
+		authorizer := authz.NewAuthorizer(policy, log)
+		go authorizer.WatchFile(ctx, policyPath, authz.DefaultReloadInterval)
+
+		strictMiddlewares = append(strictMiddlewares,
+			httpServer.AuthorizeMiddleware(authorizer, tenantService, operationService))
+	} else {
+		log.Warn(ctx, "startup", "status", "authorization disabled: AUTHZ_POLICY_FILE is not set")
+	}
+
+	// -------------------------------------------------------------------------
+	// Security Monitoring
+	securityCfg := security.DefaultConfig
+	if v := os.Getenv("SECURITY_BLOCK_DURATION"); v != "" {
+		if securityCfg.BlockDuration, err = time.ParseDuration(v); err != nil {
+			return fmt.Errorf("parsing security block duration: %w", err)
+		}
+	}
+	securityMonitor := security.NewMonitor(securityCfg, metricsRegistry.Security, log)
+
+	// Client addresses are taken from X-Forwarded-For only on requests from these
+	// networks, so that clients cannot choose the address they are monitored by.
+	var trustedProxies []netip.Prefix
+	if v := os.Getenv("TRUSTED_PROXIES"); v != "" {
+		for _, cidr := range strings.Split(v, ",") {
+			prefix, err := netip.ParsePrefix(strings.TrimSpace(cidr))
+			if err != nil {
+				return fmt.Errorf("parsing trusted proxies: %w", err)
+			}
+			trustedProxies = append(trustedProxies, prefix.Masked())
+		}
+	} else {
+		log.Warn(ctx, "startup", "status", "X-Forwarded-For ignored: TRUSTED_PROXIES is not set")
+	}
 
 	// -------------------------------------------------------------------------
 	// Start API Service.
@@ -267,7 +448,7 @@ func run(ctx context.Context, log *logger.Logger, hostname string) error {
 	signal.Notify(shutdown, syscall.SIGINT, syscall.SIGTERM)
 
 	// Create the base OpenAPI server
-	openAPIHandler := httpServer.NewHTTPServer(serverAdapter)
+	openAPIHandler := httpServer.NewHTTPServer(serverAdapter, strictMiddlewares...)
 
 	// Initialize centralized mux configuration with all dependencies.
 	webCfg := mux.Config{
@@ -278,6 +459,9 @@ func run(ctx context.Context, log *logger.Logger, hostname string) error {
 		APIMetrics:       metricsRegistry.API,
 		TenantService:    tenantService,
 		OperationService: operationService,
+		Authenticator:    authenticator,
+		SecurityMonitor:  securityMonitor,
+		TrustedProxies:   trustedProxies,
 	}
 
 	// Wrap the OpenAPI server with our middleware infrastructure.
@@ -298,6 +482,9 @@ func run(ctx context.Context, log *logger.Logger, hostname string) error {
 		IdleTimeout:  cfg.Web.IdleTimeout,
 		ErrorLog:     logger.NewStdLogger(log, logger.LevelError),
 	}
+	// Operation event streams last until their operation finishes, so end them
+	// instead of waiting for them during shutdown.
+	api.RegisterOnShutdown(operationHandler.CloseStreams)
 
 	serverErrors := make(chan error, 1)
 
@@ -328,6 +515,62 @@ func run(ctx context.Context, log *logger.Logger, hostname string) error {
 	return nil
 }
 
+// newAuthenticator creates the authenticator for API requests. Setting
+// AUTH_TRUSTED_HEADERS to true trusts the caller identity stated in request
+// headers, which is only safe in development. Otherwise bearer tokens are
+// verified as configured by newVerifier. It returns nil if neither is configured.
+func newAuthenticator(ctx context.Context, log *logger.Logger) (auth.Authenticator, error) {
+	if trusted, _ := strconv.ParseBool(os.Getenv("AUTH_TRUSTED_HEADERS")); trusted {
+		log.Warn(ctx, "startup", "status", "trusting caller identity from request headers; do not use in production",
+			"subject_header", auth.SubjectHeader, "roles_header", auth.RolesHeader)
+		return auth.NewHeaderAuthenticator(), nil
+	}
+
+	verifier, err := newVerifier()
+	if err != nil {
+		return nil, err
+	}
+	if verifier == nil {
+		log.Warn(ctx, "startup", "status", "authentication disabled: neither AUTH_JWKS_FILE nor AUTH_HS256_SECRET is set")
+		return nil, nil
+	}
+	return verifier, nil
+}
+
+// newVerifier creates the verifier for API bearer tokens from the keys in the
+// JWKS file named by AUTH_JWKS_FILE and the shared secret in AUTH_HS256_SECRET.
+// AUTH_ISSUER and AUTH_AUDIENCE optionally restrict which tokens are accepted.
+// It returns nil if no keys are configured.
+func newVerifier() (*auth.Verifier, error) {
+	keys := new(auth.KeySet)
+
+	if path := os.Getenv("AUTH_JWKS_FILE"); path != "" {
+		jwks, err := auth.LoadJWKS(path)
+		if err != nil {
+			return nil, err
+		}
+		keys = jwks
+	}
+
+	if secret := os.Getenv("AUTH_HS256_SECRET"); secret != "" {
+		key, err := auth.NewHMACKey("", []byte(secret))
+		if err != nil {
+			return nil, err
+		}
+		keys.Add(key)
+	}
+
+	if keys.Len() == 0 {
+		return nil, nil
+	}
+
+	return auth.NewVerifier(auth.VerifierConfig{
+		Keys:     keys,
+		Issuer:   os.Getenv("AUTH_ISSUER"),
+		Audience: os.Getenv("AUTH_AUDIENCE"),
+	})
+}
+
 // TODO: consider moving this to an init container.
 // runMigrations uses golang-migrate to apply all up migrations from "db/migrations".
 // runMigrations acquires a single pgx connection from the pool, runs migrations,
@@ -0,0 +1,5 @@
+-- 0002_operation_steps.down.sql
+
+DROP INDEX IF EXISTS idx_operations_incomplete_updated;
+DROP TABLE IF EXISTS operation_steps;
+DROP TYPE IF EXISTS operation_step_status;
@@ -0,0 +1,31 @@
+-- 0002_operation_steps.up.sql
+
+-- -----------------------------------------------------------------------------
+-- Operation Steps
+-- -----------------------------------------------------------------------------
+
+-- Define operation step status enum
+CREATE TYPE operation_step_status AS ENUM ('in_progress', 'completed', 'failed');
+
+-- Operation steps table - Checkpoints workflow step progress so interrupted
+-- operations can be resumed from their last completed step
+CREATE TABLE operation_steps (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    operation_id BIGINT NOT NULL REFERENCES operations(id) ON DELETE CASCADE, -- Owning operation
+
+    step_index INTEGER NOT NULL,                   -- Position of the step within the workflow
+    step_name VARCHAR(64) NOT NULL,                -- Name of the workflow step
+    status operation_step_status NOT NULL DEFAULT 'in_progress', -- Current status of the step
+    attempts INTEGER NOT NULL DEFAULT 1,           -- Number of times the step has been started
+    error_message TEXT,                            -- Error details if the step failed
+
+    -- Timestamps
+    started_at TIMESTAMPTZ NOT NULL DEFAULT NOW(), -- When the latest attempt began
+    completed_at TIMESTAMPTZ,                      -- When the latest attempt finished
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    UNIQUE (operation_id, step_name)
+);
+
+CREATE INDEX idx_operations_incomplete_updated ON operations(updated_at)
+    WHERE status IN ('pending', 'in_progress');
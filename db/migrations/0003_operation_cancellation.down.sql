@@ -0,0 +1,3 @@
+-- 0003_operation_cancellation.down.sql
+
+ALTER TABLE operations DROP COLUMN IF EXISTS cancelled_by;
@@ -0,0 +1,8 @@
+-- 0003_operation_cancellation.up.sql
+
+-- -----------------------------------------------------------------------------
+-- Operation Cancellation
+-- -----------------------------------------------------------------------------
+
+-- Record who cancelled an operation; the reason is kept in error_message
+ALTER TABLE operations ADD COLUMN cancelled_by VARCHAR(64);
@@ -0,0 +1,18 @@
+-- 0004_operation_retries.down.sql
+
+-- Enum values cannot be dropped, so rebuild the type without 'compensated'.
+-- Compensated steps no longer have effects in place, so they become failed.
+ALTER TYPE operation_step_status RENAME TO operation_step_status_old;
+CREATE TYPE operation_step_status AS ENUM ('in_progress', 'completed', 'failed');
+ALTER TABLE operation_steps
+    ALTER COLUMN status DROP DEFAULT,
+    ALTER COLUMN status TYPE operation_step_status USING (
+        CASE WHEN status = 'compensated' THEN 'failed' ELSE status::TEXT END
+    )::operation_step_status,
+    ALTER COLUMN status SET DEFAULT 'in_progress';
+DROP TYPE operation_step_status_old;
+
+DROP INDEX IF EXISTS idx_operations_parent;
+ALTER TABLE operations
+    DROP COLUMN IF EXISTS attempt,
+    DROP COLUMN IF EXISTS parent_operation_id;
@@ -0,0 +1,17 @@
+-- 0004_operation_retries.up.sql
+
+-- -----------------------------------------------------------------------------
+-- Operation Retries
+-- -----------------------------------------------------------------------------
+
+-- Link a retry to the failed operation it retries
+ALTER TABLE operations
+    ADD COLUMN parent_operation_id BIGINT REFERENCES operations(id), -- Operation this one retries
+    ADD COLUMN attempt INTEGER NOT NULL DEFAULT 1;                   -- 1 for the original operation, incremented by each retry
+
+-- A failed operation can be retried only once; further retries go through the retry
+CREATE UNIQUE INDEX idx_operations_parent ON operations(parent_operation_id)
+    WHERE parent_operation_id IS NOT NULL;
+
+-- Mark steps whose effects were undone by compensation so a retry runs them again
+ALTER TYPE operation_step_status ADD VALUE 'compensated';
@@ -0,0 +1,4 @@
+-- 0005_tenant_listing.down.sql
+
+DROP INDEX IF EXISTS idx_tenants_name_pattern;
+DROP INDEX IF EXISTS idx_tenants_created_at;
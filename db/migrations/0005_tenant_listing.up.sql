@@ -0,0 +1,11 @@
+-- 0005_tenant_listing.up.sql
+
+-- -----------------------------------------------------------------------------
+-- Tenant Listing
+-- -----------------------------------------------------------------------------
+
+-- Keyset pagination over creation time, with the ID breaking ties
+CREATE INDEX idx_tenants_created_at ON tenants(created_at, id);
+
+-- Name prefix search; the unique index on name cannot serve LIKE under a non-C collation
+CREATE INDEX idx_tenants_name_pattern ON tenants(name text_pattern_ops);
@@ -0,0 +1,3 @@
+-- 0006_operation_listing.down.sql
+
+DROP INDEX IF EXISTS idx_operations_created_at;
@@ -0,0 +1,8 @@
+-- 0006_operation_listing.up.sql
+
+-- -----------------------------------------------------------------------------
+-- Operation Listing
+-- -----------------------------------------------------------------------------
+
+-- Keyset pagination over creation time, newest first, with the ID breaking ties
+CREATE INDEX idx_operations_created_at ON operations(created_at DESC, id DESC);
@@ -0,0 +1,13 @@
+-- 0007_operation_pausing.down.sql
+
+-- Enum values cannot be dropped, so rebuild the type without 'paused'.
+-- Paused operations become in progress so that recovery resumes them.
+ALTER TYPE operation_status RENAME TO operation_status_old;
+CREATE TYPE operation_status AS ENUM ('pending', 'in_progress', 'completed', 'failed', 'cancelled');
+ALTER TABLE operations
+    ALTER COLUMN status DROP DEFAULT,
+    ALTER COLUMN status TYPE operation_status USING (
+        CASE WHEN status = 'paused' THEN 'in_progress' ELSE status::TEXT END
+    )::operation_status,
+    ALTER COLUMN status SET DEFAULT 'pending';
+DROP TYPE operation_status_old;
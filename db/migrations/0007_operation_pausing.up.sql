@@ -0,0 +1,8 @@
+-- 0007_operation_pausing.up.sql
+
+-- -----------------------------------------------------------------------------
+-- Operation Pausing
+-- -----------------------------------------------------------------------------
+
+-- Operations paused between steps; recovery leaves them alone until resumed
+ALTER TYPE operation_status ADD VALUE 'paused';
@@ -0,0 +1,5 @@
+-- 0008_tenant_suspensions.down.sql
+
+DROP INDEX IF EXISTS idx_tenant_suspensions_open;
+DROP INDEX IF EXISTS idx_tenant_suspensions_tenant;
+DROP TABLE IF EXISTS tenant_suspensions;
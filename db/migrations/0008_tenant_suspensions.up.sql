@@ -0,0 +1,29 @@
+-- 0008_tenant_suspensions.up.sql
+
+-- -----------------------------------------------------------------------------
+-- Tenant Suspensions
+-- -----------------------------------------------------------------------------
+
+-- History of tenant suspensions; a row stays open until the tenant is resumed
+CREATE TABLE tenant_suspensions (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    tenant_id BIGINT NOT NULL REFERENCES tenants(id) ON DELETE CASCADE, -- Suspended tenant
+
+    -- Suspension
+    reason TEXT NOT NULL,                          -- Why the tenant was suspended
+    suspended_by VARCHAR(64) NOT NULL,             -- User who requested the suspension
+    suspended_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    suspend_operation_id BIGINT UNIQUE REFERENCES operations(id) ON DELETE SET NULL,
+
+    -- Resumption
+    resumed_at TIMESTAMPTZ,                        -- NULL while the tenant is suspended
+    resumed_by VARCHAR(64),                        -- User who requested the resumption
+    resume_reason TEXT,                            -- Why the tenant was resumed
+    resume_operation_id BIGINT REFERENCES operations(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_tenant_suspensions_tenant ON tenant_suspensions(tenant_id, suspended_at DESC);
+
+-- A tenant has at most one open suspension
+CREATE UNIQUE INDEX idx_tenant_suspensions_open ON tenant_suspensions(tenant_id)
+    WHERE resumed_at IS NULL;
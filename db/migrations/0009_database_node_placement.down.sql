@@ -0,0 +1,10 @@
+-- 0009_database_node_placement.down.sql
+
+DROP INDEX IF EXISTS idx_database_nodes_placement;
+
+ALTER TABLE database_nodes
+    DROP CONSTRAINT IF EXISTS database_nodes_max_tenants_check,
+    DROP CONSTRAINT IF EXISTS database_nodes_tenant_count_check,
+    ALTER COLUMN current_utilization_percent DROP NOT NULL,
+    ALTER COLUMN max_tenants DROP NOT NULL,
+    ALTER COLUMN tenant_count DROP NOT NULL;
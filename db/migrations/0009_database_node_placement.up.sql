@@ -0,0 +1,20 @@
+-- 0009_database_node_placement.up.sql
+
+-- -----------------------------------------------------------------------------
+-- Database Node Placement
+-- -----------------------------------------------------------------------------
+
+-- Placement adjusts capacity counters arithmetically, so they must always be set
+UPDATE database_nodes SET tenant_count = 0 WHERE tenant_count IS NULL;
+UPDATE database_nodes SET max_tenants = 10000 WHERE max_tenants IS NULL;
+UPDATE database_nodes SET current_utilization_percent = 0 WHERE current_utilization_percent IS NULL;
+
+ALTER TABLE database_nodes
+    ALTER COLUMN tenant_count SET NOT NULL,
+    ALTER COLUMN max_tenants SET NOT NULL,
+    ALTER COLUMN current_utilization_percent SET NOT NULL,
+    ADD CONSTRAINT database_nodes_tenant_count_check CHECK (tenant_count >= 0),
+    ADD CONSTRAINT database_nodes_max_tenants_check CHECK (max_tenants > 0);
+
+-- Placement looks up active nodes of a type within a region
+CREATE INDEX idx_database_nodes_placement ON database_nodes(region, node_type, status);
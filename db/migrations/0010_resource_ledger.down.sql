@@ -0,0 +1,3 @@
+-- 0010_resource_ledger.down.sql
+
+DROP INDEX IF EXISTS uq_resources_tenant_type_name;
@@ -0,0 +1,9 @@
+-- 0010_resource_ledger.up.sql
+
+-- -----------------------------------------------------------------------------
+-- Resource Ledger
+-- -----------------------------------------------------------------------------
+
+-- Workflow steps register resources by name, so a retried step must find the
+-- resource it registered before rather than record a second one
+CREATE UNIQUE INDEX uq_resources_tenant_type_name ON resources(tenant_id, resource_type, resource_name);
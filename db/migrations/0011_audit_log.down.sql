@@ -0,0 +1,3 @@
+-- 0011_audit_log.down.sql
+
+DROP INDEX IF EXISTS idx_audit_logs_operation;
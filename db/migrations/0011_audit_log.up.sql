@@ -0,0 +1,9 @@
+-- 0011_audit_log.up.sql
+
+-- -----------------------------------------------------------------------------
+-- Audit Log
+-- -----------------------------------------------------------------------------
+
+-- Audit entries are looked up by operation, and operations being deleted null out
+-- the entries that reference them
+CREATE INDEX idx_audit_logs_operation ON audit_logs(operation_id);
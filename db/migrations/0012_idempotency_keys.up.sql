@@ -0,0 +1,25 @@
+-- 0012_idempotency_keys.up.sql
+
+-- -----------------------------------------------------------------------------
+-- Idempotency Keys
+-- -----------------------------------------------------------------------------
+
+-- Idempotency keys sent with mutating requests, so that a retried request is
+-- answered with the original response instead of being carried out again
+CREATE TABLE idempotency_keys (
+    caller VARCHAR(255) NOT NULL,                  -- Subject of the caller that sent the key
+    operation VARCHAR(64) NOT NULL,                -- API operation the key was sent to
+    idempotency_key VARCHAR(255) NOT NULL,         -- Key chosen by the caller
+    fingerprint VARCHAR(64) NOT NULL,              -- Hash of the request the key was first used with
+
+    -- Original response; NULL while the request is in progress
+    status_code INTEGER,
+    response_body JSONB,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL,               -- When the key may be reused
+
+    PRIMARY KEY (caller, operation, idempotency_key)
+);
+
+CREATE INDEX idx_idempotency_keys_expires_at ON idempotency_keys(expires_at);
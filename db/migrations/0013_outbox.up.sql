@@ -0,0 +1,26 @@
+-- 0013_outbox.up.sql
+
+-- -----------------------------------------------------------------------------
+-- Outbox
+-- -----------------------------------------------------------------------------
+
+-- Lifecycle events written in the same transaction as the state change they
+-- describe, and relayed to external sinks afterwards. Events carry no foreign
+-- keys so that they outlive the tenants and operations they describe.
+CREATE TABLE outbox_events (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    event_type VARCHAR(64) NOT NULL,               -- operation.completed, tenant.deleted, etc.
+    tenant_id BIGINT,                              -- Tenant the event is about; events are relayed in order per tenant
+    operation_id BIGINT,                           -- Operation that caused the event
+    data JSONB NOT NULL DEFAULT '{}'::jsonb,       -- Event-specific details
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    -- Delivery
+    published_at TIMESTAMPTZ,                      -- NULL until relayed to every sink
+    attempts INTEGER NOT NULL DEFAULT 0,           -- Failed relay attempts
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(), -- Earliest time of the next attempt
+    last_error TEXT                                -- Why the last attempt failed
+);
+
+CREATE INDEX idx_outbox_events_pending ON outbox_events(id) WHERE published_at IS NULL;
+CREATE INDEX idx_outbox_events_published_at ON outbox_events(published_at) WHERE published_at IS NOT NULL;
@@ -0,0 +1,8 @@
+-- 0014_webhooks.down.sql
+
+DROP INDEX IF EXISTS idx_webhook_deliveries_subscription;
+DROP INDEX IF EXISTS idx_webhook_deliveries_due;
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP INDEX IF EXISTS idx_webhook_subscriptions_tenant;
+DROP TABLE IF EXISTS webhook_subscriptions;
+DROP TYPE IF EXISTS webhook_delivery_status;
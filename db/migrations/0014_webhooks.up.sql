@@ -0,0 +1,50 @@
+-- 0014_webhooks.up.sql
+
+-- -----------------------------------------------------------------------------
+-- Webhooks
+-- -----------------------------------------------------------------------------
+
+CREATE TYPE webhook_delivery_status AS ENUM ('pending', 'succeeded', 'dead_letter');
+
+-- Endpoints that are sent the lifecycle events they subscribe to
+CREATE TABLE webhook_subscriptions (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    url TEXT NOT NULL,                             -- Endpoint events are posted to
+    secret VARCHAR(255) NOT NULL,                  -- Key deliveries are signed with
+    event_types VARCHAR(64)[] NOT NULL DEFAULT '{}', -- Event types sent; empty for all
+    tenant_id BIGINT REFERENCES tenants(id) ON DELETE CASCADE, -- Tenant events are sent for; NULL for all
+    description TEXT NOT NULL DEFAULT '',
+    active BOOLEAN NOT NULL DEFAULT TRUE,          -- Inactive subscriptions are sent nothing
+    created_by VARCHAR(64) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_webhook_subscriptions_tenant ON webhook_subscriptions(tenant_id);
+
+-- Log of the events sent to each subscription, which doubles as the queue of
+-- deliveries waiting to be attempted
+CREATE TABLE webhook_deliveries (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    subscription_id BIGINT NOT NULL REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+    event_id BIGINT NOT NULL,                      -- Outbox event being delivered
+    event_type VARCHAR(64) NOT NULL,
+    tenant_id BIGINT,                              -- Tenant the event is about
+    payload JSONB NOT NULL,                        -- Request body, signed on each attempt
+
+    -- Attempts
+    status webhook_delivery_status NOT NULL DEFAULT 'pending',
+    attempts INTEGER NOT NULL DEFAULT 0,           -- Attempts made so far
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(), -- Earliest time of the next attempt
+    last_attempt_at TIMESTAMPTZ,
+    response_status INTEGER,                       -- HTTP status of the last response, if any
+    last_error TEXT,                               -- Why the last attempt failed
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    delivered_at TIMESTAMPTZ,                      -- When the endpoint accepted the event
+
+    -- Events relayed more than once are delivered once per subscription
+    UNIQUE (subscription_id, event_id)
+);
+
+CREATE INDEX idx_webhook_deliveries_due ON webhook_deliveries(next_attempt_at) WHERE status = 'pending';
+CREATE INDEX idx_webhook_deliveries_subscription ON webhook_deliveries(subscription_id, id DESC);
@@ -0,0 +1,4 @@
+-- 0015_operation_events.down.sql
+
+DROP INDEX IF EXISTS idx_operation_events_operation;
+DROP TABLE IF EXISTS operation_events;
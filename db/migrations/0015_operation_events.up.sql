@@ -0,0 +1,23 @@
+-- 0015_operation_events.up.sql
+
+-- -----------------------------------------------------------------------------
+-- Operation Events
+-- -----------------------------------------------------------------------------
+
+-- Timeline of an operation's status changes and workflow step progress, written
+-- in the same transaction as the change it records and streamed to clients
+-- watching the operation. Events are appended while holding the operation's row
+-- lock, so within an operation they become visible in ID order and a client can
+-- resume after the last event it received.
+CREATE TABLE operation_events (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    operation_id BIGINT NOT NULL REFERENCES operations(id) ON DELETE CASCADE, -- Operation the event is about
+    event_type VARCHAR(32) NOT NULL,               -- status_changed, step_started, step_finished, step_compensated
+    status VARCHAR(32) NOT NULL,                   -- New operation status, or step status for step events
+    step_index INTEGER,                            -- Position of the step within the workflow, for step events
+    step_name VARCHAR(64),                         -- Name of the workflow step, for step events
+    error_message TEXT,                            -- Why the operation or step failed
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_operation_events_operation ON operation_events(operation_id, id);
@@ -0,0 +1,7 @@
+-- 0016_outbox_dead_letter.down.sql
+
+DROP INDEX IF EXISTS idx_outbox_events_pending_operation;
+DROP INDEX IF EXISTS idx_outbox_events_pending_tenant;
+DROP INDEX IF EXISTS idx_outbox_events_pending;
+CREATE INDEX idx_outbox_events_pending ON outbox_events(id) WHERE published_at IS NULL;
+ALTER TABLE outbox_events DROP COLUMN IF EXISTS dead_lettered_at;
@@ -0,0 +1,16 @@
+-- 0016_outbox_dead_letter.up.sql
+
+-- -----------------------------------------------------------------------------
+-- Outbox Dead Letters
+-- -----------------------------------------------------------------------------
+
+-- Events that keep failing to publish are given up on, so that they no longer
+-- hold back the later events about the same tenant.
+ALTER TABLE outbox_events ADD COLUMN dead_lettered_at TIMESTAMPTZ; -- When the relay gave up on the event
+
+-- Pending events are those neither published nor given up on. The relay picks
+-- the oldest of them per tenant, or per operation for events without a tenant.
+DROP INDEX IF EXISTS idx_outbox_events_pending;
+CREATE INDEX idx_outbox_events_pending ON outbox_events(id) WHERE published_at IS NULL AND dead_lettered_at IS NULL;
+CREATE INDEX idx_outbox_events_pending_tenant ON outbox_events(tenant_id, id) WHERE published_at IS NULL AND dead_lettered_at IS NULL;
+CREATE INDEX idx_outbox_events_pending_operation ON outbox_events(operation_id, id) WHERE published_at IS NULL AND dead_lettered_at IS NULL;
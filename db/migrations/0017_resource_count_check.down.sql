@@ -0,0 +1,3 @@
+-- 0017_resource_count_check.down.sql
+
+ALTER TABLE resource_counts DROP CONSTRAINT IF EXISTS resource_counts_count_check;
@@ -0,0 +1,9 @@
+-- 0017_resource_count_check.up.sql
+
+-- -----------------------------------------------------------------------------
+-- Resource Count Check
+-- -----------------------------------------------------------------------------
+
+-- Releasing more quota than was reserved is a bookkeeping bug, so it fails
+-- instead of being clamped at zero.
+ALTER TABLE resource_counts ADD CONSTRAINT resource_counts_count_check CHECK (count >= 0);
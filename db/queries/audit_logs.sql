@@ -0,0 +1,28 @@
+-- name: CreateAuditLog :one
+INSERT INTO audit_logs (
+    action,
+    timestamp,
+    status,
+    actor,
+    actor_ip,
+    tenant_id,
+    resource_id,
+    operation_id,
+    details,
+    error_details,
+    duration_ms
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+RETURNING id;
+
+-- name: ListAuditLogs :many
+SELECT * FROM audit_logs
+WHERE (sqlc.narg(actor)::varchar IS NULL OR actor = sqlc.narg(actor))
+    AND (sqlc.narg(action)::varchar IS NULL OR action = sqlc.narg(action))
+    AND (sqlc.narg(status)::audit_status IS NULL OR status = sqlc.narg(status))
+    AND (sqlc.narg(tenant_id)::bigint IS NULL OR tenant_id = sqlc.narg(tenant_id))
+    AND (sqlc.narg(operation_id)::bigint IS NULL OR operation_id = sqlc.narg(operation_id))
+    AND (sqlc.narg(since)::timestamptz IS NULL OR timestamp >= sqlc.narg(since))
+    AND (sqlc.narg(until)::timestamptz IS NULL OR timestamp < sqlc.narg(until))
+    AND (sqlc.narg(before_id)::bigint IS NULL OR id < sqlc.narg(before_id))
+ORDER BY id DESC
+LIMIT sqlc.arg(row_limit);
@@ -0,0 +1,60 @@
+-- name: CreateDatabaseNode :one
+INSERT INTO database_nodes (
+    hostname,
+    port,
+    region,
+    node_type,
+    status,
+    max_tenants,
+    created_by
+) VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id;
+
+-- name: FindDatabaseNodeByID :one
+SELECT * FROM database_nodes
+WHERE id = $1
+LIMIT 1;
+
+-- name: ListDatabaseNodes :many
+SELECT * FROM database_nodes
+WHERE (sqlc.narg(region)::region_type IS NULL OR region = sqlc.narg(region))
+    AND (sqlc.narg(node_type)::node_type IS NULL OR node_type = sqlc.narg(node_type))
+    AND (sqlc.narg(status)::database_node_status IS NULL OR status = sqlc.narg(status))
+ORDER BY region ASC, hostname ASC;
+
+-- name: UpdateDatabaseNodeStatus :execrows
+UPDATE database_nodes
+SET
+    status = $2,
+    updated_at = NOW()
+WHERE id = $1;
+
+-- name: FindPlacementCandidate :one
+SELECT * FROM database_nodes
+WHERE region = $1
+    AND node_type = $2
+    AND status = 'active'
+    AND tenant_count < max_tenants
+ORDER BY tenant_count::float8 / max_tenants ASC, id ASC
+LIMIT 1
+FOR UPDATE;
+
+-- name: AdjustDatabaseNodeTenantCount :exec
+UPDATE database_nodes
+SET
+    tenant_count = tenant_count + sqlc.arg(delta)::integer,
+    current_utilization_percent = (tenant_count + sqlc.arg(delta)::integer) * 100 / max_tenants,
+    updated_at = NOW()
+WHERE id = sqlc.arg(id);
+
+-- name: LockTenantPrimaryNode :one
+SELECT primary_node_id FROM tenants
+WHERE id = $1
+FOR UPDATE;
+
+-- name: SetTenantPrimaryNode :exec
+UPDATE tenants
+SET
+    primary_node_id = $2,
+    updated_at = NOW()
+WHERE id = $1;
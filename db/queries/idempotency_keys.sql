@@ -0,0 +1,42 @@
+-- name: ClaimIdempotencyKey :execrows
+INSERT INTO idempotency_keys (
+    caller,
+    operation,
+    idempotency_key,
+    fingerprint,
+    expires_at
+) VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (caller, operation, idempotency_key) DO UPDATE
+SET
+    fingerprint = EXCLUDED.fingerprint,
+    status_code = NULL,
+    response_body = NULL,
+    created_at = NOW(),
+    expires_at = EXCLUDED.expires_at
+WHERE idempotency_keys.expires_at <= NOW();
+
+-- name: CompleteIdempotencyKey :execrows
+UPDATE idempotency_keys
+SET
+    status_code = $4,
+    response_body = $5,
+    expires_at = $6
+WHERE caller = $1
+    AND operation = $2
+    AND idempotency_key = $3
+    AND status_code IS NULL;
+
+-- name: DeleteExpiredIdempotencyKeys :execrows
+DELETE FROM idempotency_keys
+WHERE expires_at <= $1;
+
+-- name: FindIdempotencyKey :one
+SELECT * FROM idempotency_keys
+WHERE caller = $1 AND operation = $2 AND idempotency_key = $3;
+
+-- name: ReleaseIdempotencyKey :exec
+DELETE FROM idempotency_keys
+WHERE caller = $1
+    AND operation = $2
+    AND idempotency_key = $3
+    AND status_code IS NULL;
@@ -0,0 +1,25 @@
+-- name: CreateIsolationGroup :one
+INSERT INTO isolation_groups (
+    name,
+    region,
+    created_by
+) VALUES ($1, $2, $3)
+RETURNING id;
+
+-- name: FindIsolationGroupByID :one
+SELECT * FROM isolation_groups
+WHERE id = $1
+LIMIT 1;
+
+-- name: ListIsolationGroups :many
+SELECT * FROM isolation_groups
+WHERE sqlc.narg(region)::region_type IS NULL OR region = sqlc.narg(region)
+ORDER BY name ASC;
+
+-- name: CountIsolationGroupTenants :one
+SELECT COUNT(*) FROM tenants
+WHERE isolation_group_id = $1 AND status != 'deleted';
+
+-- name: DeleteIsolationGroup :execrows
+DELETE FROM isolation_groups
+WHERE id = $1;
@@ -0,0 +1,16 @@
+-- name: CreateOperationEvent :exec
+INSERT INTO operation_events (
+    operation_id,
+    event_type,
+    status,
+    step_index,
+    step_name,
+    error_message
+) VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: ListOperationEvents :many
+SELECT * FROM operation_events
+WHERE operation_id = $1
+  AND id > sqlc.arg(after_id)
+ORDER BY id ASC
+LIMIT sqlc.arg(row_limit);
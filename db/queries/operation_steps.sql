@@ -0,0 +1,70 @@
+-- name: StartOperationStep :exec
+INSERT INTO operation_steps (
+    operation_id,
+    step_index,
+    step_name,
+    status
+) VALUES ($1, $2, $3, 'in_progress')
+ON CONFLICT (operation_id, step_name) DO UPDATE
+SET
+    status = 'in_progress',
+    attempts = operation_steps.attempts + 1,
+    error_message = NULL,
+    started_at = NOW(),
+    completed_at = NULL,
+    updated_at = NOW();
+
+-- name: FinishOperationStep :exec
+UPDATE operation_steps
+SET
+    status = $3,
+    error_message = $4,
+    completed_at = NOW(),
+    updated_at = NOW()
+WHERE operation_id = $1 AND step_name = $2;
+
+-- name: FindOperationSteps :many
+SELECT * FROM operation_steps
+WHERE operation_id = $1
+ORDER BY step_index ASC;
+
+-- name: TouchOperation :exec
+UPDATE operations
+SET updated_at = NOW()
+WHERE id = $1;
+
+-- name: ClaimStaleOperation :execrows
+UPDATE operations
+SET updated_at = NOW()
+WHERE id = $1
+  AND status IN ('pending', 'in_progress')
+  AND updated_at < $2;
+
+-- name: CompensateOperationStep :exec
+UPDATE operation_steps
+SET
+    status = 'compensated',
+    updated_at = NOW()
+WHERE operation_id = $1 AND step_name = $2;
+
+-- name: CopyCompletedOperationSteps :exec
+INSERT INTO operation_steps (
+    operation_id,
+    step_index,
+    step_name,
+    status,
+    attempts,
+    started_at,
+    completed_at
+)
+SELECT
+    sqlc.arg(target_operation_id)::BIGINT,
+    step_index,
+    step_name,
+    status,
+    attempts,
+    started_at,
+    completed_at
+FROM operation_steps
+WHERE operation_steps.operation_id = sqlc.arg(source_operation_id)
+  AND status = 'completed';
@@ -0,0 +1,62 @@
+-- name: AdvisoryUnlock :one
+SELECT pg_advisory_unlock(sqlc.arg(lock_id)::bigint);
+
+-- name: AppendOutboxEvent :exec
+INSERT INTO outbox_events (
+    event_type,
+    tenant_id,
+    operation_id,
+    data
+) VALUES ($1, $2, $3, $4);
+
+-- name: DeletePublishedOutboxEvents :execrows
+DELETE FROM outbox_events
+WHERE published_at < $1;
+
+-- name: ListPendingOutboxEvents :many
+SELECT * FROM outbox_events
+WHERE published_at IS NULL
+    AND dead_lettered_at IS NULL
+    AND next_attempt_at <= NOW()
+    AND NOT EXISTS (
+        SELECT 1 FROM outbox_events earlier
+        WHERE earlier.id < outbox_events.id
+            AND earlier.published_at IS NULL
+            AND earlier.dead_lettered_at IS NULL
+            AND (
+                earlier.tenant_id = outbox_events.tenant_id
+                OR (
+                    earlier.tenant_id IS NULL
+                    AND outbox_events.tenant_id IS NULL
+                    AND earlier.operation_id = outbox_events.operation_id
+                )
+            )
+    )
+ORDER BY id ASC
+LIMIT $1;
+
+-- name: MarkOutboxEventDeadLettered :exec
+UPDATE outbox_events
+SET
+    attempts = attempts + 1,
+    last_error = $2,
+    dead_lettered_at = NOW()
+WHERE id = $1;
+
+-- name: MarkOutboxEventFailed :exec
+UPDATE outbox_events
+SET
+    attempts = attempts + 1,
+    last_error = $2,
+    next_attempt_at = $3
+WHERE id = $1;
+
+-- name: MarkOutboxEventPublished :exec
+UPDATE outbox_events
+SET
+    published_at = NOW(),
+    last_error = NULL
+WHERE id = $1;
+
+-- name: TryAdvisoryLock :one
+SELECT pg_try_advisory_lock(sqlc.arg(lock_id)::bigint);
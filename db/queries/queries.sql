@@ -22,14 +22,71 @@ SET
     database_schema = $6,
     kubernetes_namespace = $7,
     primary_node_id = $8,
+    region = $9,
     updated_at = NOW()
 WHERE id = $1;
 
+-- name: TransitionTenantStatus :execrows
+UPDATE tenants
+SET
+    status = sqlc.arg(to_status),
+    updated_at = NOW()
+WHERE id = sqlc.arg(id)
+    AND status::text = ANY(sqlc.arg(from_statuses)::text[]);
+
+-- name: ChangeTenantIsolationGroup :execrows
+UPDATE tenants
+SET
+    isolation_group_id = $2,
+    is_isolated = $3,
+    updated_at = NOW()
+WHERE id = $1
+    AND status = 'active'
+    AND region = $4
+    AND NOT EXISTS (
+        SELECT 1 FROM operations
+        WHERE operations.tenant_id = tenants.id
+            AND operations.status IN ('pending', 'in_progress', 'paused')
+    );
+
+-- name: ChangeTenantTier :execrows
+UPDATE tenants
+SET
+    tier = sqlc.arg(tier),
+    updated_at = NOW()
+WHERE id = sqlc.arg(id)
+    AND status::text = ANY(sqlc.arg(statuses)::text[]);
+
+-- name: ChangeTenantRegion :execrows
+UPDATE tenants
+SET
+    region = sqlc.arg(region),
+    updated_at = NOW()
+WHERE id = sqlc.arg(id)
+    AND status::text = ANY(sqlc.arg(statuses)::text[]);
+
+-- name: LockTenant :one
+SELECT * FROM tenants
+WHERE id = $1 AND status != 'deleted'
+FOR UPDATE;
+
+-- name: TenantHasIncompleteOperation :one
+SELECT EXISTS (
+    SELECT 1 FROM operations
+    WHERE tenant_id = $1
+        AND status IN ('pending', 'in_progress', 'paused')
+);
+
 -- name: FindTenantByID :one
 SELECT * FROM tenants
 WHERE id = $1 AND status != 'deleted'
 LIMIT 1;
 
+-- name: FindTenantByIDIncludingDeleted :one
+SELECT * FROM tenants
+WHERE id = $1
+LIMIT 1;
+
 -- name: FindTenantByName :one
 SELECT * FROM tenants
 WHERE name = $1 AND status != 'deleted'
@@ -42,6 +99,42 @@ SET
     updated_at = NOW()
 WHERE id = $1;
 
+-- name: ListTenantsByCreatedAt :many
+SELECT * FROM tenants
+WHERE ((sqlc.narg(status)::tenant_status IS NULL AND status != 'deleted') OR status = sqlc.narg(status))
+    AND (sqlc.narg(region)::region_type IS NULL OR region = sqlc.narg(region))
+    AND (sqlc.narg(tier)::text IS NULL OR tier = sqlc.narg(tier))
+    AND (sqlc.narg(isolation_group_id)::bigint IS NULL OR isolation_group_id = sqlc.narg(isolation_group_id))
+    AND (sqlc.narg(name_prefix)::text IS NULL OR name LIKE sqlc.narg(name_prefix) || '%')
+    AND (
+        sqlc.narg(cursor_created_at)::timestamptz IS NULL
+        OR (sqlc.arg(descending)::boolean AND (created_at, id) < (sqlc.narg(cursor_created_at), sqlc.narg(cursor_id)::bigint))
+        OR (NOT sqlc.arg(descending) AND (created_at, id) > (sqlc.narg(cursor_created_at), sqlc.narg(cursor_id)))
+    )
+ORDER BY
+    CASE WHEN sqlc.arg(descending) THEN created_at END DESC,
+    CASE WHEN sqlc.arg(descending) THEN id END DESC,
+    CASE WHEN NOT sqlc.arg(descending) THEN created_at END ASC,
+    CASE WHEN NOT sqlc.arg(descending) THEN id END ASC
+LIMIT sqlc.arg(page_limit);
+
+-- name: ListTenantsByName :many
+SELECT * FROM tenants
+WHERE ((sqlc.narg(status)::tenant_status IS NULL AND status != 'deleted') OR status = sqlc.narg(status))
+    AND (sqlc.narg(region)::region_type IS NULL OR region = sqlc.narg(region))
+    AND (sqlc.narg(tier)::text IS NULL OR tier = sqlc.narg(tier))
+    AND (sqlc.narg(isolation_group_id)::bigint IS NULL OR isolation_group_id = sqlc.narg(isolation_group_id))
+    AND (sqlc.narg(name_prefix)::text IS NULL OR name LIKE sqlc.narg(name_prefix) || '%')
+    AND (
+        sqlc.narg(cursor_name)::text IS NULL
+        OR (sqlc.arg(descending)::boolean AND name < sqlc.narg(cursor_name))
+        OR (NOT sqlc.arg(descending) AND name > sqlc.narg(cursor_name))
+    )
+ORDER BY
+    CASE WHEN sqlc.arg(descending) THEN name END DESC,
+    CASE WHEN NOT sqlc.arg(descending) THEN name END ASC
+LIMIT sqlc.arg(page_limit);
+
 -- Operation Queries
 
 -- name: CreateOperation :one
@@ -50,10 +143,17 @@ INSERT INTO operations (
     operation_type,
     status,
     parameters,
-    created_by
-) VALUES ($1, $2, $3, $4, $5)
+    created_by,
+    parent_operation_id,
+    attempt
+) VALUES ($1, $2, $3, $4, $5, $6, $7)
 RETURNING id;
 
+-- name: LockOperationStatus :one
+SELECT status FROM operations
+WHERE id = $1
+FOR UPDATE;
+
 -- name: UpdateOperation :exec
 UPDATE operations
 SET
@@ -62,6 +162,8 @@ SET
     error_message = $4,
     started_at = $5,
     completed_at = $6,
+    cancelled_by = $7,
+    parameters = $8,
     updated_at = NOW()
 WHERE id = $1;
 
@@ -84,3 +186,18 @@ ORDER BY created_at ASC;
 SELECT * FROM operations
 WHERE status IN ('pending', 'in_progress')
 ORDER BY created_at ASC;
+
+-- name: ListOperations :many
+SELECT * FROM operations
+WHERE (sqlc.narg(tenant_id)::bigint IS NULL OR tenant_id = sqlc.narg(tenant_id))
+    AND (sqlc.narg(operation_type)::text IS NULL OR operation_type = sqlc.narg(operation_type))
+    AND (sqlc.narg(status)::operation_status IS NULL OR status = sqlc.narg(status))
+    AND (sqlc.narg(created_by)::text IS NULL OR created_by = sqlc.narg(created_by))
+    AND (sqlc.narg(created_after)::timestamptz IS NULL OR created_at >= sqlc.narg(created_after))
+    AND (sqlc.narg(created_before)::timestamptz IS NULL OR created_at < sqlc.narg(created_before))
+    AND (
+        sqlc.narg(cursor_created_at)::timestamptz IS NULL
+        OR (created_at, id) < (sqlc.narg(cursor_created_at), sqlc.narg(cursor_id)::bigint)
+    )
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg(page_limit);
@@ -0,0 +1,22 @@
+-- name: AdjustResourceCount :one
+INSERT INTO resource_counts (
+    resource_type,
+    project_id,
+    region,
+    count
+) VALUES (
+    sqlc.arg(resource_type),
+    sqlc.arg(project_id),
+    sqlc.arg(region),
+    sqlc.arg(delta)::integer
+)
+ON CONFLICT (resource_type, project_id, region) DO UPDATE
+SET
+    count = resource_counts.count + sqlc.arg(delta)::integer,
+    last_updated = NOW()
+RETURNING count;
+
+-- name: ListResourceCounts :many
+SELECT * FROM resource_counts
+WHERE project_id = $1 AND region = $2
+ORDER BY resource_type ASC;
@@ -0,0 +1,35 @@
+-- name: RegisterResource :one
+INSERT INTO resources (
+    tenant_id,
+    resource_type,
+    resource_name,
+    resource_id,
+    region,
+    project_id,
+    status,
+    metadata,
+    created_by_operation_id
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT (tenant_id, resource_type, resource_name) DO UPDATE
+SET
+    status = EXCLUDED.status,
+    updated_at = NOW()
+RETURNING id;
+
+-- name: ListTenantResources :many
+SELECT * FROM resources
+WHERE tenant_id = $1
+    AND (sqlc.narg(resource_type)::varchar IS NULL OR resource_type = sqlc.narg(resource_type))
+    AND (sqlc.narg(status)::resource_status IS NULL OR status = sqlc.narg(status))
+ORDER BY id ASC;
+
+-- name: UpdateResourceStatus :execrows
+UPDATE resources
+SET
+    status = $2,
+    updated_at = NOW()
+WHERE id = $1;
+
+-- name: DeleteResource :execrows
+DELETE FROM resources
+WHERE id = $1;
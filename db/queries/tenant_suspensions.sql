@@ -0,0 +1,23 @@
+-- name: CreateTenantSuspension :exec
+INSERT INTO tenant_suspensions (
+    tenant_id,
+    reason,
+    suspended_by,
+    suspended_at,
+    suspend_operation_id
+) VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT DO NOTHING;
+
+-- name: EndTenantSuspension :exec
+UPDATE tenant_suspensions
+SET
+    resumed_at = NOW(),
+    resumed_by = $2,
+    resume_reason = $3,
+    resume_operation_id = $4
+WHERE tenant_id = $1 AND resumed_at IS NULL;
+
+-- name: ListTenantSuspensions :many
+SELECT * FROM tenant_suspensions
+WHERE tenant_id = $1
+ORDER BY suspended_at DESC, id DESC;
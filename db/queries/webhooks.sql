@@ -0,0 +1,89 @@
+-- name: ClaimDueWebhookDeliveries :many
+UPDATE webhook_deliveries
+SET next_attempt_at = sqlc.arg(lease_until)
+WHERE id IN (
+    SELECT id FROM webhook_deliveries
+    WHERE status = 'pending' AND next_attempt_at <= NOW()
+    ORDER BY next_attempt_at ASC, id ASC
+    LIMIT sqlc.arg(row_limit)
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: CreateWebhookDelivery :exec
+INSERT INTO webhook_deliveries (
+    subscription_id,
+    event_id,
+    event_type,
+    tenant_id,
+    payload
+) VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (subscription_id, event_id) DO NOTHING;
+
+-- name: CreateWebhookSubscription :one
+INSERT INTO webhook_subscriptions (
+    url,
+    secret,
+    event_types,
+    tenant_id,
+    description,
+    active,
+    created_by
+) VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id;
+
+-- name: DeleteWebhookSubscription :execrows
+DELETE FROM webhook_subscriptions
+WHERE id = $1;
+
+-- name: FindWebhookDeliveryByID :one
+SELECT * FROM webhook_deliveries
+WHERE id = $1
+LIMIT 1;
+
+-- name: FindWebhookSubscriptionByID :one
+SELECT * FROM webhook_subscriptions
+WHERE id = $1
+LIMIT 1;
+
+-- name: ListMatchingWebhookSubscriptions :many
+SELECT * FROM webhook_subscriptions
+WHERE active
+    AND (tenant_id IS NULL OR tenant_id = sqlc.narg(tenant_id))
+    AND (cardinality(event_types) = 0 OR sqlc.arg(event_type)::varchar = ANY(event_types))
+ORDER BY id ASC;
+
+-- name: ListWebhookDeliveries :many
+SELECT * FROM webhook_deliveries
+WHERE subscription_id = sqlc.arg(subscription_id)
+    AND (sqlc.narg(status)::webhook_delivery_status IS NULL OR status = sqlc.narg(status))
+    AND (sqlc.narg(before_id)::bigint IS NULL OR id < sqlc.narg(before_id))
+ORDER BY id DESC
+LIMIT sqlc.arg(row_limit);
+
+-- name: ListWebhookSubscriptions :many
+SELECT * FROM webhook_subscriptions
+WHERE sqlc.narg(tenant_id)::bigint IS NULL OR tenant_id = sqlc.narg(tenant_id)
+ORDER BY id ASC;
+
+-- name: UpdateWebhookDelivery :execrows
+UPDATE webhook_deliveries
+SET
+    status = $2,
+    attempts = $3,
+    next_attempt_at = $4,
+    last_attempt_at = $5,
+    response_status = $6,
+    last_error = $7,
+    delivered_at = $8
+WHERE id = $1;
+
+-- name: UpdateWebhookSubscription :execrows
+UPDATE webhook_subscriptions
+SET
+    url = $2,
+    event_types = $3,
+    description = $4,
+    active = $5,
+    updated_at = NOW()
+WHERE id = $1;
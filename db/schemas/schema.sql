@@ -210,3 +210,287 @@ CREATE TABLE audit_logs (
 CREATE INDEX idx_audit_logs_actor ON audit_logs(actor);
 CREATE INDEX idx_audit_logs_tenant ON audit_logs(tenant_id);
 CREATE INDEX idx_audit_logs_timestamp ON audit_logs(timestamp);
+
+-- -----------------------------------------------------------------------------
+-- Operation Steps
+-- -----------------------------------------------------------------------------
+
+-- Define operation step status enum
+CREATE TYPE operation_step_status AS ENUM ('in_progress', 'completed', 'failed');
+
+-- Operation steps table - Checkpoints workflow step progress so interrupted
+-- operations can be resumed from their last completed step
+CREATE TABLE operation_steps (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    operation_id BIGINT NOT NULL REFERENCES operations(id) ON DELETE CASCADE, -- Owning operation
+
+    step_index INTEGER NOT NULL,                   -- Position of the step within the workflow
+    step_name VARCHAR(64) NOT NULL,                -- Name of the workflow step
+    status operation_step_status NOT NULL DEFAULT 'in_progress', -- Current status of the step
+    attempts INTEGER NOT NULL DEFAULT 1,           -- Number of times the step has been started
+    error_message TEXT,                            -- Error details if the step failed
+
+    -- Timestamps
+    started_at TIMESTAMPTZ NOT NULL DEFAULT NOW(), -- When the latest attempt began
+    completed_at TIMESTAMPTZ,                      -- When the latest attempt finished
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    UNIQUE (operation_id, step_name)
+);
+
+CREATE INDEX idx_operations_incomplete_updated ON operations(updated_at)
+    WHERE status IN ('pending', 'in_progress');
+
+
+-- -----------------------------------------------------------------------------
+-- Operation Cancellation
+-- -----------------------------------------------------------------------------
+
+-- Record who cancelled an operation; the reason is kept in error_message
+ALTER TABLE operations ADD COLUMN cancelled_by VARCHAR(64);
+
+-- -----------------------------------------------------------------------------
+-- Operation Retries
+-- -----------------------------------------------------------------------------
+
+-- Link a retry to the failed operation it retries
+ALTER TABLE operations
+    ADD COLUMN parent_operation_id BIGINT REFERENCES operations(id), -- Operation this one retries
+    ADD COLUMN attempt INTEGER NOT NULL DEFAULT 1;                   -- 1 for the original operation, incremented by each retry
+
+-- A failed operation can be retried only once; further retries go through the retry
+CREATE UNIQUE INDEX idx_operations_parent ON operations(parent_operation_id)
+    WHERE parent_operation_id IS NOT NULL;
+
+-- Mark steps whose effects were undone by compensation so a retry runs them again
+ALTER TYPE operation_step_status ADD VALUE 'compensated';
+
+-- -----------------------------------------------------------------------------
+-- Tenant Listing
+-- -----------------------------------------------------------------------------
+
+-- Keyset pagination over creation time, with the ID breaking ties
+CREATE INDEX idx_tenants_created_at ON tenants(created_at, id);
+
+-- Name prefix search; the unique index on name cannot serve LIKE under a non-C collation
+CREATE INDEX idx_tenants_name_pattern ON tenants(name text_pattern_ops);
+
+-- -----------------------------------------------------------------------------
+-- Operation Listing
+-- -----------------------------------------------------------------------------
+
+-- Keyset pagination over creation time, newest first, with the ID breaking ties
+CREATE INDEX idx_operations_created_at ON operations(created_at DESC, id DESC);
+
+-- -----------------------------------------------------------------------------
+-- Operation Pausing
+-- -----------------------------------------------------------------------------
+
+-- Operations paused between steps; recovery leaves them alone until resumed
+ALTER TYPE operation_status ADD VALUE 'paused';
+
+-- -----------------------------------------------------------------------------
+-- Tenant Suspensions
+-- -----------------------------------------------------------------------------
+
+-- History of tenant suspensions; a row stays open until the tenant is resumed
+CREATE TABLE tenant_suspensions (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    tenant_id BIGINT NOT NULL REFERENCES tenants(id) ON DELETE CASCADE, -- Suspended tenant
+
+    -- Suspension
+    reason TEXT NOT NULL,                          -- Why the tenant was suspended
+    suspended_by VARCHAR(64) NOT NULL,             -- User who requested the suspension
+    suspended_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    suspend_operation_id BIGINT UNIQUE REFERENCES operations(id) ON DELETE SET NULL,
+
+    -- Resumption
+    resumed_at TIMESTAMPTZ,                        -- NULL while the tenant is suspended
+    resumed_by VARCHAR(64),                        -- User who requested the resumption
+    resume_reason TEXT,                            -- Why the tenant was resumed
+    resume_operation_id BIGINT REFERENCES operations(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_tenant_suspensions_tenant ON tenant_suspensions(tenant_id, suspended_at DESC);
+
+-- A tenant has at most one open suspension
+CREATE UNIQUE INDEX idx_tenant_suspensions_open ON tenant_suspensions(tenant_id)
+    WHERE resumed_at IS NULL;
+
+-- -----------------------------------------------------------------------------
+-- Database Node Placement
+-- -----------------------------------------------------------------------------
+
+-- Placement adjusts capacity counters arithmetically, so they must always be set
+UPDATE database_nodes SET tenant_count = 0 WHERE tenant_count IS NULL;
+UPDATE database_nodes SET max_tenants = 10000 WHERE max_tenants IS NULL;
+UPDATE database_nodes SET current_utilization_percent = 0 WHERE current_utilization_percent IS NULL;
+
+ALTER TABLE database_nodes
+    ALTER COLUMN tenant_count SET NOT NULL,
+    ALTER COLUMN max_tenants SET NOT NULL,
+    ALTER COLUMN current_utilization_percent SET NOT NULL,
+    ADD CONSTRAINT database_nodes_tenant_count_check CHECK (tenant_count >= 0),
+    ADD CONSTRAINT database_nodes_max_tenants_check CHECK (max_tenants > 0);
+
+-- Placement looks up active nodes of a type within a region
+CREATE INDEX idx_database_nodes_placement ON database_nodes(region, node_type, status);
+
+-- -----------------------------------------------------------------------------
+-- Resource Ledger
+-- -----------------------------------------------------------------------------
+
+-- Workflow steps register resources by name, so a retried step must find the
+-- resource it registered before rather than record a second one
+CREATE UNIQUE INDEX uq_resources_tenant_type_name ON resources(tenant_id, resource_type, resource_name);
+
+-- -----------------------------------------------------------------------------
+-- Audit Log
+-- -----------------------------------------------------------------------------
+
+-- Audit entries are looked up by operation, and operations being deleted null out
+-- the entries that reference them
+CREATE INDEX idx_audit_logs_operation ON audit_logs(operation_id);
+
+-- -----------------------------------------------------------------------------
+-- Idempotency Keys
+-- -----------------------------------------------------------------------------
+
+-- Idempotency keys sent with mutating requests, so that a retried request is
+-- answered with the original response instead of being carried out again
+CREATE TABLE idempotency_keys (
+    caller VARCHAR(255) NOT NULL,                  -- Subject of the caller that sent the key
+    operation VARCHAR(64) NOT NULL,                -- API operation the key was sent to
+    idempotency_key VARCHAR(255) NOT NULL,         -- Key chosen by the caller
+    fingerprint VARCHAR(64) NOT NULL,              -- Hash of the request the key was first used with
+
+    -- Original response; NULL while the request is in progress
+    status_code INTEGER,
+    response_body JSONB,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL,               -- When the key may be reused
+
+    PRIMARY KEY (caller, operation, idempotency_key)
+);
+
+CREATE INDEX idx_idempotency_keys_expires_at ON idempotency_keys(expires_at);
+
+-- -----------------------------------------------------------------------------
+-- Outbox
+-- -----------------------------------------------------------------------------
+
+-- Lifecycle events written in the same transaction as the state change they
+-- describe, and relayed to external sinks afterwards. Events carry no foreign
+-- keys so that they outlive the tenants and operations they describe.
+CREATE TABLE outbox_events (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    event_type VARCHAR(64) NOT NULL,               -- operation.completed, tenant.deleted, etc.
+    tenant_id BIGINT,                              -- Tenant the event is about; events are relayed in order per tenant
+    operation_id BIGINT,                           -- Operation that caused the event
+    data JSONB NOT NULL DEFAULT '{}'::jsonb,       -- Event-specific details
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    -- Delivery
+    published_at TIMESTAMPTZ,                      -- NULL until relayed to every sink
+    attempts INTEGER NOT NULL DEFAULT 0,           -- Failed relay attempts
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(), -- Earliest time of the next attempt
+    last_error TEXT                                -- Why the last attempt failed
+);
+
+CREATE INDEX idx_outbox_events_pending ON outbox_events(id) WHERE published_at IS NULL;
+CREATE INDEX idx_outbox_events_published_at ON outbox_events(published_at) WHERE published_at IS NOT NULL;
+
+-- -----------------------------------------------------------------------------
+-- Webhooks
+-- -----------------------------------------------------------------------------
+
+CREATE TYPE webhook_delivery_status AS ENUM ('pending', 'succeeded', 'dead_letter');
+
+-- Endpoints that are sent the lifecycle events they subscribe to
+CREATE TABLE webhook_subscriptions (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    url TEXT NOT NULL,                             -- Endpoint events are posted to
+    secret VARCHAR(255) NOT NULL,                  -- Key deliveries are signed with
+    event_types VARCHAR(64)[] NOT NULL DEFAULT '{}', -- Event types sent; empty for all
+    tenant_id BIGINT REFERENCES tenants(id) ON DELETE CASCADE, -- Tenant events are sent for; NULL for all
+    description TEXT NOT NULL DEFAULT '',
+    active BOOLEAN NOT NULL DEFAULT TRUE,          -- Inactive subscriptions are sent nothing
+    created_by VARCHAR(64) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_webhook_subscriptions_tenant ON webhook_subscriptions(tenant_id);
+
+-- Log of the events sent to each subscription, which doubles as the queue of
+-- deliveries waiting to be attempted
+CREATE TABLE webhook_deliveries (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    subscription_id BIGINT NOT NULL REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+    event_id BIGINT NOT NULL,                      -- Outbox event being delivered
+    event_type VARCHAR(64) NOT NULL,
+    tenant_id BIGINT,                              -- Tenant the event is about
+    payload JSONB NOT NULL,                        -- Request body, signed on each attempt
+
+    -- Attempts
+    status webhook_delivery_status NOT NULL DEFAULT 'pending',
+    attempts INTEGER NOT NULL DEFAULT 0,           -- Attempts made so far
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(), -- Earliest time of the next attempt
+    last_attempt_at TIMESTAMPTZ,
+    response_status INTEGER,                       -- HTTP status of the last response, if any
+    last_error TEXT,                               -- Why the last attempt failed
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    delivered_at TIMESTAMPTZ,                      -- When the endpoint accepted the event
+
+    -- Events relayed more than once are delivered once per subscription
+    UNIQUE (subscription_id, event_id)
+);
+
+CREATE INDEX idx_webhook_deliveries_due ON webhook_deliveries(next_attempt_at) WHERE status = 'pending';
+CREATE INDEX idx_webhook_deliveries_subscription ON webhook_deliveries(subscription_id, id DESC);
+
+-- -----------------------------------------------------------------------------
+-- Operation Events
+-- -----------------------------------------------------------------------------
+
+-- Timeline of an operation's status changes and workflow step progress, written
+-- in the same transaction as the change it records and streamed to clients
+-- watching the operation. Events are appended while holding the operation's row
+-- lock, so within an operation they become visible in ID order and a client can
+-- resume after the last event it received.
+CREATE TABLE operation_events (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    operation_id BIGINT NOT NULL REFERENCES operations(id) ON DELETE CASCADE, -- Operation the event is about
+    event_type VARCHAR(32) NOT NULL,               -- status_changed, step_started, step_finished, step_compensated
+    status VARCHAR(32) NOT NULL,                   -- New operation status, or step status for step events
+    step_index INTEGER,                            -- Position of the step within the workflow, for step events
+    step_name VARCHAR(64),                         -- Name of the workflow step, for step events
+    error_message TEXT,                            -- Why the operation or step failed
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_operation_events_operation ON operation_events(operation_id, id);
+
+-- -----------------------------------------------------------------------------
+-- Outbox Dead Letters
+-- -----------------------------------------------------------------------------
+
+-- Events that keep failing to publish are given up on, so that they no longer
+-- hold back the later events about the same tenant.
+ALTER TABLE outbox_events ADD COLUMN dead_lettered_at TIMESTAMPTZ; -- When the relay gave up on the event
+
+-- Pending events are those neither published nor given up on. The relay picks
+-- the oldest of them per tenant, or per operation for events without a tenant.
+DROP INDEX IF EXISTS idx_outbox_events_pending;
+CREATE INDEX idx_outbox_events_pending ON outbox_events(id) WHERE published_at IS NULL AND dead_lettered_at IS NULL;
+CREATE INDEX idx_outbox_events_pending_tenant ON outbox_events(tenant_id, id) WHERE published_at IS NULL AND dead_lettered_at IS NULL;
+CREATE INDEX idx_outbox_events_pending_operation ON outbox_events(operation_id, id) WHERE published_at IS NULL AND dead_lettered_at IS NULL;
+
+-- -----------------------------------------------------------------------------
+-- Resource Count Check
+-- -----------------------------------------------------------------------------
+
+-- Releasing more quota than was reserved is a bookkeeping bug, so it fails
+-- instead of being clamped at zero.
+ALTER TABLE resource_counts ADD CONSTRAINT resource_counts_count_check CHECK (count >= 0);
@@ -0,0 +1,32 @@
+package audit
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/ahrav/hoglet-hub/internal/domain/audit"
+)
+
+// Caller identifies who issued a request and from where.
+type Caller struct {
+	Actor string
+	IP    *netip.Addr // Nil if the client address is unknown
+}
+
+// callerKey is the context key under which the request's caller is stored.
+type callerKey struct{}
+
+// WithCaller returns a copy of ctx carrying the caller of the request.
+func WithCaller(ctx context.Context, c Caller) context.Context {
+	return context.WithValue(ctx, callerKey{}, c)
+}
+
+// CallerFromContext returns the caller stored in ctx. Requests without a known
+// actor, such as those from background jobs, are attributed to audit.SystemActor.
+func CallerFromContext(ctx context.Context) Caller {
+	c, _ := ctx.Value(callerKey{}).(Caller)
+	if c.Actor == "" {
+		c.Actor = audit.SystemActor
+	}
+	return c
+}
@@ -0,0 +1,156 @@
+// Package audit records the audit trail of mutating actions and serves it to
+// compliance reviews.
+package audit
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ahrav/hoglet-hub/internal/domain/audit"
+	"github.com/ahrav/hoglet-hub/pkg/common/cursor"
+	"github.com/ahrav/hoglet-hub/pkg/common/logger"
+)
+
+// Page size bounds for audit listings.
+const (
+	DefaultListLimit = 100
+	MaxListLimit     = 500
+)
+
+// maxActorLength is the longest actor the audit trail stores.
+const maxActorLength = 64
+
+// ListParams contains parameters for listing audit entries.
+// A zero Limit selects DefaultListLimit.
+type ListParams struct {
+	Filter audit.ListFilter
+	Cursor string // Opaque token from a previous ListResult; empty for the first page
+	Limit  int
+}
+
+// ListResult is a page of audit entries, newest first, along with the token for
+// the following page.
+type ListResult struct {
+	Entries    []*audit.Entry
+	NextCursor string // Empty when there are no more entries
+}
+
+// listCursor is the position encoded into a page token.
+type listCursor struct {
+	BeforeID int64 `json:"before_id"`
+}
+
+// Service records and lists audit entries.
+type Service struct {
+	repo audit.Repository
+
+	logger *logger.Logger
+	tracer trace.Tracer
+}
+
+// NewService creates a new audit service with the provided repository.
+func NewService(repo audit.Repository, logger *logger.Logger, tracer trace.Tracer) *Service {
+	return &Service{
+		repo:   repo,
+		logger: logger.With("component", "audit_service"),
+		tracer: tracer,
+	}
+}
+
+// Record completes the entry with the action's outcome and appends it to the
+// audit trail. An entry without an actor is attributed to the caller in ctx.
+//
+// Recording is best effort: the action has already happened by the time it is
+// audited, so a failure to record it is logged rather than returned.
+func (s *Service) Record(ctx context.Context, e *audit.Entry, outcome error) {
+	ctx, span := s.tracer.Start(ctx, "audit.Record", trace.WithAttributes(
+		attribute.String("action", string(e.Action)),
+	))
+	defer span.End()
+
+	if e.Actor == "" {
+		caller := CallerFromContext(ctx)
+		e.Actor, e.ActorIP = caller.Actor, caller.IP
+	}
+	if len(e.Actor) > maxActorLength {
+		e.Actor = e.Actor[:maxActorLength]
+	}
+	e.SetOutcome(outcome)
+	span.SetAttributes(attribute.String("status", string(e.Status)))
+
+	id, err := s.repo.Create(context.WithoutCancel(ctx), e)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error recording audit entry")
+		s.logger.Error(ctx, "failed to record audit entry",
+			"action", e.Action,
+			"actor", e.Actor,
+			"status", e.Status,
+			"error", err,
+		)
+		return
+	}
+	e.ID = id
+
+	span.SetStatus(codes.Ok, "audit entry recorded")
+}
+
+// List returns a page of audit entries matching the filter, newest first.
+// It returns audit.ErrInvalidListParams for an invalid filter or cursor.
+func (s *Service) List(ctx context.Context, params ListParams) (*ListResult, error) {
+	ctx, span := s.tracer.Start(ctx, "audit.List", trace.WithAttributes(
+		attribute.Int("limit", params.Limit),
+	))
+	defer span.End()
+
+	listParams := audit.ListParams{Filter: params.Filter, Limit: params.Limit}
+	if listParams.Limit == 0 {
+		listParams.Limit = DefaultListLimit
+	}
+	listParams.Limit = min(listParams.Limit, MaxListLimit)
+
+	if params.Cursor != "" {
+		var c listCursor
+		if err := cursor.Decode(params.Cursor, &c); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "invalid cursor")
+			return nil, fmt.Errorf("%w: %w", audit.ErrInvalidListParams, err)
+		}
+		listParams.BeforeID = c.BeforeID
+	}
+
+	if err := listParams.Validate(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "invalid list parameters")
+		return nil, err
+	}
+
+	// One entry more than the page is fetched to learn whether another page follows.
+	pageSize := listParams.Limit
+	listParams.Limit++
+	entries, err := s.repo.List(ctx, listParams)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error listing audit entries")
+		return nil, fmt.Errorf("failed to list audit entries: %w", err)
+	}
+
+	result := &ListResult{Entries: entries}
+	if len(entries) > pageSize {
+		result.Entries = entries[:pageSize]
+		result.NextCursor, err = cursor.Encode(listCursor{BeforeID: result.Entries[pageSize-1].ID})
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "error encoding cursor")
+			return nil, err
+		}
+	}
+
+	span.SetAttributes(attribute.Int("entry_count", len(result.Entries)))
+	span.SetStatus(codes.Ok, "audit entries listed")
+	return result, nil
+}
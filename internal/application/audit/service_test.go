@@ -0,0 +1,141 @@
+package audit_test
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	appAudit "github.com/ahrav/hoglet-hub/internal/application/audit"
+	"github.com/ahrav/hoglet-hub/internal/domain/audit"
+	"github.com/ahrav/hoglet-hub/pkg/common/logger"
+)
+
+// MockAuditRepo is a testify mock for audit.Repository.
+type MockAuditRepo struct{ mock.Mock }
+
+func (m *MockAuditRepo) Create(ctx context.Context, e *audit.Entry) (int64, error) {
+	args := m.Called(ctx, e)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockAuditRepo) List(ctx context.Context, params audit.ListParams) ([]*audit.Entry, error) {
+	args := m.Called(ctx, params)
+	entries, _ := args.Get(0).([]*audit.Entry)
+	return entries, args.Error(1)
+}
+
+func newService(repo *MockAuditRepo) *appAudit.Service {
+	return appAudit.NewService(repo, logger.Noop(), noop.NewTracerProvider().Tracer("test"))
+}
+
+func TestService_Record(t *testing.T) {
+	ip := netip.MustParseAddr("198.51.100.4")
+	ctx := appAudit.WithCaller(context.Background(), appAudit.Caller{Actor: "alice@example.com", IP: &ip})
+
+	t.Run("attributes the entry to the caller", func(t *testing.T) {
+		repo := new(MockAuditRepo)
+		repo.On("Create", mock.Anything, mock.MatchedBy(func(e *audit.Entry) bool {
+			return e.Actor == "alice@example.com" && e.ActorIP != nil && *e.ActorIP == ip &&
+				e.Status == audit.StatusFailure && *e.Error == "tenant not found"
+		})).Return(int64(7), nil)
+
+		e := &audit.Entry{Action: audit.ActionDeleteTenant}
+		newService(repo).Record(ctx, e, errors.New("tenant not found"))
+		assert.Equal(t, int64(7), e.ID)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("keeps an explicit actor", func(t *testing.T) {
+		repo := new(MockAuditRepo)
+		repo.On("Create", mock.Anything, mock.MatchedBy(func(e *audit.Entry) bool {
+			return e.Actor == "bob@example.com" && e.ActorIP == nil && e.Status == audit.StatusSuccess
+		})).Return(int64(8), nil)
+
+		newService(repo).Record(ctx, &audit.Entry{Action: audit.ActionExecuteOperation, Actor: "bob@example.com"}, nil)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("defaults to the system actor", func(t *testing.T) {
+		repo := new(MockAuditRepo)
+		repo.On("Create", mock.Anything, mock.MatchedBy(func(e *audit.Entry) bool {
+			return e.Actor == audit.SystemActor
+		})).Return(int64(9), nil)
+
+		newService(repo).Record(context.Background(), &audit.Entry{Action: audit.ActionCreateTenant}, nil)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("truncates long actors", func(t *testing.T) {
+		repo := new(MockAuditRepo)
+		repo.On("Create", mock.Anything, mock.MatchedBy(func(e *audit.Entry) bool {
+			return len(e.Actor) == 64
+		})).Return(int64(10), nil)
+
+		e := &audit.Entry{Action: audit.ActionCreateTenant, Actor: strings.Repeat("a", 100)}
+		newService(repo).Record(ctx, e, nil)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("does not fail the action when recording fails", func(t *testing.T) {
+		repo := new(MockAuditRepo)
+		repo.On("Create", mock.Anything, mock.Anything).Return(int64(0), errors.New("db down"))
+
+		e := &audit.Entry{Action: audit.ActionCreateTenant}
+		assert.NotPanics(t, func() { newService(repo).Record(ctx, e, nil) })
+		assert.Zero(t, e.ID)
+	})
+}
+
+func TestService_List(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("pages through entries", func(t *testing.T) {
+		repo := new(MockAuditRepo)
+		repo.On("List", mock.Anything, audit.ListParams{Limit: 3}).
+			Return([]*audit.Entry{{ID: 30}, {ID: 29}, {ID: 28}}, nil)
+		repo.On("List", mock.Anything, audit.ListParams{BeforeID: 29, Limit: 3}).
+			Return([]*audit.Entry{{ID: 28}}, nil)
+		svc := newService(repo)
+
+		first, err := svc.List(ctx, appAudit.ListParams{Limit: 2})
+		require.NoError(t, err)
+		require.Len(t, first.Entries, 2)
+		assert.Equal(t, int64(29), first.Entries[1].ID)
+		require.NotEmpty(t, first.NextCursor)
+
+		second, err := svc.List(ctx, appAudit.ListParams{Cursor: first.NextCursor, Limit: 2})
+		require.NoError(t, err)
+		require.Len(t, second.Entries, 1)
+		assert.Empty(t, second.NextCursor)
+	})
+
+	t.Run("applies the default limit", func(t *testing.T) {
+		repo := new(MockAuditRepo)
+		repo.On("List", mock.Anything, audit.ListParams{Limit: appAudit.DefaultListLimit + 1}).
+			Return([]*audit.Entry{}, nil)
+
+		_, err := newService(repo).List(ctx, appAudit.ListParams{})
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		_, err := newService(new(MockAuditRepo)).List(ctx, appAudit.ListParams{Cursor: "not-a-cursor"})
+		assert.ErrorIs(t, err, audit.ErrInvalidListParams)
+	})
+
+	t.Run("invalid status", func(t *testing.T) {
+		status := audit.Status("pending")
+		_, err := newService(new(MockAuditRepo)).List(ctx, appAudit.ListParams{
+			Filter: audit.ListFilter{Status: &status},
+		})
+		assert.ErrorIs(t, err, audit.ErrInvalidListParams)
+	})
+}
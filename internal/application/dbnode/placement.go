@@ -0,0 +1,93 @@
+package dbnode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ahrav/hoglet-hub/internal/domain/dbnode"
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+	"github.com/ahrav/hoglet-hub/pkg/common/logger"
+)
+
+// Placer decides which database node hosts a tenant's schema.
+//
+// It walks the tenant's placement criteria in order of preference and reserves
+// capacity on the least utilized node matching the first criteria that has room.
+// Reservation and assignment happen atomically in the repository, so concurrent
+// placements never oversubscribe a node.
+type Placer struct {
+	repo dbnode.Repository
+
+	logger *logger.Logger
+	tracer trace.Tracer
+}
+
+// NewPlacer creates a new placer backed by the node repository.
+func NewPlacer(repo dbnode.Repository, logger *logger.Logger, tracer trace.Tracer) *Placer {
+	return &Placer{
+		repo:   repo,
+		logger: logger.With("component", "database_node_placer"),
+		tracer: tracer,
+	}
+}
+
+// Place assigns the tenant a primary database node. A tenant that already has one keeps it.
+// Returns dbnode.ErrNoCapacity if no node matching the tenant's needs has room.
+func (p *Placer) Place(ctx context.Context, t *tenant.Tenant) (*dbnode.Node, error) {
+	logger := logger.NewLoggerContext(p.logger.With("tenant_id", t.ID, "region", t.Region, "tier", t.Tier))
+	ctx, span := p.tracer.Start(ctx, "dbnode.Place", trace.WithAttributes(
+		attribute.Int64("tenant_id", t.ID),
+		attribute.String("region", string(t.Region)),
+		attribute.String("tier", string(t.Tier)),
+		attribute.Bool("isolated", t.IsolationGroupID != nil),
+	))
+	defer span.End()
+
+	var errs []error
+	for _, criteria := range dbnode.PlacementCriteria(t) {
+		node, err := p.repo.Place(ctx, t.ID, criteria)
+		if errors.Is(err, dbnode.ErrNoCapacity) {
+			span.AddEvent("no capacity", trace.WithAttributes(attribute.String("node_type", string(criteria.Type))))
+			errs = append(errs, err)
+			continue
+		}
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "error placing tenant")
+			return nil, fmt.Errorf("failed to place tenant (%d): %w", t.ID, err)
+		}
+
+		span.SetAttributes(attribute.Int64("node_id", node.ID))
+		span.SetStatus(codes.Ok, "tenant placed")
+		logger.Info(ctx, "tenant placed", "node_id", node.ID, "hostname", node.Hostname, "node_type", node.Type)
+		return node, nil
+	}
+
+	err := errors.Join(errs...)
+	span.RecordError(err)
+	span.SetStatus(codes.Error, "no capacity")
+	logger.Warn(ctx, "no database node has capacity for tenant")
+	return nil, err
+}
+
+// Release frees the capacity reserved for the tenant on its primary node.
+func (p *Placer) Release(ctx context.Context, tenantID int64) error {
+	ctx, span := p.tracer.Start(ctx, "dbnode.Release", trace.WithAttributes(
+		attribute.Int64("tenant_id", tenantID),
+	))
+	defer span.End()
+
+	if err := p.repo.Release(ctx, tenantID); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error releasing tenant")
+		return fmt.Errorf("failed to release tenant (%d) from its database node: %w", tenantID, err)
+	}
+
+	span.SetStatus(codes.Ok, "tenant released")
+	return nil
+}
@@ -0,0 +1,185 @@
+package dbnode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ahrav/hoglet-hub/internal/domain/dbnode"
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+	"github.com/ahrav/hoglet-hub/pkg/common/logger"
+)
+
+// Service provides the database node registry: registering nodes and moving
+// them in and out of service.
+type Service struct {
+	repo dbnode.Repository
+
+	logger *logger.Logger
+	tracer trace.Tracer
+}
+
+// NewService creates a new database node service with the required repository.
+func NewService(repo dbnode.Repository, logger *logger.Logger, tracer trace.Tracer) *Service {
+	return &Service{
+		repo:   repo,
+		logger: logger.With("component", "database_node_service"),
+		tracer: tracer,
+	}
+}
+
+// RegisterParams contains parameters for registering a database node.
+// A zero MaxTenants selects dbnode.DefaultMaxTenants.
+type RegisterParams struct {
+	Hostname   string
+	Port       int32
+	Region     tenant.Region
+	Type       dbnode.Type
+	MaxTenants int32
+}
+
+// Register validates and persists a new node, which is immediately available for placement.
+// Returns dbnode.ErrNodeAlreadyExists if the hostname is already registered.
+func (s *Service) Register(ctx context.Context, params RegisterParams, actor string) (*dbnode.Node, error) {
+	logger := logger.NewLoggerContext(s.logger.With("hostname", params.Hostname, "region", params.Region))
+	ctx, span := s.tracer.Start(ctx, "dbnode.Register", trace.WithAttributes(
+		attribute.String("hostname", params.Hostname),
+		attribute.String("region", string(params.Region)),
+		attribute.String("node_type", string(params.Type)),
+	))
+	defer span.End()
+
+	node, err := dbnode.NewNode(params.Hostname, params.Port, params.Region, params.Type, params.MaxTenants, actor)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "invalid database node")
+		return nil, err
+	}
+
+	id, err := s.repo.Create(ctx, node)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error persisting database node")
+		if errors.Is(err, dbnode.ErrNodeAlreadyExists) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to persist database node (%s): %w", params.Hostname, err)
+	}
+	node.ID = id
+
+	span.SetAttributes(attribute.Int64("node_id", id))
+	span.SetStatus(codes.Ok, "database node registered")
+	logger.Info(ctx, "database node registered", "node_id", id)
+	return node, nil
+}
+
+// Get retrieves a node by ID.
+// Returns dbnode.ErrNodeNotFound if the node does not exist.
+func (s *Service) Get(ctx context.Context, id int64) (*dbnode.Node, error) {
+	ctx, span := s.tracer.Start(ctx, "dbnode.Get", trace.WithAttributes(
+		attribute.Int64("node_id", id),
+	))
+	defer span.End()
+
+	node, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error finding database node")
+		if errors.Is(err, dbnode.ErrNodeNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("error finding database node (%d): %w", id, err)
+	}
+
+	return node, nil
+}
+
+// List returns the nodes matching the filter, ordered by region and hostname.
+func (s *Service) List(ctx context.Context, filter dbnode.ListFilter) ([]*dbnode.Node, error) {
+	ctx, span := s.tracer.Start(ctx, "dbnode.List")
+	defer span.End()
+
+	if filter.Region != nil && !filter.Region.IsValid() {
+		span.SetStatus(codes.Error, "invalid region")
+		return nil, tenant.ErrInvalidRegion
+	}
+	if filter.Type != nil && !filter.Type.IsValid() {
+		span.SetStatus(codes.Error, "invalid node type")
+		return nil, dbnode.ErrInvalidNodeType
+	}
+
+	nodes, err := s.repo.List(ctx, filter)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error listing database nodes")
+		return nil, fmt.Errorf("failed to list database nodes: %w", err)
+	}
+
+	span.SetStatus(codes.Ok, "database nodes listed")
+	return nodes, nil
+}
+
+// Activate returns a node to service so that new tenants can be placed on it.
+func (s *Service) Activate(ctx context.Context, id int64) (*dbnode.Node, error) {
+	return s.changeStatus(ctx, id, "activate", (*dbnode.Node).Activate)
+}
+
+// Drain stops new tenants from being placed on a node.
+func (s *Service) Drain(ctx context.Context, id int64) (*dbnode.Node, error) {
+	return s.changeStatus(ctx, id, "drain", (*dbnode.Node).Drain)
+}
+
+// StartMaintenance takes a node out of placement for maintenance.
+func (s *Service) StartMaintenance(ctx context.Context, id int64) (*dbnode.Node, error) {
+	return s.changeStatus(ctx, id, "maintenance", (*dbnode.Node).StartMaintenance)
+}
+
+// Decommission permanently retires a node that no longer hosts any tenants.
+// Returns dbnode.ErrNodeNotEmpty while tenants remain on the node.
+func (s *Service) Decommission(ctx context.Context, id int64) (*dbnode.Node, error) {
+	return s.changeStatus(ctx, id, "decommission", (*dbnode.Node).Decommission)
+}
+
+// changeStatus applies a status change to a node and persists it. It returns
+// dbnode.ErrInvalidTransition if the node's current status does not allow the change.
+func (s *Service) changeStatus(
+	ctx context.Context,
+	id int64,
+	action string,
+	apply func(*dbnode.Node) error,
+) (*dbnode.Node, error) {
+	logger := logger.NewLoggerContext(s.logger.With("node_id", id, "action", action))
+	ctx, span := s.tracer.Start(ctx, "dbnode.ChangeStatus", trace.WithAttributes(
+		attribute.Int64("node_id", id),
+		attribute.String("action", action),
+	))
+	defer span.End()
+
+	node, err := s.Get(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error finding database node")
+		return nil, err
+	}
+
+	from := node.Status
+	if err := apply(node); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "invalid status change")
+		return nil, err
+	}
+
+	if err := s.repo.UpdateStatus(ctx, node); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error updating database node")
+		return nil, fmt.Errorf("failed to update database node (%d): %w", id, err)
+	}
+
+	span.SetStatus(codes.Ok, "database node status changed")
+	logger.Info(ctx, "database node status changed", "from", from, "to", node.Status)
+	return node, nil
+}
@@ -0,0 +1,175 @@
+package dbnode_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/ahrav/hoglet-hub/internal/application/dbnode"
+	nodeDomain "github.com/ahrav/hoglet-hub/internal/domain/dbnode"
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+	"github.com/ahrav/hoglet-hub/pkg/common/logger"
+)
+
+// MockNodeRepo is a testify mock for dbnode.Repository.
+type MockNodeRepo struct{ mock.Mock }
+
+func (m *MockNodeRepo) Create(ctx context.Context, n *nodeDomain.Node) (int64, error) {
+	args := m.Called(ctx, n)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockNodeRepo) FindByID(ctx context.Context, id int64) (*nodeDomain.Node, error) {
+	args := m.Called(ctx, id)
+	node, _ := args.Get(0).(*nodeDomain.Node)
+	return node, args.Error(1)
+}
+
+func (m *MockNodeRepo) List(ctx context.Context, filter nodeDomain.ListFilter) ([]*nodeDomain.Node, error) {
+	args := m.Called(ctx, filter)
+	nodes, _ := args.Get(0).([]*nodeDomain.Node)
+	return nodes, args.Error(1)
+}
+
+func (m *MockNodeRepo) UpdateStatus(ctx context.Context, n *nodeDomain.Node) error {
+	args := m.Called(ctx, n)
+	return args.Error(0)
+}
+
+func (m *MockNodeRepo) Place(ctx context.Context, tenantID int64, criteria nodeDomain.Criteria) (*nodeDomain.Node, error) {
+	args := m.Called(ctx, tenantID, criteria)
+	node, _ := args.Get(0).(*nodeDomain.Node)
+	return node, args.Error(1)
+}
+
+func (m *MockNodeRepo) Release(ctx context.Context, tenantID int64) error {
+	args := m.Called(ctx, tenantID)
+	return args.Error(0)
+}
+
+var testTracer = noop.NewTracerProvider().Tracer("test")
+
+func TestService_Register(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("persists the node", func(t *testing.T) {
+		repo := new(MockNodeRepo)
+		repo.On("Create", mock.Anything, mock.MatchedBy(func(n *nodeDomain.Node) bool {
+			return n.Hostname == "citus-worker-1" && n.Type == nodeDomain.TypeHighMemory &&
+				n.MaxTenants == nodeDomain.DefaultMaxTenants && n.CreatedBy == "ops@example.com"
+		})).Return(int64(3), nil)
+
+		node, err := dbnode.NewService(repo, logger.Noop(), testTracer).Register(ctx, dbnode.RegisterParams{
+			Hostname: "citus-worker-1",
+			Port:     5432,
+			Region:   tenant.RegionEU1,
+			Type:     nodeDomain.TypeHighMemory,
+		}, "ops@example.com")
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), node.ID)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("rejects an invalid node", func(t *testing.T) {
+		repo := new(MockNodeRepo)
+
+		_, err := dbnode.NewService(repo, logger.Noop(), testTracer).Register(ctx, dbnode.RegisterParams{
+			Hostname: "citus-worker-1",
+			Port:     5432,
+			Region:   tenant.RegionEU1,
+			Type:     nodeDomain.Type("gpu"),
+		}, "ops@example.com")
+		assert.ErrorIs(t, err, nodeDomain.ErrInvalidNodeType)
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestService_ChangeStatus(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("drains an active node", func(t *testing.T) {
+		repo := new(MockNodeRepo)
+		repo.On("FindByID", mock.Anything, int64(3)).
+			Return(&nodeDomain.Node{ID: 3, Status: nodeDomain.StatusActive}, nil)
+		repo.On("UpdateStatus", mock.Anything, mock.MatchedBy(func(n *nodeDomain.Node) bool {
+			return n.Status == nodeDomain.StatusDraining
+		})).Return(nil)
+
+		node, err := dbnode.NewService(repo, logger.Noop(), testTracer).Drain(ctx, 3)
+		require.NoError(t, err)
+		assert.Equal(t, nodeDomain.StatusDraining, node.Status)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("refuses to decommission a node with tenants", func(t *testing.T) {
+		repo := new(MockNodeRepo)
+		repo.On("FindByID", mock.Anything, int64(3)).
+			Return(&nodeDomain.Node{ID: 3, Status: nodeDomain.StatusDraining, TenantCount: 2}, nil)
+
+		_, err := dbnode.NewService(repo, logger.Noop(), testTracer).Decommission(ctx, 3)
+		assert.ErrorIs(t, err, nodeDomain.ErrNodeNotEmpty)
+		repo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything)
+	})
+
+	t.Run("unknown node", func(t *testing.T) {
+		repo := new(MockNodeRepo)
+		repo.On("FindByID", mock.Anything, int64(3)).Return(nil, nodeDomain.ErrNodeNotFound)
+
+		_, err := dbnode.NewService(repo, logger.Noop(), testTracer).Activate(ctx, 3)
+		assert.ErrorIs(t, err, nodeDomain.ErrNodeNotFound)
+	})
+}
+
+func TestPlacer_Place(t *testing.T) {
+	ctx := context.Background()
+	enterprise := &tenant.Tenant{ID: 11, Region: tenant.RegionUS1, Tier: tenant.TierEnterprise}
+	highMemory := nodeDomain.Criteria{Region: tenant.RegionUS1, Type: nodeDomain.TypeHighMemory}
+	standard := nodeDomain.Criteria{Region: tenant.RegionUS1, Type: nodeDomain.TypeStandard}
+
+	t.Run("prefers the first criteria with capacity", func(t *testing.T) {
+		repo := new(MockNodeRepo)
+		repo.On("Place", mock.Anything, int64(11), highMemory).
+			Return(&nodeDomain.Node{ID: 5, Type: nodeDomain.TypeHighMemory}, nil)
+
+		node, err := dbnode.NewPlacer(repo, logger.Noop(), testTracer).Place(ctx, enterprise)
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), node.ID)
+		repo.AssertNotCalled(t, "Place", mock.Anything, int64(11), standard)
+	})
+
+	t.Run("falls back when the preferred nodes are full", func(t *testing.T) {
+		repo := new(MockNodeRepo)
+		repo.On("Place", mock.Anything, int64(11), highMemory).Return(nil, nodeDomain.ErrNoCapacity)
+		repo.On("Place", mock.Anything, int64(11), standard).
+			Return(&nodeDomain.Node{ID: 8, Type: nodeDomain.TypeStandard}, nil)
+
+		node, err := dbnode.NewPlacer(repo, logger.Noop(), testTracer).Place(ctx, enterprise)
+		require.NoError(t, err)
+		assert.Equal(t, int64(8), node.ID)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("no capacity anywhere", func(t *testing.T) {
+		repo := new(MockNodeRepo)
+		repo.On("Place", mock.Anything, int64(11), mock.Anything).Return(nil, nodeDomain.ErrNoCapacity)
+
+		_, err := dbnode.NewPlacer(repo, logger.Noop(), testTracer).Place(ctx, enterprise)
+		assert.ErrorIs(t, err, nodeDomain.ErrNoCapacity)
+		repo.AssertNumberOfCalls(t, "Place", 2)
+	})
+
+	t.Run("repository failure stops placement", func(t *testing.T) {
+		repo := new(MockNodeRepo)
+		repo.On("Place", mock.Anything, int64(11), highMemory).Return(nil, errors.New("connection reset"))
+
+		_, err := dbnode.NewPlacer(repo, logger.Noop(), testTracer).Place(ctx, enterprise)
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, nodeDomain.ErrNoCapacity)
+		repo.AssertNumberOfCalls(t, "Place", 1)
+	})
+}
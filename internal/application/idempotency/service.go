@@ -0,0 +1,227 @@
+// Package idempotency makes mutating API requests safe to retry by remembering
+// the response to each request sent with an idempotency key.
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ahrav/hoglet-hub/internal/domain/idempotency"
+	"github.com/ahrav/hoglet-hub/pkg/common/logger"
+)
+
+// Errors returned when a key cannot be used for a request.
+var (
+	ErrKeyReused         = errors.New("idempotency key was already used for a different request")
+	ErrRequestInProgress = errors.New("a request with this idempotency key is still in progress")
+)
+
+// DefaultTTL is how long keys are remembered unless configured otherwise.
+const DefaultTTL = 24 * time.Hour
+
+// DefaultClaimLease is how long a key stays claimed by a request that has not
+// been completed. A request whose handler crashed, or failed to record its
+// response, can be retried with the same key once the lease runs out.
+const DefaultClaimLease = 5 * time.Minute
+
+// DefaultExpiryInterval is how often RunExpiry deletes expired keys.
+const DefaultExpiryInterval = time.Hour
+
+// Service claims idempotency keys for requests and replays the responses of
+// requests that have already been handled.
+type Service struct {
+	repo  idempotency.Repository
+	ttl   time.Duration
+	lease time.Duration
+
+	logger *logger.Logger
+	tracer trace.Tracer
+}
+
+// NewService creates a new idempotency service that remembers the keys of
+// completed requests for ttl. A non-positive ttl selects DefaultTTL. Requests in
+// progress hold their keys for DefaultClaimLease, or ttl if that is shorter.
+func NewService(repo idempotency.Repository, ttl time.Duration, logger *logger.Logger, tracer trace.Tracer) *Service {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	return &Service{
+		repo:   repo,
+		ttl:    ttl,
+		lease:  min(DefaultClaimLease, ttl),
+		logger: logger.With("component", "idempotency_service"),
+		tracer: tracer,
+	}
+}
+
+// Begin claims the key for the request, which is identified by the hash of its
+// JSON encoding.
+//
+// If the key is new, or has expired, Begin returns a nil response and the caller
+// must handle the request and then either Complete or Release the key. The key
+// is claimed for a short lease, after which a retry may claim it again if the
+// request was neither completed nor released. If the key was already used for
+// an identical request that has been handled, the original response is returned
+// and the request must not be handled again.
+//
+// Returns idempotency.ErrInvalidKey for malformed keys, ErrKeyReused if the key
+// was used for a different request and ErrRequestInProgress if the request the
+// key was first used with has not been handled yet.
+func (s *Service) Begin(
+	ctx context.Context,
+	scope idempotency.Scope,
+	key string,
+	request any,
+) (*idempotency.Response, error) {
+	ctx, span := s.tracer.Start(ctx, "idempotency.Begin", trace.WithAttributes(
+		attribute.String("operation", scope.Operation),
+	))
+	defer span.End()
+
+	if err := idempotency.ValidateKey(key); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "invalid idempotency key")
+		return nil, err
+	}
+
+	fingerprint, err := Fingerprint(request)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error fingerprinting request")
+		return nil, err
+	}
+
+	now := time.Now()
+	record := &idempotency.Record{
+		Scope:       scope,
+		Key:         key,
+		Fingerprint: fingerprint,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(s.lease),
+	}
+
+	// The existing record may be released or expire between the claim and the
+	// lookup, in which case the key can be claimed on a second attempt.
+	for range 2 {
+		claimed, err := s.repo.Claim(ctx, record)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "error claiming idempotency key")
+			return nil, fmt.Errorf("error claiming idempotency key: %w", err)
+		}
+		if claimed {
+			span.SetAttributes(attribute.Bool("claimed", true))
+			return nil, nil
+		}
+
+		existing, err := s.repo.Find(ctx, scope, key)
+		if errors.Is(err, idempotency.ErrKeyNotFound) {
+			continue
+		}
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "error finding idempotency key")
+			return nil, fmt.Errorf("error finding idempotency key: %w", err)
+		}
+
+		switch {
+		case existing.Fingerprint != fingerprint:
+			return nil, ErrKeyReused
+		case !existing.Completed():
+			return nil, ErrRequestInProgress
+		default:
+			span.SetAttributes(attribute.Bool("replayed", true))
+			return existing.Response, nil
+		}
+	}
+
+	return nil, ErrRequestInProgress
+}
+
+// Complete records the response to the request the key was claimed for, so that
+// retries are answered with it until the key's TTL runs out. The response body
+// is JSON encoded.
+//
+// Recording is best effort: the request has already been handled, so a failure
+// is logged rather than returned. The key then stays claimed until its lease
+// runs out, which makes retries until then fail with ErrRequestInProgress.
+func (s *Service) Complete(ctx context.Context, scope idempotency.Scope, key string, statusCode int, body any) {
+	ctx, span := s.tracer.Start(ctx, "idempotency.Complete", trace.WithAttributes(
+		attribute.String("operation", scope.Operation),
+		attribute.Int("status_code", statusCode),
+	))
+	defer span.End()
+
+	data, err := json.Marshal(body)
+	if err == nil {
+		resp := idempotency.Response{StatusCode: statusCode, Body: data}
+		err = s.repo.Complete(ctx, scope, key, resp, time.Now().Add(s.ttl))
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error completing idempotency key")
+		s.logger.Error(ctx, "failed to record response for idempotency key",
+			"operation", scope.Operation, "caller", scope.Caller, "error", err)
+	}
+}
+
+// Release gives up the key claimed for a request that was not carried out, so
+// that the request can be retried with the same key. Failures are logged; the
+// key is then released when it expires.
+func (s *Service) Release(ctx context.Context, scope idempotency.Scope, key string) {
+	ctx, span := s.tracer.Start(ctx, "idempotency.Release", trace.WithAttributes(
+		attribute.String("operation", scope.Operation),
+	))
+	defer span.End()
+
+	if err := s.repo.Release(ctx, scope, key); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error releasing idempotency key")
+		s.logger.Error(ctx, "failed to release idempotency key",
+			"operation", scope.Operation, "caller", scope.Caller, "error", err)
+	}
+}
+
+// RunExpiry deletes expired keys immediately and then every interval until ctx
+// is cancelled. Expired keys are ignored whether or not they have been deleted;
+// deleting them only keeps the table from growing.
+func (s *Service) RunExpiry(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		deleted, err := s.repo.DeleteExpired(ctx, time.Now())
+		if err != nil {
+			s.logger.Error(ctx, "failed to delete expired idempotency keys", "error", err)
+		} else if deleted > 0 {
+			s.logger.Info(ctx, "deleted expired idempotency keys", "count", deleted)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Fingerprint returns the hex-encoded SHA-256 hash of the request's JSON
+// encoding. Requests decoded into the same generated type encode identically
+// when they carry the same values.
+func Fingerprint(request any) (string, error) {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return "", fmt.Errorf("error encoding request: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,166 @@
+package idempotency_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	appIdempotency "github.com/ahrav/hoglet-hub/internal/application/idempotency"
+	"github.com/ahrav/hoglet-hub/internal/domain/idempotency"
+	"github.com/ahrav/hoglet-hub/pkg/common/logger"
+)
+
+// MockIdempotencyRepo is a testify mock for idempotency.Repository.
+type MockIdempotencyRepo struct{ mock.Mock }
+
+func (m *MockIdempotencyRepo) Claim(ctx context.Context, r *idempotency.Record) (bool, error) {
+	args := m.Called(ctx, r)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockIdempotencyRepo) Find(ctx context.Context, scope idempotency.Scope, key string) (*idempotency.Record, error) {
+	args := m.Called(ctx, scope, key)
+	r, _ := args.Get(0).(*idempotency.Record)
+	return r, args.Error(1)
+}
+
+func (m *MockIdempotencyRepo) Complete(
+	ctx context.Context,
+	scope idempotency.Scope,
+	key string,
+	resp idempotency.Response,
+	expiresAt time.Time,
+) error {
+	return m.Called(ctx, scope, key, resp, expiresAt).Error(0)
+}
+
+func (m *MockIdempotencyRepo) Release(ctx context.Context, scope idempotency.Scope, key string) error {
+	return m.Called(ctx, scope, key).Error(0)
+}
+
+func (m *MockIdempotencyRepo) DeleteExpired(ctx context.Context, before time.Time) (int64, error) {
+	args := m.Called(ctx, before)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func newService(repo *MockIdempotencyRepo) *appIdempotency.Service {
+	return appIdempotency.NewService(repo, time.Hour, logger.Noop(), noop.NewTracerProvider().Tracer("test"))
+}
+
+type createRequest struct {
+	Name string `json:"name"`
+}
+
+func TestService_Begin(t *testing.T) {
+	ctx := context.Background()
+	scope := idempotency.Scope{Caller: "alice@example.com", Operation: "CreateTenant"}
+	request := createRequest{Name: "acme"}
+	fingerprint, err := appIdempotency.Fingerprint(request)
+	require.NoError(t, err)
+
+	t.Run("claims a new key for a lease", func(t *testing.T) {
+		repo := new(MockIdempotencyRepo)
+		repo.On("Claim", mock.Anything, mock.MatchedBy(func(r *idempotency.Record) bool {
+			return r.Scope == scope && r.Key == "key-1" && r.Fingerprint == fingerprint &&
+				r.ExpiresAt.Sub(r.CreatedAt) == appIdempotency.DefaultClaimLease
+		})).Return(true, nil)
+
+		resp, err := newService(repo).Begin(ctx, scope, "key-1", request)
+		require.NoError(t, err)
+		assert.Nil(t, resp)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("replays the response to an identical request", func(t *testing.T) {
+		original := &idempotency.Response{StatusCode: 202, Body: []byte(`{"operation_id":7}`)}
+		repo := new(MockIdempotencyRepo)
+		repo.On("Claim", mock.Anything, mock.Anything).Return(false, nil)
+		repo.On("Find", mock.Anything, scope, "key-1").
+			Return(&idempotency.Record{Scope: scope, Key: "key-1", Fingerprint: fingerprint, Response: original}, nil)
+
+		resp, err := newService(repo).Begin(ctx, scope, "key-1", request)
+		require.NoError(t, err)
+		assert.Equal(t, original, resp)
+	})
+
+	t.Run("rejects a different request", func(t *testing.T) {
+		repo := new(MockIdempotencyRepo)
+		repo.On("Claim", mock.Anything, mock.Anything).Return(false, nil)
+		repo.On("Find", mock.Anything, scope, "key-1").
+			Return(&idempotency.Record{Scope: scope, Key: "key-1", Fingerprint: "other"}, nil)
+
+		_, err := newService(repo).Begin(ctx, scope, "key-1", request)
+		assert.ErrorIs(t, err, appIdempotency.ErrKeyReused)
+	})
+
+	t.Run("rejects a retry while the request is in progress", func(t *testing.T) {
+		repo := new(MockIdempotencyRepo)
+		repo.On("Claim", mock.Anything, mock.Anything).Return(false, nil)
+		repo.On("Find", mock.Anything, scope, "key-1").
+			Return(&idempotency.Record{Scope: scope, Key: "key-1", Fingerprint: fingerprint}, nil)
+
+		_, err := newService(repo).Begin(ctx, scope, "key-1", request)
+		assert.ErrorIs(t, err, appIdempotency.ErrRequestInProgress)
+	})
+
+	t.Run("claims a key released during the lookup", func(t *testing.T) {
+		repo := new(MockIdempotencyRepo)
+		repo.On("Claim", mock.Anything, mock.Anything).Return(false, nil).Once()
+		repo.On("Find", mock.Anything, scope, "key-1").Return(nil, idempotency.ErrKeyNotFound).Once()
+		repo.On("Claim", mock.Anything, mock.Anything).Return(true, nil).Once()
+
+		resp, err := newService(repo).Begin(ctx, scope, "key-1", request)
+		require.NoError(t, err)
+		assert.Nil(t, resp)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("rejects invalid keys", func(t *testing.T) {
+		repo := new(MockIdempotencyRepo)
+		_, err := newService(repo).Begin(ctx, scope, "", request)
+		assert.ErrorIs(t, err, idempotency.ErrInvalidKey)
+		repo.AssertNotCalled(t, "Claim", mock.Anything, mock.Anything)
+	})
+
+	t.Run("returns repository errors", func(t *testing.T) {
+		repo := new(MockIdempotencyRepo)
+		repo.On("Claim", mock.Anything, mock.Anything).Return(false, errors.New("connection refused"))
+
+		_, err := newService(repo).Begin(ctx, scope, "key-1", request)
+		assert.ErrorContains(t, err, "connection refused")
+	})
+}
+
+func TestService_Complete(t *testing.T) {
+	scope := idempotency.Scope{Caller: "alice@example.com", Operation: "DeleteTenant"}
+	repo := new(MockIdempotencyRepo)
+	start := time.Now()
+	repo.On("Complete", mock.Anything, scope, "key-1", idempotency.Response{
+		StatusCode: 202,
+		Body:       []byte(`{"name":"acme"}`),
+	}, mock.MatchedBy(func(expiresAt time.Time) bool {
+		return !expiresAt.Before(start.Add(time.Hour)) && !expiresAt.After(time.Now().Add(time.Hour))
+	})).Return(nil)
+
+	newService(repo).Complete(context.Background(), scope, "key-1", 202, createRequest{Name: "acme"})
+	repo.AssertExpectations(t)
+}
+
+func TestFingerprint(t *testing.T) {
+	a, err := appIdempotency.Fingerprint(createRequest{Name: "acme"})
+	require.NoError(t, err)
+	b, err := appIdempotency.Fingerprint(createRequest{Name: "acme"})
+	require.NoError(t, err)
+	c, err := appIdempotency.Fingerprint(createRequest{Name: "globex"})
+	require.NoError(t, err)
+
+	assert.Equal(t, a, b)
+	assert.NotEqual(t, a, c)
+	assert.Len(t, a, 64)
+}
@@ -0,0 +1,245 @@
+package isolationgroup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ahrav/hoglet-hub/internal/domain/isolationgroup"
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+	"github.com/ahrav/hoglet-hub/pkg/common/logger"
+)
+
+// Service provides isolation group application services: managing groups and
+// moving tenants between them.
+type Service struct {
+	groupRepo  isolationgroup.Repository
+	tenantRepo tenant.Repository
+
+	logger *logger.Logger
+	tracer trace.Tracer
+}
+
+// NewService creates a new isolation group service with the required repositories.
+func NewService(
+	groupRepo isolationgroup.Repository,
+	tenantRepo tenant.Repository,
+	logger *logger.Logger,
+	tracer trace.Tracer,
+) *Service {
+	return &Service{
+		groupRepo:  groupRepo,
+		tenantRepo: tenantRepo,
+		logger:     logger.With("component", "isolation_group_service"),
+		tracer:     tracer,
+	}
+}
+
+// Create validates and persists a new isolation group.
+// Returns isolationgroup.ErrGroupAlreadyExists if the name is taken.
+func (s *Service) Create(
+	ctx context.Context,
+	name string,
+	region tenant.Region,
+	actor string,
+) (*isolationgroup.IsolationGroup, error) {
+	logger := logger.NewLoggerContext(s.logger.With("group_name", name, "region", region))
+	ctx, span := s.tracer.Start(ctx, "isolationgroup.Create", trace.WithAttributes(
+		attribute.String("name", name),
+		attribute.String("region", string(region)),
+	))
+	defer span.End()
+
+	group, err := isolationgroup.NewIsolationGroup(name, region, actor)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "invalid isolation group")
+		return nil, err
+	}
+
+	id, err := s.groupRepo.Create(ctx, group)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error persisting isolation group")
+		if errors.Is(err, isolationgroup.ErrGroupAlreadyExists) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to persist isolation group (%s): %w", name, err)
+	}
+	group.ID = id
+
+	span.SetAttributes(attribute.Int64("group_id", id))
+	span.SetStatus(codes.Ok, "isolation group created")
+	logger.Info(ctx, "isolation group created", "group_id", id)
+	return group, nil
+}
+
+// Get retrieves an isolation group by ID.
+// Returns isolationgroup.ErrGroupNotFound if the group does not exist.
+func (s *Service) Get(ctx context.Context, id int64) (*isolationgroup.IsolationGroup, error) {
+	ctx, span := s.tracer.Start(ctx, "isolationgroup.Get", trace.WithAttributes(
+		attribute.Int64("group_id", id),
+	))
+	defer span.End()
+
+	group, err := s.groupRepo.FindByID(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error finding isolation group")
+		if errors.Is(err, isolationgroup.ErrGroupNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("error finding isolation group (%d): %w", id, err)
+	}
+
+	return group, nil
+}
+
+// List returns the isolation groups matching the filter, ordered by name.
+func (s *Service) List(ctx context.Context, filter isolationgroup.ListFilter) ([]*isolationgroup.IsolationGroup, error) {
+	ctx, span := s.tracer.Start(ctx, "isolationgroup.List")
+	defer span.End()
+
+	if filter.Region != nil && !filter.Region.IsValid() {
+		span.SetStatus(codes.Error, "invalid region")
+		return nil, tenant.ErrInvalidRegion
+	}
+
+	groups, err := s.groupRepo.List(ctx, filter)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error listing isolation groups")
+		return nil, fmt.Errorf("failed to list isolation groups: %w", err)
+	}
+
+	span.SetStatus(codes.Ok, "isolation groups listed")
+	return groups, nil
+}
+
+// Delete removes an isolation group that no longer has any tenants.
+// Returns isolationgroup.ErrGroupNotEmpty while tenants remain in the group.
+func (s *Service) Delete(ctx context.Context, id int64) error {
+	logger := logger.NewLoggerContext(s.logger.With("group_id", id))
+	ctx, span := s.tracer.Start(ctx, "isolationgroup.Delete", trace.WithAttributes(
+		attribute.Int64("group_id", id),
+	))
+	defer span.End()
+
+	if _, err := s.Get(ctx, id); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error finding isolation group")
+		return err
+	}
+
+	count, err := s.groupRepo.CountTenants(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error counting tenants")
+		return fmt.Errorf("failed to count tenants of isolation group (%d): %w", id, err)
+	}
+	if count > 0 {
+		span.RecordError(isolationgroup.ErrGroupNotEmpty)
+		span.SetStatus(codes.Error, "isolation group not empty")
+		return fmt.Errorf("%w: %d tenants remain", isolationgroup.ErrGroupNotEmpty, count)
+	}
+
+	if err := s.groupRepo.Delete(ctx, id); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error deleting isolation group")
+		if errors.Is(err, isolationgroup.ErrGroupNotFound) {
+			return err
+		}
+		return fmt.Errorf("failed to delete isolation group (%d): %w", id, err)
+	}
+
+	span.SetStatus(codes.Ok, "isolation group deleted")
+	logger.Info(ctx, "isolation group deleted")
+	return nil
+}
+
+// MoveTenant moves an active tenant into the given isolation group, or out of its
+// group when groupID is nil. The group must be in the tenant's region, and no
+// operation on the tenant may be unfinished, since it may depend on the tenant's
+// current placement. Only the group membership is written, and only if that still
+// holds when it is stored, so the move cannot overwrite a concurrent change.
+func (s *Service) MoveTenant(ctx context.Context, tenantID int64, groupID *int64) (*tenant.Tenant, error) {
+	logger := logger.NewLoggerContext(s.logger.With("tenant_id", tenantID))
+	ctx, span := s.tracer.Start(ctx, "isolationgroup.MoveTenant", trace.WithAttributes(
+		attribute.Int64("tenant_id", tenantID),
+	))
+	defer span.End()
+
+	t, err := s.tenantRepo.FindByID(ctx, tenantID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error finding tenant")
+		return nil, fmt.Errorf("error finding tenant (%d): %w", tenantID, err)
+	}
+	if t == nil {
+		span.RecordError(tenant.ErrTenantNotFound)
+		span.SetStatus(codes.Error, "tenant not found")
+		return nil, tenant.ErrTenantNotFound
+	}
+
+	if groupID != nil {
+		span.SetAttributes(attribute.Int64("group_id", *groupID))
+		logger.Add("group_id", *groupID)
+
+		group, err := s.Get(ctx, *groupID)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "error finding isolation group")
+			return nil, err
+		}
+		if err := group.ValidateMember(t.Region); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "region mismatch")
+			return nil, err
+		}
+	}
+
+	if err := t.ChangeIsolationGroup(groupID); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "invalid isolation group change")
+		return nil, err
+	}
+
+	changed, err := s.tenantRepo.ChangeIsolationGroup(ctx, t)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error updating tenant")
+		return nil, fmt.Errorf("failed to update tenant (%d): %w", tenantID, err)
+	}
+	if !changed {
+		err := s.moveRejectedError(ctx, t)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "tenant cannot change isolation group")
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "tenant moved")
+	logger.Info(ctx, "tenant moved between isolation groups")
+	return t, nil
+}
+
+// moveRejectedError explains why storing the isolation group of tenant t changed
+// nothing, by comparing it with the tenant as it is stored now.
+func (s *Service) moveRejectedError(ctx context.Context, t *tenant.Tenant) error {
+	current, err := s.tenantRepo.FindByID(ctx, t.ID)
+	switch {
+	case err != nil && !errors.Is(err, tenant.ErrTenantNotFound):
+		return fmt.Errorf("error finding tenant (%d): %w", t.ID, err)
+	case current == nil:
+		return tenant.ErrTenantNotFound
+	case !current.IsActive():
+		return tenant.ErrTenantNotActive
+	case current.Region != t.Region:
+		return fmt.Errorf("%w: tenant moved to %s", isolationgroup.ErrRegionMismatch, current.Region)
+	default:
+		return tenant.ErrOperationInProgress
+	}
+}
@@ -0,0 +1,229 @@
+package isolationgroup_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/ahrav/hoglet-hub/internal/application/isolationgroup"
+	groupDomain "github.com/ahrav/hoglet-hub/internal/domain/isolationgroup"
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+	"github.com/ahrav/hoglet-hub/pkg/common/logger"
+)
+
+// MockGroupRepo is a testify mock for isolationgroup.Repository.
+type MockGroupRepo struct{ mock.Mock }
+
+func (m *MockGroupRepo) Create(ctx context.Context, g *groupDomain.IsolationGroup) (int64, error) {
+	args := m.Called(ctx, g)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockGroupRepo) FindByID(ctx context.Context, id int64) (*groupDomain.IsolationGroup, error) {
+	args := m.Called(ctx, id)
+	group, _ := args.Get(0).(*groupDomain.IsolationGroup)
+	return group, args.Error(1)
+}
+
+func (m *MockGroupRepo) List(ctx context.Context, filter groupDomain.ListFilter) ([]*groupDomain.IsolationGroup, error) {
+	args := m.Called(ctx, filter)
+	groups, _ := args.Get(0).([]*groupDomain.IsolationGroup)
+	return groups, args.Error(1)
+}
+
+func (m *MockGroupRepo) CountTenants(ctx context.Context, id int64) (int64, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockGroupRepo) Delete(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+// MockTenantRepo is a testify mock for the parts of tenant.Repository used by the service.
+type MockTenantRepo struct {
+	tenant.Repository
+	mock.Mock
+}
+
+func (m *MockTenantRepo) FindByID(ctx context.Context, id int64) (*tenant.Tenant, error) {
+	args := m.Called(ctx, id)
+	t, _ := args.Get(0).(*tenant.Tenant)
+	return t, args.Error(1)
+}
+
+func (m *MockTenantRepo) ChangeIsolationGroup(ctx context.Context, t *tenant.Tenant) (bool, error) {
+	args := m.Called(ctx, t)
+	return args.Bool(0), args.Error(1)
+}
+
+func newService(groups *MockGroupRepo, tenants *MockTenantRepo) *isolationgroup.Service {
+	return isolationgroup.NewService(groups, tenants, logger.Noop(), noop.NewTracerProvider().Tracer("test"))
+}
+
+func TestService_Create(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("persists the group", func(t *testing.T) {
+		groups := new(MockGroupRepo)
+		groups.On("Create", mock.Anything, mock.MatchedBy(func(g *groupDomain.IsolationGroup) bool {
+			return g.Name == "payments" && g.Region == tenant.RegionEU1 && g.CreatedBy == "ops@example.com"
+		})).Return(int64(7), nil)
+
+		group, err := newService(groups, new(MockTenantRepo)).Create(ctx, "payments", tenant.RegionEU1, "ops@example.com")
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), group.ID)
+		groups.AssertExpectations(t)
+	})
+
+	t.Run("invalid name", func(t *testing.T) {
+		groups := new(MockGroupRepo)
+		_, err := newService(groups, new(MockTenantRepo)).Create(ctx, "Payments!", tenant.RegionEU1, "ops@example.com")
+		assert.ErrorIs(t, err, groupDomain.ErrInvalidName)
+		groups.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("duplicate name", func(t *testing.T) {
+		groups := new(MockGroupRepo)
+		groups.On("Create", mock.Anything, mock.Anything).Return(int64(0), groupDomain.ErrGroupAlreadyExists)
+
+		_, err := newService(groups, new(MockTenantRepo)).Create(ctx, "payments", tenant.RegionEU1, "ops@example.com")
+		assert.ErrorIs(t, err, groupDomain.ErrGroupAlreadyExists)
+	})
+}
+
+func TestService_Delete(t *testing.T) {
+	ctx := context.Background()
+	group := &groupDomain.IsolationGroup{ID: 7, Name: "payments", Region: tenant.RegionEU1}
+
+	t.Run("empty group", func(t *testing.T) {
+		groups := new(MockGroupRepo)
+		groups.On("FindByID", mock.Anything, int64(7)).Return(group, nil)
+		groups.On("CountTenants", mock.Anything, int64(7)).Return(int64(0), nil)
+		groups.On("Delete", mock.Anything, int64(7)).Return(nil)
+
+		require.NoError(t, newService(groups, new(MockTenantRepo)).Delete(ctx, 7))
+		groups.AssertExpectations(t)
+	})
+
+	t.Run("group with tenants", func(t *testing.T) {
+		groups := new(MockGroupRepo)
+		groups.On("FindByID", mock.Anything, int64(7)).Return(group, nil)
+		groups.On("CountTenants", mock.Anything, int64(7)).Return(int64(2), nil)
+
+		err := newService(groups, new(MockTenantRepo)).Delete(ctx, 7)
+		assert.ErrorIs(t, err, groupDomain.ErrGroupNotEmpty)
+		groups.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("unknown group", func(t *testing.T) {
+		groups := new(MockGroupRepo)
+		groups.On("FindByID", mock.Anything, int64(7)).Return(nil, groupDomain.ErrGroupNotFound)
+
+		err := newService(groups, new(MockTenantRepo)).Delete(ctx, 7)
+		assert.ErrorIs(t, err, groupDomain.ErrGroupNotFound)
+	})
+}
+
+func TestService_MoveTenant(t *testing.T) {
+	ctx := context.Background()
+	groupID := int64(7)
+	euGroup := &groupDomain.IsolationGroup{ID: groupID, Name: "payments", Region: tenant.RegionEU1}
+	activeTenant := func(region tenant.Region) *tenant.Tenant {
+		return &tenant.Tenant{ID: 123, Name: "acme", Region: region, Status: tenant.StatusActive}
+	}
+
+	testCases := []struct {
+		desc        string
+		tenant      *tenant.Tenant
+		groupID     *int64
+		group       *groupDomain.IsolationGroup
+		groupErr    error
+		rejected    bool           // the guarded update changes nothing
+		current     *tenant.Tenant // the tenant as stored when the update is rejected
+		updateErr   error
+		expectErrIs error
+		expectErr   string
+	}{
+		{desc: "joins group in its region", tenant: activeTenant(tenant.RegionEU1), groupID: &groupID, group: euGroup},
+		{desc: "leaves its group", tenant: &tenant.Tenant{
+			ID: 123, Region: tenant.RegionEU1, Status: tenant.StatusActive, IsolationGroupID: &groupID,
+		}},
+		{desc: "tenant not found", expectErrIs: tenant.ErrTenantNotFound},
+		{
+			desc: "unknown group", tenant: activeTenant(tenant.RegionEU1), groupID: &groupID,
+			groupErr: groupDomain.ErrGroupNotFound, expectErrIs: groupDomain.ErrGroupNotFound,
+		},
+		{
+			desc: "group in another region", tenant: activeTenant(tenant.RegionUS1), groupID: &groupID,
+			group: euGroup, expectErrIs: groupDomain.ErrRegionMismatch,
+		},
+		{
+			desc: "tenant not active", groupID: &groupID, group: euGroup,
+			tenant:      &tenant.Tenant{ID: 123, Region: tenant.RegionEU1, Status: tenant.StatusSuspended},
+			expectErrIs: tenant.ErrTenantNotActive,
+		},
+		{
+			desc: "operation in progress", tenant: activeTenant(tenant.RegionEU1), groupID: &groupID, group: euGroup,
+			rejected: true, current: activeTenant(tenant.RegionEU1), expectErrIs: tenant.ErrOperationInProgress,
+		},
+		{
+			desc: "tenant deleting concurrently", tenant: activeTenant(tenant.RegionEU1), groupID: &groupID, group: euGroup,
+			rejected: true, current: &tenant.Tenant{ID: 123, Region: tenant.RegionEU1, Status: tenant.StatusDeleting},
+			expectErrIs: tenant.ErrTenantNotActive,
+		},
+		{
+			desc: "tenant deleted concurrently", tenant: activeTenant(tenant.RegionEU1), groupID: &groupID, group: euGroup,
+			rejected: true, expectErrIs: tenant.ErrTenantNotFound,
+		},
+		{
+			desc: "error updating tenant", tenant: activeTenant(tenant.RegionEU1), groupID: &groupID, group: euGroup,
+			updateErr: errors.New("db error"), expectErr: "failed to update tenant",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			groups := new(MockGroupRepo)
+			tenants := new(MockTenantRepo)
+
+			tenants.On("FindByID", mock.Anything, int64(123)).Return(tc.tenant, nil).Once()
+			if tc.group != nil || tc.groupErr != nil {
+				groups.On("FindByID", mock.Anything, groupID).Return(tc.group, tc.groupErr)
+			}
+			success := tc.expectErrIs == nil && tc.expectErr == ""
+			if success || tc.rejected || tc.updateErr != nil {
+				tenants.On("ChangeIsolationGroup", mock.Anything, mock.MatchedBy(func(moved *tenant.Tenant) bool {
+					return moved.ID == 123 && moved.IsolationGroupID == tc.groupID
+				})).Return(!tc.rejected && tc.updateErr == nil, tc.updateErr)
+			}
+			if tc.rejected {
+				var notFound error
+				if tc.current == nil {
+					notFound = tenant.ErrTenantNotFound
+				}
+				tenants.On("FindByID", mock.Anything, int64(123)).Return(tc.current, notFound).Once()
+			}
+
+			moved, err := newService(groups, tenants).MoveTenant(ctx, 123, tc.groupID)
+			switch {
+			case success:
+				require.NoError(t, err)
+				assert.Equal(t, tc.groupID, moved.IsolationGroupID)
+			case tc.expectErrIs != nil:
+				assert.ErrorIs(t, err, tc.expectErrIs)
+			default:
+				assert.ErrorContains(t, err, tc.expectErr)
+			}
+
+			groups.AssertExpectations(t)
+			tenants.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ahrav/hoglet-hub/internal/domain/operation"
+)
+
+// DefaultEventPollInterval is how often Watch checks for new events of the
+// operation it watches.
+const DefaultEventPollInterval = time.Second
+
+// eventBatchSize bounds how many events Watch reads at once.
+const eventBatchSize = 100
+
+// Watch sends the events of an operation recorded after the event with ID
+// afterID to send, oldest first, and keeps sending new events as they are
+// recorded. Pass an afterID of zero to start from the first event.
+//
+// Watch returns nil once the event recording the operation reaching a terminal
+// status has been sent, or straight away if the operation was already terminal
+// and all its events have been sent. It returns the context's error if the
+// context ends first and the error of send if sending fails.
+// Returns operation.ErrOperationNotFound if the operation does not exist.
+func (s *Service) Watch(
+	ctx context.Context,
+	operationID, afterID int64,
+	send func(*operation.Event) error,
+) error {
+	ctx, span := s.tracer.Start(ctx, "operation.Watch", trace.WithAttributes(
+		attribute.Int64("operation_id", operationID),
+		attribute.Int64("after_id", afterID),
+	))
+	defer span.End()
+
+	op, err := s.GetByID(ctx, operationID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error retrieving operation")
+		return err
+	}
+	// The terminal status and its event are recorded together, so once the
+	// operation is seen to be terminal every one of its events can be read.
+	wasTerminal := op.IsTerminal()
+
+	ticker := time.NewTicker(DefaultEventPollInterval)
+	defer ticker.Stop()
+
+	sent := 0
+	for {
+		events, err := s.eventRepo.ListEvents(ctx, operationID, afterID, eventBatchSize)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "error listing events")
+			return fmt.Errorf("failed to list events of operation (%d): %w", operationID, err)
+		}
+
+		for _, e := range events {
+			if err := send(e); err != nil {
+				span.SetAttributes(attribute.Int("event_count", sent))
+				return err
+			}
+			sent++
+			afterID = e.ID
+
+			if e.IsTerminal() {
+				span.SetAttributes(attribute.Int("event_count", sent))
+				span.SetStatus(codes.Ok, "operation finished")
+				return nil
+			}
+		}
+
+		// A full batch may be followed by more events that are already recorded.
+		if len(events) == eventBatchSize {
+			continue
+		}
+		if wasTerminal {
+			span.SetAttributes(attribute.Int("event_count", sent))
+			span.SetStatus(codes.Ok, "operation already finished")
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			span.SetAttributes(attribute.Int("event_count", sent))
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
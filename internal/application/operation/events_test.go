@@ -0,0 +1,140 @@
+package operation_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/ahrav/hoglet-hub/internal/application/operation"
+	domainOp "github.com/ahrav/hoglet-hub/internal/domain/operation"
+	"github.com/ahrav/hoglet-hub/pkg/common/logger"
+)
+
+type MockEventRepo struct{ mock.Mock }
+
+func (m *MockEventRepo) ListEvents(ctx context.Context, operationID, afterID int64, limit int) ([]*domainOp.Event, error) {
+	args := m.Called(ctx, operationID, afterID, limit)
+	events, _ := args.Get(0).([]*domainOp.Event)
+	return events, args.Error(1)
+}
+
+func TestOperationService_Watch(t *testing.T) {
+	ctx := context.Background()
+	stepName := "create-schema"
+	started := &domainOp.Event{ID: 4, OperationID: 10, Type: domainOp.EventStepStarted, Status: "in_progress", StepName: &stepName}
+	finished := &domainOp.Event{ID: 5, OperationID: 10, Type: domainOp.EventStepFinished, Status: "completed", StepName: &stepName}
+	completed := &domainOp.Event{ID: 6, OperationID: 10, Type: domainOp.EventStatusChanged, Status: "completed"}
+
+	newService := func(repo *MockOperationRepo, events *MockEventRepo) *operation.Service {
+		return operation.NewService(repo, nil, events, nil, logger.Noop(), noop.NewTracerProvider().Tracer("test"))
+	}
+
+	collect := func(sent *[]*domainOp.Event) func(*domainOp.Event) error {
+		return func(e *domainOp.Event) error {
+			*sent = append(*sent, e)
+			return nil
+		}
+	}
+
+	t.Run("replays events and stops at the terminal status", func(t *testing.T) {
+		repo, events := new(MockOperationRepo), new(MockEventRepo)
+		repo.On("FindByID", mock.Anything, int64(10)).
+			Return(&domainOp.Operation{ID: 10, Status: domainOp.StatusCompleted}, nil)
+		events.On("ListEvents", mock.Anything, int64(10), int64(3), mock.Anything).
+			Return([]*domainOp.Event{started, finished, completed}, nil)
+
+		var sent []*domainOp.Event
+		require.NoError(t, newService(repo, events).Watch(ctx, 10, 3, collect(&sent)))
+		assert.Equal(t, []*domainOp.Event{started, finished, completed}, sent)
+		events.AssertExpectations(t)
+	})
+
+	t.Run("polls for new events until the operation finishes", func(t *testing.T) {
+		synctest.Run(func() {
+			repo, events := new(MockOperationRepo), new(MockEventRepo)
+			repo.On("FindByID", mock.Anything, int64(10)).
+				Return(&domainOp.Operation{ID: 10, Status: domainOp.StatusInProgress}, nil)
+			events.On("ListEvents", mock.Anything, int64(10), int64(0), mock.Anything).
+				Return([]*domainOp.Event{started}, nil).Once()
+			events.On("ListEvents", mock.Anything, int64(10), int64(4), mock.Anything).
+				Return(nil, nil).Once()
+			events.On("ListEvents", mock.Anything, int64(10), int64(4), mock.Anything).
+				Return([]*domainOp.Event{finished, completed}, nil).Once()
+
+			start := time.Now()
+			var sent []*domainOp.Event
+			require.NoError(t, newService(repo, events).Watch(ctx, 10, 0, collect(&sent)))
+			assert.Equal(t, []*domainOp.Event{started, finished, completed}, sent)
+			assert.Equal(t, 2*operation.DefaultEventPollInterval, time.Since(start))
+			events.AssertExpectations(t)
+		})
+	})
+
+	t.Run("finished operation with nothing new", func(t *testing.T) {
+		repo, events := new(MockOperationRepo), new(MockEventRepo)
+		repo.On("FindByID", mock.Anything, int64(10)).
+			Return(&domainOp.Operation{ID: 10, Status: domainOp.StatusFailed}, nil)
+		events.On("ListEvents", mock.Anything, int64(10), int64(6), mock.Anything).Return(nil, nil)
+
+		var sent []*domainOp.Event
+		require.NoError(t, newService(repo, events).Watch(ctx, 10, 6, collect(&sent)))
+		assert.Empty(t, sent)
+	})
+
+	t.Run("context ends while waiting", func(t *testing.T) {
+		synctest.Run(func() {
+			repo, events := new(MockOperationRepo), new(MockEventRepo)
+			repo.On("FindByID", mock.Anything, int64(10)).
+				Return(&domainOp.Operation{ID: 10, Status: domainOp.StatusPaused}, nil)
+			events.On("ListEvents", mock.Anything, int64(10), int64(0), mock.Anything).Return(nil, nil)
+
+			ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+			defer cancel()
+			err := newService(repo, events).Watch(ctx, 10, 0, collect(new([]*domainOp.Event)))
+			assert.ErrorIs(t, err, context.DeadlineExceeded)
+		})
+	})
+
+	t.Run("send error", func(t *testing.T) {
+		repo, events := new(MockOperationRepo), new(MockEventRepo)
+		repo.On("FindByID", mock.Anything, int64(10)).
+			Return(&domainOp.Operation{ID: 10, Status: domainOp.StatusInProgress}, nil)
+		events.On("ListEvents", mock.Anything, int64(10), int64(0), mock.Anything).
+			Return([]*domainOp.Event{started, finished}, nil)
+
+		sendErr := errors.New("client went away")
+		calls := 0
+		err := newService(repo, events).Watch(ctx, 10, 0, func(*domainOp.Event) error {
+			calls++
+			return sendErr
+		})
+		assert.ErrorIs(t, err, sendErr)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("operation not found", func(t *testing.T) {
+		repo, events := new(MockOperationRepo), new(MockEventRepo)
+		repo.On("FindByID", mock.Anything, int64(10)).Return(nil, domainOp.ErrOperationNotFound)
+
+		err := newService(repo, events).Watch(ctx, 10, 0, collect(new([]*domainOp.Event)))
+		assert.ErrorIs(t, err, domainOp.ErrOperationNotFound)
+		events.AssertNotCalled(t, "ListEvents", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		repo, events := new(MockOperationRepo), new(MockEventRepo)
+		repo.On("FindByID", mock.Anything, int64(10)).
+			Return(&domainOp.Operation{ID: 10, Status: domainOp.StatusInProgress}, nil)
+		events.On("ListEvents", mock.Anything, int64(10), int64(0), mock.Anything).Return(nil, errors.New("db error"))
+
+		err := newService(repo, events).Watch(ctx, 10, 0, collect(new([]*domainOp.Event)))
+		assert.ErrorContains(t, err, "failed to list events of operation (10): db error")
+	})
+}
@@ -0,0 +1,94 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ahrav/hoglet-hub/internal/domain/operation"
+	"github.com/ahrav/hoglet-hub/pkg/common/cursor"
+)
+
+// Page size bounds for operation listings.
+const (
+	DefaultListLimit = 50
+	MaxListLimit     = 200
+)
+
+// ListParams contains parameters for listing operations.
+// A zero Limit selects DefaultListLimit.
+type ListParams struct {
+	Filter operation.ListFilter
+	Cursor string // Opaque token from a previous ListResult; empty for the first page
+	Limit  int
+}
+
+// ListResult is a page of operations along with the token for the following page.
+type ListResult struct {
+	Operations []*operation.Operation
+	NextCursor string // Empty when there are no more operations
+}
+
+// listCursor is the position encoded into a page token.
+type listCursor struct {
+	ID        int64     `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// List returns a page of operations matching the filter, newest first.
+// It returns operation.ErrInvalidListParams for unsupported parameters or a malformed cursor.
+func (s *Service) List(ctx context.Context, params ListParams) (*ListResult, error) {
+	ctx, span := s.tracer.Start(ctx, "operation.List", trace.WithAttributes(
+		attribute.Int("limit", params.Limit),
+	))
+	defer span.End()
+
+	listParams := operation.ListParams{Filter: params.Filter, Limit: params.Limit}
+	if listParams.Limit == 0 {
+		listParams.Limit = DefaultListLimit
+	}
+	listParams.Limit = min(listParams.Limit, MaxListLimit)
+
+	if err := listParams.Validate(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "invalid list parameters")
+		return nil, err
+	}
+
+	if params.Cursor != "" {
+		var c listCursor
+		if err := cursor.Decode(params.Cursor, &c); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "invalid cursor")
+			return nil, fmt.Errorf("%w: %w", operation.ErrInvalidListParams, err)
+		}
+		listParams.After = &operation.Cursor{ID: c.ID, CreatedAt: c.CreatedAt}
+	}
+
+	page, err := s.repo.List(ctx, listParams)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error listing operations")
+		return nil, fmt.Errorf("failed to list operations: %w", err)
+	}
+
+	result := &ListResult{Operations: page.Operations}
+	if page.Next != nil {
+		result.NextCursor, err = cursor.Encode(listCursor{ID: page.Next.ID, CreatedAt: page.Next.CreatedAt})
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "error encoding cursor")
+			return nil, err
+		}
+	}
+
+	span.AddEvent("operations listed", trace.WithAttributes(
+		attribute.Int("operation_count", len(result.Operations)),
+	))
+	span.SetStatus(codes.Ok, "operations listed")
+	return result, nil
+}
@@ -0,0 +1,98 @@
+package operation_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/ahrav/hoglet-hub/internal/application/operation"
+	domainOp "github.com/ahrav/hoglet-hub/internal/domain/operation"
+	"github.com/ahrav/hoglet-hub/pkg/common/logger"
+)
+
+func TestOperationService_List(t *testing.T) {
+	ctx := context.Background()
+	tenantID := int64(100)
+	createdAt := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	newer := &domainOp.Operation{ID: 2, TenantID: &tenantID, CreatedAt: createdAt.Add(time.Minute)}
+	older := &domainOp.Operation{ID: 1, TenantID: &tenantID, CreatedAt: createdAt}
+
+	newService := func(repo *MockOperationRepo) *operation.Service {
+		return operation.NewService(repo, nil, nil, nil, logger.Noop(), noop.NewTracerProvider().Tracer("test"))
+	}
+
+	t.Run("defaults are applied", func(t *testing.T) {
+		repo := new(MockOperationRepo)
+		filter := domainOp.ListFilter{TenantID: &tenantID}
+		repo.On("List", mock.Anything, domainOp.ListParams{Filter: filter, Limit: operation.DefaultListLimit}).
+			Return(&domainOp.Page{Operations: []*domainOp.Operation{newer, older}}, nil)
+
+		res, err := newService(repo).List(ctx, operation.ListParams{Filter: filter})
+		require.NoError(t, err)
+		assert.Equal(t, []*domainOp.Operation{newer, older}, res.Operations)
+		assert.Empty(t, res.NextCursor)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("next cursor resumes after the last operation", func(t *testing.T) {
+		repo := new(MockOperationRepo)
+		repo.On("List", mock.Anything, mock.MatchedBy(func(p domainOp.ListParams) bool {
+			return p.After == nil
+		})).Return(&domainOp.Page{
+			Operations: []*domainOp.Operation{newer},
+			Next:       domainOp.CursorAt(newer),
+		}, nil).Once()
+		repo.On("List", mock.Anything, mock.MatchedBy(func(p domainOp.ListParams) bool {
+			return p.After != nil && *p.After == *domainOp.CursorAt(newer)
+		})).Return(&domainOp.Page{Operations: []*domainOp.Operation{older}}, nil).Once()
+
+		svc := newService(repo)
+		first, err := svc.List(ctx, operation.ListParams{Limit: 1})
+		require.NoError(t, err)
+		require.NotEmpty(t, first.NextCursor)
+
+		second, err := svc.List(ctx, operation.ListParams{Limit: 1, Cursor: first.NextCursor})
+		require.NoError(t, err)
+		assert.Equal(t, []*domainOp.Operation{older}, second.Operations)
+		assert.Empty(t, second.NextCursor)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		repo := new(MockOperationRepo)
+		repo.On("List", mock.Anything, mock.Anything).Return(nil, errors.New("db error"))
+
+		_, err := newService(repo).List(ctx, operation.ListParams{})
+		assert.ErrorContains(t, err, "failed to list operations: db error")
+	})
+
+	unknownType := domainOp.Op("tenant.explode")
+	unknownStatus := domainOp.Status("archived")
+	after, before := createdAt, createdAt.Add(-time.Hour)
+	invalid := []struct {
+		desc   string
+		params operation.ListParams
+	}{
+		{desc: "malformed cursor", params: operation.ListParams{Cursor: "not-a-cursor"}},
+		{desc: "negative limit", params: operation.ListParams{Limit: -1}},
+		{desc: "unknown type", params: operation.ListParams{Filter: domainOp.ListFilter{Type: &unknownType}}},
+		{desc: "unknown status", params: operation.ListParams{Filter: domainOp.ListFilter{Status: &unknownStatus}}},
+		{desc: "inverted time range", params: operation.ListParams{
+			Filter: domainOp.ListFilter{CreatedAfter: &after, CreatedBefore: &before},
+		}},
+	}
+	for _, tc := range invalid {
+		t.Run(tc.desc, func(t *testing.T) {
+			repo := new(MockOperationRepo)
+			_, err := newService(repo).List(ctx, tc.params)
+			assert.ErrorIs(t, err, domainOp.ErrInvalidListParams)
+			repo.AssertNotCalled(t, "List", mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -9,27 +9,52 @@ import (
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 
+	"github.com/ahrav/hoglet-hub/internal/application/sdk/auth"
 	"github.com/ahrav/hoglet-hub/internal/domain/operation"
 	"github.com/ahrav/hoglet-hub/pkg/common/logger"
 )
 
+// WorkflowLauncher starts the workflow that executes a persisted operation.
+// It is implemented by the services that own the workflows for each kind of operation,
+// which keeps this service independent of how operations are carried out.
+type WorkflowLauncher interface {
+	// ResumeOperation launches the workflow for the operation, skipping the steps
+	// already checkpointed as completed for it.
+	ResumeOperation(ctx context.Context, op *operation.Operation) error
+}
+
 // Service provides operation-related application services.
 // It coordinates operation state transitions and manages lifecycle events,
 // abstracting the underlying data persistence.
 type Service struct {
-	repo operation.Repository
+	repo      operation.Repository
+	stepRepo  operation.StepRepository
+	eventRepo operation.EventRepository
+	launcher  WorkflowLauncher
 
 	logger *logger.Logger
 	tracer trace.Tracer
 }
 
-// NewService creates a new operation service with the provided repository.
-// The repository is used for persisting and retrieving operation data.
-func NewService(repo operation.Repository, logger *logger.Logger, tracer trace.Tracer) *Service {
+// NewService creates a new operation service with the provided repositories.
+// The repository is used for persisting and retrieving operation data, the step
+// repository and launcher are used to retry failed operations, and the event
+// repository is used to watch the progress of operations.
+func NewService(
+	repo operation.Repository,
+	stepRepo operation.StepRepository,
+	eventRepo operation.EventRepository,
+	launcher WorkflowLauncher,
+	logger *logger.Logger,
+	tracer trace.Tracer,
+) *Service {
 	return &Service{
-		repo:   repo,
-		logger: logger.With("component", "operation_service"),
-		tracer: tracer,
+		repo:      repo,
+		stepRepo:  stepRepo,
+		eventRepo: eventRepo,
+		launcher:  launcher,
+		logger:    logger.With("component", "operation_service"),
+		tracer:    tracer,
 	}
 }
 
@@ -60,6 +85,142 @@ func (s *Service) GetByID(ctx context.Context, operationID int64) (*operation.Op
 	return op, nil
 }
 
+// Retry creates a new operation that retries a failed operation and launches it.
+//
+// The retry is linked to the failed operation as its child with the next attempt
+// number. It inherits the checkpoints of the steps that completed and were not
+// undone by compensation, so its workflow resumes from the step that failed rather
+// than starting over. An operation can only be retried once; a retry that fails is
+// retried in turn.
+func (s *Service) Retry(ctx context.Context, operationID int64) (*operation.Operation, error) {
+	logger := logger.NewLoggerContext(s.logger.With("operation_id", operationID))
+	ctx, span := s.tracer.Start(ctx, "operation.Retry", trace.WithAttributes(
+		attribute.Int64("operation_id", operationID),
+	))
+	defer span.End()
+
+	parent, err := s.repo.FindByID(ctx, operationID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error retrieving operation")
+		return nil, fmt.Errorf("failed to retrieve operation: %w", err)
+	}
+	if parent == nil {
+		span.RecordError(operation.ErrOperationNotFound)
+		span.SetStatus(codes.Error, "operation not found")
+		return nil, operation.ErrOperationNotFound
+	}
+
+	retry, err := parent.NewRetry()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "operation not retryable")
+		return nil, err
+	}
+	if subject := auth.Subject(ctx); subject != "" {
+		retry.CreatedBy = &subject
+	}
+
+	retryID, err := s.repo.Create(ctx, retry)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error persisting retry operation")
+		return nil, fmt.Errorf("failed to persist retry of operation (%d): %w", operationID, err)
+	}
+	retry.ID = retryID
+	logger.Add("retry_operation_id", retryID)
+	span.SetAttributes(attribute.Int64("retry_operation_id", retryID), attribute.Int("attempt", retry.Attempt))
+	span.AddEvent("retry operation persisted")
+
+	if err := s.stepRepo.CopyCompletedSteps(ctx, parent.ID, retryID); err != nil {
+		return nil, s.abandonRetry(ctx, retry, fmt.Errorf("failed to copy step checkpoints: %w", err))
+	}
+	span.AddEvent("step checkpoints copied")
+
+	if err := s.launcher.ResumeOperation(ctx, retry); err != nil {
+		return nil, s.abandonRetry(ctx, retry, fmt.Errorf("failed to launch retry workflow: %w", err))
+	}
+	logger.Info(ctx, "operation retry launched", "attempt", retry.Attempt)
+	span.SetStatus(codes.Ok, "operation retry launched")
+
+	return retry, nil
+}
+
+// Resume continues a paused operation from the first step that has not completed.
+//
+// The operation is moved back to in progress and its workflow is launched again,
+// skipping the steps checkpointed as completed before the pause. Of concurrent
+// resumes only one succeeds; the rest fail with operation.ErrOperationNotPaused.
+// If the workflow cannot be launched, the operation is returned to the paused status.
+func (s *Service) Resume(ctx context.Context, operationID int64) (*operation.Operation, error) {
+	logger := logger.NewLoggerContext(s.logger.With("operation_id", operationID))
+	ctx, span := s.tracer.Start(ctx, "operation.Resume", trace.WithAttributes(
+		attribute.Int64("operation_id", operationID),
+	))
+	defer span.End()
+
+	op, err := s.repo.FindByID(ctx, operationID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error retrieving operation")
+		return nil, fmt.Errorf("failed to retrieve operation: %w", err)
+	}
+	if op == nil {
+		span.RecordError(operation.ErrOperationNotFound)
+		span.SetStatus(codes.Error, "operation not found")
+		return nil, operation.ErrOperationNotFound
+	}
+
+	if err := op.Resume(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "operation not paused")
+		return nil, err
+	}
+	// Only the request that moves the stored operation out of paused launches its
+	// workflow, so that concurrent resumes never run it twice.
+	resumed, err := s.repo.TransitionStatus(ctx, op, operation.StatusPaused)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error persisting resumed operation")
+		return nil, fmt.Errorf("failed to persist resumed operation (%d): %w", operationID, err)
+	}
+	if !resumed {
+		span.RecordError(operation.ErrOperationNotPaused)
+		span.SetStatus(codes.Error, "operation not paused")
+		return nil, operation.ErrOperationNotPaused
+	}
+	span.AddEvent("operation resumed")
+
+	if err := s.launcher.ResumeOperation(ctx, op); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error launching workflow")
+		if pauseErr := op.Pause(); pauseErr == nil {
+			if _, err := s.repo.TransitionStatus(ctx, op, operation.StatusInProgress); err != nil {
+				s.logger.Error(ctx, "error re-pausing operation", "operation_id", op.ID, "error", err)
+			}
+		}
+		return nil, fmt.Errorf("failed to launch workflow for operation (%d): %w", operationID, err)
+	}
+	logger.Info(ctx, "paused operation resumed")
+	span.SetStatus(codes.Ok, "operation resumed")
+
+	return op, nil
+}
+
+// abandonRetry fails a persisted retry operation that could not be launched so it
+// does not remain pending forever, and returns the cause.
+func (s *Service) abandonRetry(ctx context.Context, retry *operation.Operation, cause error) error {
+	span := trace.SpanFromContext(ctx)
+	span.RecordError(cause)
+	span.SetStatus(codes.Error, "error launching retry")
+
+	retry.Fail(cause.Error())
+	if err := s.repo.Update(ctx, retry); err != nil {
+		s.logger.Error(ctx, "error failing abandoned retry", "operation_id", retry.ID, "error", err)
+	}
+	return cause
+}
+
 // // StartOperation transitions an operation from pending to in-progress state.
 // // This is typically called when execution of the operation begins.
 // func (s *Service) StartOperation(ctx context.Context, operationID int64) error {
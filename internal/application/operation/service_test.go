@@ -27,6 +27,15 @@ func (m *MockOperationRepo) Update(ctx context.Context, op *domainOp.Operation)
 	return args.Error(0)
 }
 
+func (m *MockOperationRepo) TransitionStatus(
+	ctx context.Context,
+	op *domainOp.Operation,
+	from domainOp.Status,
+) (bool, error) {
+	args := m.Called(ctx, op, from)
+	return args.Bool(0), args.Error(1)
+}
+
 func (m *MockOperationRepo) FindByID(ctx context.Context, id int64) (*domainOp.Operation, error) {
 	args := m.Called(ctx, id)
 	val, _ := args.Get(0).(*domainOp.Operation)
@@ -51,6 +60,59 @@ func (m *MockOperationRepo) FindIncomplete(ctx context.Context) ([]*domainOp.Ope
 	return val, args.Error(1)
 }
 
+func (m *MockOperationRepo) List(ctx context.Context, params domainOp.ListParams) (*domainOp.Page, error) {
+	args := m.Called(ctx, params)
+	page, _ := args.Get(0).(*domainOp.Page)
+	return page, args.Error(1)
+}
+
+// MockStepRepo is a testify mock for domainOp.StepRepository.
+type MockStepRepo struct{ mock.Mock }
+
+func (m *MockStepRepo) StartStep(ctx context.Context, step *domainOp.StepState) error {
+	args := m.Called(ctx, step)
+	return args.Error(0)
+}
+
+func (m *MockStepRepo) FinishStep(ctx context.Context, step *domainOp.StepState) error {
+	args := m.Called(ctx, step)
+	return args.Error(0)
+}
+
+func (m *MockStepRepo) CompensateStep(ctx context.Context, operationID int64, name string) error {
+	args := m.Called(ctx, operationID, name)
+	return args.Error(0)
+}
+
+func (m *MockStepRepo) FindSteps(ctx context.Context, operationID int64) ([]*domainOp.StepState, error) {
+	args := m.Called(ctx, operationID)
+	val, _ := args.Get(0).([]*domainOp.StepState)
+	return val, args.Error(1)
+}
+
+func (m *MockStepRepo) CopyCompletedSteps(ctx context.Context, fromOperationID, toOperationID int64) error {
+	args := m.Called(ctx, fromOperationID, toOperationID)
+	return args.Error(0)
+}
+
+func (m *MockStepRepo) Heartbeat(ctx context.Context, operationID int64) error {
+	args := m.Called(ctx, operationID)
+	return args.Error(0)
+}
+
+func (m *MockStepRepo) ClaimStale(ctx context.Context, operationID int64, staleBefore time.Time) (bool, error) {
+	args := m.Called(ctx, operationID, staleBefore)
+	return args.Bool(0), args.Error(1)
+}
+
+// MockLauncher is a testify mock for operation.WorkflowLauncher.
+type MockLauncher struct{ mock.Mock }
+
+func (m *MockLauncher) ResumeOperation(ctx context.Context, op *domainOp.Operation) error {
+	args := m.Called(ctx, op)
+	return args.Error(0)
+}
+
 func TestOperationService_GetByID(t *testing.T) {
 	ctx := context.Background()
 
@@ -100,7 +162,7 @@ func TestOperationService_GetByID(t *testing.T) {
 
 		logger := logger.Noop()
 		tracer := noop.NewTracerProvider().Tracer("test")
-		svc := operation.NewService(mockRepo, logger, tracer)
+		svc := operation.NewService(mockRepo, nil, nil, nil, logger, tracer)
 		op, err := svc.GetByID(ctx, tc.operationID)
 		if tc.wantError {
 			assert.Error(t, err, "expected an error")
@@ -482,7 +544,7 @@ func TestOperationService_ListIncompleteOperations(t *testing.T) {
 
 		logger := logger.Noop()
 		tracer := noop.NewTracerProvider().Tracer("test")
-		svc := operation.NewService(mockRepo, logger, tracer)
+		svc := operation.NewService(mockRepo, nil, nil, nil, logger, tracer)
 		ops, err := svc.ListIncompleteOperations(ctx)
 		if tc.wantError {
 			assert.Error(t, err)
@@ -554,7 +616,7 @@ func TestOperationService_ListStalledOperations(t *testing.T) {
 
 		logger := logger.Noop()
 		tracer := noop.NewTracerProvider().Tracer("test")
-		svc := operation.NewService(mockRepo, logger, tracer)
+		svc := operation.NewService(mockRepo, nil, nil, nil, logger, tracer)
 		stalled, err := svc.ListStalledOperations(ctx, tc.threshold)
 		if tc.wantError {
 			assert.Error(t, err)
@@ -618,7 +680,7 @@ func TestOperationService_GetOperationsByTenant(t *testing.T) {
 
 		logger := logger.Noop()
 		tracer := noop.NewTracerProvider().Tracer("test")
-		svc := operation.NewService(mockRepo, logger, tracer)
+		svc := operation.NewService(mockRepo, nil, nil, nil, logger, tracer)
 		ops, err := svc.GetOperationsByTenant(ctx, tc.tenantID)
 		if tc.wantError {
 			assert.Error(t, err)
@@ -706,7 +768,7 @@ func TestOperationService_GetOperationProgress(t *testing.T) {
 
 		logger := logger.Noop()
 		tracer := noop.NewTracerProvider().Tracer("test")
-		svc := operation.NewService(mockRepo, logger, tracer)
+		svc := operation.NewService(mockRepo, nil, nil, nil, logger, tracer)
 		prog, err := svc.GetOperationProgress(ctx, tc.opID)
 		if tc.wantError {
 			assert.Error(t, err)
@@ -784,7 +846,7 @@ func TestOperationService_GetOperationEstimatedCompletion(t *testing.T) {
 
 		logger := logger.Noop()
 		tracer := noop.NewTracerProvider().Tracer("test")
-		svc := operation.NewService(mockRepo, logger, tracer)
+		svc := operation.NewService(mockRepo, nil, nil, nil, logger, tracer)
 		est, err := svc.GetOperationEstimatedCompletion(ctx, tc.opID)
 		if tc.wantError {
 			assert.Error(t, err)
@@ -888,3 +950,218 @@ func TestOperationService_GetOperationEstimatedCompletion(t *testing.T) {
 // 		mockRepo.AssertExpectations(t)
 // 	}
 // }
+
+func TestOperationService_Retry(t *testing.T) {
+	ctx := context.Background()
+	tenantID := int64(7)
+
+	failedOp := func() *domainOp.Operation {
+		return &domainOp.Operation{
+			ID:         10,
+			Type:       domainOp.OpTenantCreate,
+			Status:     domainOp.StatusFailed,
+			TenantID:   &tenantID,
+			Parameters: map[string]any{"name": "acme"},
+			Attempt:    1,
+		}
+	}
+	isRetry := func(op *domainOp.Operation) bool {
+		return op.ParentID != nil && *op.ParentID == 10 && op.Attempt == 2
+	}
+
+	testCases := []struct {
+		desc        string
+		mockSetup   func(*MockOperationRepo, *MockStepRepo, *MockLauncher)
+		wantErrorIs error
+		wantError   bool
+	}{
+		{
+			desc: "operation not found",
+			mockSetup: func(r *MockOperationRepo, _ *MockStepRepo, _ *MockLauncher) {
+				r.On("FindByID", mock.Anything, int64(10)).Return((*domainOp.Operation)(nil), nil)
+			},
+			wantErrorIs: domainOp.ErrOperationNotFound,
+		},
+		{
+			desc: "operation not retryable",
+			mockSetup: func(r *MockOperationRepo, _ *MockStepRepo, _ *MockLauncher) {
+				op := failedOp()
+				op.Status = domainOp.StatusCompleted
+				r.On("FindByID", mock.Anything, int64(10)).Return(op, nil)
+			},
+			wantErrorIs: domainOp.ErrOperationNotRetryable,
+		},
+		{
+			desc: "operation already retried",
+			mockSetup: func(r *MockOperationRepo, _ *MockStepRepo, _ *MockLauncher) {
+				r.On("FindByID", mock.Anything, int64(10)).Return(failedOp(), nil)
+				r.On("Create", mock.Anything, mock.MatchedBy(isRetry)).
+					Return(int64(0), domainOp.ErrOperationAlreadyRetried)
+			},
+			wantErrorIs: domainOp.ErrOperationAlreadyRetried,
+		},
+		{
+			desc: "launch failure fails the retry",
+			mockSetup: func(r *MockOperationRepo, sr *MockStepRepo, l *MockLauncher) {
+				r.On("FindByID", mock.Anything, int64(10)).Return(failedOp(), nil)
+				r.On("Create", mock.Anything, mock.MatchedBy(isRetry)).Return(int64(11), nil)
+				sr.On("CopyCompletedSteps", mock.Anything, int64(10), int64(11)).Return(nil)
+				l.On("ResumeOperation", mock.Anything, mock.Anything).Return(errors.New("tenant gone"))
+				r.On("Update", mock.Anything, mock.MatchedBy(func(op *domainOp.Operation) bool {
+					return op.ID == 11 && op.Status == domainOp.StatusFailed
+				})).Return(nil)
+			},
+			wantError: true,
+		},
+		{
+			desc: "retry launched from checkpoints",
+			mockSetup: func(r *MockOperationRepo, sr *MockStepRepo, l *MockLauncher) {
+				r.On("FindByID", mock.Anything, int64(10)).Return(failedOp(), nil)
+				r.On("Create", mock.Anything, mock.MatchedBy(isRetry)).Return(int64(11), nil)
+				sr.On("CopyCompletedSteps", mock.Anything, int64(10), int64(11)).Return(nil)
+				l.On("ResumeOperation", mock.Anything, mock.MatchedBy(func(op *domainOp.Operation) bool {
+					return op.ID == 11 && isRetry(op)
+				})).Return(nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mockRepo := new(MockOperationRepo)
+			mockStepRepo := new(MockStepRepo)
+			mockLauncher := new(MockLauncher)
+			tc.mockSetup(mockRepo, mockStepRepo, mockLauncher)
+
+			svc := operation.NewService(
+				mockRepo,
+				mockStepRepo,
+				nil,
+				mockLauncher,
+				logger.Noop(),
+				noop.NewTracerProvider().Tracer("test"),
+			)
+			retry, err := svc.Retry(ctx, 10)
+			switch {
+			case tc.wantErrorIs != nil:
+				assert.ErrorIs(t, err, tc.wantErrorIs)
+				assert.Nil(t, retry)
+			case tc.wantError:
+				assert.Error(t, err)
+				assert.Nil(t, retry)
+			default:
+				assert.NoError(t, err)
+				assert.Equal(t, int64(11), retry.ID)
+				assert.Equal(t, domainOp.StatusPending, retry.Status)
+			}
+
+			mockRepo.AssertExpectations(t)
+			mockStepRepo.AssertExpectations(t)
+			mockLauncher.AssertExpectations(t)
+		})
+	}
+}
+
+func TestOperationService_Resume(t *testing.T) {
+	ctx := context.Background()
+	tenantID := int64(7)
+
+	pausedOp := func() *domainOp.Operation {
+		return &domainOp.Operation{
+			ID:       10,
+			Type:     domainOp.OpTenantMigrate,
+			Status:   domainOp.StatusPaused,
+			TenantID: &tenantID,
+			Attempt:  1,
+		}
+	}
+	withStatus := func(status domainOp.Status) any {
+		return mock.MatchedBy(func(op *domainOp.Operation) bool { return op.Status == status })
+	}
+
+	testCases := []struct {
+		desc        string
+		mockSetup   func(*MockOperationRepo, *MockLauncher)
+		wantErrorIs error
+		wantError   bool
+	}{
+		{
+			desc: "operation not found",
+			mockSetup: func(r *MockOperationRepo, _ *MockLauncher) {
+				r.On("FindByID", mock.Anything, int64(10)).Return((*domainOp.Operation)(nil), nil)
+			},
+			wantErrorIs: domainOp.ErrOperationNotFound,
+		},
+		{
+			desc: "operation not paused",
+			mockSetup: func(r *MockOperationRepo, _ *MockLauncher) {
+				op := pausedOp()
+				op.Status = domainOp.StatusInProgress
+				r.On("FindByID", mock.Anything, int64(10)).Return(op, nil)
+			},
+			wantErrorIs: domainOp.ErrOperationNotPaused,
+		},
+		{
+			desc: "resumed concurrently",
+			mockSetup: func(r *MockOperationRepo, _ *MockLauncher) {
+				r.On("FindByID", mock.Anything, int64(10)).Return(pausedOp(), nil)
+				r.On("TransitionStatus", mock.Anything, withStatus(domainOp.StatusInProgress), domainOp.StatusPaused).
+					Return(false, nil)
+			},
+			wantErrorIs: domainOp.ErrOperationNotPaused,
+		},
+		{
+			desc: "launch failure pauses the operation again",
+			mockSetup: func(r *MockOperationRepo, l *MockLauncher) {
+				r.On("FindByID", mock.Anything, int64(10)).Return(pausedOp(), nil)
+				r.On("TransitionStatus", mock.Anything, withStatus(domainOp.StatusInProgress), domainOp.StatusPaused).
+					Return(true, nil).Once()
+				l.On("ResumeOperation", mock.Anything, mock.Anything).Return(errors.New("tenant gone"))
+				r.On("TransitionStatus", mock.Anything, withStatus(domainOp.StatusPaused), domainOp.StatusInProgress).
+					Return(true, nil).Once()
+			},
+			wantError: true,
+		},
+		{
+			desc: "paused operation resumed",
+			mockSetup: func(r *MockOperationRepo, l *MockLauncher) {
+				r.On("FindByID", mock.Anything, int64(10)).Return(pausedOp(), nil)
+				r.On("TransitionStatus", mock.Anything, withStatus(domainOp.StatusInProgress), domainOp.StatusPaused).
+					Return(true, nil)
+				l.On("ResumeOperation", mock.Anything, withStatus(domainOp.StatusInProgress)).Return(nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mockRepo := new(MockOperationRepo)
+			mockLauncher := new(MockLauncher)
+			tc.mockSetup(mockRepo, mockLauncher)
+
+			svc := operation.NewService(
+				mockRepo,
+				new(MockStepRepo),
+				nil,
+				mockLauncher,
+				logger.Noop(),
+				noop.NewTracerProvider().Tracer("test"),
+			)
+			op, err := svc.Resume(ctx, 10)
+			switch {
+			case tc.wantErrorIs != nil:
+				assert.ErrorIs(t, err, tc.wantErrorIs)
+				assert.Nil(t, op)
+			case tc.wantError:
+				assert.Error(t, err)
+				assert.Nil(t, op)
+			default:
+				assert.NoError(t, err)
+				assert.Equal(t, domainOp.StatusInProgress, op.Status)
+			}
+
+			mockRepo.AssertExpectations(t)
+			mockLauncher.AssertExpectations(t)
+		})
+	}
+}
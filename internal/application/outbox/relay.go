@@ -0,0 +1,204 @@
+// Package outbox relays the lifecycle events recorded in the outbox to sinks
+// outside the service, such as webhooks and files.
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ahrav/hoglet-hub/internal/domain/outbox"
+	"github.com/ahrav/hoglet-hub/pkg/common/logger"
+)
+
+const (
+	// DefaultRelayInterval is how often Run relays pending events.
+	DefaultRelayInterval = 5 * time.Second
+
+	// DefaultBatchSize is the most events relayed in a single pass.
+	DefaultBatchSize = 100
+
+	// DefaultRetention is how long published events are kept before deletion.
+	DefaultRetention = 7 * 24 * time.Hour
+
+	// maxBackoff caps the delay between attempts to publish an event.
+	maxBackoff = 10 * time.Minute
+)
+
+// Sink is a destination events are published to.
+type Sink interface {
+	// Name identifies the sink in logs and errors.
+	Name() string
+
+	// Publish delivers the event. Events may be delivered more than once, so
+	// receivers should deduplicate them by ID.
+	Publish(ctx context.Context, event *outbox.Event) error
+}
+
+// Relay publishes the events recorded in the outbox to every sink.
+//
+// Delivery is at least once: an event is marked published only after every sink
+// accepted it, and is retried with exponential backoff otherwise. Events with
+// the same ordering key, which are those about the same tenant, are published in
+// the order they occurred; an event that cannot be published holds back the
+// events after it until it is dead-lettered after outbox.MaxAttempts.
+type Relay struct {
+	repo      outbox.Repository
+	sinks     []Sink
+	batchSize int
+	retention time.Duration
+
+	logger *logger.Logger
+	tracer trace.Tracer
+}
+
+// NewRelay creates a new Relay publishing to the sinks. Without sinks, events
+// are marked published without being delivered anywhere.
+func NewRelay(repo outbox.Repository, sinks []Sink, logger *logger.Logger, tracer trace.Tracer) *Relay {
+	return &Relay{
+		repo:      repo,
+		sinks:     sinks,
+		batchSize: DefaultBatchSize,
+		retention: DefaultRetention,
+		logger:    logger.With("component", "outbox_relay"),
+		tracer:    tracer,
+	}
+}
+
+// RelayPending publishes a batch of due events and returns how many were
+// published. The batch holds at most one event per ordering key, so events
+// later in a sequence are relayed by the following passes. Only one relay runs
+// at a time across all instances of the service; if another holds the relay
+// lock, RelayPending does nothing.
+func (r *Relay) RelayPending(ctx context.Context) (int, error) {
+	ctx, span := r.tracer.Start(ctx, "outbox.RelayPending")
+	defer span.End()
+
+	release, locked, err := r.repo.TryLock(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to take relay lock")
+		return 0, fmt.Errorf("failed to take relay lock: %w", err)
+	}
+	if !locked {
+		span.AddEvent("relay_lock_held_elsewhere")
+		return 0, nil
+	}
+	defer release()
+
+	events, err := r.repo.ListPending(ctx, r.batchSize)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to list pending events")
+		return 0, fmt.Errorf("failed to list pending events: %w", err)
+	}
+
+	published := 0
+	for _, event := range events {
+		if err := r.publish(ctx, event); err != nil {
+			r.markFailed(ctx, event, err)
+			continue
+		}
+
+		if err := r.repo.MarkPublished(ctx, event.ID); err != nil {
+			// The event will be published again, which at-least-once delivery allows.
+			r.logger.Error(ctx, "failed to mark event published", "event_id", event.ID, "error", err)
+			continue
+		}
+		published++
+	}
+	span.SetAttributes(
+		attribute.Int("events.pending", len(events)),
+		attribute.Int("events.published", published),
+	)
+
+	if deleted, err := r.repo.DeletePublished(ctx, time.Now().Add(-r.retention)); err != nil {
+		r.logger.Error(ctx, "failed to delete published events", "error", err)
+	} else if deleted > 0 {
+		r.logger.Debug(ctx, "deleted published events", "count", deleted)
+	}
+
+	return published, nil
+}
+
+// publish delivers the event to every sink, returning the errors of those that
+// failed. Sinks that already accepted the event receive it again on retry.
+func (r *Relay) publish(ctx context.Context, event *outbox.Event) error {
+	var errs []error
+	for _, sink := range r.sinks {
+		if err := sink.Publish(ctx, event); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", sink.Name(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// markFailed schedules the next attempt to publish the event, or dead-letters it
+// once it has failed outbox.MaxAttempts times.
+func (r *Relay) markFailed(ctx context.Context, event *outbox.Event, cause error) {
+	if event.Attempts+1 >= outbox.MaxAttempts {
+		r.logger.Error(ctx, "giving up on publishing event",
+			"event_id", event.ID,
+			"event_type", event.Type,
+			"attempts", event.Attempts+1,
+			"error", cause,
+		)
+		if err := r.repo.MarkDeadLettered(ctx, event.ID, cause.Error()); err != nil {
+			r.logger.Error(ctx, "failed to record dead-lettered event", "event_id", event.ID, "error", err)
+		}
+		return
+	}
+
+	next := time.Now().Add(backoff(event.Attempts))
+	r.logger.Warn(ctx, "failed to publish event",
+		"event_id", event.ID,
+		"event_type", event.Type,
+		"attempts", event.Attempts+1,
+		"next_attempt_at", next,
+		"error", cause,
+	)
+	if err := r.repo.MarkFailed(ctx, event.ID, cause.Error(), next); err != nil {
+		r.logger.Error(ctx, "failed to record failed publish", "event_id", event.ID, "error", err)
+	}
+}
+
+// backoff returns the delay before the next attempt to publish an event that
+// has failed the given number of times before.
+func backoff(attempts int) time.Duration {
+	if attempts >= 10 {
+		return maxBackoff
+	}
+	return min(time.Second<<attempts, maxBackoff)
+}
+
+// Run relays pending events immediately and then every interval until ctx is
+// cancelled. A pass that published events is followed by another straight away,
+// so that a backlog, and the later events of each sequence, drain without
+// waiting for the interval.
+func (r *Relay) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			published, err := r.RelayPending(ctx)
+			if err != nil {
+				r.logger.Error(ctx, "failed to relay outbox events", "error", err)
+			}
+			if err != nil || published == 0 || ctx.Err() != nil {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
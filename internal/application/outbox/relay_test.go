@@ -0,0 +1,246 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/ahrav/hoglet-hub/internal/domain/outbox"
+	"github.com/ahrav/hoglet-hub/pkg/common/logger"
+)
+
+// fakeRepo is an in-memory outbox.Repository.
+type fakeRepo struct {
+	mu           sync.Mutex
+	events       []*outbox.Event
+	published    map[int64]bool
+	deadLettered map[int64]bool
+	lockHeld     bool
+	deleted      time.Time
+}
+
+func newFakeRepo(events ...*outbox.Event) *fakeRepo {
+	return &fakeRepo{events: events, published: make(map[int64]bool), deadLettered: make(map[int64]bool)}
+}
+
+func (r *fakeRepo) Append(_ context.Context, events ...*outbox.Event) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, events...)
+	return nil
+}
+
+func (r *fakeRepo) ListPending(_ context.Context, limit int) ([]*outbox.Event, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var pending []*outbox.Event
+	seen := make(map[string]bool)
+	now := time.Now()
+	for _, e := range r.events {
+		if r.published[e.ID] || r.deadLettered[e.ID] {
+			continue
+		}
+		key := e.OrderingKey()
+		if key != "" && seen[key] {
+			continue
+		}
+		seen[key] = true
+		if !e.NextAttemptAt.After(now) && len(pending) < limit {
+			copied := *e
+			pending = append(pending, &copied)
+		}
+	}
+	return pending, nil
+}
+
+func (r *fakeRepo) MarkPublished(_ context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.published[id] = true
+	return nil
+}
+
+func (r *fakeRepo) MarkFailed(_ context.Context, id int64, _ string, nextAttemptAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, e := range r.events {
+		if e.ID == id {
+			e.Attempts++
+			e.NextAttemptAt = nextAttemptAt
+		}
+	}
+	return nil
+}
+
+func (r *fakeRepo) MarkDeadLettered(_ context.Context, id int64, _ string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, e := range r.events {
+		if e.ID == id {
+			e.Attempts++
+		}
+	}
+	r.deadLettered[id] = true
+	return nil
+}
+
+func (r *fakeRepo) DeletePublished(_ context.Context, before time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.deleted = before
+	return 0, nil
+}
+
+func (r *fakeRepo) TryLock(context.Context) (func(), bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.lockHeld {
+		return nil, false, nil
+	}
+	r.lockHeld = true
+	return func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.lockHeld = false
+	}, true, nil
+}
+
+// recordingSink records the IDs of the events it receives and fails those
+// listed in failing.
+type recordingSink struct {
+	received []int64
+	failing  map[int64]bool
+}
+
+func (s *recordingSink) Name() string { return "recording" }
+
+func (s *recordingSink) Publish(_ context.Context, e *outbox.Event) error {
+	if s.failing[e.ID] {
+		return errors.New("unavailable")
+	}
+	s.received = append(s.received, e.ID)
+	return nil
+}
+
+func newRelay(repo outbox.Repository, sinks ...Sink) *Relay {
+	return NewRelay(repo, sinks, logger.Noop(), noop.NewTracerProvider().Tracer("test"))
+}
+
+func tenantEvent(id, tenantID int64) *outbox.Event {
+	return &outbox.Event{ID: id, Type: outbox.EventOperationCompleted, TenantID: &tenantID}
+}
+
+func TestRelay_RelayPending(t *testing.T) {
+	ctx := context.Background()
+	repo := newFakeRepo(tenantEvent(1, 10), tenantEvent(2, 20), tenantEvent(3, 10))
+	first, second := &recordingSink{}, &recordingSink{}
+
+	relay := newRelay(repo, first, second)
+
+	published, err := relay.RelayPending(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, published, "one event per tenant is relayed in a pass")
+	assert.Equal(t, []int64{1, 2}, first.received)
+	assert.Equal(t, []int64{1, 2}, second.received)
+	assert.False(t, repo.deleted.IsZero(), "published events past retention are deleted")
+	assert.False(t, repo.lockHeld, "the relay lock is released")
+
+	published, err = relay.RelayPending(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, published)
+	assert.Equal(t, []int64{1, 2, 3}, first.received)
+	assert.Equal(t, []int64{1, 2, 3}, second.received)
+	assert.Len(t, repo.published, 3)
+}
+
+func TestRelay_RelayPending_FailureHoldsBackTenant(t *testing.T) {
+	ctx := context.Background()
+	repo := newFakeRepo(tenantEvent(1, 10), tenantEvent(2, 20), tenantEvent(3, 10))
+	sink := &recordingSink{failing: map[int64]bool{1: true}}
+	relay := newRelay(repo, sink)
+
+	published, err := relay.RelayPending(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, published)
+	assert.Equal(t, []int64{2}, sink.received, "later events about the same tenant wait for the failed one")
+	assert.Equal(t, 1, repo.events[0].Attempts)
+	assert.True(t, repo.events[0].NextAttemptAt.After(time.Now()))
+
+	// The failed event is in backoff, which still holds back its tenant.
+	delete(sink.failing, 1)
+	published, err = relay.RelayPending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, published)
+
+	repo.events[0].NextAttemptAt = time.Time{}
+	published, err = relay.RelayPending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, published)
+
+	published, err = relay.RelayPending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, published)
+	assert.Equal(t, []int64{2, 1, 3}, sink.received)
+}
+
+func TestRelay_RelayPending_DeadLetter(t *testing.T) {
+	ctx := context.Background()
+	failing := tenantEvent(1, 10)
+	failing.Attempts = outbox.MaxAttempts - 1
+	repo := newFakeRepo(failing, tenantEvent(2, 10))
+	sink := &recordingSink{failing: map[int64]bool{1: true}}
+	relay := newRelay(repo, sink)
+
+	published, err := relay.RelayPending(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, published)
+	assert.True(t, repo.deadLettered[1], "the event is given up on after its last attempt")
+	assert.Equal(t, outbox.MaxAttempts, repo.events[0].Attempts)
+
+	published, err = relay.RelayPending(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, published)
+	assert.Equal(t, []int64{2}, sink.received, "a dead-lettered event no longer holds back its tenant")
+}
+
+func TestRelay_RelayPending_LockHeldElsewhere(t *testing.T) {
+	ctx := context.Background()
+	repo := newFakeRepo(tenantEvent(1, 10))
+	repo.lockHeld = true
+	sink := &recordingSink{}
+
+	published, err := newRelay(repo, sink).RelayPending(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, published)
+	assert.Empty(t, sink.received)
+}
+
+func TestRelay_RelayPending_NoSinks(t *testing.T) {
+	ctx := context.Background()
+	repo := newFakeRepo(tenantEvent(1, 10), tenantEvent(2, 20))
+
+	published, err := newRelay(repo).RelayPending(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, published)
+	assert.Len(t, repo.published, 2)
+}
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, time.Second, backoff(0))
+	assert.Equal(t, 8*time.Second, backoff(3))
+	assert.Equal(t, maxBackoff, backoff(10))
+	assert.Equal(t, maxBackoff, backoff(100))
+}
@@ -0,0 +1,156 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ahrav/hoglet-hub/internal/application/sdk/errs"
+	"github.com/ahrav/hoglet-hub/internal/domain/resource"
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+	"github.com/ahrav/hoglet-hub/pkg/common/logger"
+)
+
+// QuotaService enforces per-project resource quotas such as the Pub/Sub topic limit.
+//
+// Each region is served by several projects in order of preference. A tenant's
+// resources all live in one project, the first with room for its footprint, so
+// new tenants spill over to the next project once one is full.
+type QuotaService struct {
+	repo     resource.QuotaRepository
+	quotas   resource.Quotas
+	projects int
+
+	logger *logger.Logger
+	tracer trace.Tracer
+}
+
+// NewQuotaService creates a quota service enforcing quotas across projectsPerRegion
+// projects in each region.
+func NewQuotaService(
+	repo resource.QuotaRepository,
+	quotas resource.Quotas,
+	projectsPerRegion int,
+	logger *logger.Logger,
+	tracer trace.Tracer,
+) *QuotaService {
+	return &QuotaService{
+		repo:     repo,
+		quotas:   quotas,
+		projects: projectsPerRegion,
+		logger:   logger.With("component", "resource_quota_service"),
+		tracer:   tracer,
+	}
+}
+
+// SelectProject returns the first project in the region with room for the footprint.
+// Nothing is reserved; callers reserve once they create the resources. Returns an
+// errs.ResourceExhausted error if every project in the region is full.
+func (s *QuotaService) SelectProject(
+	ctx context.Context,
+	region tenant.Region,
+	footprint resource.Footprint,
+) (string, error) {
+	ctx, span := s.tracer.Start(ctx, "resource.SelectProject", trace.WithAttributes(
+		attribute.String("region", string(region)),
+	))
+	defer span.End()
+
+	for _, projectID := range resource.ProjectIDs(region, s.projects) {
+		counts, err := s.repo.Counts(ctx, projectID, region)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "error reading resource counts")
+			return "", fmt.Errorf("failed to read resource counts for project %s: %w", projectID, err)
+		}
+		if s.quotas.Fits(counts, footprint) {
+			span.SetAttributes(attribute.String("project_id", projectID))
+			span.SetStatus(codes.Ok, "project selected")
+			return projectID, nil
+		}
+		span.AddEvent("project full", trace.WithAttributes(attribute.String("project_id", projectID)))
+	}
+
+	span.SetStatus(codes.Error, "quota exhausted")
+	s.logger.Warn(ctx, "resource quota exhausted in every project", "region", region)
+	return "", errs.Newf(errs.ResourceExhausted, "no project in region %s has quota for the requested resources", region)
+}
+
+// Reserve atomically reserves the footprint in the preferred project or, once that
+// is full, in the first other project in the region with room. An empty preferred
+// project starts from the region's first project. It returns the project reserved in,
+// or resource.ErrQuotaExhausted if every project in the region is full.
+func (s *QuotaService) Reserve(
+	ctx context.Context,
+	preferred string,
+	region tenant.Region,
+	footprint resource.Footprint,
+) (string, error) {
+	logger := logger.NewLoggerContext(s.logger.With("region", region, "preferred_project_id", preferred))
+	ctx, span := s.tracer.Start(ctx, "resource.Reserve", trace.WithAttributes(
+		attribute.String("region", string(region)),
+		attribute.String("preferred_project_id", preferred),
+	))
+	defer span.End()
+
+	projects := resource.ProjectIDs(region, s.projects)
+	if i := slices.Index(projects, preferred); i > 0 {
+		projects = append([]string{preferred}, slices.Delete(projects, i, i+1)...)
+	}
+
+	var exhausted []error
+	for _, projectID := range projects {
+		err := s.repo.Reserve(ctx, projectID, region, footprint, s.quotas)
+		if errors.Is(err, resource.ErrQuotaExhausted) {
+			span.AddEvent("project full", trace.WithAttributes(attribute.String("project_id", projectID)))
+			exhausted = append(exhausted, err)
+			continue
+		}
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "error reserving quota")
+			return "", fmt.Errorf("failed to reserve quota in project %s: %w", projectID, err)
+		}
+
+		span.SetAttributes(attribute.String("project_id", projectID))
+		span.SetStatus(codes.Ok, "quota reserved")
+		if projectID != preferred && preferred != "" {
+			logger.Info(ctx, "quota spilled over to another project", "project_id", projectID)
+		}
+		return projectID, nil
+	}
+
+	err := errors.Join(exhausted...)
+	span.RecordError(err)
+	span.SetStatus(codes.Error, "quota exhausted")
+	logger.Warn(ctx, "resource quota exhausted in every project")
+	return "", err
+}
+
+// Release returns the footprint's quota to the project.
+func (s *QuotaService) Release(
+	ctx context.Context,
+	projectID string,
+	region tenant.Region,
+	footprint resource.Footprint,
+) error {
+	ctx, span := s.tracer.Start(ctx, "resource.Release", trace.WithAttributes(
+		attribute.String("project_id", projectID),
+		attribute.String("region", string(region)),
+	))
+	defer span.End()
+
+	if err := s.repo.Release(ctx, projectID, region, footprint); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error releasing quota")
+		return fmt.Errorf("failed to release quota in project %s: %w", projectID, err)
+	}
+
+	span.SetStatus(codes.Ok, "quota released")
+	return nil
+}
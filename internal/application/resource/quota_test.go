@@ -0,0 +1,140 @@
+package resource_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/ahrav/hoglet-hub/internal/application/resource"
+	"github.com/ahrav/hoglet-hub/internal/application/sdk/errs"
+	resourceDomain "github.com/ahrav/hoglet-hub/internal/domain/resource"
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+	"github.com/ahrav/hoglet-hub/pkg/common/logger"
+)
+
+// MockQuotaRepo is a testify mock for resource.QuotaRepository.
+type MockQuotaRepo struct{ mock.Mock }
+
+func (m *MockQuotaRepo) Counts(
+	ctx context.Context,
+	projectID string,
+	region tenant.Region,
+) (resourceDomain.Footprint, error) {
+	args := m.Called(ctx, projectID, region)
+	counts, _ := args.Get(0).(resourceDomain.Footprint)
+	return counts, args.Error(1)
+}
+
+func (m *MockQuotaRepo) Reserve(
+	ctx context.Context,
+	projectID string,
+	region tenant.Region,
+	footprint resourceDomain.Footprint,
+	quotas resourceDomain.Quotas,
+) error {
+	args := m.Called(ctx, projectID, region, footprint, quotas)
+	return args.Error(0)
+}
+
+func (m *MockQuotaRepo) Release(
+	ctx context.Context,
+	projectID string,
+	region tenant.Region,
+	footprint resourceDomain.Footprint,
+) error {
+	args := m.Called(ctx, projectID, region, footprint)
+	return args.Error(0)
+}
+
+var testQuotas = resourceDomain.Quotas{resourceDomain.TypePubSubTopic: 10}
+
+func newQuotaService(repo *MockQuotaRepo) *resource.QuotaService {
+	return resource.NewQuotaService(repo, testQuotas, 3, logger.Noop(), noop.NewTracerProvider().Tracer("test"))
+}
+
+func TestQuotaService_SelectProject(t *testing.T) {
+	ctx := context.Background()
+	full := resourceDomain.Footprint{resourceDomain.TypePubSubTopic: 10}
+
+	t.Run("selects the first project with room", func(t *testing.T) {
+		repo := new(MockQuotaRepo)
+		repo.On("Counts", mock.Anything, "hoglet-hub-eu1", tenant.RegionEU1).Return(full, nil)
+		repo.On("Counts", mock.Anything, "hoglet-hub-eu1-2", tenant.RegionEU1).
+			Return(resourceDomain.Footprint{resourceDomain.TypePubSubTopic: 9}, nil)
+
+		got, err := newQuotaService(repo).SelectProject(ctx, tenant.RegionEU1, resourceDomain.TenantFootprint)
+		require.NoError(t, err)
+		assert.Equal(t, "hoglet-hub-eu1-2", got)
+		repo.AssertNotCalled(t, "Counts", mock.Anything, "hoglet-hub-eu1-3", tenant.RegionEU1)
+	})
+
+	t.Run("every project full", func(t *testing.T) {
+		repo := new(MockQuotaRepo)
+		repo.On("Counts", mock.Anything, mock.Anything, tenant.RegionEU1).Return(full, nil)
+
+		_, err := newQuotaService(repo).SelectProject(ctx, tenant.RegionEU1, resourceDomain.TenantFootprint)
+		var appErr *errs.Error
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, errs.ResourceExhausted, appErr.Code)
+		repo.AssertNumberOfCalls(t, "Counts", 3)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		repo := new(MockQuotaRepo)
+		repo.On("Counts", mock.Anything, "hoglet-hub-eu1", tenant.RegionEU1).Return(nil, errors.New("db down"))
+
+		_, err := newQuotaService(repo).SelectProject(ctx, tenant.RegionEU1, resourceDomain.TenantFootprint)
+		assert.ErrorContains(t, err, "db down")
+	})
+}
+
+func TestQuotaService_Reserve(t *testing.T) {
+	ctx := context.Background()
+	footprint := resourceDomain.TenantFootprint
+
+	t.Run("reserves in the preferred project", func(t *testing.T) {
+		repo := new(MockQuotaRepo)
+		repo.On("Reserve", mock.Anything, "hoglet-hub-eu1-3", tenant.RegionEU1, footprint, testQuotas).Return(nil)
+
+		got, err := newQuotaService(repo).Reserve(ctx, "hoglet-hub-eu1-3", tenant.RegionEU1, footprint)
+		require.NoError(t, err)
+		assert.Equal(t, "hoglet-hub-eu1-3", got)
+		repo.AssertNumberOfCalls(t, "Reserve", 1)
+	})
+
+	t.Run("spills over when the preferred project filled up", func(t *testing.T) {
+		repo := new(MockQuotaRepo)
+		repo.On("Reserve", mock.Anything, "hoglet-hub-eu1-2", tenant.RegionEU1, footprint, testQuotas).
+			Return(resourceDomain.ErrQuotaExhausted)
+		repo.On("Reserve", mock.Anything, "hoglet-hub-eu1", tenant.RegionEU1, footprint, testQuotas).Return(nil)
+
+		got, err := newQuotaService(repo).Reserve(ctx, "hoglet-hub-eu1-2", tenant.RegionEU1, footprint)
+		require.NoError(t, err)
+		assert.Equal(t, "hoglet-hub-eu1", got)
+	})
+
+	t.Run("every project full", func(t *testing.T) {
+		repo := new(MockQuotaRepo)
+		repo.On("Reserve", mock.Anything, mock.Anything, tenant.RegionEU1, footprint, testQuotas).
+			Return(resourceDomain.ErrQuotaExhausted)
+
+		_, err := newQuotaService(repo).Reserve(ctx, "", tenant.RegionEU1, footprint)
+		assert.ErrorIs(t, err, resourceDomain.ErrQuotaExhausted)
+		repo.AssertNumberOfCalls(t, "Reserve", 3)
+	})
+
+	t.Run("repository error stops spillover", func(t *testing.T) {
+		repo := new(MockQuotaRepo)
+		repo.On("Reserve", mock.Anything, "hoglet-hub-eu1", tenant.RegionEU1, footprint, testQuotas).
+			Return(errors.New("db down"))
+
+		_, err := newQuotaService(repo).Reserve(ctx, "", tenant.RegionEU1, footprint)
+		assert.ErrorContains(t, err, "db down")
+		repo.AssertNumberOfCalls(t, "Reserve", 1)
+	})
+}
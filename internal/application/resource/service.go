@@ -0,0 +1,84 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ahrav/hoglet-hub/internal/domain/resource"
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+	"github.com/ahrav/hoglet-hub/pkg/common/logger"
+)
+
+// Service provides read access to the ledger of resources provisioned for tenants.
+// The ledger itself is written by the tenant workflows as they create and remove resources.
+type Service struct {
+	resourceRepo resource.Repository
+	tenantRepo   tenant.Repository
+
+	logger *logger.Logger
+	tracer trace.Tracer
+}
+
+// NewService creates a new resource service with the required repositories.
+func NewService(
+	resourceRepo resource.Repository,
+	tenantRepo tenant.Repository,
+	logger *logger.Logger,
+	tracer trace.Tracer,
+) *Service {
+	return &Service{
+		resourceRepo: resourceRepo,
+		tenantRepo:   tenantRepo,
+		logger:       logger.With("component", "resource_service"),
+		tracer:       tracer,
+	}
+}
+
+// ListForTenant retrieves the tenant's recorded resources matching the filter.
+// Returns tenant.ErrTenantNotFound if the tenant does not exist.
+func (s *Service) ListForTenant(
+	ctx context.Context,
+	tenantID int64,
+	filter resource.ListFilter,
+) ([]*resource.Resource, error) {
+	ctx, span := s.tracer.Start(ctx, "resource.ListForTenant", trace.WithAttributes(
+		attribute.Int64("tenant_id", tenantID),
+	))
+	defer span.End()
+
+	if filter.Type != nil && !filter.Type.IsValid() {
+		span.SetStatus(codes.Error, "invalid resource type")
+		return nil, resource.ErrInvalidType
+	}
+	if filter.Status != nil && !filter.Status.IsValid() {
+		span.SetStatus(codes.Error, "invalid resource status")
+		return nil, resource.ErrInvalidStatus
+	}
+
+	t, err := s.tenantRepo.FindByID(ctx, tenantID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error finding tenant")
+		return nil, fmt.Errorf("error finding tenant (%d): %w", tenantID, err)
+	}
+	if t == nil {
+		span.RecordError(tenant.ErrTenantNotFound)
+		span.SetStatus(codes.Error, "tenant not found")
+		return nil, tenant.ErrTenantNotFound
+	}
+
+	resources, err := s.resourceRepo.ListByTenant(ctx, tenantID, filter)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error listing resources")
+		return nil, fmt.Errorf("failed to list resources for tenant (%d): %w", tenantID, err)
+	}
+
+	span.SetAttributes(attribute.Int("resource_count", len(resources)))
+	span.SetStatus(codes.Ok, "resources listed")
+	return resources, nil
+}
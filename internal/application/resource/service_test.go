@@ -0,0 +1,98 @@
+package resource_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/ahrav/hoglet-hub/internal/application/resource"
+	resourceDomain "github.com/ahrav/hoglet-hub/internal/domain/resource"
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+	"github.com/ahrav/hoglet-hub/pkg/common/logger"
+)
+
+// MockResourceRepo is a testify mock for resource.Repository.
+type MockResourceRepo struct{ mock.Mock }
+
+func (m *MockResourceRepo) Register(ctx context.Context, r *resourceDomain.Resource) (int64, error) {
+	args := m.Called(ctx, r)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockResourceRepo) ListByTenant(
+	ctx context.Context,
+	tenantID int64,
+	filter resourceDomain.ListFilter,
+) ([]*resourceDomain.Resource, error) {
+	args := m.Called(ctx, tenantID, filter)
+	resources, _ := args.Get(0).([]*resourceDomain.Resource)
+	return resources, args.Error(1)
+}
+
+func (m *MockResourceRepo) UpdateStatus(ctx context.Context, r *resourceDomain.Resource) error {
+	args := m.Called(ctx, r)
+	return args.Error(0)
+}
+
+func (m *MockResourceRepo) Delete(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+// MockTenantRepo is a testify mock for the parts of tenant.Repository used by the service.
+type MockTenantRepo struct {
+	tenant.Repository
+	mock.Mock
+}
+
+func (m *MockTenantRepo) FindByID(ctx context.Context, id int64) (*tenant.Tenant, error) {
+	args := m.Called(ctx, id)
+	t, _ := args.Get(0).(*tenant.Tenant)
+	return t, args.Error(1)
+}
+
+func newService(resources *MockResourceRepo, tenants *MockTenantRepo) *resource.Service {
+	return resource.NewService(resources, tenants, logger.Noop(), noop.NewTracerProvider().Tracer("test"))
+}
+
+func TestService_ListForTenant(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("lists the tenant's resources", func(t *testing.T) {
+		tenants := new(MockTenantRepo)
+		tenants.On("FindByID", mock.Anything, int64(42)).Return(&tenant.Tenant{ID: 42}, nil)
+		secret := resourceDomain.TypeSecret
+		filter := resourceDomain.ListFilter{Type: &secret}
+		resources := new(MockResourceRepo)
+		resources.On("ListByTenant", mock.Anything, int64(42), filter).Return([]*resourceDomain.Resource{
+			{ID: 1, TenantID: 42, Type: resourceDomain.TypeSecret, Name: "tenant-acme-api-key"},
+		}, nil)
+
+		got, err := newService(resources, tenants).ListForTenant(ctx, 42, filter)
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		assert.Equal(t, "tenant-acme-api-key", got[0].Name)
+	})
+
+	t.Run("unknown tenant", func(t *testing.T) {
+		tenants := new(MockTenantRepo)
+		tenants.On("FindByID", mock.Anything, int64(42)).Return(nil, nil)
+		resources := new(MockResourceRepo)
+
+		_, err := newService(resources, tenants).ListForTenant(ctx, 42, resourceDomain.ListFilter{})
+		assert.ErrorIs(t, err, tenant.ErrTenantNotFound)
+		resources.AssertNotCalled(t, "ListByTenant", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid filter", func(t *testing.T) {
+		status := resourceDomain.Status("gone")
+
+		_, err := newService(new(MockResourceRepo), new(MockTenantRepo)).
+			ListForTenant(ctx, 42, resourceDomain.ListFilter{Status: &status})
+		assert.ErrorIs(t, err, resourceDomain.ErrInvalidStatus)
+	})
+}
@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// ErrNoCredentials is returned by an Authenticator when the request carries no
+// credentials at all, as opposed to credentials that are invalid.
+var ErrNoCredentials = errors.New("no credentials")
+
+// Authenticator identifies the caller of a request.
+type Authenticator interface {
+	// Authenticate returns the claims of the request's caller.
+	// Returns ErrNoCredentials if the request carries no credentials.
+	Authenticate(r *http.Request) (*Claims, error)
+}
+
+// Authenticate verifies the bearer token in the request's Authorization header.
+// It implements Authenticator.
+func (v *Verifier) Authenticate(r *http.Request) (*Claims, error) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	token = strings.TrimSpace(token)
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return nil, ErrNoCredentials
+	}
+	return v.Verify(token)
+}
+
+// Headers read by HeaderAuthenticator.
+const (
+	SubjectHeader = "X-Auth-Subject"
+	RolesHeader   = "X-Auth-Roles" // Comma-separated
+)
+
+// HeaderAuthenticator trusts the caller's identity as stated in the request's
+// SubjectHeader and RolesHeader. It is meant for development, or for deployments
+// where a proxy that strips these headers from client requests authenticates
+// callers; anyone who can reach the server directly can claim to be anyone.
+type HeaderAuthenticator struct{}
+
+// NewHeaderAuthenticator creates a new HeaderAuthenticator.
+func NewHeaderAuthenticator() *HeaderAuthenticator { return new(HeaderAuthenticator) }
+
+// Authenticate implements Authenticator.
+func (*HeaderAuthenticator) Authenticate(r *http.Request) (*Claims, error) {
+	subject := strings.TrimSpace(r.Header.Get(SubjectHeader))
+	if subject == "" {
+		return nil, ErrNoCredentials
+	}
+
+	var roles []string
+	for role := range strings.SplitSeq(r.Header.Get(RolesHeader), ",") {
+		if role = strings.TrimSpace(role); role != "" {
+			roles = append(roles, role)
+		}
+	}
+	return &Claims{Subject: subject, Roles: roles}, nil
+}
@@ -0,0 +1,70 @@
+package auth_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ahrav/hoglet-hub/internal/application/sdk/auth"
+)
+
+func TestVerifier_Authenticate(t *testing.T) {
+	v := newHMACVerifier(t, auth.VerifierConfig{})
+	token := signHS256(t, testSecret, map[string]any{"alg": "HS256"}, validClaims())
+
+	tests := []struct {
+		name          string
+		authorization string
+		wantErr       error
+	}{
+		{name: "bearer token", authorization: "Bearer " + token},
+		{name: "scheme is case-insensitive", authorization: "bearer " + token},
+		{name: "no header", wantErr: auth.ErrNoCredentials},
+		{name: "basic auth", authorization: "Basic YWxpY2U6c2VjcmV0", wantErr: auth.ErrNoCredentials},
+		{name: "empty token", authorization: "Bearer ", wantErr: auth.ErrNoCredentials},
+		{name: "invalid token", authorization: "Bearer " + token + "x", wantErr: auth.ErrInvalidSignature},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/api/v1/tenants", nil)
+			if tc.authorization != "" {
+				r.Header.Set("Authorization", tc.authorization)
+			}
+
+			claims, err := v.Authenticate(r)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "alice@example.com", claims.Subject)
+		})
+	}
+}
+
+func TestHeaderAuthenticator(t *testing.T) {
+	a := auth.NewHeaderAuthenticator()
+
+	t.Run("subject and roles", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/api/v1/tenants", nil)
+		r.Header.Set(auth.SubjectHeader, "alice@example.com")
+		r.Header.Set(auth.RolesHeader, "operator, eu-admin,,")
+
+		claims, err := a.Authenticate(r)
+		require.NoError(t, err)
+		assert.Equal(t, "alice@example.com", claims.Subject)
+		assert.Equal(t, []string{"operator", "eu-admin"}, claims.Roles)
+	})
+
+	t.Run("no subject", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/api/v1/tenants", nil)
+		r.Header.Set(auth.RolesHeader, "admin")
+
+		_, err := a.Authenticate(r)
+		assert.ErrorIs(t, err, auth.ErrNoCredentials)
+	})
+}
@@ -0,0 +1,116 @@
+// Package auth authenticates API requests by validating JWT bearer tokens and
+// carries the authenticated claims through the request context.
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Claims are the validated claims of a bearer token.
+type Claims struct {
+	Subject   string
+	Issuer    string
+	Audience  []string
+	ExpiresAt time.Time
+	NotBefore time.Time // Zero if the token does not restrict when it becomes valid
+	IssuedAt  time.Time // Zero if the token does not say when it was issued
+
+	// Roles are the roles and scopes granted to the subject, taken from the roles,
+	// scope and scp claims.
+	Roles []string
+
+	// Raw holds every claim in the token, including the registered claims above,
+	// for consumers that need custom claims such as roles.
+	Raw map[string]any
+}
+
+// claimsKey is the context key under which the request's claims are stored.
+type claimsKey struct{}
+
+// WithClaims returns a copy of ctx carrying the authenticated claims.
+func WithClaims(ctx context.Context, c *Claims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, c)
+}
+
+// ClaimsFromContext returns the claims of the authenticated request, if any.
+func ClaimsFromContext(ctx context.Context) (*Claims, bool) {
+	c, ok := ctx.Value(claimsKey{}).(*Claims)
+	return c, ok && c != nil
+}
+
+// Subject returns the subject of the authenticated request, or an empty string
+// if the request was not authenticated.
+func Subject(ctx context.Context) string {
+	if c, ok := ClaimsFromContext(ctx); ok {
+		return c.Subject
+	}
+	return ""
+}
+
+// registeredClaims is the wire form of the registered claims the verifier checks.
+type registeredClaims struct {
+	Subject   string       `json:"sub"`
+	Issuer    string       `json:"iss"`
+	Audience  audience     `json:"aud"`
+	ExpiresAt *json.Number `json:"exp"`
+	NotBefore *json.Number `json:"nbf"`
+	IssuedAt  *json.Number `json:"iat"`
+}
+
+// audience accepts the aud claim as either a single string or a list of strings.
+type audience []string
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (a *audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = audience{single}
+		return nil
+	}
+
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return fmt.Errorf("aud must be a string or a list of strings: %w", err)
+	}
+	*a = list
+	return nil
+}
+
+// numericDate converts a NumericDate claim to a time. A nil claim is the zero time.
+func numericDate(n *json.Number) (time.Time, error) {
+	if n == nil {
+		return time.Time{}, nil
+	}
+	secs, err := n.Float64()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(0, int64(secs*float64(time.Second))), nil
+}
+
+// rolesFromClaims collects the roles and scopes granted by a token. The roles and
+// scp claims may be a string or a list of strings, and the scope claim is a
+// space-separated string as defined by RFC 8693.
+func rolesFromClaims(raw map[string]any) []string {
+	var roles []string
+	for _, name := range []string{"roles", "scp"} {
+		switch v := raw[name].(type) {
+		case string:
+			roles = append(roles, v)
+		case []any:
+			for _, r := range v {
+				if s, ok := r.(string); ok {
+					roles = append(roles, s)
+				}
+			}
+		}
+	}
+	if scope, ok := raw["scope"].(string); ok {
+		roles = append(roles, strings.Fields(scope)...)
+	}
+	return roles
+}
@@ -0,0 +1,159 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+)
+
+// Supported signing algorithms.
+const (
+	AlgHS256 = "HS256"
+	AlgRS256 = "RS256"
+)
+
+// minHMACKeySize is the shortest HS256 secret accepted, matching the hash size.
+const minHMACKeySize = 32
+
+// Key is a verification key along with the algorithm it verifies.
+type Key struct {
+	ID        string
+	Algorithm string
+	hmac      []byte
+	rsa       *rsa.PublicKey
+}
+
+// KeySet holds the keys tokens may be signed with.
+type KeySet struct{ keys []Key }
+
+// NewHMACKey creates an HS256 key from a shared secret.
+func NewHMACKey(id string, secret []byte) (Key, error) {
+	if len(secret) < minHMACKeySize {
+		return Key{}, fmt.Errorf("HS256 secret must be at least %d bytes", minHMACKeySize)
+	}
+	return Key{ID: id, Algorithm: AlgHS256, hmac: secret}, nil
+}
+
+// NewRSAKey creates an RS256 key from an RSA public key.
+func NewRSAKey(id string, pub *rsa.PublicKey) Key {
+	return Key{ID: id, Algorithm: AlgRS256, rsa: pub}
+}
+
+// Add adds keys to the set.
+func (s *KeySet) Add(keys ...Key) { s.keys = append(s.keys, keys...) }
+
+// Len returns the number of keys in the set.
+func (s *KeySet) Len() int { return len(s.keys) }
+
+// lookup returns the key a token with the given header fields was signed with.
+// Keys are matched on algorithm as well as ID so that a token cannot pick the
+// algorithm its key is used with. A token without a key ID is only accepted
+// while exactly one key with its algorithm exists.
+func (s *KeySet) lookup(alg, kid string) (Key, error) {
+	var candidates []Key
+	for _, k := range s.keys {
+		if k.Algorithm != alg {
+			continue
+		}
+		if kid != "" && k.ID == kid {
+			return k, nil
+		}
+		candidates = append(candidates, k)
+	}
+	if kid == "" && len(candidates) == 1 {
+		return candidates[0], nil
+	}
+	return Key{}, ErrUnknownKey
+}
+
+// jwk is the wire form of a JSON Web Key.
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	K   string `json:"k"`
+}
+
+// LoadJWKS reads a JSON Web Key Set file. RSA keys are used for RS256 and
+// symmetric ("oct") keys for HS256; keys for other uses are skipped.
+func LoadJWKS(path string) (*KeySet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWKS file: %w", err)
+	}
+	return ParseJWKS(data)
+}
+
+// ParseJWKS parses a JSON Web Key Set. See LoadJWKS.
+func ParseJWKS(data []byte) (*KeySet, error) {
+	var doc struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse JWKS: %w", err)
+	}
+
+	set := new(KeySet)
+	for i, k := range doc.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+
+		key, err := k.toKey()
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %d (%s): %w", i, k.Kid, err)
+		}
+		set.Add(key)
+	}
+	if set.Len() == 0 {
+		return nil, errors.New("JWKS contains no signing keys")
+	}
+	return set, nil
+}
+
+// toKey converts a JSON Web Key to a verification key.
+func (k jwk) toKey() (Key, error) {
+	switch k.Kty {
+	case "RSA":
+		if k.Alg != "" && k.Alg != AlgRS256 {
+			return Key{}, fmt.Errorf("unsupported algorithm %q for RSA key", k.Alg)
+		}
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return Key{}, fmt.Errorf("invalid modulus: %w", err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return Key{}, fmt.Errorf("invalid exponent: %w", err)
+		}
+		exponent := new(big.Int).SetBytes(e)
+		if !exponent.IsInt64() || exponent.Int64() < 3 || exponent.Int64() > 1<<31-1 {
+			return Key{}, errors.New("invalid exponent")
+		}
+		pub := &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}
+		if pub.N.BitLen() < 2048 {
+			return Key{}, errors.New("RSA keys must be at least 2048 bits")
+		}
+		return NewRSAKey(k.Kid, pub), nil
+
+	case "oct":
+		if k.Alg != "" && k.Alg != AlgHS256 {
+			return Key{}, fmt.Errorf("unsupported algorithm %q for symmetric key", k.Alg)
+		}
+		secret, err := base64.RawURLEncoding.DecodeString(k.K)
+		if err != nil {
+			return Key{}, fmt.Errorf("invalid secret: %w", err)
+		}
+		return NewHMACKey(k.Kid, secret)
+
+	default:
+		return Key{}, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
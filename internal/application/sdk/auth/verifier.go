@@ -0,0 +1,199 @@
+package auth
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Common errors that can be returned when verifying a token.
+var (
+	ErrMalformedToken       = errors.New("malformed token")
+	ErrUnsupportedAlgorithm = errors.New("unsupported signing algorithm")
+	ErrUnknownKey           = errors.New("token signed with unknown key")
+	ErrInvalidSignature     = errors.New("invalid token signature")
+	ErrTokenExpired         = errors.New("token has expired")
+	ErrTokenNotYetValid     = errors.New("token is not yet valid")
+	ErrInvalidClaims        = errors.New("invalid token claims")
+)
+
+// DefaultLeeway is the clock skew tolerated when checking token lifetimes.
+const DefaultLeeway = time.Minute
+
+// VerifierConfig configures a Verifier.
+type VerifierConfig struct {
+	Keys *KeySet
+
+	// Issuer, if set, must match the iss claim exactly.
+	Issuer string
+	// Audience, if set, must be one of the values of the aud claim.
+	Audience string
+	// Leeway is the clock skew tolerated when checking exp and nbf.
+	// Defaults to DefaultLeeway.
+	Leeway time.Duration
+}
+
+// Verifier validates compact-serialized JWTs signed with HS256 or RS256.
+type Verifier struct {
+	keys     *KeySet
+	issuer   string
+	audience string
+	leeway   time.Duration
+	now      func() time.Time
+}
+
+// NewVerifier creates a new Verifier.
+func NewVerifier(cfg VerifierConfig) (*Verifier, error) {
+	if cfg.Keys == nil || cfg.Keys.Len() == 0 {
+		return nil, errors.New("at least one verification key is required")
+	}
+	if cfg.Leeway <= 0 {
+		cfg.Leeway = DefaultLeeway
+	}
+
+	return &Verifier{
+		keys:     cfg.Keys,
+		issuer:   cfg.Issuer,
+		audience: cfg.Audience,
+		leeway:   cfg.Leeway,
+		now:      time.Now,
+	}, nil
+}
+
+// header is the JOSE header of a token.
+type header struct {
+	Alg  string `json:"alg"`
+	Kid  string `json:"kid"`
+	Crit []any  `json:"crit"`
+}
+
+// Verify checks the token's signature and lifetime, along with its issuer and
+// audience when the verifier is configured to, and returns its claims.
+// Tokens must carry a subject and an expiry.
+func (v *Verifier) Verify(token string) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrMalformedToken
+	}
+
+	var hdr header
+	if err := decodeSegment(parts[0], &hdr); err != nil {
+		return nil, fmt.Errorf("%w: header: %w", ErrMalformedToken, err)
+	}
+	// Critical extensions must be understood by the recipient, and we understand none.
+	if len(hdr.Crit) > 0 {
+		return nil, fmt.Errorf("%w: unsupported critical header", ErrMalformedToken)
+	}
+
+	key, err := v.keys.lookup(hdr.Alg, hdr.Kid)
+	if err != nil {
+		if hdr.Alg != AlgHS256 && hdr.Alg != AlgRS256 {
+			return nil, fmt.Errorf("%w: %q", ErrUnsupportedAlgorithm, hdr.Alg)
+		}
+		return nil, err
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: signature: %w", ErrMalformedToken, err)
+	}
+	if err := verifySignature(key, parts[0]+"."+parts[1], sig); err != nil {
+		return nil, err
+	}
+
+	var raw map[string]any
+	if err := decodeSegment(parts[1], &raw); err != nil {
+		return nil, fmt.Errorf("%w: payload: %w", ErrMalformedToken, err)
+	}
+	var registered registeredClaims
+	if err := decodeSegment(parts[1], &registered); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidClaims, err)
+	}
+
+	claims, err := v.validate(registered)
+	if err != nil {
+		return nil, err
+	}
+	claims.Roles = rolesFromClaims(raw)
+	claims.Raw = raw
+	return claims, nil
+}
+
+// validate checks the registered claims against the verifier's configuration.
+func (v *Verifier) validate(rc registeredClaims) (*Claims, error) {
+	if rc.Subject == "" {
+		return nil, fmt.Errorf("%w: missing subject", ErrInvalidClaims)
+	}
+	if rc.ExpiresAt == nil {
+		return nil, fmt.Errorf("%w: missing expiry", ErrInvalidClaims)
+	}
+
+	claims := &Claims{Subject: rc.Subject, Issuer: rc.Issuer, Audience: rc.Audience}
+	var err error
+	if claims.ExpiresAt, err = numericDate(rc.ExpiresAt); err != nil {
+		return nil, fmt.Errorf("%w: exp: %w", ErrInvalidClaims, err)
+	}
+	if claims.NotBefore, err = numericDate(rc.NotBefore); err != nil {
+		return nil, fmt.Errorf("%w: nbf: %w", ErrInvalidClaims, err)
+	}
+	if claims.IssuedAt, err = numericDate(rc.IssuedAt); err != nil {
+		return nil, fmt.Errorf("%w: iat: %w", ErrInvalidClaims, err)
+	}
+
+	now := v.now()
+	if !now.Before(claims.ExpiresAt.Add(v.leeway)) {
+		return nil, ErrTokenExpired
+	}
+	if !claims.NotBefore.IsZero() && now.Add(v.leeway).Before(claims.NotBefore) {
+		return nil, ErrTokenNotYetValid
+	}
+
+	if v.issuer != "" && claims.Issuer != v.issuer {
+		return nil, fmt.Errorf("%w: unexpected issuer %q", ErrInvalidClaims, claims.Issuer)
+	}
+	if v.audience != "" && !slices.Contains(claims.Audience, v.audience) {
+		return nil, fmt.Errorf("%w: token not intended for this audience", ErrInvalidClaims)
+	}
+
+	return claims, nil
+}
+
+// verifySignature checks sig over the signing input with the key.
+func verifySignature(key Key, signingInput string, sig []byte) error {
+	switch key.Algorithm {
+	case AlgHS256:
+		mac := hmac.New(sha256.New, key.hmac)
+		mac.Write([]byte(signingInput))
+		if !hmac.Equal(sig, mac.Sum(nil)) {
+			return ErrInvalidSignature
+		}
+	case AlgRS256:
+		digest := sha256.Sum256([]byte(signingInput))
+		if err := rsa.VerifyPKCS1v15(key.rsa, crypto.SHA256, digest[:], sig); err != nil {
+			return ErrInvalidSignature
+		}
+	default:
+		return fmt.Errorf("%w: %q", ErrUnsupportedAlgorithm, key.Algorithm)
+	}
+	return nil
+}
+
+// decodeSegment decodes a base64url-encoded JSON segment of a token into v.
+func decodeSegment(seg string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(seg)
+	if err != nil {
+		return err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	return dec.Decode(v)
+}
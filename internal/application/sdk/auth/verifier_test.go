@@ -0,0 +1,251 @@
+package auth_test
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ahrav/hoglet-hub/internal/application/sdk/auth"
+)
+
+var testSecret = []byte("0123456789abcdef0123456789abcdef")
+
+func segment(t *testing.T, v any) string {
+	t.Helper()
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func signHS256(t *testing.T, secret []byte, hdr, claims map[string]any) string {
+	t.Helper()
+	input := segment(t, hdr) + "." + segment(t, claims)
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(input))
+	return input + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func signRS256(t *testing.T, key *rsa.PrivateKey, hdr, claims map[string]any) string {
+	t.Helper()
+	input := segment(t, hdr) + "." + segment(t, claims)
+	digest := sha256.Sum256([]byte(input))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+	return input + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func validClaims() map[string]any {
+	now := time.Now()
+	return map[string]any{
+		"sub":   "alice@example.com",
+		"iss":   "https://idp.example.com",
+		"aud":   []string{"hoglet-hub"},
+		"exp":   now.Add(time.Hour).Unix(),
+		"iat":   now.Unix(),
+		"roles": []string{"admin"},
+		"scope": "tenants:read tenants:write",
+	}
+}
+
+func newHMACVerifier(t *testing.T, cfg auth.VerifierConfig) *auth.Verifier {
+	t.Helper()
+	key, err := auth.NewHMACKey("", testSecret)
+	require.NoError(t, err)
+	cfg.Keys = new(auth.KeySet)
+	cfg.Keys.Add(key)
+	v, err := auth.NewVerifier(cfg)
+	require.NoError(t, err)
+	return v
+}
+
+func TestVerifier_HS256(t *testing.T) {
+	hdr := map[string]any{"alg": "HS256", "typ": "JWT"}
+	v := newHMACVerifier(t, auth.VerifierConfig{Issuer: "https://idp.example.com", Audience: "hoglet-hub"})
+
+	t.Run("valid token", func(t *testing.T) {
+		claims, err := v.Verify(signHS256(t, testSecret, hdr, validClaims()))
+		require.NoError(t, err)
+		assert.Equal(t, "alice@example.com", claims.Subject)
+		assert.Equal(t, []string{"hoglet-hub"}, claims.Audience)
+		assert.Equal(t, []string{"admin", "tenants:read", "tenants:write"}, claims.Roles)
+		assert.Equal(t, "tenants:read tenants:write", claims.Raw["scope"])
+	})
+
+	tests := []struct {
+		name    string
+		token   func() string
+		wantErr error
+	}{
+		{
+			name: "bad signature",
+			token: func() string {
+				return signHS256(t, []byte("another-secret-another-secret-xx"), hdr, validClaims())
+			},
+			wantErr: auth.ErrInvalidSignature,
+		},
+		{
+			name: "expired",
+			token: func() string {
+				c := validClaims()
+				c["exp"] = time.Now().Add(-2 * auth.DefaultLeeway).Unix()
+				return signHS256(t, testSecret, hdr, c)
+			},
+			wantErr: auth.ErrTokenExpired,
+		},
+		{
+			name: "not yet valid",
+			token: func() string {
+				c := validClaims()
+				c["nbf"] = time.Now().Add(10 * time.Minute).Unix()
+				return signHS256(t, testSecret, hdr, c)
+			},
+			wantErr: auth.ErrTokenNotYetValid,
+		},
+		{
+			name: "missing subject",
+			token: func() string {
+				c := validClaims()
+				delete(c, "sub")
+				return signHS256(t, testSecret, hdr, c)
+			},
+			wantErr: auth.ErrInvalidClaims,
+		},
+		{
+			name: "missing expiry",
+			token: func() string {
+				c := validClaims()
+				delete(c, "exp")
+				return signHS256(t, testSecret, hdr, c)
+			},
+			wantErr: auth.ErrInvalidClaims,
+		},
+		{
+			name: "wrong issuer",
+			token: func() string {
+				c := validClaims()
+				c["iss"] = "https://evil.example.com"
+				return signHS256(t, testSecret, hdr, c)
+			},
+			wantErr: auth.ErrInvalidClaims,
+		},
+		{
+			name: "wrong audience",
+			token: func() string {
+				c := validClaims()
+				c["aud"] = "someone-else"
+				return signHS256(t, testSecret, hdr, c)
+			},
+			wantErr: auth.ErrInvalidClaims,
+		},
+		{
+			name: "alg none",
+			token: func() string {
+				return segment(t, map[string]any{"alg": "none"}) + "." + segment(t, validClaims()) + "."
+			},
+			wantErr: auth.ErrUnsupportedAlgorithm,
+		},
+		{
+			name: "RS256 header with HMAC key",
+			token: func() string {
+				return signHS256(t, testSecret, map[string]any{"alg": "RS256"}, validClaims())
+			},
+			wantErr: auth.ErrUnknownKey,
+		},
+		{
+			name:    "malformed",
+			token:   func() string { return "not-a-jwt" },
+			wantErr: auth.ErrMalformedToken,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			claims, err := v.Verify(tc.token())
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Nil(t, claims)
+		})
+	}
+}
+
+func TestVerifier_RS256WithJWKS(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	jwks := map[string]any{"keys": []map[string]any{
+		{
+			"kty": "RSA", "kid": "rsa-1", "alg": "RS256", "use": "sig",
+			"n": base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e": base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		},
+		{"kty": "oct", "kid": "hmac-1", "k": base64.RawURLEncoding.EncodeToString(testSecret)},
+		{"kty": "RSA", "kid": "enc-1", "use": "enc"},
+	}}
+	data, err := json.Marshal(jwks)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+
+	keys, err := auth.LoadJWKS(path)
+	require.NoError(t, err)
+	assert.Equal(t, 2, keys.Len())
+
+	v, err := auth.NewVerifier(auth.VerifierConfig{Keys: keys})
+	require.NoError(t, err)
+
+	t.Run("RS256 token", func(t *testing.T) {
+		claims, err := v.Verify(signRS256(t, key, map[string]any{"alg": "RS256", "kid": "rsa-1"}, validClaims()))
+		require.NoError(t, err)
+		assert.Equal(t, "alice@example.com", claims.Subject)
+	})
+
+	t.Run("HS256 token", func(t *testing.T) {
+		_, err := v.Verify(signHS256(t, testSecret, map[string]any{"alg": "HS256", "kid": "hmac-1"}, validClaims()))
+		require.NoError(t, err)
+	})
+
+	t.Run("unknown key ID", func(t *testing.T) {
+		_, err := v.Verify(signRS256(t, key, map[string]any{"alg": "RS256", "kid": "rsa-2"}, validClaims()))
+		assert.ErrorIs(t, err, auth.ErrUnknownKey)
+	})
+
+	t.Run("signed by another key", func(t *testing.T) {
+		other, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		_, err = v.Verify(signRS256(t, other, map[string]any{"alg": "RS256", "kid": "rsa-1"}, validClaims()))
+		assert.ErrorIs(t, err, auth.ErrInvalidSignature)
+	})
+}
+
+func TestParseJWKS_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		jwks string
+	}{
+		{name: "not JSON", jwks: "{"},
+		{name: "no keys", jwks: `{"keys":[]}`},
+		{name: "short secret", jwks: `{"keys":[{"kty":"oct","k":"c2hvcnQ"}]}`},
+		{name: "unsupported key type", jwks: `{"keys":[{"kty":"EC","crv":"P-256"}]}`},
+		{name: "mismatched algorithm", jwks: fmt.Sprintf(`{"keys":[{"kty":"oct","alg":"RS256","k":%q}]}`,
+			base64.RawURLEncoding.EncodeToString(testSecret))},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := auth.ParseJWKS([]byte(tc.jwks))
+			assert.Error(t, err)
+		})
+	}
+}
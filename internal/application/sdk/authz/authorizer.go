@@ -0,0 +1,186 @@
+package authz
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/ahrav/hoglet-hub/internal/application/sdk/auth"
+	"github.com/ahrav/hoglet-hub/internal/application/sdk/errs"
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+	"github.com/ahrav/hoglet-hub/pkg/common/logger"
+)
+
+// DefaultReloadInterval is how often WatchFile checks the policy file for changes.
+const DefaultReloadInterval = 10 * time.Second
+
+// Scope is what a request acts on, checked against grants restricted to some
+// regions or tiers. A request that changes a tenant's region or tier has both
+// the current and the requested values in its scope.
+type Scope struct {
+	Regions []tenant.Region
+	Tiers   []tenant.Tier
+
+	// AllRegions and AllTiers mark listings that are not limited to some regions
+	// or tiers, and so span every one of them.
+	AllRegions bool
+	AllTiers   bool
+}
+
+// TenantScope returns the scope of a request acting on the tenant.
+func TenantScope(t *tenant.Tenant) Scope {
+	return Scope{Regions: []tenant.Region{t.Region}, Tiers: []tenant.Tier{t.Tier}}
+}
+
+// ListingScope returns the scope of a request listing tenants, or records about
+// them, filtered to the region and tier. A nil filter spans every region or tier.
+func ListingScope(region *tenant.Region, tier *tenant.Tier) Scope {
+	var s Scope
+	if region != nil {
+		s.Regions = []tenant.Region{*region}
+	} else {
+		s.AllRegions = true
+	}
+	if tier != nil {
+		s.Tiers = []tenant.Tier{*tier}
+	} else {
+		s.AllTiers = true
+	}
+	return s
+}
+
+// IsZero reports whether the scope is empty, meaning the request does not act
+// on particular tenants.
+func (s Scope) IsZero() bool {
+	return len(s.Regions) == 0 && len(s.Tiers) == 0 && !s.AllRegions && !s.AllTiers
+}
+
+// ScopeResolver returns the scope of the request being authorized. It is only
+// called for callers whose grants are restricted, since it may need to look up
+// the tenant the request acts on.
+type ScopeResolver func(ctx context.Context) (Scope, error)
+
+// Authorizer decides whether the caller of a request may perform an operation.
+// Its policy can be replaced while requests are being served.
+type Authorizer struct {
+	logger *logger.Logger
+	policy atomic.Pointer[Policy]
+}
+
+// NewAuthorizer creates a new Authorizer enforcing the policy.
+func NewAuthorizer(policy *Policy, logger *logger.Logger) *Authorizer {
+	a := &Authorizer{logger: logger}
+	a.policy.Store(policy)
+	return a
+}
+
+// Authorize returns nil if the caller may perform the operation, identified by
+// its name in the generated StrictServerInterface.
+//
+// The caller is authorized by any of its roles or scopes whose grant includes
+// the role the operation requires. A grant restricted to some regions or tiers
+// only authorizes requests whose scope lies entirely within them, so listings of
+// tenants must be filtered to those regions and tiers. Requests that do not act
+// on tenants at all are authorized by restricted grants only if they need no
+// more than the viewer role.
+//
+// Returns an errs.Unauthenticated error if the request carries no claims, and an
+// errs.PermissionDenied error if the caller may not perform the operation.
+func (a *Authorizer) Authorize(ctx context.Context, operation string, resolve ScopeResolver) error {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return errs.Newf(errs.Unauthenticated, "authentication required")
+	}
+
+	policy := a.policy.Load()
+	required, ok := policy.Operations[operation]
+	if !ok {
+		return errs.Newf(errs.PermissionDenied, "operation %s is not covered by the access policy", operation)
+	}
+
+	var restricted []Grant
+	for _, name := range claims.Roles {
+		g, ok := policy.Roles[name]
+		if !ok || !g.Role.Includes(required) {
+			continue
+		}
+		if !g.restricted() {
+			return nil
+		}
+		restricted = append(restricted, g)
+	}
+	if len(restricted) == 0 {
+		return errs.Newf(errs.PermissionDenied, "%s requires the %s role", operation, required)
+	}
+
+	var scope Scope
+	if resolve != nil {
+		var err error
+		if scope, err = resolve(ctx); err != nil {
+			return fmt.Errorf("failed to resolve scope of %s: %w", operation, err)
+		}
+	}
+	if scope.IsZero() {
+		if required == RoleViewer {
+			return nil
+		}
+		return errs.Newf(errs.PermissionDenied, "%s is not permitted to callers restricted to some tenants", operation)
+	}
+
+	for _, g := range restricted {
+		if g.covers(scope) {
+			return nil
+		}
+	}
+	return errs.Newf(errs.PermissionDenied, "%s is not permitted on tenants outside the caller's regions and tiers", operation)
+}
+
+// Reload replaces the policy with the one in the file. The current policy is
+// kept if the file cannot be loaded.
+func (a *Authorizer) Reload(path string) error {
+	policy, err := LoadPolicy(path)
+	if err != nil {
+		return err
+	}
+	a.policy.Store(policy)
+	return nil
+}
+
+// WatchFile reloads the policy whenever the file's modification time changes,
+// checking at the given interval until ctx is done. Policies that fail to load
+// are logged and leave the current policy in force.
+func (a *Authorizer) WatchFile(ctx context.Context, path string, interval time.Duration) {
+	var lastMod time.Time
+	if info, err := os.Stat(path); err == nil {
+		lastMod = info.ModTime()
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		info, err := os.Stat(path)
+		if err != nil {
+			a.logger.Error(ctx, "failed to stat access policy", "path", path, "err", err)
+			continue
+		}
+		if info.ModTime().Equal(lastMod) {
+			continue
+		}
+		lastMod = info.ModTime()
+
+		if err := a.Reload(path); err != nil {
+			a.logger.Error(ctx, "failed to reload access policy; keeping the current policy", "path", path, "err", err)
+			continue
+		}
+		a.logger.Info(ctx, "access policy reloaded", "path", path)
+	}
+}
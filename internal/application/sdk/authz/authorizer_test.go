@@ -0,0 +1,176 @@
+package authz_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ahrav/hoglet-hub/internal/application/sdk/auth"
+	"github.com/ahrav/hoglet-hub/internal/application/sdk/authz"
+	"github.com/ahrav/hoglet-hub/internal/application/sdk/errs"
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+	"github.com/ahrav/hoglet-hub/pkg/common/logger"
+)
+
+const testPolicy = `{
+	"roles": {
+		"eu-operator": {"role": "operator", "regions": ["eu1", "eu2"]},
+		"free-support": {"role": "operator", "tiers": ["free"]}
+	}
+}`
+
+func newAuthorizer(t *testing.T, policy string) *authz.Authorizer {
+	t.Helper()
+	p, err := authz.ParsePolicy([]byte(policy))
+	require.NoError(t, err)
+	return authz.NewAuthorizer(p, logger.Noop())
+}
+
+func callerWith(roles ...string) context.Context {
+	return auth.WithClaims(context.Background(), &auth.Claims{Subject: "alice@example.com", Roles: roles})
+}
+
+func scopeOf(region tenant.Region, tier tenant.Tier) authz.ScopeResolver {
+	return func(context.Context) (authz.Scope, error) {
+		return authz.TenantScope(&tenant.Tenant{Region: region, Tier: tier}), nil
+	}
+}
+
+func listing(region *tenant.Region, tier *tenant.Tier) authz.ScopeResolver {
+	return func(context.Context) (authz.Scope, error) { return authz.ListingScope(region, tier), nil }
+}
+
+func ptr[T any](v T) *T { return &v }
+
+func assertErrCode(t *testing.T, err error, code errs.ErrCode) {
+	t.Helper()
+	var appErr *errs.Error
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, code, appErr.Code)
+}
+
+func TestAuthorizer_Authorize(t *testing.T) {
+	a := newAuthorizer(t, testPolicy)
+	migration := func(context.Context) (authz.Scope, error) {
+		return authz.Scope{Regions: []tenant.Region{tenant.RegionEU1, tenant.RegionUS1}}, nil
+	}
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		operation string
+		resolve   authz.ScopeResolver
+		wantCode  *errs.ErrCode
+	}{
+		{name: "viewer reads", ctx: callerWith("viewer"), operation: "GetTenant"},
+		{name: "viewer cannot create", ctx: callerWith("viewer"), operation: "CreateTenant", wantCode: &errs.PermissionDenied},
+		{name: "operator creates", ctx: callerWith("operator"), operation: "CreateTenant"},
+		{name: "operator cannot delete", ctx: callerWith("operator"), operation: "DeleteTenant", wantCode: &errs.PermissionDenied},
+		{name: "admin deletes", ctx: callerWith("admin"), operation: "DeleteTenant"},
+		{name: "any sufficient role", ctx: callerWith("unknown", "viewer", "admin"), operation: "ListAuditLogs"},
+		{name: "no roles", ctx: callerWith(), operation: "GetTenant", wantCode: &errs.PermissionDenied},
+		{name: "unauthenticated", ctx: context.Background(), operation: "GetTenant", wantCode: &errs.Unauthenticated},
+		{name: "unknown operation", ctx: callerWith("admin"), operation: "DropDatabase", wantCode: &errs.PermissionDenied},
+		{
+			name: "restricted operator within region", ctx: callerWith("eu-operator"), operation: "SuspendTenant",
+			resolve: scopeOf(tenant.RegionEU2, tenant.TierPro),
+		},
+		{
+			name: "restricted operator outside region", ctx: callerWith("eu-operator"), operation: "SuspendTenant",
+			resolve: scopeOf(tenant.RegionUS1, tenant.TierPro), wantCode: &errs.PermissionDenied,
+		},
+		{
+			name: "migration out of allowed regions", ctx: callerWith("eu-operator"), operation: "MigrateTenant",
+			resolve: migration, wantCode: &errs.PermissionDenied,
+		},
+		{
+			name: "any covering grant", ctx: callerWith("eu-operator", "free-support"), operation: "SuspendTenant",
+			resolve: scopeOf(tenant.RegionUS1, tenant.TierFree),
+		},
+		{name: "restricted grant lists", ctx: callerWith("eu-operator"), operation: "ListIsolationGroups"},
+		{
+			name: "restricted grant lists its tenants", ctx: callerWith("eu-operator"), operation: "ListTenants",
+			resolve: listing(ptr(tenant.RegionEU1), nil),
+		},
+		{
+			name: "restricted grant lists every tenant", ctx: callerWith("eu-operator"), operation: "ListTenants",
+			resolve: listing(nil, nil), wantCode: &errs.PermissionDenied,
+		},
+		{
+			name: "restricted grant lists tenants elsewhere", ctx: callerWith("eu-operator"), operation: "ListTenants",
+			resolve: listing(ptr(tenant.RegionUS1), nil), wantCode: &errs.PermissionDenied,
+		},
+		{
+			name: "tier grant lists every region", ctx: callerWith("free-support"), operation: "ListOperations",
+			resolve: listing(ptr(tenant.RegionEU1), nil), wantCode: &errs.PermissionDenied,
+		},
+		{
+			name: "tier grant lists its tier", ctx: callerWith("free-support"), operation: "ListOperations",
+			resolve: listing(nil, ptr(tenant.TierFree)),
+		},
+		{name: "unrestricted grant lists every tenant", ctx: callerWith("viewer"), operation: "ListTenants", resolve: listing(nil, nil)},
+		{
+			name: "restricted grant on unscoped change", ctx: callerWith("eu-operator"), operation: "CancelOperation",
+			wantCode: &errs.PermissionDenied,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := a.Authorize(tc.ctx, tc.operation, tc.resolve)
+			if tc.wantCode == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assertErrCode(t, err, *tc.wantCode)
+		})
+	}
+}
+
+func TestAuthorizer_ScopeOnlyResolvedForRestrictedGrants(t *testing.T) {
+	a := newAuthorizer(t, testPolicy)
+	resolveErr := errors.New("db down")
+	failing := func(context.Context) (authz.Scope, error) { return authz.Scope{}, resolveErr }
+
+	assert.NoError(t, a.Authorize(callerWith("operator"), "SuspendTenant", failing))
+	assert.ErrorIs(t, a.Authorize(callerWith("eu-operator"), "SuspendTenant", failing), resolveErr)
+	assertErrCode(t, a.Authorize(callerWith("viewer"), "SuspendTenant", failing), errs.PermissionDenied)
+}
+
+func TestAuthorizer_WatchFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{}`), 0o600))
+	p, err := authz.LoadPolicy(path)
+	require.NoError(t, err)
+	a := authz.NewAuthorizer(p, logger.Noop())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go a.WatchFile(ctx, path, 10*time.Millisecond)
+
+	require.Error(t, a.Authorize(callerWith("operator"), "DeleteTenant", nil))
+
+	// An invalid policy leaves the current one in force.
+	writePolicy(t, path, `{"operations": {"DeleteTenant": "nobody"}}`, time.Now().Add(time.Second))
+	time.Sleep(50 * time.Millisecond)
+	require.Error(t, a.Authorize(callerWith("operator"), "DeleteTenant", nil))
+
+	writePolicy(t, path, `{"operations": {"DeleteTenant": "operator"}}`, time.Now().Add(2*time.Second))
+	assert.Eventually(t, func() bool {
+		return a.Authorize(callerWith("operator"), "DeleteTenant", nil) == nil
+	}, time.Second, 10*time.Millisecond)
+}
+
+// writePolicy replaces the policy file, stamping it with an explicit modification
+// time so that changes are detected regardless of the file system's resolution.
+func writePolicy(t *testing.T, path, policy string, modTime time.Time) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, []byte(policy), 0o600))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
@@ -0,0 +1,219 @@
+// Package authz decides which API operations a caller may perform, based on the
+// roles and scopes in its credentials and an access policy loaded from a file.
+package authz
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+)
+
+// Role is a level of access to the API. Each role includes the access of the
+// roles below it.
+type Role string
+
+const (
+	RoleViewer   Role = "viewer"   // Read-only access
+	RoleOperator Role = "operator" // Tenant lifecycle changes and operation control
+	RoleAdmin    Role = "admin"    // Destructive and fleet-wide changes
+)
+
+// rank orders roles from least to most privileged; unknown roles rank lowest.
+func (r Role) rank() int {
+	switch r {
+	case RoleViewer:
+		return 1
+	case RoleOperator:
+		return 2
+	case RoleAdmin:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// IsValid reports whether r is a known role.
+func (r Role) IsValid() bool { return r.rank() > 0 }
+
+// Includes reports whether r grants at least the access of other.
+func (r Role) Includes(other Role) bool { return r.IsValid() && r.rank() >= other.rank() }
+
+// DefaultOperations is the role required for each operation of the API, keyed by
+// the operation's name in the generated StrictServerInterface. A policy may
+// override individual entries.
+var DefaultOperations = map[string]Role{
+	// Reads.
+	"GetDatabaseNode":       RoleViewer,
+	"GetIsolationGroup":     RoleViewer,
+	"GetOperation":          RoleViewer,
+	"GetTenant":             RoleViewer,
+	"ListDatabaseNodes":     RoleViewer,
+	"ListIsolationGroups":   RoleViewer,
+	"ListOperations":        RoleViewer,
+	"ListTenantOperations":  RoleViewer,
+	"ListTenantResources":   RoleViewer,
+	"ListTenantSuspensions": RoleViewer,
+	"ListTenants":           RoleViewer,
+	"StreamOperationEvents": RoleViewer,
+
+	// Tenant lifecycle and operation control.
+	"CancelOperation":            RoleOperator,
+	"ChangeTenantIsolationGroup": RoleOperator,
+	"ChangeTenantTier":           RoleOperator,
+	"CreateTenant":               RoleOperator,
+	"MigrateTenant":              RoleOperator,
+	"PauseOperation":             RoleOperator,
+	"ResumeOperation":            RoleOperator,
+	"ResumeTenant":               RoleOperator,
+	"RetryOperation":             RoleOperator,
+	"SuspendTenant":              RoleOperator,
+
+	// Destructive, fleet-wide and audit access. Webhooks send event payloads
+	// to external endpoints, so reading their configuration is also restricted.
+	"ActivateDatabaseNode":         RoleAdmin,
+	"CreateIsolationGroup":         RoleAdmin,
+	"CreateWebhookSubscription":    RoleAdmin,
+	"DecommissionDatabaseNode":     RoleAdmin,
+	"DeleteIsolationGroup":         RoleAdmin,
+	"DeleteTenant":                 RoleAdmin,
+	"DeleteWebhookSubscription":    RoleAdmin,
+	"DrainDatabaseNode":            RoleAdmin,
+	"GetWebhookSubscription":       RoleAdmin,
+	"ListAuditLogs":                RoleAdmin,
+	"ListWebhookDeliveries":        RoleAdmin,
+	"ListWebhookSubscriptions":     RoleAdmin,
+	"RedeliverWebhookDelivery":     RoleAdmin,
+	"RegisterDatabaseNode":         RoleAdmin,
+	"StartDatabaseNodeMaintenance": RoleAdmin,
+	"UpdateWebhookSubscription":    RoleAdmin,
+}
+
+// Grant is the access conferred by a role or scope in a caller's credentials.
+type Grant struct {
+	Role Role `json:"role"`
+	// Regions, if set, limits the grant to tenants in these regions.
+	Regions []tenant.Region `json:"regions,omitempty"`
+	// Tiers, if set, limits the grant to tenants on these tiers.
+	Tiers []tenant.Tier `json:"tiers,omitempty"`
+}
+
+// restricted reports whether the grant is limited to some tenants.
+func (g Grant) restricted() bool { return len(g.Regions) > 0 || len(g.Tiers) > 0 }
+
+// covers reports whether every region and tier in the scope is within the
+// grant's restrictions.
+func (g Grant) covers(s Scope) bool {
+	if len(g.Regions) > 0 {
+		if s.AllRegions {
+			return false
+		}
+		for _, r := range s.Regions {
+			if !slices.Contains(g.Regions, r) {
+				return false
+			}
+		}
+	}
+	if len(g.Tiers) > 0 {
+		if s.AllTiers {
+			return false
+		}
+		for _, t := range s.Tiers {
+			if !slices.Contains(g.Tiers, t) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Policy maps the roles and scopes in callers' credentials to grants, and the
+// API's operations to the role they require.
+type Policy struct {
+	// Roles maps role and scope names, as they appear in credentials, to the
+	// access they grant. The names "viewer", "operator" and "admin" grant the
+	// corresponding role without restriction unless the policy redefines them.
+	Roles map[string]Grant `json:"roles"`
+
+	// Operations overrides the role required for individual operations.
+	// Operations not listed require the role given by DefaultOperations.
+	Operations map[string]Role `json:"operations"`
+}
+
+// LoadPolicy reads a policy from a JSON file.
+func LoadPolicy(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read policy file: %w", err)
+	}
+	return ParsePolicy(data)
+}
+
+// ParsePolicy parses and validates a JSON policy, filling in the built-in roles
+// and the default role of every operation it does not override.
+func ParsePolicy(data []byte) (*Policy, error) {
+	var p Policy
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&p); err != nil {
+		return nil, fmt.Errorf("failed to parse policy: %w", err)
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	roles := map[string]Grant{
+		string(RoleViewer):   {Role: RoleViewer},
+		string(RoleOperator): {Role: RoleOperator},
+		string(RoleAdmin):    {Role: RoleAdmin},
+	}
+	maps.Copy(roles, p.Roles)
+	p.Roles = roles
+
+	operations := maps.Clone(DefaultOperations)
+	maps.Copy(operations, p.Operations)
+	p.Operations = operations
+
+	return &p, nil
+}
+
+// validate checks that the policy only refers to known roles, regions, tiers and
+// operations, so that a typo cannot silently widen or narrow access.
+func (p *Policy) validate() error {
+	var errs []error
+	for _, name := range slices.Sorted(maps.Keys(p.Roles)) {
+		g := p.Roles[name]
+		if !g.Role.IsValid() {
+			errs = append(errs, fmt.Errorf("role %q: unknown role %q", name, g.Role))
+		}
+		for _, r := range g.Regions {
+			if !r.IsValid() {
+				errs = append(errs, fmt.Errorf("role %q: %w: %q", name, tenant.ErrInvalidRegion, r))
+			}
+		}
+		for _, t := range g.Tiers {
+			if !t.IsValid() {
+				errs = append(errs, fmt.Errorf("role %q: %w: %q", name, tenant.ErrInvalidTier, t))
+			}
+		}
+	}
+	for _, op := range slices.Sorted(maps.Keys(p.Operations)) {
+		role := p.Operations[op]
+		if _, ok := DefaultOperations[op]; !ok {
+			errs = append(errs, fmt.Errorf("unknown operation %q", op))
+		}
+		if !role.IsValid() {
+			errs = append(errs, fmt.Errorf("operation %q: unknown role %q", op, role))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("invalid policy: %w", err)
+	}
+	return nil
+}
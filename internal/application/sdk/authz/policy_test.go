@@ -0,0 +1,79 @@
+package authz_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ahrav/hoglet-hub/api/v1/server"
+	"github.com/ahrav/hoglet-hub/internal/application/sdk/authz"
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+)
+
+func TestParsePolicy(t *testing.T) {
+	p, err := authz.ParsePolicy([]byte(`{
+		"roles": {
+			"eu-operator": {"role": "operator", "regions": ["eu1", "eu2"]},
+			"viewer": {"role": "viewer", "tiers": ["free"]}
+		},
+		"operations": {"DeleteTenant": "operator"}
+	}`))
+	require.NoError(t, err)
+
+	assert.Equal(t, authz.Grant{Role: authz.RoleOperator, Regions: []tenant.Region{tenant.RegionEU1, tenant.RegionEU2}},
+		p.Roles["eu-operator"])
+	assert.Equal(t, authz.Grant{Role: authz.RoleViewer, Tiers: []tenant.Tier{tenant.TierFree}}, p.Roles["viewer"],
+		"built-in roles can be redefined")
+	assert.Equal(t, authz.Grant{Role: authz.RoleAdmin}, p.Roles["admin"])
+
+	assert.Equal(t, authz.RoleOperator, p.Operations["DeleteTenant"])
+	assert.Equal(t, authz.RoleViewer, p.Operations["GetTenant"])
+	assert.Len(t, p.Operations, len(authz.DefaultOperations))
+}
+
+func TestParsePolicy_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy string
+	}{
+		{name: "not JSON", policy: "{"},
+		{name: "unknown field", policy: `{"rolez": {}}`},
+		{name: "unknown role", policy: `{"roles": {"root": {"role": "superuser"}}}`},
+		{name: "unknown region", policy: `{"roles": {"mars": {"role": "admin", "regions": ["mars1"]}}}`},
+		{name: "unknown tier", policy: `{"roles": {"gold": {"role": "admin", "tiers": ["gold"]}}}`},
+		{name: "unknown operation", policy: `{"operations": {"DeleteEverything": "admin"}}`},
+		{name: "operation with unknown role", policy: `{"operations": {"GetTenant": "anyone"}}`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := authz.ParsePolicy([]byte(tc.policy))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestRole_Includes(t *testing.T) {
+	assert.True(t, authz.RoleAdmin.Includes(authz.RoleOperator))
+	assert.True(t, authz.RoleOperator.Includes(authz.RoleOperator))
+	assert.False(t, authz.RoleViewer.Includes(authz.RoleOperator))
+	assert.False(t, authz.Role("root").Includes(authz.RoleViewer))
+}
+
+// TestDefaultOperations_CoverAPI guards against new API operations being denied to
+// everyone, or stale entries lingering after operations are removed.
+func TestDefaultOperations_CoverAPI(t *testing.T) {
+	api := reflect.TypeFor[server.StrictServerInterface]()
+
+	operations := make(map[string]bool, api.NumMethod())
+	for i := range api.NumMethod() {
+		name := api.Method(i).Name
+		operations[name] = true
+		assert.Contains(t, authz.DefaultOperations, name, "operation %s has no default role", name)
+	}
+	for name := range authz.DefaultOperations {
+		assert.True(t, operations[name], "default role given for unknown operation %s", name)
+	}
+}
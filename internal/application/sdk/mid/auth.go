@@ -0,0 +1,52 @@
+package mid
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/ahrav/hoglet-hub/internal/application/audit"
+	"github.com/ahrav/hoglet-hub/internal/application/sdk/auth"
+	"github.com/ahrav/hoglet-hub/pkg/common/logger"
+)
+
+// AuthenticateHTTP provides a standard HTTP middleware that requires each request
+// to be authenticated. The caller's claims are stored in the request context, and
+// its subject becomes the actor of the request's caller so that audited actions
+// and created records are attributed to it. It must run after CallerHTTP, which
+// it relies on for the client address.
+//
+// Requests that fail authentication are rejected with 401 Unauthorized.
+func AuthenticateHTTP(log *logger.Logger, authenticator auth.Authenticator) HTTPMiddleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			claims, err := authenticator.Authenticate(r)
+			if errors.Is(err, auth.ErrNoCredentials) {
+				writeUnauthorized(w, "missing credentials")
+				return
+			}
+			if err != nil {
+				log.Info(ctx, "rejected credentials", "path", r.URL.Path, "err", err)
+				writeUnauthorized(w, "invalid credentials")
+				return
+			}
+
+			caller := audit.CallerFromContext(ctx)
+			caller.Actor = claims.Subject
+			ctx = audit.WithCaller(auth.WithClaims(ctx, claims), caller)
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// writeUnauthorized responds with 401 Unauthorized in the API's error format.
+// The reason credentials were rejected is logged rather than returned to the client.
+func writeUnauthorized(w http.ResponseWriter, msg string) {
+	w.Header().Set("WWW-Authenticate", `Bearer realm="hoglet-hub"`)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusUnauthorized)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": "unauthorized", "message": msg})
+}
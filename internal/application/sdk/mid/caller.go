@@ -0,0 +1,70 @@
+package mid
+
+import (
+	"net"
+	"net/http"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/ahrav/hoglet-hub/internal/application/audit"
+)
+
+// CallerHTTP provides a standard HTTP middleware that stores the client address of
+// each request in its context, so that audited actions can be traced back to where
+// they came from. AuthenticateHTTP, running after it, fills in the actor.
+//
+// X-Forwarded-For is only honoured on requests from trustedProxies, the networks
+// of the load balancers in front of the service. Without any, the peer address is
+// the client address.
+func CallerHTTP(trustedProxies []netip.Prefix) HTTPMiddleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := audit.WithCaller(r.Context(), audit.Caller{IP: clientIP(r, trustedProxies)})
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// clientIP returns the address of the client that sent the request, or nil if it
+// cannot be determined.
+//
+// Behind the load balancer the peer address is the balancer itself, which appends
+// the address it received the request from to X-Forwarded-For. Each trusted proxy
+// does the same, so the hops are walked from the right and the first one that is
+// not a trusted proxy is the client. Entries left of it are supplied by the client
+// and may be forged. Requests from anywhere else carry no trustworthy header, and
+// neither do ones whose header is malformed, so their peer address is used.
+func clientIP(r *http.Request, trustedProxies []netip.Prefix) *netip.Addr {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	peer, err := netip.ParseAddr(host)
+	if err != nil {
+		return nil
+	}
+	peer = peer.Unmap()
+
+	trusted := func(addr netip.Addr) bool {
+		return slices.ContainsFunc(trustedProxies, func(p netip.Prefix) bool { return p.Contains(addr) })
+	}
+	if !trusted(peer) {
+		return &peer
+	}
+
+	var hops []string
+	for _, forwarded := range r.Header.Values("X-Forwarded-For") {
+		hops = append(hops, strings.Split(forwarded, ",")...)
+	}
+	for _, hop := range slices.Backward(hops) {
+		addr, err := netip.ParseAddr(strings.TrimSpace(hop))
+		if err != nil {
+			break
+		}
+		if addr = addr.Unmap(); !trusted(addr) {
+			return &addr
+		}
+	}
+	return &peer
+}
@@ -0,0 +1,92 @@
+package mid_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ahrav/hoglet-hub/internal/application/audit"
+	"github.com/ahrav/hoglet-hub/internal/application/sdk/mid"
+)
+
+func TestCallerHTTP_ClientIP(t *testing.T) {
+	trustedProxies := []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}
+
+	testCases := []struct {
+		desc           string
+		remoteAddr     string
+		forwardedFor   []string
+		trustedProxies []netip.Prefix
+		expectIP       string
+	}{
+		{
+			desc:       "direct request",
+			remoteAddr: "203.0.113.7:51234", trustedProxies: trustedProxies,
+			expectIP: "203.0.113.7",
+		},
+		{
+			desc:       "spoofed header from an untrusted peer",
+			remoteAddr: "203.0.113.7:51234", forwardedFor: []string{"198.51.100.1"}, trustedProxies: trustedProxies,
+			expectIP: "203.0.113.7",
+		},
+		{
+			desc:       "header ignored without trusted proxies",
+			remoteAddr: "10.0.0.5:51234", forwardedFor: []string{"198.51.100.1"},
+			expectIP: "10.0.0.5",
+		},
+		{
+			desc:       "proxied request",
+			remoteAddr: "10.0.0.5:51234", forwardedFor: []string{"198.51.100.1"}, trustedProxies: trustedProxies,
+			expectIP: "198.51.100.1",
+		},
+		{
+			desc:       "forged hops left of the client are ignored",
+			remoteAddr: "10.0.0.5:51234", forwardedFor: []string{"192.0.2.9, 198.51.100.1"}, trustedProxies: trustedProxies,
+			expectIP: "198.51.100.1",
+		},
+		{
+			desc:       "chain of trusted proxies",
+			remoteAddr: "10.0.0.5:51234", forwardedFor: []string{"192.0.2.9, 198.51.100.1", "10.1.2.3"},
+			trustedProxies: trustedProxies,
+			expectIP:       "198.51.100.1",
+		},
+		{
+			desc:       "malformed hop",
+			remoteAddr: "10.0.0.5:51234", forwardedFor: []string{"198.51.100.1, unknown"}, trustedProxies: trustedProxies,
+			expectIP: "10.0.0.5",
+		},
+		{
+			desc:       "only trusted proxies",
+			remoteAddr: "10.0.0.5:51234", forwardedFor: []string{"10.1.2.3"}, trustedProxies: trustedProxies,
+			expectIP: "10.0.0.5",
+		},
+		{
+			desc:       "IPv4-mapped proxy address",
+			remoteAddr: "[::ffff:10.0.0.5]:51234", forwardedFor: []string{"198.51.100.1"}, trustedProxies: trustedProxies,
+			expectIP: "198.51.100.1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var caller audit.Caller
+			handler := mid.CallerHTTP(tc.trustedProxies)(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				caller = audit.CallerFromContext(r.Context())
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/tenants", nil)
+			req.RemoteAddr = tc.remoteAddr
+			for _, forwarded := range tc.forwardedFor {
+				req.Header.Add("X-Forwarded-For", forwarded)
+			}
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+
+			if assert.NotNil(t, caller.IP) {
+				assert.Equal(t, netip.MustParseAddr(tc.expectIP), *caller.IP)
+			}
+		})
+	}
+}
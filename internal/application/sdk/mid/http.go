@@ -4,6 +4,7 @@ package mid
 import (
 	"context"
 	"net/http"
+	"net/netip"
 	"time"
 
 	"go.opentelemetry.io/otel"
@@ -77,6 +78,23 @@ func (w *statusWriter) Write(b []byte) (int, error) {
 	return w.ResponseWriter.Write(b)
 }
 
+// Flush captures a 200 status if WriteHeader hasn't been called yet and flushes
+// the wrapped ResponseWriter if it supports flushing, so streamed responses
+// reach the client as they are written.
+func (w *statusWriter) Flush() {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap returns the wrapped ResponseWriter for use by http.ResponseController.
+func (w *statusWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
 // LoggerHTTP provides a standard HTTP middleware for request logging. It logs the
 // start and completion of HTTP requests along with important request metadata
 // such as method, path, status code, and duration.
@@ -143,9 +161,16 @@ func OtelHTTP(tracer trace.Tracer) HTTPMiddleware {
 // GetMiddlewareChain returns a complete chain of standard HTTP middleware
 // combining both direct HTTP middleware and converted application middleware.
 // This provides a consistent middleware stack regardless of whether using the
-// web.App framework or standard HTTP handlers.
-func GetMiddlewareChain(log *logger.Logger, tracer trace.Tracer, metrics APIMetrics) []HTTPMiddleware {
+// web.App framework or standard HTTP handlers. X-Forwarded-For is honoured only
+// on requests from trustedProxies.
+func GetMiddlewareChain(
+	log *logger.Logger,
+	tracer trace.Tracer,
+	metrics APIMetrics,
+	trustedProxies []netip.Prefix,
+) []HTTPMiddleware {
 	return []HTTPMiddleware{
+		CallerHTTP(trustedProxies),
 		OtelHTTP(tracer),
 		MetricsMiddleware(metrics),
 		LoggerHTTP(log),
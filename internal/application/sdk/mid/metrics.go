@@ -27,6 +27,18 @@ func (w *MetricsResponseWriter) StatusCode() int {
 	return w.statusCode
 }
 
+// Flush flushes the wrapped ResponseWriter if it supports flushing
+func (w *MetricsResponseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap returns the wrapped ResponseWriter for use by http.ResponseController
+func (w *MetricsResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
 // APIMetrics defines metrics for API operations.
 type APIMetrics interface {
 	// ObserveRequestLatency records the latency of API requests.
@@ -0,0 +1,97 @@
+package mid
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ahrav/hoglet-hub/internal/application/audit"
+	"github.com/ahrav/hoglet-hub/internal/application/sdk/auth"
+	"github.com/ahrav/hoglet-hub/internal/application/security"
+)
+
+// MonitorClientsHTTP provides a standard HTTP middleware that rejects requests
+// from IPs the monitor has blocked and reports failed authentications to it. It
+// must run after CallerHTTP, which supplies the client address, and before
+// AuthenticateHTTP, whose rejections it observes.
+func MonitorClientsHTTP(monitor *security.Monitor) HTTPMiddleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			ip := callerIP(ctx)
+
+			if until, blocked := monitor.IPBlocked(ip); blocked {
+				writeBlocked(w, until)
+				return
+			}
+
+			sw := &statusWriter{ResponseWriter: w}
+			next.ServeHTTP(sw, r)
+
+			if sw.status == http.StatusUnauthorized {
+				monitor.RecordAuthFailure(ctx, ip)
+			}
+		})
+	}
+}
+
+// MonitorActorsHTTP provides a standard HTTP middleware that rejects requests
+// from actors the monitor has blocked and records the activity of the rest. It
+// must run after AuthenticateHTTP so that callers are identified by their
+// subject; unauthenticated callers are identified by their address.
+func MonitorActorsHTTP(monitor *security.Monitor) HTTPMiddleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			ip := callerIP(ctx)
+
+			actorID, actorType := auth.Subject(ctx), security.ActorTypeUser
+			if actorID == "" {
+				actorID, actorType = ip, security.ActorTypeAnonymous
+			}
+
+			if until, blocked := monitor.ActorBlocked(actorID); blocked {
+				writeBlocked(w, until)
+				return
+			}
+			monitor.RecordActivity(ctx, actorID, actorType, ip, activityOf(r.Method))
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// callerIP returns the client address CallerHTTP stored for the request.
+func callerIP(ctx context.Context) string {
+	if ip := audit.CallerFromContext(ctx).IP; ip != nil {
+		return ip.String()
+	}
+	return "unknown"
+}
+
+// activityOf classifies a request by its method.
+func activityOf(method string) security.Activity {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return security.ActivityRead
+	case http.MethodDelete:
+		return security.ActivityDelete
+	default:
+		return security.ActivityWrite
+	}
+}
+
+// writeBlocked responds with 429 Too Many Requests in the API's error format,
+// telling the client when it may try again.
+func writeBlocked(w http.ResponseWriter, until time.Time) {
+	retryAfter := max(int(time.Until(until).Round(time.Second).Seconds()), 1)
+	w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusTooManyRequests)
+	_ = json.NewEncoder(w).Encode(map[string]string{
+		"error":   "temporarily_blocked",
+		"message": "too many suspicious requests; try again later",
+	})
+}
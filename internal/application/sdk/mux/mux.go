@@ -2,12 +2,15 @@ package mux
 
 import (
 	"net/http"
+	"net/netip"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"go.opentelemetry.io/otel/trace"
 
 	operationApp "github.com/ahrav/hoglet-hub/internal/application/operation"
+	"github.com/ahrav/hoglet-hub/internal/application/sdk/auth"
 	"github.com/ahrav/hoglet-hub/internal/application/sdk/mid"
+	"github.com/ahrav/hoglet-hub/internal/application/security"
 	tenantApp "github.com/ahrav/hoglet-hub/internal/application/tenant"
 	"github.com/ahrav/hoglet-hub/pkg/common/logger"
 )
@@ -33,6 +36,19 @@ type Config struct {
 	APIMetrics       mid.APIMetrics
 	TenantService    *tenantApp.Service
 	OperationService *operationApp.Service
+
+	// Authenticator identifies the callers of API requests. If nil, requests are
+	// not authenticated.
+	Authenticator auth.Authenticator
+
+	// SecurityMonitor watches callers for anomalous activity and blocks them if
+	// configured to. If nil, callers are not monitored.
+	SecurityMonitor *security.Monitor
+
+	// TrustedProxies are the networks of the load balancers in front of the API,
+	// whose X-Forwarded-For headers identify the client. If empty, the header is
+	// ignored and the peer address identifies the client.
+	TrustedProxies []netip.Prefix
 }
 
 // healthHandler provides health check endpoints for liveness and readiness probes.
@@ -82,14 +98,30 @@ func WrapWithMiddleware(cfg Config, handler http.Handler, options ...func(opts *
 	}
 
 	// Create a middleware chain using our mid package.
-	chain := mid.GetMiddlewareChain(cfg.Log, cfg.Tracer, cfg.APIMetrics)
+	chain := mid.GetMiddlewareChain(cfg.Log, cfg.Tracer, cfg.APIMetrics, cfg.TrustedProxies)
+
+	// Authentication and monitoring run innermost so that rejected requests are
+	// still traced, measured and logged, and after CallerHTTP so they can attribute
+	// the caller. Clients are monitored outside authentication to observe its
+	// failures, and actors inside it once they have been identified.
+	var access []mid.HTTPMiddleware
+	if cfg.SecurityMonitor != nil {
+		access = append(access, mid.MonitorActorsHTTP(cfg.SecurityMonitor))
+	}
+	if cfg.Authenticator != nil {
+		access = append(access, mid.AuthenticateHTTP(cfg.Log, cfg.Authenticator))
+	}
+	if cfg.SecurityMonitor != nil {
+		access = append(access, mid.MonitorClientsHTTP(cfg.SecurityMonitor))
+	}
+	chain = append(access, chain...)
 
 	if len(opts.corsOrigin) > 0 {
 		chain = append(chain, func(next http.Handler) http.Handler {
 			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				if r.Method == http.MethodOptions {
-					w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-					w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+					w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+					w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, Idempotency-Key")
 					w.Header().Set("Access-Control-Max-Age", "86400")
 
 					origin := r.Header.Get("Origin")
@@ -0,0 +1,251 @@
+package security
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ahrav/hoglet-hub/pkg/common/logger"
+)
+
+// Activity classifies what a request did.
+type Activity string
+
+const (
+	ActivityRead   Activity = "read"
+	ActivityWrite  Activity = "write"
+	ActivityDelete Activity = "delete"
+)
+
+// Actor types recorded with activity.
+const (
+	ActorTypeUser      = "user"      // Authenticated caller, identified by subject
+	ActorTypeAnonymous = "anonymous" // Unauthenticated caller, identified by IP
+)
+
+// Anomaly types reported by the monitor.
+const (
+	AnomalyDeleteBurst      = "delete_burst"
+	AnomalyAuthFailureBurst = "auth_failure_burst"
+)
+
+// Severities reported with anomalies.
+const (
+	SeverityLow    = 1
+	SeverityMedium = 2
+	SeverityHigh   = 3
+)
+
+// EventAttr is the log attribute naming the anomaly type of security events, so
+// that logger event hooks can pick them out.
+const EventAttr = "security_event"
+
+// Config tunes anomaly detection.
+type Config struct {
+	// An actor issuing DeleteThreshold deletes within DeleteWindow is anomalous.
+	DeleteThreshold int
+	DeleteWindow    time.Duration
+
+	// An IP failing authentication AuthFailureThreshold times within
+	// AuthFailureWindow is anomalous.
+	AuthFailureThreshold int
+	AuthFailureWindow    time.Duration
+
+	// BlockDuration is how long offending actors and IPs are blocked for.
+	// Zero disables blocking; anomalies are then only reported.
+	BlockDuration time.Duration
+}
+
+// DefaultConfig reports bursts without blocking anyone.
+var DefaultConfig = Config{
+	DeleteThreshold:      10,
+	DeleteWindow:         time.Minute,
+	AuthFailureThreshold: 20,
+	AuthFailureWindow:    time.Minute,
+}
+
+// Monitor records the activity of API callers, detects anomalous bursts of it
+// and, if configured to, temporarily blocks the callers responsible. Anomalies are
+// recorded in the security metrics and logged at warn level with EventAttr set,
+// which surfaces them through the logger's Warn event.
+type Monitor struct {
+	cfg     Config
+	metrics SecurityMetrics
+	logger  *logger.Logger
+
+	mu           sync.Mutex
+	deletes      burstTracker // Keyed by actor
+	authFailures burstTracker // Keyed by IP
+	blockedActor map[string]time.Time
+	blockedIP    map[string]time.Time
+	lastSweep    time.Time
+}
+
+// NewMonitor creates a new Monitor.
+func NewMonitor(cfg Config, metrics SecurityMetrics, logger *logger.Logger) *Monitor {
+	return &Monitor{
+		cfg:          cfg,
+		metrics:      metrics,
+		logger:       logger,
+		deletes:      newBurstTracker(cfg.DeleteThreshold, cfg.DeleteWindow),
+		authFailures: newBurstTracker(cfg.AuthFailureThreshold, cfg.AuthFailureWindow),
+		blockedActor: make(map[string]time.Time),
+		blockedIP:    make(map[string]time.Time),
+		lastSweep:    time.Now(),
+	}
+}
+
+// RecordActivity records a request by the actor, reporting a delete burst if the
+// actor has issued too many deletes recently.
+func (m *Monitor) RecordActivity(ctx context.Context, actorID, actorType, ip string, activity Activity) {
+	m.metrics.RecordActorActivity(ctx, actorID, actorType, string(activity))
+	if activity != ActivityDelete {
+		return
+	}
+
+	m.mu.Lock()
+	now := time.Now()
+	m.sweep(now)
+	count, burst := m.deletes.observe(actorID, now)
+	var until time.Time
+	if burst && m.cfg.BlockDuration > 0 {
+		until = now.Add(m.cfg.BlockDuration)
+		m.blockedActor[actorID] = until
+	}
+	m.mu.Unlock()
+
+	if burst {
+		m.report(ctx, AnomalyDeleteBurst, SeverityHigh, ip, until,
+			"actor", actorID, "actor_type", actorType, "deletes", count, "window", m.cfg.DeleteWindow.String())
+	}
+}
+
+// RecordAuthFailure records a failed authentication from the IP, reporting an
+// auth failure burst if the IP has failed too often recently.
+func (m *Monitor) RecordAuthFailure(ctx context.Context, ip string) {
+	m.mu.Lock()
+	now := time.Now()
+	m.sweep(now)
+	count, burst := m.authFailures.observe(ip, now)
+	var until time.Time
+	if burst && m.cfg.BlockDuration > 0 {
+		until = now.Add(m.cfg.BlockDuration)
+		m.blockedIP[ip] = until
+	}
+	m.mu.Unlock()
+
+	if burst {
+		m.report(ctx, AnomalyAuthFailureBurst, SeverityMedium, ip, until,
+			"failures", count, "window", m.cfg.AuthFailureWindow.String())
+	}
+}
+
+// ActorBlocked reports whether the actor is blocked, and if so until when.
+func (m *Monitor) ActorBlocked(actorID string) (time.Time, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return blockedUntil(m.blockedActor, actorID, time.Now())
+}
+
+// IPBlocked reports whether the IP is blocked, and if so until when.
+func (m *Monitor) IPBlocked(ip string) (time.Time, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return blockedUntil(m.blockedIP, ip, time.Now())
+}
+
+// report emits an anomaly through the metrics and the logger.
+func (m *Monitor) report(
+	ctx context.Context,
+	anomaly string,
+	severity int,
+	ip string,
+	blockedUntil time.Time,
+	args ...any,
+) {
+	m.metrics.RecordIPAnomaly(ctx, ip, anomaly, severity)
+
+	args = append(args, EventAttr, anomaly, "severity", severity, "ip", ip)
+	if !blockedUntil.IsZero() {
+		args = append(args, "blocked_until", blockedUntil.UTC().Format(time.RFC3339))
+	}
+	m.logger.Warn(ctx, "security anomaly detected", args...)
+}
+
+// sweep drops expired blocks and idle burst windows so that memory does not grow
+// with every caller ever seen. It runs at most once a minute. Callers must hold mu.
+func (m *Monitor) sweep(now time.Time) {
+	if now.Sub(m.lastSweep) < time.Minute {
+		return
+	}
+	m.lastSweep = now
+
+	m.deletes.prune(now)
+	m.authFailures.prune(now)
+	for key, until := range m.blockedActor {
+		if !now.Before(until) {
+			delete(m.blockedActor, key)
+		}
+	}
+	for key, until := range m.blockedIP {
+		if !now.Before(until) {
+			delete(m.blockedIP, key)
+		}
+	}
+}
+
+// blockedUntil looks up an unexpired block.
+func blockedUntil(blocks map[string]time.Time, key string, now time.Time) (time.Time, bool) {
+	until, ok := blocks[key]
+	if !ok || !now.Before(until) {
+		return time.Time{}, false
+	}
+	return until, true
+}
+
+// burstTracker counts events per key over a sliding window.
+type burstTracker struct {
+	threshold int
+	window    time.Duration
+	events    map[string][]time.Time
+}
+
+func newBurstTracker(threshold int, window time.Duration) burstTracker {
+	return burstTracker{threshold: threshold, window: window, events: make(map[string][]time.Time)}
+}
+
+// observe records an event for the key and returns the number of events within
+// the window. Reaching the threshold is a burst, after which counting starts
+// over so that a sustained burst is reported once per threshold events rather
+// than on every event.
+func (t *burstTracker) observe(key string, now time.Time) (int, bool) {
+	events := append(t.recent(key, now), now)
+	if t.threshold > 0 && len(events) >= t.threshold {
+		delete(t.events, key)
+		return len(events), true
+	}
+	t.events[key] = events
+	return len(events), false
+}
+
+// recent returns the key's events that are still within the window.
+func (t *burstTracker) recent(key string, now time.Time) []time.Time {
+	events := t.events[key]
+	cutoff := now.Add(-t.window)
+	i := 0
+	for i < len(events) && !events[i].After(cutoff) {
+		i++
+	}
+	return events[i:]
+}
+
+// prune drops keys without events in the window.
+func (t *burstTracker) prune(now time.Time) {
+	for key := range t.events {
+		if recent := t.recent(key, now); len(recent) == 0 {
+			delete(t.events, key)
+		} else {
+			t.events[key] = recent
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package security_test
+
+import (
+	"context"
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ahrav/hoglet-hub/internal/application/security"
+	"github.com/ahrav/hoglet-hub/pkg/common/logger"
+)
+
+// MockSecurityMetrics is a testify mock for security.SecurityMetrics.
+type MockSecurityMetrics struct{ mock.Mock }
+
+func (m *MockSecurityMetrics) RecordActorActivity(ctx context.Context, actorID, actorType, activity string) {
+	m.Called(ctx, actorID, actorType, activity)
+}
+
+func (m *MockSecurityMetrics) RecordIPAnomaly(ctx context.Context, ip, anomalyType string, severity int) {
+	m.Called(ctx, ip, anomalyType, severity)
+}
+
+func newMockMetrics() *MockSecurityMetrics {
+	m := new(MockSecurityMetrics)
+	m.On("RecordActorActivity", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	m.On("RecordIPAnomaly", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	return m
+}
+
+var testConfig = security.Config{
+	DeleteThreshold:      3,
+	DeleteWindow:         time.Minute,
+	AuthFailureThreshold: 3,
+	AuthFailureWindow:    time.Minute,
+}
+
+func TestMonitor_DeleteBurst(t *testing.T) {
+	synctest.Run(func() {
+		ctx := context.Background()
+		metrics := newMockMetrics()
+		m := security.NewMonitor(testConfig, metrics, logger.Noop())
+
+		for range 5 {
+			m.RecordActivity(ctx, "alice", security.ActorTypeUser, "10.0.0.1", security.ActivityRead)
+		}
+		m.RecordActivity(ctx, "alice", security.ActorTypeUser, "10.0.0.1", security.ActivityDelete)
+		m.RecordActivity(ctx, "alice", security.ActorTypeUser, "10.0.0.1", security.ActivityDelete)
+		m.RecordActivity(ctx, "bob", security.ActorTypeUser, "10.0.0.2", security.ActivityDelete)
+		metrics.AssertNotCalled(t, "RecordIPAnomaly", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+		m.RecordActivity(ctx, "alice", security.ActorTypeUser, "10.0.0.1", security.ActivityDelete)
+		metrics.AssertCalled(t, "RecordIPAnomaly", mock.Anything, "10.0.0.1", security.AnomalyDeleteBurst, security.SeverityHigh)
+		metrics.AssertCalled(t, "RecordActorActivity", mock.Anything, "alice", security.ActorTypeUser, "delete")
+		metrics.AssertNumberOfCalls(t, "RecordActorActivity", 9)
+
+		_, blocked := m.ActorBlocked("alice")
+		assert.False(t, blocked, "blocking is disabled")
+	})
+}
+
+func TestMonitor_DeletesOutsideWindow(t *testing.T) {
+	synctest.Run(func() {
+		ctx := context.Background()
+		metrics := newMockMetrics()
+		m := security.NewMonitor(testConfig, metrics, logger.Noop())
+
+		for range 4 {
+			m.RecordActivity(ctx, "alice", security.ActorTypeUser, "10.0.0.1", security.ActivityDelete)
+			time.Sleep(40 * time.Second)
+		}
+		metrics.AssertNotCalled(t, "RecordIPAnomaly", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestMonitor_AuthFailureBurstBlocksIP(t *testing.T) {
+	synctest.Run(func() {
+		ctx := context.Background()
+		metrics := newMockMetrics()
+		cfg := testConfig
+		cfg.BlockDuration = 5 * time.Minute
+		m := security.NewMonitor(cfg, metrics, logger.Noop())
+
+		for range 3 {
+			m.RecordAuthFailure(ctx, "10.0.0.9")
+		}
+		metrics.AssertCalled(t, "RecordIPAnomaly", mock.Anything, "10.0.0.9", security.AnomalyAuthFailureBurst, security.SeverityMedium)
+
+		until, blocked := m.IPBlocked("10.0.0.9")
+		assert.True(t, blocked)
+		assert.Equal(t, time.Now().Add(5*time.Minute), until)
+		_, blocked = m.IPBlocked("10.0.0.10")
+		assert.False(t, blocked)
+
+		time.Sleep(5 * time.Minute)
+		_, blocked = m.IPBlocked("10.0.0.9")
+		assert.False(t, blocked, "blocks expire")
+	})
+}
+
+func TestMonitor_DeleteBurstBlocksActor(t *testing.T) {
+	synctest.Run(func() {
+		ctx := context.Background()
+		cfg := testConfig
+		cfg.BlockDuration = time.Minute
+		m := security.NewMonitor(cfg, newMockMetrics(), logger.Noop())
+
+		for range 3 {
+			m.RecordActivity(ctx, "alice", security.ActorTypeUser, "10.0.0.1", security.ActivityDelete)
+		}
+
+		_, blocked := m.ActorBlocked("alice")
+		assert.True(t, blocked)
+		_, blocked = m.IPBlocked("10.0.0.1")
+		assert.False(t, blocked, "only the actor is blocked")
+	})
+}
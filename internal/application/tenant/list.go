@@ -0,0 +1,122 @@
+package tenant
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+	"github.com/ahrav/hoglet-hub/pkg/common/cursor"
+)
+
+// Page size bounds for tenant listings.
+const (
+	DefaultListLimit = 50
+	MaxListLimit     = 200
+)
+
+// ListParams contains parameters for listing tenants.
+// Zero values select the defaults: newest tenants first, DefaultListLimit per page.
+type ListParams struct {
+	Filter tenant.ListFilter
+	SortBy tenant.SortField
+	Order  tenant.SortOrder
+	Cursor string // Opaque token from a previous ListResult; empty for the first page
+	Limit  int
+}
+
+// ListResult is a page of tenants along with the token for the following page.
+type ListResult struct {
+	Tenants    []*tenant.Tenant
+	NextCursor string // Empty when there are no more tenants
+}
+
+// listCursor is the position encoded into a page token. The sort it was issued
+// for is included so a token cannot be replayed against a different ordering.
+type listCursor struct {
+	SortBy    tenant.SortField `json:"sort_by"`
+	Order     tenant.SortOrder `json:"order"`
+	ID        int64            `json:"id"`
+	Name      string           `json:"name"`
+	CreatedAt time.Time        `json:"created_at"`
+}
+
+// List returns a page of tenants matching the filter.
+// It returns tenant.ErrInvalidListParams for unsupported parameters or a cursor
+// that was issued for a different sort.
+func (s *Service) List(ctx context.Context, params ListParams) (*ListResult, error) {
+	ctx, span := s.tracer.Start(ctx, "tenant.List", trace.WithAttributes(
+		attribute.String("sort_by", string(params.SortBy)),
+		attribute.String("order", string(params.Order)),
+		attribute.Int("limit", params.Limit),
+	))
+	defer span.End()
+
+	listParams := tenant.ListParams{
+		Filter: params.Filter,
+		SortBy: params.SortBy,
+		Order:  params.Order,
+		Limit:  params.Limit,
+	}
+	if listParams.SortBy == "" {
+		listParams.SortBy = tenant.SortByCreatedAt
+	}
+	if listParams.Order == "" {
+		listParams.Order = tenant.SortDesc
+	}
+	if listParams.Limit == 0 {
+		listParams.Limit = DefaultListLimit
+	}
+	listParams.Limit = min(listParams.Limit, MaxListLimit)
+
+	if err := listParams.Validate(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "invalid list parameters")
+		return nil, err
+	}
+
+	if params.Cursor != "" {
+		var c listCursor
+		if err := cursor.Decode(params.Cursor, &c); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "invalid cursor")
+			return nil, fmt.Errorf("%w: %w", tenant.ErrInvalidListParams, err)
+		}
+		if c.SortBy != listParams.SortBy || c.Order != listParams.Order {
+			span.SetStatus(codes.Error, "cursor sort mismatch")
+			return nil, fmt.Errorf("%w: cursor was issued for a different sort", tenant.ErrInvalidListParams)
+		}
+		listParams.After = &tenant.Cursor{ID: c.ID, Name: c.Name, CreatedAt: c.CreatedAt}
+	}
+
+	page, err := s.tenantRepo.List(ctx, listParams)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error listing tenants")
+		return nil, fmt.Errorf("failed to list tenants: %w", err)
+	}
+
+	result := &ListResult{Tenants: page.Tenants}
+	if page.Next != nil {
+		result.NextCursor, err = cursor.Encode(listCursor{
+			SortBy:    listParams.SortBy,
+			Order:     listParams.Order,
+			ID:        page.Next.ID,
+			Name:      page.Next.Name,
+			CreatedAt: page.Next.CreatedAt,
+		})
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "error encoding cursor")
+			return nil, err
+		}
+	}
+
+	span.SetAttributes(attribute.Int("tenant_count", len(result.Tenants)))
+	span.SetStatus(codes.Ok, "tenants listed")
+	return result, nil
+}
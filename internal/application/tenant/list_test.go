@@ -0,0 +1,150 @@
+package tenant_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/ahrav/hoglet-hub/internal/application/tenant"
+	tenantDomain "github.com/ahrav/hoglet-hub/internal/domain/tenant"
+	"github.com/ahrav/hoglet-hub/pkg/common/logger"
+)
+
+func newListService(repo *MockTenantRepo) *tenant.Service {
+	return tenant.NewServiceWithWorkflowFactory(
+		repo,
+		new(MockOperationRepo),
+		nil,
+		new(MockStepRepo),
+		new(MockIsolationGroupRepo),
+		nil,
+		new(MockWorkflowFactory),
+		logger.Noop(),
+		noop.NewTracerProvider().Tracer("test"),
+		new(MockProvisioningMetrics),
+	)
+}
+
+func TestServiceList(t *testing.T) {
+	ctx := context.Background()
+	createdAt := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	acme := &tenantDomain.Tenant{ID: 1, Name: "acme", CreatedAt: createdAt}
+	globex := &tenantDomain.Tenant{ID: 2, Name: "globex", CreatedAt: createdAt}
+
+	t.Run("defaults are applied", func(t *testing.T) {
+		repo := new(MockTenantRepo)
+		repo.On("List", mock.Anything, tenantDomain.ListParams{
+			SortBy: tenantDomain.SortByCreatedAt,
+			Order:  tenantDomain.SortDesc,
+			Limit:  tenant.DefaultListLimit,
+		}).Return(&tenantDomain.Page{Tenants: []*tenantDomain.Tenant{acme}}, nil)
+
+		res, err := newListService(repo).List(ctx, tenant.ListParams{})
+		require.NoError(t, err)
+		assert.Equal(t, []*tenantDomain.Tenant{acme}, res.Tenants)
+		assert.Empty(t, res.NextCursor)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("limit is capped", func(t *testing.T) {
+		repo := new(MockTenantRepo)
+		repo.On("List", mock.Anything, mock.MatchedBy(func(p tenantDomain.ListParams) bool {
+			return p.Limit == tenant.MaxListLimit
+		})).Return(&tenantDomain.Page{}, nil)
+
+		_, err := newListService(repo).List(ctx, tenant.ListParams{Limit: tenant.MaxListLimit + 1})
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("next cursor resumes after the last tenant", func(t *testing.T) {
+		repo := new(MockTenantRepo)
+		filter := tenantDomain.ListFilter{NamePrefix: "a"}
+		repo.On("List", mock.Anything, mock.MatchedBy(func(p tenantDomain.ListParams) bool {
+			return p.After == nil
+		})).Return(&tenantDomain.Page{
+			Tenants: []*tenantDomain.Tenant{acme},
+			Next:    tenantDomain.CursorAt(acme),
+		}, nil).Once()
+		repo.On("List", mock.Anything, mock.MatchedBy(func(p tenantDomain.ListParams) bool {
+			return p.After != nil && *p.After == *tenantDomain.CursorAt(acme) && p.Filter == filter
+		})).Return(&tenantDomain.Page{Tenants: []*tenantDomain.Tenant{globex}}, nil).Once()
+
+		svc := newListService(repo)
+		params := tenant.ListParams{Filter: filter, SortBy: tenantDomain.SortByName, Order: tenantDomain.SortAsc, Limit: 1}
+		first, err := svc.List(ctx, params)
+		require.NoError(t, err)
+		require.NotEmpty(t, first.NextCursor)
+
+		params.Cursor = first.NextCursor
+		second, err := svc.List(ctx, params)
+		require.NoError(t, err)
+		assert.Equal(t, []*tenantDomain.Tenant{globex}, second.Tenants)
+		assert.Empty(t, second.NextCursor)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("cursor from a different sort is rejected", func(t *testing.T) {
+		repo := new(MockTenantRepo)
+		repo.On("List", mock.Anything, mock.Anything).Return(&tenantDomain.Page{
+			Tenants: []*tenantDomain.Tenant{acme},
+			Next:    tenantDomain.CursorAt(acme),
+		}, nil).Once()
+
+		svc := newListService(repo)
+		first, err := svc.List(ctx, tenant.ListParams{SortBy: tenantDomain.SortByName, Limit: 1})
+		require.NoError(t, err)
+
+		_, err = svc.List(ctx, tenant.ListParams{Cursor: first.NextCursor})
+		assert.ErrorIs(t, err, tenantDomain.ErrInvalidListParams)
+		repo.AssertExpectations(t)
+	})
+
+	invalid := []struct {
+		desc   string
+		params tenant.ListParams
+	}{
+		{desc: "malformed cursor", params: tenant.ListParams{Cursor: "not-a-cursor"}},
+		{desc: "unknown sort field", params: tenant.ListParams{SortBy: "tier"}},
+		{desc: "unknown sort order", params: tenant.ListParams{Order: "sideways"}},
+		{desc: "negative limit", params: tenant.ListParams{Limit: -1}},
+		{desc: "prefix outside name alphabet", params: tenant.ListParams{
+			Filter: tenantDomain.ListFilter{NamePrefix: "ac%"},
+		}},
+	}
+	for _, tc := range invalid {
+		t.Run(tc.desc, func(t *testing.T) {
+			repo := new(MockTenantRepo)
+			_, err := newListService(repo).List(ctx, tc.params)
+			assert.ErrorIs(t, err, tenantDomain.ErrInvalidListParams)
+			repo.AssertNotCalled(t, "List", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestServiceGet(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("found", func(t *testing.T) {
+		repo := new(MockTenantRepo)
+		existing := &tenantDomain.Tenant{ID: 42, Name: "acme"}
+		repo.On("FindByID", mock.Anything, int64(42)).Return(existing, nil)
+
+		got, err := newListService(repo).Get(ctx, 42)
+		require.NoError(t, err)
+		assert.Equal(t, existing, got)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repo := new(MockTenantRepo)
+		repo.On("FindByID", mock.Anything, int64(42)).Return(nil, tenantDomain.ErrTenantNotFound)
+
+		_, err := newListService(repo).Get(ctx, 42)
+		assert.ErrorIs(t, err, tenantDomain.ErrTenantNotFound)
+	})
+}
@@ -0,0 +1,186 @@
+package tenant
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ahrav/hoglet-hub/internal/application/workflow"
+	"github.com/ahrav/hoglet-hub/internal/domain/operation"
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+	"github.com/ahrav/hoglet-hub/pkg/common/logger"
+)
+
+// DefaultStaleAfter is how long an incomplete operation may go without an update
+// before recovery considers it orphaned. It spans several heartbeat intervals so a
+// workflow that is still running in another process is never claimed.
+const DefaultStaleAfter = 4 * workflow.DefaultHeartbeatInterval
+
+// DefaultRecoveryInterval is how often RunRecovery looks for orphaned operations.
+const DefaultRecoveryInterval = time.Minute
+
+// RecoveryResult summarizes a recovery pass over incomplete operations.
+type RecoveryResult struct {
+	Resumed   int // Operations whose workflows were restarted from their last completed step
+	Completed int // Operations found to have nothing left to do and marked as completed
+	Failed    int // Operations that could not be resumed and were marked as failed
+}
+
+// workflowTypeFor maps a persisted operation type to the workflow that executes it.
+func workflowTypeFor(op operation.Op) (workflow.OperationType, bool) {
+	switch op {
+	case operation.OpTenantCreate:
+		return workflow.OperationTypeCreate, true
+	case operation.OpTenantDelete:
+		return workflow.OperationTypeDelete, true
+	case operation.OpTenantUpgrade:
+		return workflow.OperationTypeUpgrade, true
+	case operation.OpTenantMigrate:
+		return workflow.OperationTypeMigrate, true
+	case operation.OpTenantSuspend:
+		return workflow.OperationTypeSuspend, true
+	case operation.OpTenantResume:
+		return workflow.OperationTypeResume, true
+	default:
+		return "", false
+	}
+}
+
+// RecoverOrphaned resumes every incomplete operation that no live process is executing.
+//
+// An operation is considered orphaned when it is not tracked by this service and has
+// not been updated for staleAfter. Each orphaned operation is claimed atomically so that
+// only one replica recovers it, then its workflow is restarted and skips the steps whose
+// completion was checkpointed. Operations that cannot be resumed (unknown type, missing
+// tenant) are failed so they do not remain in progress forever.
+func (s *Service) RecoverOrphaned(ctx context.Context, staleAfter time.Duration) (*RecoveryResult, error) {
+	logger := logger.NewLoggerContext(s.logger.With("operation_type", "recover"))
+	ctx, span := s.tracer.Start(ctx, "tenant.RecoverOrphaned", trace.WithAttributes(
+		attribute.String("stale_after", staleAfter.String()),
+	))
+	defer span.End()
+
+	ops, err := s.operationRepo.FindIncomplete(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error finding incomplete operations")
+		return nil, fmt.Errorf("error finding incomplete operations: %w", err)
+	}
+
+	result := new(RecoveryResult)
+	staleBefore := time.Now().Add(-staleAfter)
+	for _, op := range ops {
+		s.mu.RLock()
+		_, active := s.activeWorkflows[op.ID]
+		s.mu.RUnlock()
+		if active {
+			continue
+		}
+
+		claimed, err := s.stepRepo.ClaimStale(ctx, op.ID, staleBefore)
+		if err != nil {
+			logger.Error(ctx, "error claiming orphaned operation", "operation_id", op.ID, "error", err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		resumed, err := s.recoverOperation(ctx, op)
+		if err != nil {
+			logger.Error(ctx, "error recovering operation", "operation_id", op.ID, "error", err)
+			continue
+		}
+		switch {
+		case resumed:
+			result.Resumed++
+			logger.Info(ctx, "orphaned operation resumed", "operation_id", op.ID)
+		case op.Status == operation.StatusCompleted:
+			result.Completed++
+			logger.Info(ctx, "orphaned operation completed", "operation_id", op.ID)
+		default:
+			result.Failed++
+			logger.Warn(ctx, "orphaned operation could not be resumed", "operation_id", op.ID)
+		}
+	}
+
+	span.SetAttributes(
+		attribute.Int("resumed_count", result.Resumed),
+		attribute.Int("completed_count", result.Completed),
+		attribute.Int("failed_count", result.Failed),
+	)
+	span.SetStatus(codes.Ok, "recovery complete")
+
+	return result, nil
+}
+
+// recoverOperation restarts the workflow for a claimed operation. It returns false
+// if the operation was settled without resuming it.
+func (s *Service) recoverOperation(ctx context.Context, op *operation.Operation) (bool, error) {
+	ctx, span := s.tracer.Start(ctx, "tenant.recoverOperation", trace.WithAttributes(
+		attribute.Int64("operation_id", op.ID),
+		attribute.String("operation_type", string(op.Type)),
+	))
+	defer span.End()
+
+	opType, ok := workflowTypeFor(op.Type)
+	if !ok || op.TenantID == nil {
+		op.Fail("operation interrupted and cannot be resumed")
+		return false, s.operationRepo.Update(ctx, op)
+	}
+
+	t, err := s.tenantRepo.FindByID(ctx, *op.TenantID)
+	if err != nil && !errors.Is(err, tenant.ErrTenantNotFound) {
+		return false, fmt.Errorf("error finding tenant (%d): %w", *op.TenantID, err)
+	}
+
+	if t == nil {
+		// A deletion that lost its final status update has nothing left to do.
+		if opType == workflow.OperationTypeDelete {
+			op.Complete(map[string]any{"tenant_id": *op.TenantID, "status": string(tenant.StatusDeleted)})
+			return false, s.operationRepo.Update(ctx, op)
+		}
+		op.Fail("operation interrupted and tenant no longer exists")
+		return false, s.operationRepo.Update(ctx, op)
+	}
+
+	p := workflowExecutionParams{
+		OperationType: opType,
+		Tenant:        t,
+		TenantID:      *op.TenantID,
+		Operation:     op,
+	}
+	if err := s.launchWorkflow(ctx, p); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error resuming workflow")
+		return false, err
+	}
+	span.SetStatus(codes.Ok, "workflow resumed")
+
+	return true, nil
+}
+
+// RunRecovery performs a recovery pass immediately and then every interval until
+// ctx is cancelled. Running it at startup picks up operations orphaned by a previous
+// instance, while the periodic passes pick up those orphaned by crashed replicas.
+func (s *Service) RunRecovery(ctx context.Context, interval, staleAfter time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.RecoverOrphaned(ctx, staleAfter); err != nil {
+			s.logger.Error(ctx, "recovery pass failed", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
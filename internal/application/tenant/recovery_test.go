@@ -0,0 +1,125 @@
+package tenant_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/ahrav/hoglet-hub/internal/application/tenant"
+	"github.com/ahrav/hoglet-hub/internal/application/workflow"
+	"github.com/ahrav/hoglet-hub/internal/domain/operation"
+	tenantDomain "github.com/ahrav/hoglet-hub/internal/domain/tenant"
+	"github.com/ahrav/hoglet-hub/pkg/common/logger"
+)
+
+func TestServiceRecoverOrphaned(t *testing.T) {
+	ctx := context.Background()
+	tenantID := int64(42)
+
+	newCreateOp := func(t *testing.T, id int64) *operation.Operation {
+		op, err := operation.NewTenantCreateOperation(tenantID, "acme", "us1", "free", nil)
+		require.NoError(t, err)
+		op.ID = id
+		op.Start()
+		return op
+	}
+	newDeleteOp := func(t *testing.T, id int64) *operation.Operation {
+		op, err := operation.NewTenantDeleteOperation(tenantID)
+		require.NoError(t, err)
+		op.ID = id
+		op.Start()
+		return op
+	}
+
+	testCases := []struct {
+		desc   string
+		setup  func(*testing.T, *MockTenantRepo, *MockOperationRepo, *MockStepRepo, *MockWorkflowFactory)
+		expect tenant.RecoveryResult
+	}{
+		{
+			desc: "claimed operation is resumed",
+			setup: func(t *testing.T, tr *MockTenantRepo, or *MockOperationRepo, sr *MockStepRepo, wf *MockWorkflowFactory) {
+				op := newCreateOp(t, 1)
+				existing := &tenantDomain.Tenant{ID: tenantID, Name: "acme"}
+				or.On("FindIncomplete", mock.Anything).Return([]*operation.Operation{op}, nil)
+				sr.On("ClaimStale", mock.Anything, int64(1), mock.Anything).Return(true, nil)
+				tr.On("FindByID", mock.Anything, tenantID).Return(existing, nil)
+
+				mockWorkflow := NewMockWorkflow()
+				mockWorkflow.TestMode()
+				wf.On("NewWorkflow", workflow.OperationTypeCreate, existing, tenantID, op).Return(mockWorkflow, nil)
+			},
+			expect: tenant.RecoveryResult{Resumed: 1},
+		},
+		{
+			desc: "operation owned by a live process is skipped",
+			setup: func(t *testing.T, tr *MockTenantRepo, or *MockOperationRepo, sr *MockStepRepo, wf *MockWorkflowFactory) {
+				or.On("FindIncomplete", mock.Anything).Return([]*operation.Operation{newCreateOp(t, 2)}, nil)
+				sr.On("ClaimStale", mock.Anything, int64(2), mock.Anything).Return(false, nil)
+			},
+			expect: tenant.RecoveryResult{},
+		},
+		{
+			desc: "delete of a missing tenant is completed",
+			setup: func(t *testing.T, tr *MockTenantRepo, or *MockOperationRepo, sr *MockStepRepo, wf *MockWorkflowFactory) {
+				op := newDeleteOp(t, 3)
+				or.On("FindIncomplete", mock.Anything).Return([]*operation.Operation{op}, nil)
+				sr.On("ClaimStale", mock.Anything, int64(3), mock.Anything).Return(true, nil)
+				tr.On("FindByID", mock.Anything, tenantID).Return(nil, tenantDomain.ErrTenantNotFound)
+				or.On("Update", mock.Anything, mock.MatchedBy(func(o *operation.Operation) bool {
+					return o.ID == 3 && o.Status == operation.StatusCompleted
+				})).Return(nil)
+			},
+			expect: tenant.RecoveryResult{Completed: 1},
+		},
+		{
+			desc: "create of a missing tenant is failed",
+			setup: func(t *testing.T, tr *MockTenantRepo, or *MockOperationRepo, sr *MockStepRepo, wf *MockWorkflowFactory) {
+				op := newCreateOp(t, 4)
+				or.On("FindIncomplete", mock.Anything).Return([]*operation.Operation{op}, nil)
+				sr.On("ClaimStale", mock.Anything, int64(4), mock.Anything).Return(true, nil)
+				tr.On("FindByID", mock.Anything, tenantID).Return(nil, tenantDomain.ErrTenantNotFound)
+				or.On("Update", mock.Anything, mock.MatchedBy(func(o *operation.Operation) bool {
+					return o.ID == 4 && o.Status == operation.StatusFailed
+				})).Return(nil)
+			},
+			expect: tenant.RecoveryResult{Failed: 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mockTenantRepo := new(MockTenantRepo)
+			mockOperationRepo := new(MockOperationRepo)
+			mockStepRepo := new(MockStepRepo)
+			mockWorkflowFactory := new(MockWorkflowFactory)
+			tc.setup(t, mockTenantRepo, mockOperationRepo, mockStepRepo, mockWorkflowFactory)
+
+			svc := tenant.NewServiceWithWorkflowFactory(
+				mockTenantRepo,
+				mockOperationRepo,
+				nil,
+				mockStepRepo,
+				new(MockIsolationGroupRepo),
+				nil,
+				mockWorkflowFactory,
+				logger.Noop(),
+				noop.NewTracerProvider().Tracer("test"),
+				new(MockProvisioningMetrics),
+			)
+
+			res, err := svc.RecoverOrphaned(ctx, tenant.DefaultStaleAfter)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expect, *res)
+
+			mockTenantRepo.AssertExpectations(t)
+			mockOperationRepo.AssertExpectations(t)
+			mockStepRepo.AssertExpectations(t)
+			mockWorkflowFactory.AssertExpectations(t)
+		})
+	}
+}
@@ -5,13 +5,17 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 
+	"github.com/ahrav/hoglet-hub/internal/application/sdk/auth"
 	"github.com/ahrav/hoglet-hub/internal/application/workflow"
+	"github.com/ahrav/hoglet-hub/internal/domain/isolationgroup"
 	"github.com/ahrav/hoglet-hub/internal/domain/operation"
+	"github.com/ahrav/hoglet-hub/internal/domain/resource"
 	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
 	"github.com/ahrav/hoglet-hub/pkg/common/logger"
 )
@@ -33,6 +37,23 @@ type OperationResult struct {
 	TenantID    int64 // Zero value (0) for operations that don't create tenants
 }
 
+// QuotaEnforcer checks and reserves the resource quota that tenants consume.
+type QuotaEnforcer interface {
+	workflow.QuotaReserver
+
+	// SelectProject returns a project in the region with quota left for the
+	// footprint, or an errs.ResourceExhausted error if every project is full.
+	SelectProject(ctx context.Context, region tenant.Region, footprint resource.Footprint) (string, error)
+}
+
+// Transactor runs units of work atomically. The repositories take part in the
+// unit of work when called with the context passed to it.
+type Transactor interface {
+	// WithinTransaction runs fn in a transaction that is committed if fn returns
+	// nil and rolled back otherwise.
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
 // WorkflowFactory creates workflows for tenant operations.
 //
 // This factory pattern provides several important architectural benefits:
@@ -61,6 +82,12 @@ type WorkflowFactory interface {
 type DefaultWorkflowFactory struct {
 	tenantRepo    tenant.Repository
 	operationRepo operation.Repository
+	stepRepo      operation.StepRepository
+	resourceRepo  resource.Repository
+	quotas        workflow.QuotaReserver
+	placer        workflow.NodePlacer
+	auditor       workflow.AuditRecorder
+	transactor    workflow.Transactor
 
 	logger  *logger.Logger
 	tracer  trace.Tracer
@@ -68,9 +95,20 @@ type DefaultWorkflowFactory struct {
 }
 
 // NewDefaultWorkflowFactory creates a new default workflow factory.
+// Workflows record the resources they create in resourceRepo, reserve quota for them
+// with quotas, and the placer assigns created tenants to database nodes. Workflows
+// record the outcome of their operations with auditor. Any of them may be nil to
+// skip that bookkeeping. Writes that belong together are made in a single
+// transaction of transactor, or one after another if it is nil.
 func NewDefaultWorkflowFactory(
 	tenantRepo tenant.Repository,
 	operationRepo operation.Repository,
+	stepRepo operation.StepRepository,
+	resourceRepo resource.Repository,
+	quotas workflow.QuotaReserver,
+	placer workflow.NodePlacer,
+	auditor workflow.AuditRecorder,
+	transactor workflow.Transactor,
 	logger *logger.Logger,
 	tracer trace.Tracer,
 	metrics workflow.ProvisioningMetrics,
@@ -78,6 +116,12 @@ func NewDefaultWorkflowFactory(
 	return &DefaultWorkflowFactory{
 		tenantRepo:    tenantRepo,
 		operationRepo: operationRepo,
+		stepRepo:      stepRepo,
+		resourceRepo:  resourceRepo,
+		quotas:        quotas,
+		placer:        placer,
+		auditor:       auditor,
+		transactor:    transactor,
 		logger:        logger,
 		tracer:        tracer,
 		metrics:       metrics,
@@ -102,6 +146,12 @@ func (f *DefaultWorkflowFactory) NewWorkflow(
 		Operation:     op,
 		TenantRepo:    f.tenantRepo,
 		OperationRepo: f.operationRepo,
+		StepRepo:      f.stepRepo,
+		ResourceRepo:  f.resourceRepo,
+		Quotas:        f.quotas,
+		Placer:        f.placer,
+		Audit:         f.auditor,
+		Transactor:    f.transactor,
 	}
 
 	return workflow.NewTenantOperationWorkflow(cfg, f.logger, f.tracer, f.metrics)
@@ -115,10 +165,14 @@ func (f *DefaultWorkflowFactory) NewWorkflow(
 type Service struct {
 	tenantRepo    tenant.Repository
 	operationRepo operation.Repository
+	transactor    Transactor
+	stepRepo      operation.StepRepository
+	groupRepo     isolationgroup.Repository
+	quotas        QuotaEnforcer
 
 	// Track active workflows for monitoring and management.
 	mu              sync.RWMutex
-	activeWorkflows map[int64]workflow.Workflow
+	activeWorkflows map[int64]*activeWorkflow
 	workflowFactory WorkflowFactory
 
 	logger  *logger.Logger
@@ -126,20 +180,54 @@ type Service struct {
 	metrics workflow.ProvisioningMetrics
 }
 
+// activeWorkflow is a workflow running in this process along with the means to cancel it.
+type activeWorkflow struct {
+	workflow workflow.Workflow
+	cancel   context.CancelCauseFunc
+}
+
 // NewService creates a new tenant service with the required repositories.
 // It initializes the workflow tracking map needed for asynchronous operations.
+// A tenant and the operation creating it are persisted in a single transaction
+// of transactor, or without one if it is nil, and workflows use it likewise.
+// Tenants are only created when quotas has room for them, unless it is nil. The
+// resource ledger, quotas, placer, and auditor are passed to the workflows; see
+// NewDefaultWorkflowFactory.
 func NewService(
 	tenantRepo tenant.Repository,
 	operationRepo operation.Repository,
+	transactor Transactor,
+	stepRepo operation.StepRepository,
+	groupRepo isolationgroup.Repository,
+	resourceRepo resource.Repository,
+	quotas QuotaEnforcer,
+	placer workflow.NodePlacer,
+	auditor workflow.AuditRecorder,
 	logger *logger.Logger,
 	tracer trace.Tracer,
 	metrics workflow.ProvisioningMetrics,
 ) *Service {
-	factory := NewDefaultWorkflowFactory(tenantRepo, operationRepo, logger, tracer, metrics)
+	factory := NewDefaultWorkflowFactory(
+		tenantRepo,
+		operationRepo,
+		stepRepo,
+		resourceRepo,
+		quotas,
+		placer,
+		auditor,
+		transactor,
+		logger,
+		tracer,
+		metrics,
+	)
 	return &Service{
 		tenantRepo:      tenantRepo,
 		operationRepo:   operationRepo,
-		activeWorkflows: make(map[int64]workflow.Workflow),
+		transactor:      transactor,
+		stepRepo:        stepRepo,
+		groupRepo:       groupRepo,
+		quotas:          quotas,
+		activeWorkflows: make(map[int64]*activeWorkflow),
 		workflowFactory: factory,
 		logger:          logger.With("component", "tenant_service"),
 		tracer:          tracer,
@@ -154,6 +242,10 @@ func NewService(
 func NewServiceWithWorkflowFactory(
 	tenantRepo tenant.Repository,
 	operationRepo operation.Repository,
+	transactor Transactor,
+	stepRepo operation.StepRepository,
+	groupRepo isolationgroup.Repository,
+	quotas QuotaEnforcer,
 	workflowFactory WorkflowFactory,
 	logger *logger.Logger,
 	tracer trace.Tracer,
@@ -162,7 +254,11 @@ func NewServiceWithWorkflowFactory(
 	return &Service{
 		tenantRepo:      tenantRepo,
 		operationRepo:   operationRepo,
-		activeWorkflows: make(map[int64]workflow.Workflow),
+		transactor:      transactor,
+		stepRepo:        stepRepo,
+		groupRepo:       groupRepo,
+		quotas:          quotas,
+		activeWorkflows: make(map[int64]*activeWorkflow),
 		workflowFactory: workflowFactory,
 		logger:          logger.With("component", "tenant_service"),
 		tracer:          tracer,
@@ -172,7 +268,13 @@ func NewServiceWithWorkflowFactory(
 
 // Create initiates tenant creation and returns tenant ID and operation information.
 // It performs validation, creates necessary domain entities, and launches an async workflow.
-// TODO: Come back and deal with isolation group ID.
+// A referenced isolation group must exist and be in the tenant's region, and some
+// project in the region must have quota for the tenant's resources.
+//
+// The tenant and its creation operation are persisted atomically, so a failure
+// cannot leave a tenant behind that no operation provisions. Of concurrent
+// requests for the same name, all but one fail with ErrTenantAlreadyExists when
+// the repository rejects the duplicate.
 func (s *Service) Create(ctx context.Context, params CreateParams) (*OperationResult, error) {
 	name, region, tier, isolationGroupID := params.Name, params.Region, params.Tier, params.IsolationGroupID
 	logger := logger.NewLoggerContext(s.logger.With(
@@ -188,6 +290,8 @@ func (s *Service) Create(ctx context.Context, params CreateParams) (*OperationRe
 	))
 	defer span.End()
 
+	// The repository rejects duplicate names when the tenant is persisted; checking
+	// here rejects most of them before a project is selected for the tenant.
 	existingTenant, err := s.tenantRepo.FindByName(ctx, name)
 	if err != nil && !errors.Is(err, tenant.ErrTenantNotFound) {
 		span.RecordError(err)
@@ -207,33 +311,69 @@ func (s *Service) Create(ctx context.Context, params CreateParams) (*OperationRe
 		span.SetStatus(codes.Error, "error creating tenant")
 		return nil, err
 	}
+	newTenant.CreatedBy = auth.Subject(ctx)
 	span.AddEvent("tenant created")
 
-	tenantID, err := s.tenantRepo.Create(ctx, newTenant)
+	if isolationGroupID != nil {
+		if err := s.validateIsolationGroup(ctx, *isolationGroupID, region); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "invalid isolation group")
+			return nil, err
+		}
+		span.AddEvent("isolation group validated")
+	}
+
+	// The workflow makes the actual reservation; checking here rejects tenants that
+	// cannot be provisioned before anything is persisted for them.
+	var projectID string
+	if s.quotas != nil {
+		projectID, err = s.quotas.SelectProject(ctx, region, resource.TenantFootprint)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "error selecting project")
+			return nil, err
+		}
+		span.AddEvent("project selected", trace.WithAttributes(attribute.String("project_id", projectID)))
+	}
+
+	var newOperation *operation.Operation
+	err = s.withinTransaction(ctx, func(ctx context.Context) error {
+		tenantID, err := s.tenantRepo.Create(ctx, newTenant)
+		if err != nil {
+			return fmt.Errorf("failed to persist tenant (%s): %w", name, err)
+		}
+		newTenant.ID = tenantID
+
+		newOperation, err = operation.NewTenantCreateOperation(
+			tenantID,
+			name,
+			string(region),
+			string(tier),
+			isolationGroupID,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create operation for tenant (%s): %w", name, err)
+		}
+		if projectID != "" {
+			newOperation.Parameters["project_id"] = projectID
+		}
+
+		if newOperation.ID, err = s.persistOperation(ctx, newOperation); err != nil {
+			return fmt.Errorf("failed to persist operation for tenant (%s): %w", name, err)
+		}
+		return nil
+	})
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, "error persisting tenant")
-		return nil, fmt.Errorf("failed to persist tenant (%s): %w", name, err)
+		return nil, err
 	}
+	tenantID := newTenant.ID
 	span.SetAttributes(attribute.Int64("tenant_id", tenantID))
 	logger.Add("tenant_id", tenantID)
-	span.AddEvent("tenant persisted")
+	span.AddEvent("tenant and operation persisted")
 	logger.Info(ctx, "tenant created")
 
-	newOperation, err := operation.NewTenantCreateOperation(
-		tenantID,
-		name,
-		string(region),
-		string(tier),
-		isolationGroupID,
-	)
-	if err != nil {
-		span.RecordError(err)
-		span.SetStatus(codes.Error, "error creating operation")
-		return nil, fmt.Errorf("failed to create operation for tenant (%s): %w", name, err)
-	}
-	span.AddEvent("operation created")
-
 	p := workflowExecutionParams{
 		OperationType: workflow.OperationTypeCreate,
 		Tenant:        newTenant,
@@ -244,8 +384,24 @@ func (s *Service) Create(ctx context.Context, params CreateParams) (*OperationRe
 	return s.executeWorkflow(ctx, p, logger)
 }
 
+// validateIsolationGroup checks that the isolation group exists and accepts
+// members from the given region.
+func (s *Service) validateIsolationGroup(ctx context.Context, groupID int64, region tenant.Region) error {
+	group, err := s.groupRepo.FindByID(ctx, groupID)
+	if err != nil {
+		if errors.Is(err, isolationgroup.ErrGroupNotFound) {
+			return err
+		}
+		return fmt.Errorf("error finding isolation group (%d): %w", groupID, err)
+	}
+	return group.ValidateMember(region)
+}
+
 // Delete initiates tenant deletion and returns operation information.
-// It verifies the tenant exists, creates a tracking operation, and launches an async workflow.
+// It claims the tenant, marks it as deleting together with creating a tracking
+// operation, and launches an async workflow. Only one of several concurrent
+// deletions of a tenant is accepted; the rest fail with a TransitionError or
+// tenant.ErrOperationInProgress.
 // TODO: Does this need to be async?
 func (s *Service) Delete(ctx context.Context, tenantID int64) (*OperationResult, error) {
 	logger := logger.NewLoggerContext(s.logger.With("operation_type", "delete", "tenant_id", tenantID))
@@ -254,29 +410,138 @@ func (s *Service) Delete(ctx context.Context, tenantID int64) (*OperationResult,
 	))
 	defer span.End()
 
-	t, err := s.tenantRepo.FindByID(ctx, tenantID)
+	t, newOperation, err := s.claimTenant(ctx, tenantID, func(ctx context.Context, t *tenant.Tenant) (*operation.Operation, error) {
+		// A tenant that is already being deleted, or is still being provisioned, is
+		// owned by its running workflow and must not be deleted a second time.
+		if err := t.ValidateTransition(tenant.StatusDeleting); err != nil {
+			return nil, err
+		}
+
+		op, err := operation.NewTenantDeleteOperation(tenantID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create operation for tenant (%d): %w", tenantID, err)
+		}
+
+		claimed, err := s.tenantRepo.TransitionStatus(
+			ctx,
+			tenantID,
+			tenant.TransitionSources(tenant.StatusDeleting),
+			tenant.StatusDeleting,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to mark tenant (%d) for deletion: %w", tenantID, err)
+		}
+		if !claimed {
+			return nil, s.concurrentTransitionError(ctx, tenantID, tenant.StatusDeleting)
+		}
+		return op, t.MarkForDeletion()
+	})
 	if err != nil {
 		span.RecordError(err)
-		span.SetStatus(codes.Error, "error finding tenant")
-		return nil, fmt.Errorf("error finding tenant (%d): %w", tenantID, err)
+		span.SetStatus(codes.Error, "error marking tenant for deletion")
+		return nil, err
 	}
+	span.AddEvent("tenant marked for deletion")
 
-	if t == nil {
-		span.RecordError(tenant.ErrTenantNotFound)
-		span.SetStatus(codes.Error, "tenant not found")
-		return nil, tenant.ErrTenantNotFound
+	p := workflowExecutionParams{
+		OperationType: workflow.OperationTypeDelete,
+		Tenant:        t,
+		TenantID:      tenantID,
+		Operation:     newOperation,
 	}
 
-	newOperation, err := operation.NewTenantDeleteOperation(tenantID)
+	return s.executeWorkflow(ctx, p, logger)
+}
+
+// claimTenant locks the tenant and persists the operation start creates for it, in
+// one transaction. start runs while the lock is held and sees the tenant as stored,
+// so it validates the request against the tenant's current state. A tenant with an
+// unfinished operation is refused with tenant.ErrOperationInProgress, so of several
+// concurrent requests for operations on a tenant only one is accepted, and
+// workflows never change a tenant underneath each other.
+func (s *Service) claimTenant(
+	ctx context.Context,
+	tenantID int64,
+	start func(ctx context.Context, t *tenant.Tenant) (*operation.Operation, error),
+) (*tenant.Tenant, *operation.Operation, error) {
+	var (
+		t  *tenant.Tenant
+		op *operation.Operation
+	)
+	err := s.withinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if t, err = s.tenantRepo.LockForOperation(ctx, tenantID); err != nil {
+			if errors.Is(err, tenant.ErrTenantNotFound) || errors.Is(err, tenant.ErrOperationInProgress) {
+				return err
+			}
+			return fmt.Errorf("error finding tenant (%d): %w", tenantID, err)
+		}
+
+		if op, err = start(ctx, t); err != nil {
+			return err
+		}
+		if op.ID, err = s.persistOperation(ctx, op); err != nil {
+			return fmt.Errorf("failed to persist operation for tenant (%d): %w", tenantID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return t, op, nil
+}
+
+// concurrentTransitionError explains why a tenant whose status allowed the change
+// when it was read could not be moved to the status after all: a concurrent
+// request changed or deleted it in between.
+func (s *Service) concurrentTransitionError(ctx context.Context, tenantID int64, to tenant.Status) error {
+	current, err := s.tenantRepo.FindByID(ctx, tenantID)
+	if err != nil {
+		if errors.Is(err, tenant.ErrTenantNotFound) {
+			return err
+		}
+		return fmt.Errorf("error finding tenant (%d): %w", tenantID, err)
+	}
+	if err := current.ValidateTransition(to); err != nil {
+		return err
+	}
+	return fmt.Errorf("tenant (%d) changed status concurrently: %w", tenantID, tenant.ErrInvalidTransition)
+}
+
+// ChangeTier initiates a tier change for the tenant and returns operation information.
+// It claims the tenant and validates that the transition is allowed before creating a
+// tracking operation and launching an async workflow that resizes the tenant's
+// resources and quotas.
+func (s *Service) ChangeTier(ctx context.Context, tenantID int64, newTier tenant.Tier) (*OperationResult, error) {
+	logger := logger.NewLoggerContext(s.logger.With("operation_type", "upgrade", "tenant_id", tenantID))
+	ctx, span := s.tracer.Start(ctx, "tenant.ChangeTier", trace.WithAttributes(
+		attribute.Int64("tenant_id", tenantID),
+		attribute.String("tier", string(newTier)),
+	))
+	defer span.End()
+
+	t, newOperation, err := s.claimTenant(ctx, tenantID, func(ctx context.Context, t *tenant.Tenant) (*operation.Operation, error) {
+		if err := t.ValidateTierChange(newTier); err != nil {
+			return nil, err
+		}
+
+		op, err := operation.NewTenantUpgradeOperation(tenantID, string(t.Tier), string(newTier))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create operation for tenant (%d): %w", tenantID, err)
+		}
+		return op, nil
+	})
 	if err != nil {
 		span.RecordError(err)
-		span.SetStatus(codes.Error, "error creating operation")
-		return nil, fmt.Errorf("failed to create operation for tenant (%d): %w", tenantID, err)
+		span.SetStatus(codes.Error, "invalid tier change")
+		return nil, err
 	}
+	logger.Add("from_tier", string(t.Tier))
+	logger.Add("to_tier", string(newTier))
 	span.AddEvent("operation created")
 
 	p := workflowExecutionParams{
-		OperationType: workflow.OperationTypeDelete,
+		OperationType: workflow.OperationTypeUpgrade,
 		Tenant:        t,
 		TenantID:      tenantID,
 		Operation:     newOperation,
@@ -285,6 +550,154 @@ func (s *Service) Delete(ctx context.Context, tenantID int64) (*OperationResult,
 	return s.executeWorkflow(ctx, p, logger)
 }
 
+// Migrate initiates moving the tenant to another region and returns operation information.
+// The migration runs in phases through an async workflow; the tenant stays active in its
+// current region until cut over, and the operation can be paused between phases.
+func (s *Service) Migrate(ctx context.Context, tenantID int64, region tenant.Region) (*OperationResult, error) {
+	logger := logger.NewLoggerContext(s.logger.With("operation_type", "migrate", "tenant_id", tenantID))
+	ctx, span := s.tracer.Start(ctx, "tenant.Migrate", trace.WithAttributes(
+		attribute.Int64("tenant_id", tenantID),
+		attribute.String("region", string(region)),
+	))
+	defer span.End()
+
+	t, newOperation, err := s.claimTenant(ctx, tenantID, func(ctx context.Context, t *tenant.Tenant) (*operation.Operation, error) {
+		if err := t.ValidateMigration(region); err != nil {
+			return nil, err
+		}
+
+		op, err := operation.NewTenantMigrateOperation(tenantID, string(t.Region), string(region))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create operation for tenant (%d): %w", tenantID, err)
+		}
+		return op, nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "invalid migration")
+		return nil, err
+	}
+	logger.Add("from_region", string(t.Region))
+	logger.Add("to_region", string(region))
+	span.AddEvent("operation created")
+
+	p := workflowExecutionParams{
+		OperationType: workflow.OperationTypeMigrate,
+		Tenant:        t,
+		TenantID:      tenantID,
+		Operation:     newOperation,
+	}
+
+	return s.executeWorkflow(ctx, p, logger)
+}
+
+// Suspend initiates suspending the tenant for the given reason and returns operation information.
+// Only active tenants can be suspended. The async workflow disables access, scales the
+// tenant's resources down, and opens an entry in the tenant's suspension history.
+func (s *Service) Suspend(ctx context.Context, tenantID int64, reason, actor string) (*OperationResult, error) {
+	logger := logger.NewLoggerContext(s.logger.With("operation_type", "suspend", "tenant_id", tenantID))
+	ctx, span := s.tracer.Start(ctx, "tenant.Suspend", trace.WithAttributes(
+		attribute.Int64("tenant_id", tenantID),
+		attribute.String("actor", actor),
+	))
+	defer span.End()
+
+	t, newOperation, err := s.claimTenant(ctx, tenantID, func(ctx context.Context, t *tenant.Tenant) (*operation.Operation, error) {
+		if err := t.ValidateSuspend(reason); err != nil {
+			return nil, err
+		}
+
+		op, err := operation.NewTenantSuspendOperation(tenantID, reason, actor)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create operation for tenant (%d): %w", tenantID, err)
+		}
+		return op, nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "invalid suspension")
+		return nil, err
+	}
+	logger.Add("reason", reason)
+	logger.Add("actor", actor)
+	span.AddEvent("operation created")
+
+	p := workflowExecutionParams{
+		OperationType: workflow.OperationTypeSuspend,
+		Tenant:        t,
+		TenantID:      tenantID,
+		Operation:     newOperation,
+	}
+
+	return s.executeWorkflow(ctx, p, logger)
+}
+
+// Resume initiates resuming a suspended tenant for the given reason and returns operation
+// information. The async workflow scales the tenant's resources back up, restores access,
+// and closes the open entry in the tenant's suspension history.
+func (s *Service) Resume(ctx context.Context, tenantID int64, reason, actor string) (*OperationResult, error) {
+	logger := logger.NewLoggerContext(s.logger.With("operation_type", "resume", "tenant_id", tenantID))
+	ctx, span := s.tracer.Start(ctx, "tenant.Resume", trace.WithAttributes(
+		attribute.Int64("tenant_id", tenantID),
+		attribute.String("actor", actor),
+	))
+	defer span.End()
+
+	t, newOperation, err := s.claimTenant(ctx, tenantID, func(ctx context.Context, t *tenant.Tenant) (*operation.Operation, error) {
+		if err := t.ValidateResume(reason); err != nil {
+			return nil, err
+		}
+
+		op, err := operation.NewTenantResumeOperation(tenantID, reason, actor)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create operation for tenant (%d): %w", tenantID, err)
+		}
+		return op, nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "invalid resumption")
+		return nil, err
+	}
+	logger.Add("reason", reason)
+	logger.Add("actor", actor)
+	span.AddEvent("operation created")
+
+	p := workflowExecutionParams{
+		OperationType: workflow.OperationTypeResume,
+		Tenant:        t,
+		TenantID:      tenantID,
+		Operation:     newOperation,
+	}
+
+	return s.executeWorkflow(ctx, p, logger)
+}
+
+// Suspensions retrieves the tenant's suspension history, most recent first.
+// Returns tenant.ErrTenantNotFound if the tenant does not exist or has been deleted.
+func (s *Service) Suspensions(ctx context.Context, tenantID int64) ([]*tenant.Suspension, error) {
+	ctx, span := s.tracer.Start(ctx, "tenant.Suspensions", trace.WithAttributes(
+		attribute.Int64("tenant_id", tenantID),
+	))
+	defer span.End()
+
+	if _, err := s.Get(ctx, tenantID); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error finding tenant")
+		return nil, err
+	}
+
+	suspensions, err := s.tenantRepo.FindSuspensions(ctx, tenantID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error finding suspensions")
+		return nil, fmt.Errorf("error finding suspensions for tenant (%d): %w", tenantID, err)
+	}
+
+	span.SetStatus(codes.Ok, "suspensions found")
+	return suspensions, nil
+}
+
 // workflowExecutionParams encapsulates the parameters needed to execute a workflow.
 type workflowExecutionParams struct {
 	OperationType workflow.OperationType
@@ -308,18 +721,58 @@ func (s *Service) executeWorkflow(
 		trace.WithAttributes(attribute.Int64("tenant_id", params.TenantID)))
 	defer span.End()
 
-	operationID, err := s.operationRepo.Create(ctx, params.Operation)
-	if err != nil {
-		span.RecordError(err)
-		span.SetStatus(codes.Error, "error persisting operation")
-		return nil, fmt.Errorf("failed to persist operation for tenant (%d): %w", params.TenantID, err)
+	// Operations persisted together with the tenant they act on only need launching.
+	if params.Operation.ID == 0 {
+		operationID, err := s.persistOperation(ctx, params.Operation)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "error persisting operation")
+			return nil, fmt.Errorf("failed to persist operation for tenant (%d): %w", params.TenantID, err)
+		}
+		params.Operation.ID = operationID
+		span.AddEvent("operation persisted")
 	}
+	operationID := params.Operation.ID
 	logger.Add("operation_id", operationID)
 	span.SetAttributes(attribute.Int64("operation_id", operationID))
-	span.AddEvent("operation persisted")
 	logger.Info(ctx, "operation created")
 
-	params.Operation.ID = operationID
+	if err := s.launchWorkflow(ctx, params); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error creating workflow")
+		return nil, err
+	}
+
+	logger.Info(ctx, "async "+string(params.OperationType)+" workflow started")
+	span.AddEvent("async " + string(params.OperationType) + " workflow started")
+	span.SetStatus(codes.Ok, "tenant "+string(params.OperationType)+" process started")
+
+	return &OperationResult{OperationID: operationID, TenantID: params.TenantID}, nil
+}
+
+// persistOperation persists a new operation, crediting it to the caller, and
+// returns its ID.
+func (s *Service) persistOperation(ctx context.Context, op *operation.Operation) (int64, error) {
+	if subject := auth.Subject(ctx); subject != "" {
+		op.CreatedBy = &subject
+	}
+	return s.operationRepo.Create(ctx, op)
+}
+
+// withinTransaction runs fn in a transaction of the service's transactor, or
+// directly if the service has none.
+func (s *Service) withinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if s.transactor == nil {
+		return fn(ctx)
+	}
+	return s.transactor.WithinTransaction(ctx, fn)
+}
+
+// launchWorkflow creates the workflow for an already persisted operation, registers
+// it as active, and starts it in the background.
+func (s *Service) launchWorkflow(ctx context.Context, params workflowExecutionParams) error {
+	span := trace.SpanFromContext(ctx)
+	operationID := params.Operation.ID
 
 	tenantWorkflow, err := s.workflowFactory.NewWorkflow(
 		params.OperationType,
@@ -328,14 +781,18 @@ func (s *Service) executeWorkflow(
 		params.Operation,
 	)
 	if err != nil {
-		span.RecordError(err)
-		span.SetStatus(codes.Error, "error creating workflow")
-		return nil, fmt.Errorf("failed to create workflow for tenant (%d): %w", params.TenantID, err)
+		return fmt.Errorf("failed to create workflow for tenant (%d): %w", params.TenantID, err)
 	}
 	span.AddEvent(string(params.OperationType) + " workflow created")
 
+	// Create a background context for the async workflow to prevent it from
+	// being canceled when the original request completes. It can still be
+	// cancelled explicitly through CancelOperation.
+	backgroundCtx := trace.ContextWithSpan(context.Background(), span)
+	workflowCtx, cancel := context.WithCancelCause(backgroundCtx)
+
 	s.mu.Lock()
-	s.activeWorkflows[operationID] = tenantWorkflow
+	s.activeWorkflows[operationID] = &activeWorkflow{workflow: tenantWorkflow, cancel: cancel}
 	s.mu.Unlock()
 
 	// Start workflow execution in background.
@@ -343,19 +800,119 @@ func (s *Service) executeWorkflow(
 	// of the API request, ensuring good user experience while potentially lengthy
 	// resource cleanup operations occur. The operation can be monitored through
 	// the operations API.
-	// Create a background context for the async workflow to prevent it from
-	// being canceled when the original request completes.
-	backgroundCtx := trace.ContextWithSpan(context.Background(), span)
-	tenantWorkflow.Start(backgroundCtx)
+	tenantWorkflow.Start(workflowCtx)
 
 	// Set up goroutine to handle workflow completion and cleanup.
 	go s.handleWorkflowCompletion(backgroundCtx, operationID, tenantWorkflow)
 
-	logger.Info(ctx, "async "+string(params.OperationType)+" workflow started")
-	span.AddEvent("async " + string(params.OperationType) + " workflow started")
-	span.SetStatus(codes.Ok, "tenant "+string(params.OperationType)+" process started")
+	return nil
+}
 
-	return &OperationResult{OperationID: operationID, TenantID: params.TenantID}, nil
+// ResumeOperation launches the workflow for an already persisted operation, such as
+// a retry of a failed operation. The workflow skips the steps whose completion is
+// checkpointed for the operation, so it picks up from the first step that did not
+// complete. A tenant whose creation is being retried is moved back to provisioning.
+func (s *Service) ResumeOperation(ctx context.Context, op *operation.Operation) error {
+	logger := logger.NewLoggerContext(s.logger.With(
+		"operation_type", "resume",
+		"operation_id", op.ID,
+	))
+	ctx, span := s.tracer.Start(ctx, "tenant.ResumeOperation", trace.WithAttributes(
+		attribute.Int64("operation_id", op.ID),
+		attribute.String("operation_type", string(op.Type)),
+	))
+	defer span.End()
+
+	opType, ok := workflowTypeFor(op.Type)
+	if !ok || op.TenantID == nil {
+		err := fmt.Errorf("operation (%d) of type %s has no tenant workflow", op.ID, op.Type)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "unsupported operation")
+		return err
+	}
+
+	t, err := s.tenantRepo.FindByID(ctx, *op.TenantID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error finding tenant")
+		return fmt.Errorf("error finding tenant (%d): %w", *op.TenantID, err)
+	}
+	if t == nil {
+		span.RecordError(tenant.ErrTenantNotFound)
+		span.SetStatus(codes.Error, "tenant not found")
+		return tenant.ErrTenantNotFound
+	}
+
+	if opType == workflow.OperationTypeCreate {
+		if err := t.MarkProvisioning(); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "invalid tenant status")
+			return fmt.Errorf("failed to mark tenant (%d) as provisioning: %w", t.ID, err)
+		}
+		if err := s.tenantRepo.Update(ctx, t); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "error updating tenant")
+			return fmt.Errorf("failed to mark tenant (%d) as provisioning: %w", t.ID, err)
+		}
+		span.AddEvent("tenant marked as provisioning")
+	}
+
+	p := workflowExecutionParams{
+		OperationType: opType,
+		Tenant:        t,
+		TenantID:      *op.TenantID,
+		Operation:     op,
+	}
+	if err := s.launchWorkflow(ctx, p); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error creating workflow")
+		return err
+	}
+
+	logger.Info(ctx, "async "+string(opType)+" workflow resumed")
+	span.SetStatus(codes.Ok, "workflow resumed")
+
+	return nil
+}
+
+// Get retrieves a tenant by ID.
+// Returns tenant.ErrTenantNotFound if the tenant does not exist or has been deleted.
+func (s *Service) Get(ctx context.Context, tenantID int64) (*tenant.Tenant, error) {
+	ctx, span := s.tracer.Start(ctx, "tenant.Get", trace.WithAttributes(
+		attribute.Int64("tenant_id", tenantID),
+	))
+	defer span.End()
+
+	t, err := s.tenantRepo.FindByID(ctx, tenantID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error finding tenant")
+		return nil, fmt.Errorf("error finding tenant (%d): %w", tenantID, err)
+	}
+
+	if t == nil {
+		span.SetStatus(codes.Error, "tenant not found")
+		return nil, tenant.ErrTenantNotFound
+	}
+
+	return t, nil
+}
+
+// GetIncludingDeleted retrieves a tenant by ID like Get, but also returns tenants
+// that have been deleted, whose records such as operations outlive them.
+func (s *Service) GetIncludingDeleted(ctx context.Context, tenantID int64) (*tenant.Tenant, error) {
+	ctx, span := s.tracer.Start(ctx, "tenant.GetIncludingDeleted", trace.WithAttributes(
+		attribute.Int64("tenant_id", tenantID),
+	))
+	defer span.End()
+
+	t, err := s.tenantRepo.FindByIDIncludingDeleted(ctx, tenantID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error finding tenant")
+		return nil, fmt.Errorf("error finding tenant (%d): %w", tenantID, err)
+	}
+	return t, nil
 }
 
 // GetOperationStatus retrieves the current status of an operation.
@@ -373,6 +930,176 @@ func (s *Service) GetOperationStatus(ctx context.Context, operationID int64) (*o
 	return op, nil
 }
 
+// CancelOperation requests cancellation of a pending or in-progress operation.
+//
+// If the operation's workflow runs in this process, its context is cancelled with a
+// workflow.CancellationError as the cause. Running steps get a grace period to finish,
+// no further steps start, completed steps are compensated, and the workflow records
+// the operation as cancelled once it winds down. The returned operation therefore
+// still reflects its state at the time of the request.
+//
+// An operation without a workflow in this process is marked cancelled directly,
+// unless its workflow may be running in another replica, which would overwrite the
+// cancelled status; that is refused with operation.ErrOperationRunsElsewhere.
+// TODO: Propagate cancellation to workflows running in other replicas.
+func (s *Service) CancelOperation(
+	ctx context.Context,
+	operationID int64,
+	reason, actor string,
+) (*operation.Operation, error) {
+	logger := logger.NewLoggerContext(s.logger.With(
+		"operation_type", "cancel",
+		"operation_id", operationID,
+		"cancelled_by", actor,
+	))
+	ctx, span := s.tracer.Start(ctx, "tenant.CancelOperation", trace.WithAttributes(
+		attribute.Int64("operation_id", operationID),
+		attribute.String("cancelled_by", actor),
+	))
+	defer span.End()
+
+	op, err := s.operationRepo.FindByID(ctx, operationID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error finding operation")
+		return nil, fmt.Errorf("error retrieving operation (%d): %w", operationID, err)
+	}
+	if op == nil {
+		span.RecordError(operation.ErrOperationNotFound)
+		span.SetStatus(codes.Error, "operation not found")
+		return nil, operation.ErrOperationNotFound
+	}
+	if op.IsTerminal() {
+		span.RecordError(operation.ErrOperationNotCancellable)
+		span.SetStatus(codes.Error, "operation not cancellable")
+		return nil, operation.ErrOperationNotCancellable
+	}
+
+	s.mu.RLock()
+	active, ok := s.activeWorkflows[operationID]
+	s.mu.RUnlock()
+	if ok {
+		active.cancel(&workflow.CancellationError{Reason: reason, Actor: actor})
+		span.AddEvent("workflow cancellation requested")
+		logger.Info(ctx, "workflow cancellation requested", "reason", reason)
+		span.SetStatus(codes.Ok, "cancellation requested")
+		return op, nil
+	}
+	if runsElsewhere(op, time.Now()) {
+		span.RecordError(operation.ErrOperationRunsElsewhere)
+		span.SetStatus(codes.Error, "operation running in another replica")
+		return nil, operation.ErrOperationRunsElsewhere
+	}
+
+	if err := op.CancelBy(reason, actor); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "operation not cancellable")
+		return nil, err
+	}
+	if err := s.operationRepo.Update(ctx, op); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error persisting cancelled operation")
+		return nil, fmt.Errorf("failed to persist cancelled operation (%d): %w", operationID, err)
+	}
+	span.AddEvent("operation cancelled")
+	logger.Info(ctx, "operation cancelled", "reason", reason)
+	span.SetStatus(codes.Ok, "operation cancelled")
+
+	return op, nil
+}
+
+// runsElsewhere reports whether an operation without a workflow in this process may
+// have one in another replica: it is neither paused nor finished, and was updated
+// more recently than recovery considers it orphaned. Running workflows heartbeat,
+// so an operation whose workflow died soon goes stale.
+func runsElsewhere(op *operation.Operation, now time.Time) bool {
+	if op.IsTerminal() || op.IsPaused() {
+		return false
+	}
+	lastUpdate := op.CreatedAt
+	if op.UpdatedAt != nil {
+		lastUpdate = *op.UpdatedAt
+	}
+	return now.Sub(lastUpdate) < DefaultStaleAfter
+}
+
+// PauseOperation requests that an in-progress operation stop between steps.
+//
+// If the operation's workflow runs in this process, it finishes the step it is running,
+// starts no further steps, and records the operation as paused once it stops. Completed
+// steps are left in place so that resuming the operation continues from the next step.
+// The returned operation therefore still reflects its state at the time of the request.
+//
+// An operation without a workflow in this process is marked paused directly, which also
+// keeps recovery from resuming it. Like cancellation, this does not yet reach workflows
+// running in other replicas, so pausing such an operation is refused with
+// operation.ErrOperationRunsElsewhere.
+func (s *Service) PauseOperation(ctx context.Context, operationID int64) (*operation.Operation, error) {
+	logger := logger.NewLoggerContext(s.logger.With(
+		"operation_type", "pause",
+		"operation_id", operationID,
+	))
+	ctx, span := s.tracer.Start(ctx, "tenant.PauseOperation", trace.WithAttributes(
+		attribute.Int64("operation_id", operationID),
+	))
+	defer span.End()
+
+	op, err := s.operationRepo.FindByID(ctx, operationID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error finding operation")
+		return nil, fmt.Errorf("error retrieving operation (%d): %w", operationID, err)
+	}
+	if op == nil {
+		span.RecordError(operation.ErrOperationNotFound)
+		span.SetStatus(codes.Error, "operation not found")
+		return nil, operation.ErrOperationNotFound
+	}
+	if !op.IsPausable() {
+		span.RecordError(operation.ErrOperationNotPausable)
+		span.SetStatus(codes.Error, "operation not pausable")
+		return nil, operation.ErrOperationNotPausable
+	}
+
+	s.mu.RLock()
+	active, ok := s.activeWorkflows[operationID]
+	s.mu.RUnlock()
+	if ok {
+		pauser, ok := active.workflow.(workflow.Pauser)
+		if !ok {
+			span.RecordError(operation.ErrOperationNotPausable)
+			span.SetStatus(codes.Error, "workflow not pausable")
+			return nil, operation.ErrOperationNotPausable
+		}
+		pauser.RequestPause()
+		span.AddEvent("workflow pause requested")
+		logger.Info(ctx, "workflow pause requested")
+		span.SetStatus(codes.Ok, "pause requested")
+		return op, nil
+	}
+	if runsElsewhere(op, time.Now()) {
+		span.RecordError(operation.ErrOperationRunsElsewhere)
+		span.SetStatus(codes.Error, "operation running in another replica")
+		return nil, operation.ErrOperationRunsElsewhere
+	}
+
+	if err := op.Pause(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "operation not pausable")
+		return nil, err
+	}
+	if err := s.operationRepo.Update(ctx, op); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error persisting paused operation")
+		return nil, fmt.Errorf("failed to persist paused operation (%d): %w", operationID, err)
+	}
+	span.AddEvent("operation paused")
+	logger.Info(ctx, "operation paused")
+	span.SetStatus(codes.Ok, "operation paused")
+
+	return op, nil
+}
+
 // handleWorkflowCompletion cleans up workflow resources after completion.
 // This prevents memory leaks by removing references to completed workflows.
 //
@@ -394,7 +1121,10 @@ func (s *Service) handleWorkflowCompletion(ctx context.Context, operationID int6
 	span.AddEvent("workflow completed")
 
 	s.mu.Lock()
-	delete(s.activeWorkflows, operationID)
+	if active, ok := s.activeWorkflows[operationID]; ok {
+		active.cancel(nil)
+		delete(s.activeWorkflows, operationID)
+	}
 	s.mu.Unlock()
 
 	s.logger.Info(ctx, "workflow cleanup complete")
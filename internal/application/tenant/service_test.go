@@ -3,16 +3,24 @@ package tenant_test
 import (
 	"context"
 	"errors"
+	"slices"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/otel/trace/noop"
 
+	"github.com/ahrav/hoglet-hub/internal/application/sdk/auth"
+	"github.com/ahrav/hoglet-hub/internal/application/sdk/errs"
 	"github.com/ahrav/hoglet-hub/internal/application/tenant"
 	"github.com/ahrav/hoglet-hub/internal/application/workflow"
+	"github.com/ahrav/hoglet-hub/internal/domain/isolationgroup"
 	"github.com/ahrav/hoglet-hub/internal/domain/operation"
+	"github.com/ahrav/hoglet-hub/internal/domain/resource"
 	tenantDomain "github.com/ahrav/hoglet-hub/internal/domain/tenant"
 	"github.com/ahrav/hoglet-hub/pkg/common/logger"
 )
@@ -75,6 +83,47 @@ func (m *MockTenantRepo) Update(ctx context.Context, t *tenantDomain.Tenant) err
 	return args.Error(0)
 }
 
+func (m *MockTenantRepo) TransitionStatus(
+	ctx context.Context,
+	id int64,
+	from []tenantDomain.Status,
+	to tenantDomain.Status,
+) (bool, error) {
+	args := m.Called(ctx, id, from, to)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockTenantRepo) ChangeTier(
+	ctx context.Context,
+	id int64,
+	tier tenantDomain.Tier,
+	from []tenantDomain.Status,
+) (bool, error) {
+	args := m.Called(ctx, id, tier, from)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockTenantRepo) ChangeRegion(
+	ctx context.Context,
+	id int64,
+	region tenantDomain.Region,
+	from []tenantDomain.Status,
+) (bool, error) {
+	args := m.Called(ctx, id, region, from)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockTenantRepo) LockForOperation(ctx context.Context, id int64) (*tenantDomain.Tenant, error) {
+	args := m.Called(ctx, id)
+	tenant, _ := args.Get(0).(*tenantDomain.Tenant)
+	return tenant, args.Error(1)
+}
+
+func (m *MockTenantRepo) ChangeIsolationGroup(ctx context.Context, tenant *tenantDomain.Tenant) (bool, error) {
+	args := m.Called(ctx, tenant)
+	return args.Bool(0), args.Error(1)
+}
+
 func (m *MockTenantRepo) FindByName(ctx context.Context, name string) (*tenantDomain.Tenant, error) {
 	args := m.Called(ctx, name)
 	tenant, _ := args.Get(0).(*tenantDomain.Tenant)
@@ -86,6 +135,38 @@ func (m *MockTenantRepo) FindByID(ctx context.Context, id int64) (*tenantDomain.
 	tenant, _ := args.Get(0).(*tenantDomain.Tenant)
 	return tenant, args.Error(1)
 }
+func (m *MockTenantRepo) FindByIDIncludingDeleted(ctx context.Context, id int64) (*tenantDomain.Tenant, error) {
+	args := m.Called(ctx, id)
+	tenant, _ := args.Get(0).(*tenantDomain.Tenant)
+	return tenant, args.Error(1)
+}
+
+func (m *MockTenantRepo) List(ctx context.Context, params tenantDomain.ListParams) (*tenantDomain.Page, error) {
+	args := m.Called(ctx, params)
+	page, _ := args.Get(0).(*tenantDomain.Page)
+	return page, args.Error(1)
+}
+
+func (m *MockTenantRepo) RecordSuspension(ctx context.Context, suspension *tenantDomain.Suspension) error {
+	args := m.Called(ctx, suspension)
+	return args.Error(0)
+}
+
+func (m *MockTenantRepo) EndSuspension(
+	ctx context.Context,
+	tenantID int64,
+	reason, resumedBy string,
+	operationID int64,
+) error {
+	args := m.Called(ctx, tenantID, reason, resumedBy, operationID)
+	return args.Error(0)
+}
+
+func (m *MockTenantRepo) FindSuspensions(ctx context.Context, tenantID int64) ([]*tenantDomain.Suspension, error) {
+	args := m.Called(ctx, tenantID)
+	suspensions, _ := args.Get(0).([]*tenantDomain.Suspension)
+	return suspensions, args.Error(1)
+}
 
 func (m *MockTenantRepo) Delete(ctx context.Context, id int64) error {
 	args := m.Called(ctx, id)
@@ -105,6 +186,15 @@ func (m *MockOperationRepo) Update(ctx context.Context, op *operation.Operation)
 	return args.Error(0)
 }
 
+func (m *MockOperationRepo) TransitionStatus(
+	ctx context.Context,
+	op *operation.Operation,
+	from operation.Status,
+) (bool, error) {
+	args := m.Called(ctx, op, from)
+	return args.Bool(0), args.Error(1)
+}
+
 func (m *MockOperationRepo) FindByID(ctx context.Context, id int64) (*operation.Operation, error) {
 	args := m.Called(ctx, id)
 	op, _ := args.Get(0).(*operation.Operation)
@@ -129,6 +219,84 @@ func (m *MockOperationRepo) FindIncomplete(ctx context.Context) ([]*operation.Op
 	return ops, args.Error(1)
 }
 
+func (m *MockOperationRepo) List(ctx context.Context, params operation.ListParams) (*operation.Page, error) {
+	args := m.Called(ctx, params)
+	page, _ := args.Get(0).(*operation.Page)
+	return page, args.Error(1)
+}
+
+// MockStepRepo is a testify mock for operation.StepRepository.
+type MockStepRepo struct{ mock.Mock }
+
+func (m *MockStepRepo) StartStep(ctx context.Context, step *operation.StepState) error {
+	args := m.Called(ctx, step)
+	return args.Error(0)
+}
+
+func (m *MockStepRepo) FinishStep(ctx context.Context, step *operation.StepState) error {
+	args := m.Called(ctx, step)
+	return args.Error(0)
+}
+
+func (m *MockStepRepo) CompensateStep(ctx context.Context, operationID int64, name string) error {
+	args := m.Called(ctx, operationID, name)
+	return args.Error(0)
+}
+
+func (m *MockStepRepo) FindSteps(ctx context.Context, operationID int64) ([]*operation.StepState, error) {
+	args := m.Called(ctx, operationID)
+	steps, _ := args.Get(0).([]*operation.StepState)
+	return steps, args.Error(1)
+}
+
+func (m *MockStepRepo) CopyCompletedSteps(ctx context.Context, fromOperationID, toOperationID int64) error {
+	args := m.Called(ctx, fromOperationID, toOperationID)
+	return args.Error(0)
+}
+
+func (m *MockStepRepo) Heartbeat(ctx context.Context, operationID int64) error {
+	args := m.Called(ctx, operationID)
+	return args.Error(0)
+}
+
+func (m *MockStepRepo) ClaimStale(ctx context.Context, operationID int64, staleBefore time.Time) (bool, error) {
+	args := m.Called(ctx, operationID, staleBefore)
+	return args.Bool(0), args.Error(1)
+}
+
+// MockIsolationGroupRepo is a testify mock for isolationgroup.Repository.
+type MockIsolationGroupRepo struct{ mock.Mock }
+
+func (m *MockIsolationGroupRepo) Create(ctx context.Context, g *isolationgroup.IsolationGroup) (int64, error) {
+	args := m.Called(ctx, g)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockIsolationGroupRepo) FindByID(ctx context.Context, id int64) (*isolationgroup.IsolationGroup, error) {
+	args := m.Called(ctx, id)
+	group, _ := args.Get(0).(*isolationgroup.IsolationGroup)
+	return group, args.Error(1)
+}
+
+func (m *MockIsolationGroupRepo) List(
+	ctx context.Context,
+	filter isolationgroup.ListFilter,
+) ([]*isolationgroup.IsolationGroup, error) {
+	args := m.Called(ctx, filter)
+	groups, _ := args.Get(0).([]*isolationgroup.IsolationGroup)
+	return groups, args.Error(1)
+}
+
+func (m *MockIsolationGroupRepo) CountTenants(ctx context.Context, id int64) (int64, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockIsolationGroupRepo) Delete(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
 // MockWorkflow is a testify mock implementation of the Workflow interface.
 //
 // While our architectural design uses asynchronous workflows for production,
@@ -145,8 +313,11 @@ type MockWorkflow struct {
 	resultChan chan workflow.WorkflowResult
 }
 
-// Ensure MockWorkflow satisfies workflow.Workflow
-var _ workflow.Workflow = (*MockWorkflow)(nil)
+// Ensure MockWorkflow satisfies workflow.Workflow and workflow.Pauser
+var (
+	_ workflow.Workflow = (*MockWorkflow)(nil)
+	_ workflow.Pauser   = (*MockWorkflow)(nil)
+)
 
 func NewMockWorkflow() *MockWorkflow {
 	return &MockWorkflow{resultChan: make(chan workflow.WorkflowResult, 1)}
@@ -183,6 +354,8 @@ func (m *MockWorkflow) Start(ctx context.Context) {
 
 func (m *MockWorkflow) ResultChan() <-chan workflow.WorkflowResult { return m.resultChan }
 
+func (m *MockWorkflow) RequestPause() { m.Called() }
+
 // Helper method to let the test inject results.
 func (m *MockWorkflow) SendResult(result workflow.WorkflowResult) { m.resultChan <- result }
 
@@ -238,8 +411,9 @@ func (m *MockProvisioningMetrics) ObserveProvisioningStageDuration(
 	tenantTier string,
 	region string,
 	duration time.Duration,
+	attempts int,
 ) {
-	m.Called(ctx, stage, tenantTier, region, duration)
+	m.Called(ctx, stage, tenantTier, region, duration, attempts)
 }
 
 func (m *MockProvisioningMetrics) IncTenantDeletionSuccess(ctx context.Context, tenantTier string, region string) {
@@ -266,11 +440,19 @@ func TestServiceCreate(t *testing.T) {
 		Region: tenantDomain.RegionEU1,
 		Tier:   tenantDomain.TierPro,
 	}
+	groupID := int64(7)
+	groupedParams := tenant.CreateParams{
+		Name:             "my-tenant",
+		Region:           tenantDomain.RegionUS1,
+		Tier:             tenantDomain.TierEnterprise,
+		IsolationGroupID: &groupID,
+	}
 
 	testCases := []struct {
 		desc                string
 		mockTenantRepoFn    func(*MockTenantRepo)
 		mockOperationRepoFn func(*MockOperationRepo)
+		mockGroupRepoFn     func(*MockIsolationGroupRepo)
 		inputParams         tenant.CreateParams
 		expectError         bool
 		expectErrorContains string
@@ -346,6 +528,56 @@ func TestServiceCreate(t *testing.T) {
 			expectTenantID:    123,
 			expectOperationID: 456,
 		},
+		{
+			desc: "isolation group not found",
+			mockTenantRepoFn: func(m *MockTenantRepo) {
+				m.On("FindByName", mock.Anything, "my-tenant").
+					Return((*tenantDomain.Tenant)(nil), tenantDomain.ErrTenantNotFound)
+			},
+			mockOperationRepoFn: func(m *MockOperationRepo) {},
+			mockGroupRepoFn: func(m *MockIsolationGroupRepo) {
+				m.On("FindByID", mock.Anything, int64(7)).Return(nil, isolationgroup.ErrGroupNotFound)
+			},
+			inputParams: groupedParams,
+			expectError: true,
+			expectErrIs: isolationgroup.ErrGroupNotFound,
+		},
+		{
+			desc: "isolation group in another region",
+			mockTenantRepoFn: func(m *MockTenantRepo) {
+				m.On("FindByName", mock.Anything, "my-tenant").
+					Return((*tenantDomain.Tenant)(nil), tenantDomain.ErrTenantNotFound)
+			},
+			mockOperationRepoFn: func(m *MockOperationRepo) {},
+			mockGroupRepoFn: func(m *MockIsolationGroupRepo) {
+				m.On("FindByID", mock.Anything, int64(7)).
+					Return(&isolationgroup.IsolationGroup{ID: 7, Name: "payments", Region: tenantDomain.RegionEU1}, nil)
+			},
+			inputParams: groupedParams,
+			expectError: true,
+			expectErrIs: isolationgroup.ErrRegionMismatch,
+		},
+		{
+			desc: "successful create in isolation group",
+			mockTenantRepoFn: func(m *MockTenantRepo) {
+				m.On("FindByName", mock.Anything, "my-tenant").
+					Return((*tenantDomain.Tenant)(nil), tenantDomain.ErrTenantNotFound)
+				m.On("Create", mock.Anything, mock.MatchedBy(func(t *tenantDomain.Tenant) bool {
+					return t.IsolationGroupID != nil && *t.IsolationGroupID == 7
+				})).Return(int64(123), nil)
+			},
+			mockOperationRepoFn: func(m *MockOperationRepo) {
+				m.On("Create", mock.Anything, mock.AnythingOfType("*operation.Operation")).
+					Return(int64(456), nil)
+			},
+			mockGroupRepoFn: func(m *MockIsolationGroupRepo) {
+				m.On("FindByID", mock.Anything, int64(7)).
+					Return(&isolationgroup.IsolationGroup{ID: 7, Name: "payments", Region: groupedParams.Region}, nil)
+			},
+			inputParams:       groupedParams,
+			expectTenantID:    123,
+			expectOperationID: 456,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -371,6 +603,10 @@ func TestServiceCreate(t *testing.T) {
 
 			tc.mockTenantRepoFn(mockTenantRepo)
 			tc.mockOperationRepoFn(mockOperationRepo)
+			mockGroupRepo := new(MockIsolationGroupRepo)
+			if tc.mockGroupRepoFn != nil {
+				tc.mockGroupRepoFn(mockGroupRepo)
+			}
 
 			logger := logger.Noop()
 			tracer := noop.NewTracerProvider().Tracer("test")
@@ -379,6 +615,10 @@ func TestServiceCreate(t *testing.T) {
 			svc := tenant.NewServiceWithWorkflowFactory(
 				mockTenantRepo,
 				mockOperationRepo,
+				nil,
+				new(MockStepRepo),
+				mockGroupRepo,
+				nil,
 				mockWorkflowFactory,
 				logger,
 				tracer,
@@ -406,12 +646,285 @@ func TestServiceCreate(t *testing.T) {
 
 			mockTenantRepo.AssertExpectations(t)
 			mockOperationRepo.AssertExpectations(t)
+			mockGroupRepo.AssertExpectations(t)
 			mockWorkflowFactory.AssertExpectations(t)
 			mockWorkflow.AssertExpectations(t)
 		})
 	}
 }
 
+// MockQuotaEnforcer is a testify mock for tenant.QuotaEnforcer.
+type MockQuotaEnforcer struct{ mock.Mock }
+
+func (m *MockQuotaEnforcer) SelectProject(
+	ctx context.Context,
+	region tenantDomain.Region,
+	footprint resource.Footprint,
+) (string, error) {
+	args := m.Called(ctx, region, footprint)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockQuotaEnforcer) Reserve(
+	ctx context.Context,
+	preferred string,
+	region tenantDomain.Region,
+	footprint resource.Footprint,
+) (string, error) {
+	args := m.Called(ctx, preferred, region, footprint)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockQuotaEnforcer) Release(
+	ctx context.Context,
+	projectID string,
+	region tenantDomain.Region,
+	footprint resource.Footprint,
+) error {
+	args := m.Called(ctx, projectID, region, footprint)
+	return args.Error(0)
+}
+
+func TestServiceCreate_Quota(t *testing.T) {
+	ctx := context.Background()
+	params := tenant.CreateParams{Name: "my-tenant", Region: tenantDomain.RegionUS1, Tier: tenantDomain.TierPro}
+
+	t.Run("rejects tenants when every project is full", func(t *testing.T) {
+		mockTenantRepo := new(MockTenantRepo)
+		mockTenantRepo.On("FindByName", mock.Anything, "my-tenant").
+			Return((*tenantDomain.Tenant)(nil), tenantDomain.ErrTenantNotFound)
+		quotas := new(MockQuotaEnforcer)
+		quotas.On("SelectProject", mock.Anything, tenantDomain.RegionUS1, resource.TenantFootprint).
+			Return("", errs.Newf(errs.ResourceExhausted, "no project in region us1 has quota"))
+
+		svc := tenant.NewServiceWithWorkflowFactory(
+			mockTenantRepo,
+			new(MockOperationRepo),
+			nil,
+			new(MockStepRepo),
+			new(MockIsolationGroupRepo),
+			quotas,
+			new(MockWorkflowFactory),
+			logger.Noop(),
+			noop.NewTracerProvider().Tracer("test"),
+			new(MockProvisioningMetrics),
+		)
+
+		res, err := svc.Create(ctx, params)
+		var appErr *errs.Error
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, errs.ResourceExhausted, appErr.Code)
+		assert.Nil(t, res)
+		mockTenantRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("records the selected project on the operation", func(t *testing.T) {
+		mockTenantRepo := new(MockTenantRepo)
+		mockTenantRepo.On("FindByName", mock.Anything, "my-tenant").
+			Return((*tenantDomain.Tenant)(nil), tenantDomain.ErrTenantNotFound)
+		mockTenantRepo.On("Create", mock.Anything, mock.AnythingOfType("*tenant.Tenant")).Return(int64(123), nil)
+		mockOperationRepo := new(MockOperationRepo)
+		mockOperationRepo.On("Create", mock.Anything, mock.MatchedBy(func(op *operation.Operation) bool {
+			return op.Parameters["project_id"] == "hoglet-hub-us1-2"
+		})).Return(int64(456), nil)
+		quotas := new(MockQuotaEnforcer)
+		quotas.On("SelectProject", mock.Anything, tenantDomain.RegionUS1, resource.TenantFootprint).
+			Return("hoglet-hub-us1-2", nil)
+		mockWorkflow := NewMockWorkflow()
+		mockWorkflow.TestMode()
+		mockWorkflowFactory := new(MockWorkflowFactory)
+		mockWorkflowFactory.On("NewWorkflow",
+			workflow.OperationTypeCreate,
+			mock.AnythingOfType("*tenant.Tenant"),
+			int64(123),
+			mock.AnythingOfType("*operation.Operation")).
+			Return(mockWorkflow)
+
+		svc := tenant.NewServiceWithWorkflowFactory(
+			mockTenantRepo,
+			mockOperationRepo,
+			nil,
+			new(MockStepRepo),
+			new(MockIsolationGroupRepo),
+			quotas,
+			mockWorkflowFactory,
+			logger.Noop(),
+			noop.NewTracerProvider().Tracer("test"),
+			new(MockProvisioningMetrics),
+		)
+
+		res, err := svc.Create(ctx, params)
+		require.NoError(t, err)
+		assert.EqualValues(t, 456, res.OperationID)
+		mockOperationRepo.AssertExpectations(t)
+		quotas.AssertExpectations(t)
+	})
+}
+
+func TestServiceCreate_CreatedBy(t *testing.T) {
+	subject := "alice@example.com"
+	params := tenant.CreateParams{Name: "my-tenant", Region: tenantDomain.RegionUS1, Tier: tenantDomain.TierPro}
+
+	tests := []struct {
+		desc          string
+		ctx           context.Context
+		wantTenant    string
+		wantOperation *string
+	}{
+		{
+			desc:          "authenticated subject is the creator",
+			ctx:           auth.WithClaims(context.Background(), &auth.Claims{Subject: subject}),
+			wantTenant:    subject,
+			wantOperation: &subject,
+		},
+		{
+			desc: "unauthenticated request leaves the creator to the store",
+			ctx:  context.Background(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			mockTenantRepo := new(MockTenantRepo)
+			mockTenantRepo.On("FindByName", mock.Anything, "my-tenant").
+				Return((*tenantDomain.Tenant)(nil), tenantDomain.ErrTenantNotFound)
+			mockTenantRepo.On("Create", mock.Anything, mock.MatchedBy(func(tn *tenantDomain.Tenant) bool {
+				return tn.CreatedBy == tc.wantTenant
+			})).Return(int64(123), nil)
+			mockOperationRepo := new(MockOperationRepo)
+			mockOperationRepo.On("Create", mock.Anything, mock.MatchedBy(func(op *operation.Operation) bool {
+				return assert.ObjectsAreEqual(tc.wantOperation, op.CreatedBy)
+			})).Return(int64(456), nil)
+			mockWorkflow := NewMockWorkflow()
+			mockWorkflow.TestMode()
+			mockWorkflowFactory := new(MockWorkflowFactory)
+			mockWorkflowFactory.On("NewWorkflow",
+				workflow.OperationTypeCreate,
+				mock.AnythingOfType("*tenant.Tenant"),
+				int64(123),
+				mock.AnythingOfType("*operation.Operation")).
+				Return(mockWorkflow)
+
+			svc := tenant.NewServiceWithWorkflowFactory(
+				mockTenantRepo,
+				mockOperationRepo,
+				nil,
+				new(MockStepRepo),
+				new(MockIsolationGroupRepo),
+				nil,
+				mockWorkflowFactory,
+				logger.Noop(),
+				noop.NewTracerProvider().Tracer("test"),
+				new(MockProvisioningMetrics),
+			)
+
+			_, err := svc.Create(tc.ctx, params)
+			require.NoError(t, err)
+			mockTenantRepo.AssertExpectations(t)
+			mockOperationRepo.AssertExpectations(t)
+		})
+	}
+}
+
+// fakeTransactor runs units of work with a marker in their context, recording
+// whether the last one committed.
+type fakeTransactor struct {
+	committed bool
+}
+
+type txMarker struct{}
+
+func (f *fakeTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	err := fn(context.WithValue(ctx, txMarker{}, true))
+	f.committed = err == nil
+	return err
+}
+
+func inTransaction(ctx context.Context) bool { return ctx.Value(txMarker{}) != nil }
+
+func TestServiceCreate_Transaction(t *testing.T) {
+	params := tenant.CreateParams{Name: "my-tenant", Region: tenantDomain.RegionUS1, Tier: tenantDomain.TierPro}
+
+	tests := []struct {
+		desc               string
+		tenantCreateErr    error
+		operationCreateErr error
+		wantErrIs          error
+		wantCommitted      bool
+	}{
+		{
+			desc:          "tenant and operation are committed together",
+			wantCommitted: true,
+		},
+		{
+			desc:               "failing to persist the operation rolls back the tenant",
+			operationCreateErr: errors.New("op creation error"),
+		},
+		{
+			desc:            "concurrently created duplicate is reported as existing",
+			tenantCreateErr: tenantDomain.ErrTenantAlreadyExists,
+			wantErrIs:       tenantDomain.ErrTenantAlreadyExists,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			mockTenantRepo := new(MockTenantRepo)
+			mockTenantRepo.On("FindByName", mock.Anything, "my-tenant").
+				Return((*tenantDomain.Tenant)(nil), tenantDomain.ErrTenantNotFound)
+			mockTenantRepo.On("Create", mock.MatchedBy(inTransaction), mock.AnythingOfType("*tenant.Tenant")).
+				Return(int64(123), tc.tenantCreateErr)
+			mockOperationRepo := new(MockOperationRepo)
+			if tc.tenantCreateErr == nil {
+				mockOperationRepo.On("Create", mock.MatchedBy(inTransaction), mock.AnythingOfType("*operation.Operation")).
+					Return(int64(456), tc.operationCreateErr)
+			}
+			mockWorkflowFactory := new(MockWorkflowFactory)
+			mockWorkflow := NewMockWorkflow()
+			if tc.wantCommitted {
+				mockWorkflow.TestMode()
+				mockWorkflowFactory.On("NewWorkflow",
+					workflow.OperationTypeCreate,
+					mock.AnythingOfType("*tenant.Tenant"),
+					int64(123),
+					mock.MatchedBy(func(op *operation.Operation) bool { return op.ID == 456 })).
+					Return(mockWorkflow)
+			}
+
+			transactor := new(fakeTransactor)
+			svc := tenant.NewServiceWithWorkflowFactory(
+				mockTenantRepo,
+				mockOperationRepo,
+				transactor,
+				new(MockStepRepo),
+				new(MockIsolationGroupRepo),
+				nil,
+				mockWorkflowFactory,
+				logger.Noop(),
+				noop.NewTracerProvider().Tracer("test"),
+				new(MockProvisioningMetrics),
+			)
+
+			res, err := svc.Create(context.Background(), params)
+			assert.Equal(t, tc.wantCommitted, transactor.committed)
+			if tc.wantCommitted {
+				require.NoError(t, err)
+				assert.Equal(t, int64(123), res.TenantID)
+				assert.Equal(t, int64(456), res.OperationID)
+			} else {
+				assert.Error(t, err)
+				assert.Nil(t, res)
+			}
+			if tc.wantErrIs != nil {
+				assert.ErrorIs(t, err, tc.wantErrIs)
+			}
+			mockTenantRepo.AssertExpectations(t)
+			mockOperationRepo.AssertExpectations(t)
+			mockWorkflowFactory.AssertExpectations(t)
+		})
+	}
+}
+
 func TestServiceDelete(t *testing.T) {
 	ctx := context.Background()
 
@@ -429,7 +942,7 @@ func TestServiceDelete(t *testing.T) {
 			desc:     "error finding tenant",
 			tenantID: 999,
 			mockTenantRepoFn: func(m *MockTenantRepo) {
-				m.On("FindByID", mock.Anything, int64(999)).
+				m.On("LockForOperation", mock.Anything, int64(999)).
 					Return((*tenantDomain.Tenant)(nil), errors.New("DB error"))
 			},
 			mockOperationRepoFn: func(m *MockOperationRepo) {},
@@ -440,8 +953,8 @@ func TestServiceDelete(t *testing.T) {
 			desc:     "tenant not found",
 			tenantID: 999,
 			mockTenantRepoFn: func(m *MockTenantRepo) {
-				m.On("FindByID", mock.Anything, int64(999)).
-					Return((*tenantDomain.Tenant)(nil), nil)
+				m.On("LockForOperation", mock.Anything, int64(999)).
+					Return((*tenantDomain.Tenant)(nil), tenantDomain.ErrTenantNotFound)
 			},
 			mockOperationRepoFn: func(m *MockOperationRepo) {},
 			expectError:         true,
@@ -451,8 +964,10 @@ func TestServiceDelete(t *testing.T) {
 			desc:     "error creating operation",
 			tenantID: 123,
 			mockTenantRepoFn: func(m *MockTenantRepo) {
-				m.On("FindByID", mock.Anything, int64(123)).
-					Return(&tenantDomain.Tenant{ID: 123}, nil)
+				m.On("LockForOperation", mock.Anything, int64(123)).
+					Return(&tenantDomain.Tenant{ID: 123, Status: tenantDomain.StatusActive}, nil)
+				m.On("TransitionStatus", mock.Anything, int64(123), mock.Anything, tenantDomain.StatusDeleting).
+					Return(true, nil)
 			},
 			mockOperationRepoFn: func(m *MockOperationRepo) {
 				m.On("Create", mock.Anything, mock.AnythingOfType("*operation.Operation")).
@@ -462,11 +977,78 @@ func TestServiceDelete(t *testing.T) {
 			expectErrorContains: "failed to persist operation",
 		},
 		{
-			desc:     "successful delete",
+			desc:     "tenant already deleting",
+			tenantID: 123,
+			mockTenantRepoFn: func(m *MockTenantRepo) {
+				m.On("LockForOperation", mock.Anything, int64(123)).
+					Return(&tenantDomain.Tenant{ID: 123, Status: tenantDomain.StatusDeleting}, nil)
+			},
+			mockOperationRepoFn: func(m *MockOperationRepo) {},
+			expectError:         true,
+			expectErrIs:         tenantDomain.ErrInvalidTransition,
+		},
+		{
+			desc:     "tenant claimed by a concurrent deletion",
 			tenantID: 123,
 			mockTenantRepoFn: func(m *MockTenantRepo) {
+				m.On("LockForOperation", mock.Anything, int64(123)).
+					Return(&tenantDomain.Tenant{ID: 123, Status: tenantDomain.StatusActive}, nil)
+				m.On("TransitionStatus", mock.Anything, int64(123), mock.Anything, tenantDomain.StatusDeleting).
+					Return(false, nil)
 				m.On("FindByID", mock.Anything, int64(123)).
-					Return(&tenantDomain.Tenant{ID: 123, Name: "my-tenant"}, nil)
+					Return(&tenantDomain.Tenant{ID: 123, Status: tenantDomain.StatusDeleting}, nil).Once()
+			},
+			mockOperationRepoFn: func(m *MockOperationRepo) {},
+			expectError:         true,
+			expectErrIs:         tenantDomain.ErrInvalidTransition,
+		},
+		{
+			desc:     "error marking tenant for deletion",
+			tenantID: 123,
+			mockTenantRepoFn: func(m *MockTenantRepo) {
+				m.On("LockForOperation", mock.Anything, int64(123)).
+					Return(&tenantDomain.Tenant{ID: 123, Status: tenantDomain.StatusActive}, nil)
+				m.On("TransitionStatus", mock.Anything, int64(123), mock.Anything, tenantDomain.StatusDeleting).
+					Return(false, errors.New("DB error"))
+			},
+			mockOperationRepoFn: func(m *MockOperationRepo) {},
+			expectError:         true,
+			expectErrorContains: "failed to mark tenant (123) for deletion",
+		},
+		{
+			desc:     "another operation in progress",
+			tenantID: 123,
+			mockTenantRepoFn: func(m *MockTenantRepo) {
+				m.On("LockForOperation", mock.Anything, int64(123)).
+					Return((*tenantDomain.Tenant)(nil), tenantDomain.ErrOperationInProgress)
+			},
+			mockOperationRepoFn: func(m *MockOperationRepo) {},
+			expectError:         true,
+			expectErrIs:         tenantDomain.ErrOperationInProgress,
+		},
+		{
+			desc:     "tenant still provisioning",
+			tenantID: 123,
+			mockTenantRepoFn: func(m *MockTenantRepo) {
+				m.On("LockForOperation", mock.Anything, int64(123)).
+					Return(&tenantDomain.Tenant{ID: 123, Status: tenantDomain.StatusProvisioning}, nil)
+			},
+			mockOperationRepoFn: func(m *MockOperationRepo) {},
+			expectError:         true,
+			expectErrIs:         tenantDomain.ErrInvalidTransition,
+		},
+		{
+			desc:     "successful delete",
+			tenantID: 123,
+			mockTenantRepoFn: func(m *MockTenantRepo) {
+				m.On("LockForOperation", mock.Anything, int64(123)).
+					Return(&tenantDomain.Tenant{ID: 123, Name: "my-tenant", Status: tenantDomain.StatusSuspended}, nil)
+				m.On("TransitionStatus",
+					mock.Anything,
+					int64(123),
+					tenantDomain.TransitionSources(tenantDomain.StatusDeleting),
+					tenantDomain.StatusDeleting).
+					Return(true, nil)
 			},
 			mockOperationRepoFn: func(m *MockOperationRepo) {
 				m.On("Create", mock.Anything, mock.AnythingOfType("*operation.Operation")).
@@ -508,6 +1090,10 @@ func TestServiceDelete(t *testing.T) {
 			svc := tenant.NewServiceWithWorkflowFactory(
 				mockTenantRepo,
 				mockOperationRepo,
+				nil,
+				new(MockStepRepo),
+				new(MockIsolationGroupRepo),
+				nil,
 				mockWorkflowFactory,
 				logger,
 				tracer,
@@ -539,12 +1125,656 @@ func TestServiceDelete(t *testing.T) {
 	}
 }
 
-func TestServiceGetOperationStatus(t *testing.T) {
-	ctx := context.Background()
-	tenantID := int64(55)
+// lockingTenantRepo stores a single tenant and emulates the row lock
+// LockForOperation takes: the lock is held until the transaction it was taken
+// in ends, and the tenant is refused while it has an unfinished operation.
+// It is its own transactor, so that it knows when transactions end.
+type lockingTenantRepo struct {
+	*MockTenantRepo
 
-	testCases := []struct {
-		desc                string
+	row        sync.Mutex
+	status     tenantDomain.Status
+	operations atomic.Int32 // Operations persisted for the tenant
+}
+
+type rowLockHeld struct{}
+
+func (r *lockingTenantRepo) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	held := new(bool)
+	err := fn(context.WithValue(ctx, rowLockHeld{}, held))
+	if *held {
+		r.row.Unlock()
+	}
+	return err
+}
+
+func (r *lockingTenantRepo) LockForOperation(ctx context.Context, id int64) (*tenantDomain.Tenant, error) {
+	r.row.Lock()
+	*ctx.Value(rowLockHeld{}).(*bool) = true
+	if r.operations.Load() > 0 {
+		return nil, tenantDomain.ErrOperationInProgress
+	}
+	return &tenantDomain.Tenant{
+		ID:     id,
+		Name:   "my-tenant",
+		Tier:   tenantDomain.TierFree,
+		Region: tenantDomain.RegionUS1,
+		Status: r.status,
+	}, nil
+}
+
+func (r *lockingTenantRepo) TransitionStatus(
+	ctx context.Context,
+	id int64,
+	from []tenantDomain.Status,
+	to tenantDomain.Status,
+) (bool, error) {
+	if !slices.Contains(from, r.status) {
+		return false, nil
+	}
+	r.status = to
+	return true, nil
+}
+
+func TestServiceOperations_Concurrent(t *testing.T) {
+	ctx := context.Background()
+	repo := &lockingTenantRepo{MockTenantRepo: new(MockTenantRepo), status: tenantDomain.StatusActive}
+
+	mockOperationRepo := new(MockOperationRepo)
+	mockOperationRepo.On("Create", mock.Anything, mock.AnythingOfType("*operation.Operation")).
+		Run(func(mock.Arguments) { repo.operations.Add(1) }).
+		Return(int64(456), nil).Once()
+	mockWorkflow := NewMockWorkflow()
+	mockWorkflow.TestMode()
+	mockWorkflowFactory := new(MockWorkflowFactory)
+	mockWorkflowFactory.On("NewWorkflow",
+		mock.Anything,
+		mock.AnythingOfType("*tenant.Tenant"),
+		int64(123),
+		mock.AnythingOfType("*operation.Operation")).
+		Return(mockWorkflow).Once()
+
+	svc := tenant.NewServiceWithWorkflowFactory(
+		repo,
+		mockOperationRepo,
+		repo,
+		new(MockStepRepo),
+		new(MockIsolationGroupRepo),
+		nil,
+		mockWorkflowFactory,
+		logger.Noop(),
+		noop.NewTracerProvider().Tracer("test"),
+		new(MockProvisioningMetrics),
+	)
+
+	requests := []func() (*tenant.OperationResult, error){
+		func() (*tenant.OperationResult, error) { return svc.Delete(ctx, 123) },
+		func() (*tenant.OperationResult, error) { return svc.Delete(ctx, 123) },
+		func() (*tenant.OperationResult, error) { return svc.ChangeTier(ctx, 123, tenantDomain.TierPro) },
+		func() (*tenant.OperationResult, error) { return svc.Migrate(ctx, 123, tenantDomain.RegionEU1) },
+		func() (*tenant.OperationResult, error) {
+			return svc.Suspend(ctx, 123, "unpaid invoice", "ops@example.com")
+		},
+	}
+	results := make(chan error, len(requests))
+	for _, request := range requests {
+		go func() {
+			_, err := request()
+			results <- err
+		}()
+	}
+
+	var accepted int
+	for range requests {
+		if err := <-results; err != nil {
+			assert.ErrorIs(t, err, tenantDomain.ErrOperationInProgress)
+			continue
+		}
+		accepted++
+	}
+	assert.Equal(t, 1, accepted, "exactly one operation must be accepted")
+	assert.EqualValues(t, 1, repo.operations.Load())
+	mockOperationRepo.AssertExpectations(t)
+	mockWorkflowFactory.AssertExpectations(t)
+}
+
+func TestServiceSuspend_Concurrent(t *testing.T) {
+	ctx := context.Background()
+	repo := &lockingTenantRepo{MockTenantRepo: new(MockTenantRepo), status: tenantDomain.StatusActive}
+
+	mockOperationRepo := new(MockOperationRepo)
+	mockOperationRepo.On("Create", mock.Anything, mock.AnythingOfType("*operation.Operation")).
+		Run(func(mock.Arguments) { repo.operations.Add(1) }).
+		Return(int64(456), nil).Once()
+	mockWorkflow := NewMockWorkflow()
+	mockWorkflow.TestMode()
+	mockWorkflowFactory := new(MockWorkflowFactory)
+	mockWorkflowFactory.On("NewWorkflow",
+		workflow.OperationTypeSuspend,
+		mock.AnythingOfType("*tenant.Tenant"),
+		int64(123),
+		mock.AnythingOfType("*operation.Operation")).
+		Return(mockWorkflow).Once()
+
+	svc := tenant.NewServiceWithWorkflowFactory(
+		repo,
+		mockOperationRepo,
+		repo,
+		new(MockStepRepo),
+		new(MockIsolationGroupRepo),
+		nil,
+		mockWorkflowFactory,
+		logger.Noop(),
+		noop.NewTracerProvider().Tracer("test"),
+		new(MockProvisioningMetrics),
+	)
+
+	// Only one suspension workflow may run, so that a second one never records
+	// a duplicate suspension and re-enables the tenant while compensating.
+	results := make(chan error, 2)
+	for range 2 {
+		go func() {
+			_, err := svc.Suspend(ctx, 123, "unpaid invoice", "ops@example.com")
+			results <- err
+		}()
+	}
+
+	var accepted int
+	for range 2 {
+		if err := <-results; err != nil {
+			assert.ErrorIs(t, err, tenantDomain.ErrOperationInProgress)
+			continue
+		}
+		accepted++
+	}
+	assert.Equal(t, 1, accepted, "exactly one suspension must be accepted")
+	mockOperationRepo.AssertExpectations(t)
+	mockWorkflowFactory.AssertExpectations(t)
+}
+
+func TestServiceChangeTier(t *testing.T) {
+	ctx := context.Background()
+	activeFree := func() *tenantDomain.Tenant {
+		return &tenantDomain.Tenant{ID: 123, Name: "my-tenant", Tier: tenantDomain.TierFree, Status: tenantDomain.StatusActive}
+	}
+
+	testCases := []struct {
+		desc                string
+		tier                tenantDomain.Tier
+		mockTenantRepoFn    func(*MockTenantRepo)
+		mockOperationRepoFn func(*MockOperationRepo)
+		expectError         bool
+		expectErrorContains string
+		expectErrIs         error
+		expectOperationID   int64
+	}{
+		{
+			desc: "error finding tenant",
+			tier: tenantDomain.TierPro,
+			mockTenantRepoFn: func(m *MockTenantRepo) {
+				m.On("LockForOperation", mock.Anything, int64(123)).
+					Return((*tenantDomain.Tenant)(nil), errors.New("DB error"))
+			},
+			mockOperationRepoFn: func(m *MockOperationRepo) {},
+			expectError:         true,
+			expectErrorContains: "error finding tenant",
+		},
+		{
+			desc: "tenant not found",
+			tier: tenantDomain.TierPro,
+			mockTenantRepoFn: func(m *MockTenantRepo) {
+				m.On("LockForOperation", mock.Anything, int64(123)).
+					Return((*tenantDomain.Tenant)(nil), tenantDomain.ErrTenantNotFound)
+			},
+			mockOperationRepoFn: func(m *MockOperationRepo) {},
+			expectError:         true,
+			expectErrIs:         tenantDomain.ErrTenantNotFound,
+		},
+		{
+			desc: "tier unchanged",
+			tier: tenantDomain.TierFree,
+			mockTenantRepoFn: func(m *MockTenantRepo) {
+				m.On("LockForOperation", mock.Anything, int64(123)).Return(activeFree(), nil)
+			},
+			mockOperationRepoFn: func(m *MockOperationRepo) {},
+			expectError:         true,
+			expectErrIs:         tenantDomain.ErrTierUnchanged,
+		},
+		{
+			desc: "tenant not active",
+			tier: tenantDomain.TierPro,
+			mockTenantRepoFn: func(m *MockTenantRepo) {
+				t := activeFree()
+				t.Status = tenantDomain.StatusProvisioning
+				m.On("LockForOperation", mock.Anything, int64(123)).Return(t, nil)
+			},
+			mockOperationRepoFn: func(m *MockOperationRepo) {},
+			expectError:         true,
+			expectErrIs:         tenantDomain.ErrTenantNotActive,
+		},
+		{
+			desc: "another operation in progress",
+			tier: tenantDomain.TierPro,
+			mockTenantRepoFn: func(m *MockTenantRepo) {
+				m.On("LockForOperation", mock.Anything, int64(123)).
+					Return((*tenantDomain.Tenant)(nil), tenantDomain.ErrOperationInProgress)
+			},
+			mockOperationRepoFn: func(m *MockOperationRepo) {},
+			expectError:         true,
+			expectErrIs:         tenantDomain.ErrOperationInProgress,
+		},
+		{
+			desc: "error persisting operation",
+			tier: tenantDomain.TierPro,
+			mockTenantRepoFn: func(m *MockTenantRepo) {
+				m.On("LockForOperation", mock.Anything, int64(123)).Return(activeFree(), nil)
+			},
+			mockOperationRepoFn: func(m *MockOperationRepo) {
+				m.On("Create", mock.Anything, mock.AnythingOfType("*operation.Operation")).
+					Return(int64(0), errors.New("op creation error"))
+			},
+			expectError:         true,
+			expectErrorContains: "failed to persist operation",
+		},
+		{
+			desc: "successful upgrade",
+			tier: tenantDomain.TierEnterprise,
+			mockTenantRepoFn: func(m *MockTenantRepo) {
+				m.On("LockForOperation", mock.Anything, int64(123)).Return(activeFree(), nil)
+			},
+			mockOperationRepoFn: func(m *MockOperationRepo) {
+				m.On("Create", mock.Anything, mock.MatchedBy(func(op *operation.Operation) bool {
+					return op.Type == operation.OpTenantUpgrade &&
+						op.Parameters["from_tier"] == "free" &&
+						op.Parameters["to_tier"] == "enterprise"
+				})).Return(int64(456), nil)
+			},
+			expectOperationID: 456,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mockTenantRepo := new(MockTenantRepo)
+			mockOperationRepo := new(MockOperationRepo)
+			mockWorkflow := NewMockWorkflow()
+			mockWorkflowFactory := new(MockWorkflowFactory)
+
+			if !tc.expectError {
+				mockWorkflow.TestMode()
+				mockWorkflowFactory.On("NewWorkflow",
+					workflow.OperationTypeUpgrade,
+					mock.AnythingOfType("*tenant.Tenant"),
+					int64(123),
+					mock.AnythingOfType("*operation.Operation")).
+					Return(mockWorkflow)
+			}
+
+			tc.mockTenantRepoFn(mockTenantRepo)
+			tc.mockOperationRepoFn(mockOperationRepo)
+
+			svc := tenant.NewServiceWithWorkflowFactory(
+				mockTenantRepo,
+				mockOperationRepo,
+				nil,
+				new(MockStepRepo),
+				new(MockIsolationGroupRepo),
+				nil,
+				mockWorkflowFactory,
+				logger.Noop(),
+				noop.NewTracerProvider().Tracer("test"),
+				new(MockProvisioningMetrics),
+			)
+
+			res, err := svc.ChangeTier(ctx, 123, tc.tier)
+
+			if tc.expectError {
+				assert.Error(t, err)
+				if tc.expectErrorContains != "" {
+					assert.Contains(t, err.Error(), tc.expectErrorContains)
+				}
+				if tc.expectErrIs != nil {
+					assert.ErrorIs(t, err, tc.expectErrIs)
+				}
+				assert.Nil(t, res)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, res)
+				assert.EqualValues(t, tc.expectOperationID, res.OperationID)
+			}
+
+			mockTenantRepo.AssertExpectations(t)
+			mockOperationRepo.AssertExpectations(t)
+			mockWorkflowFactory.AssertExpectations(t)
+			mockWorkflow.AssertExpectations(t)
+		})
+	}
+}
+
+func TestServiceMigrate(t *testing.T) {
+	ctx := context.Background()
+	activeUS1 := func() *tenantDomain.Tenant {
+		return &tenantDomain.Tenant{ID: 123, Name: "my-tenant", Region: tenantDomain.RegionUS1, Status: tenantDomain.StatusActive}
+	}
+
+	testCases := []struct {
+		desc                string
+		region              tenantDomain.Region
+		tenant              *tenantDomain.Tenant
+		lockErr             error
+		mockOperationRepoFn func(*MockOperationRepo)
+		expectErrIs         error
+		expectOperationID   int64
+	}{
+		{
+			desc:                "tenant not found",
+			region:              tenantDomain.RegionEU1,
+			lockErr:             tenantDomain.ErrTenantNotFound,
+			mockOperationRepoFn: func(m *MockOperationRepo) {},
+			expectErrIs:         tenantDomain.ErrTenantNotFound,
+		},
+		{
+			desc:                "another operation in progress",
+			region:              tenantDomain.RegionEU1,
+			lockErr:             tenantDomain.ErrOperationInProgress,
+			mockOperationRepoFn: func(m *MockOperationRepo) {},
+			expectErrIs:         tenantDomain.ErrOperationInProgress,
+		},
+		{
+			desc:                "region unchanged",
+			region:              tenantDomain.RegionUS1,
+			tenant:              activeUS1(),
+			mockOperationRepoFn: func(m *MockOperationRepo) {},
+			expectErrIs:         tenantDomain.ErrRegionUnchanged,
+		},
+		{
+			desc:                "invalid region",
+			region:              tenantDomain.Region("mars1"),
+			tenant:              activeUS1(),
+			mockOperationRepoFn: func(m *MockOperationRepo) {},
+			expectErrIs:         tenantDomain.ErrInvalidRegion,
+		},
+		{
+			desc:   "successful migration",
+			region: tenantDomain.RegionEU1,
+			tenant: activeUS1(),
+			mockOperationRepoFn: func(m *MockOperationRepo) {
+				m.On("Create", mock.Anything, mock.MatchedBy(func(op *operation.Operation) bool {
+					return op.Type == operation.OpTenantMigrate &&
+						op.Parameters["from_region"] == "us1" &&
+						op.Parameters["to_region"] == "eu1"
+				})).Return(int64(456), nil)
+			},
+			expectOperationID: 456,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mockTenantRepo := new(MockTenantRepo)
+			mockOperationRepo := new(MockOperationRepo)
+			mockWorkflow := NewMockWorkflow()
+			mockWorkflowFactory := new(MockWorkflowFactory)
+
+			mockTenantRepo.On("LockForOperation", mock.Anything, int64(123)).Return(tc.tenant, tc.lockErr)
+			if tc.expectErrIs == nil {
+				mockWorkflow.TestMode()
+				mockWorkflowFactory.On("NewWorkflow",
+					workflow.OperationTypeMigrate,
+					mock.AnythingOfType("*tenant.Tenant"),
+					int64(123),
+					mock.AnythingOfType("*operation.Operation")).
+					Return(mockWorkflow)
+			}
+			tc.mockOperationRepoFn(mockOperationRepo)
+
+			svc := tenant.NewServiceWithWorkflowFactory(
+				mockTenantRepo,
+				mockOperationRepo,
+				nil,
+				new(MockStepRepo),
+				new(MockIsolationGroupRepo),
+				nil,
+				mockWorkflowFactory,
+				logger.Noop(),
+				noop.NewTracerProvider().Tracer("test"),
+				new(MockProvisioningMetrics),
+			)
+
+			res, err := svc.Migrate(ctx, 123, tc.region)
+			if tc.expectErrIs != nil {
+				assert.ErrorIs(t, err, tc.expectErrIs)
+				assert.Nil(t, res)
+			} else {
+				require.NoError(t, err)
+				assert.EqualValues(t, tc.expectOperationID, res.OperationID)
+			}
+
+			mockTenantRepo.AssertExpectations(t)
+			mockOperationRepo.AssertExpectations(t)
+			mockWorkflowFactory.AssertExpectations(t)
+			mockWorkflow.AssertExpectations(t)
+		})
+	}
+}
+
+func TestServiceSuspendResume(t *testing.T) {
+	ctx := context.Background()
+	withStatus := func(status tenantDomain.Status) *tenantDomain.Tenant {
+		return &tenantDomain.Tenant{ID: 123, Name: "my-tenant", Tier: tenantDomain.TierPro, Status: status}
+	}
+
+	testCases := []struct {
+		desc              string
+		opType            workflow.OperationType
+		status            tenantDomain.Status
+		reason            string
+		findErr           error
+		createErr         error
+		expectErrIs       error
+		expectErrContains string
+	}{
+		{
+			desc:              "suspend: error finding tenant",
+			opType:            workflow.OperationTypeSuspend,
+			reason:            "unpaid invoice",
+			findErr:           errors.New("DB error"),
+			expectErrContains: "error finding tenant",
+		},
+		{
+			desc:        "suspend: missing reason",
+			opType:      workflow.OperationTypeSuspend,
+			status:      tenantDomain.StatusActive,
+			reason:      " ",
+			expectErrIs: tenantDomain.ErrReasonRequired,
+		},
+		{
+			desc:        "suspend: deleting tenant",
+			opType:      workflow.OperationTypeSuspend,
+			status:      tenantDomain.StatusDeleting,
+			reason:      "unpaid invoice",
+			expectErrIs: tenantDomain.ErrTenantNotActive,
+		},
+		{
+			desc:        "suspend: another operation in progress",
+			opType:      workflow.OperationTypeSuspend,
+			reason:      "unpaid invoice",
+			findErr:     tenantDomain.ErrOperationInProgress,
+			expectErrIs: tenantDomain.ErrOperationInProgress,
+		},
+		{
+			desc:        "suspend: already suspended",
+			opType:      workflow.OperationTypeSuspend,
+			status:      tenantDomain.StatusSuspended,
+			reason:      "unpaid invoice",
+			expectErrIs: tenantDomain.ErrTenantAlreadySuspended,
+		},
+		{
+			desc:              "suspend: error persisting operation",
+			opType:            workflow.OperationTypeSuspend,
+			status:            tenantDomain.StatusActive,
+			reason:            "unpaid invoice",
+			createErr:         errors.New("op creation error"),
+			expectErrContains: "failed to persist operation",
+		},
+		{
+			desc:   "suspend: success",
+			opType: workflow.OperationTypeSuspend,
+			status: tenantDomain.StatusActive,
+			reason: "unpaid invoice",
+		},
+		{
+			desc:        "resume: tenant not suspended",
+			opType:      workflow.OperationTypeResume,
+			status:      tenantDomain.StatusActive,
+			reason:      "invoice paid",
+			expectErrIs: tenantDomain.ErrTenantNotSuspended,
+		},
+		{
+			desc:        "resume: another operation in progress",
+			opType:      workflow.OperationTypeResume,
+			reason:      "invoice paid",
+			findErr:     tenantDomain.ErrOperationInProgress,
+			expectErrIs: tenantDomain.ErrOperationInProgress,
+		},
+		{
+			desc:        "resume: missing reason",
+			opType:      workflow.OperationTypeResume,
+			status:      tenantDomain.StatusSuspended,
+			expectErrIs: tenantDomain.ErrReasonRequired,
+		},
+		{
+			desc:   "resume: success",
+			opType: workflow.OperationTypeResume,
+			status: tenantDomain.StatusSuspended,
+			reason: "invoice paid",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mockTenantRepo := new(MockTenantRepo)
+			mockOperationRepo := new(MockOperationRepo)
+			mockWorkflow := NewMockWorkflow()
+			mockWorkflowFactory := new(MockWorkflowFactory)
+
+			if tc.findErr != nil {
+				mockTenantRepo.On("LockForOperation", mock.Anything, int64(123)).
+					Return((*tenantDomain.Tenant)(nil), tc.findErr)
+			} else {
+				mockTenantRepo.On("LockForOperation", mock.Anything, int64(123)).Return(withStatus(tc.status), nil)
+			}
+
+			expectOp := operation.OpTenantSuspend
+			if tc.opType == workflow.OperationTypeResume {
+				expectOp = operation.OpTenantResume
+			}
+			success := tc.expectErrIs == nil && tc.expectErrContains == ""
+			if tc.createErr != nil || success {
+				mockOperationRepo.On("Create", mock.Anything, mock.MatchedBy(func(op *operation.Operation) bool {
+					return op.Type == expectOp &&
+						op.Parameters["reason"] == tc.reason &&
+						op.Parameters["requested_by"] == "ops@example.com"
+				})).Return(int64(456), tc.createErr)
+			}
+			if success {
+				mockWorkflow.TestMode()
+				mockWorkflowFactory.On("NewWorkflow",
+					tc.opType,
+					mock.AnythingOfType("*tenant.Tenant"),
+					int64(123),
+					mock.AnythingOfType("*operation.Operation")).
+					Return(mockWorkflow)
+			}
+
+			svc := tenant.NewServiceWithWorkflowFactory(
+				mockTenantRepo,
+				mockOperationRepo,
+				nil,
+				new(MockStepRepo),
+				new(MockIsolationGroupRepo),
+				nil,
+				mockWorkflowFactory,
+				logger.Noop(),
+				noop.NewTracerProvider().Tracer("test"),
+				new(MockProvisioningMetrics),
+			)
+
+			var res *tenant.OperationResult
+			var err error
+			if tc.opType == workflow.OperationTypeSuspend {
+				res, err = svc.Suspend(ctx, 123, tc.reason, "ops@example.com")
+			} else {
+				res, err = svc.Resume(ctx, 123, tc.reason, "ops@example.com")
+			}
+
+			if success {
+				require.NoError(t, err)
+				assert.EqualValues(t, 456, res.OperationID)
+			} else {
+				assert.Error(t, err)
+				if tc.expectErrIs != nil {
+					assert.ErrorIs(t, err, tc.expectErrIs)
+				}
+				if tc.expectErrContains != "" {
+					assert.Contains(t, err.Error(), tc.expectErrContains)
+				}
+				assert.Nil(t, res)
+			}
+
+			mockTenantRepo.AssertExpectations(t)
+			mockOperationRepo.AssertExpectations(t)
+			mockWorkflowFactory.AssertExpectations(t)
+		})
+	}
+}
+
+func TestServiceSuspensions(t *testing.T) {
+	ctx := context.Background()
+	newService := func(repo *MockTenantRepo) *tenant.Service {
+		return tenant.NewServiceWithWorkflowFactory(
+			repo,
+			new(MockOperationRepo),
+			nil,
+			new(MockStepRepo),
+			new(MockIsolationGroupRepo),
+			nil,
+			new(MockWorkflowFactory),
+			logger.Noop(),
+			noop.NewTracerProvider().Tracer("test"),
+			new(MockProvisioningMetrics),
+		)
+	}
+
+	t.Run("returns history", func(t *testing.T) {
+		history := []*tenantDomain.Suspension{tenantDomain.NewSuspension(123, "unpaid invoice", "ops@example.com", 456)}
+		repo := new(MockTenantRepo)
+		repo.On("FindByID", mock.Anything, int64(123)).
+			Return(&tenantDomain.Tenant{ID: 123, Status: tenantDomain.StatusSuspended}, nil)
+		repo.On("FindSuspensions", mock.Anything, int64(123)).Return(history, nil)
+
+		got, err := newService(repo).Suspensions(ctx, 123)
+		require.NoError(t, err)
+		assert.Equal(t, history, got)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("tenant not found", func(t *testing.T) {
+		repo := new(MockTenantRepo)
+		repo.On("FindByID", mock.Anything, int64(123)).Return((*tenantDomain.Tenant)(nil), nil)
+
+		_, err := newService(repo).Suspensions(ctx, 123)
+		assert.ErrorIs(t, err, tenantDomain.ErrTenantNotFound)
+		repo.AssertNotCalled(t, "FindSuspensions", mock.Anything, mock.Anything)
+	})
+}
+
+func TestServiceGetOperationStatus(t *testing.T) {
+	ctx := context.Background()
+	tenantID := int64(55)
+
+	testCases := []struct {
+		desc                string
 		operationID         int64
 		mockOperationRepoFn func(*MockOperationRepo)
 		expectError         bool
@@ -588,7 +1818,20 @@ func TestServiceGetOperationStatus(t *testing.T) {
 
 		logger := logger.Noop()
 		tracer := noop.NewTracerProvider().Tracer("test")
-		svc := tenant.NewService(mockTenantRepo, mockOperationRepo, logger, tracer, new(MockProvisioningMetrics))
+		svc := tenant.NewService(
+			mockTenantRepo,
+			mockOperationRepo,
+			nil,
+			new(MockStepRepo),
+			new(MockIsolationGroupRepo),
+			nil,
+			nil,
+			nil,
+			nil,
+			logger,
+			tracer,
+			new(MockProvisioningMetrics),
+		)
 		op, err := svc.GetOperationStatus(ctx, tc.operationID)
 		if tc.expectError {
 			assert.Error(t, err)
@@ -606,3 +1849,371 @@ func TestServiceGetOperationStatus(t *testing.T) {
 		mockOperationRepo.AssertExpectations(t)
 	}
 }
+
+func TestServiceCancelOperation(t *testing.T) {
+	ctx := context.Background()
+	tenantID := int64(55)
+
+	newOp := func(status operation.Status) *operation.Operation {
+		return &operation.Operation{ID: 123, TenantID: &tenantID, Status: status}
+	}
+
+	testCases := []struct {
+		desc                string
+		mockOperationRepoFn func(*MockOperationRepo)
+		expectErrIs         error
+	}{
+		{
+			desc: "operation not found",
+			mockOperationRepoFn: func(m *MockOperationRepo) {
+				m.On("FindByID", mock.Anything, int64(123)).
+					Return((*operation.Operation)(nil), nil)
+			},
+			expectErrIs: operation.ErrOperationNotFound,
+		},
+		{
+			desc: "completed operation cannot be cancelled",
+			mockOperationRepoFn: func(m *MockOperationRepo) {
+				m.On("FindByID", mock.Anything, int64(123)).
+					Return(newOp(operation.StatusCompleted), nil)
+			},
+			expectErrIs: operation.ErrOperationNotCancellable,
+		},
+		{
+			desc: "operation running in another replica is refused",
+			mockOperationRepoFn: func(m *MockOperationRepo) {
+				op := newOp(operation.StatusInProgress)
+				heartbeat := time.Now()
+				op.UpdatedAt = &heartbeat
+				m.On("FindByID", mock.Anything, int64(123)).Return(op, nil)
+			},
+			expectErrIs: operation.ErrOperationRunsElsewhere,
+		},
+		{
+			desc: "orphaned operation without a local workflow is cancelled directly",
+			mockOperationRepoFn: func(m *MockOperationRepo) {
+				op := newOp(operation.StatusInProgress)
+				heartbeat := time.Now().Add(-2 * tenant.DefaultStaleAfter)
+				op.UpdatedAt = &heartbeat
+				m.On("FindByID", mock.Anything, int64(123)).Return(op, nil)
+				m.On("Update", mock.Anything, mock.MatchedBy(func(o *operation.Operation) bool {
+					return o.Status == operation.StatusCancelled
+				})).Return(nil)
+			},
+		},
+		{
+			desc: "operation without a local workflow is cancelled directly",
+			mockOperationRepoFn: func(m *MockOperationRepo) {
+				m.On("FindByID", mock.Anything, int64(123)).
+					Return(newOp(operation.StatusPending), nil)
+				m.On("Update", mock.Anything, mock.MatchedBy(func(o *operation.Operation) bool {
+					return o.Status == operation.StatusCancelled &&
+						*o.CancelledBy == "alice" &&
+						*o.ErrorMessage == "no longer needed"
+				})).Return(nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mockOperationRepo := new(MockOperationRepo)
+			tc.mockOperationRepoFn(mockOperationRepo)
+
+			svc := tenant.NewService(
+				new(MockTenantRepo),
+				mockOperationRepo,
+				nil,
+				new(MockStepRepo),
+				new(MockIsolationGroupRepo),
+				nil,
+				nil,
+				nil,
+				nil,
+				logger.Noop(),
+				noop.NewTracerProvider().Tracer("test"),
+				new(MockProvisioningMetrics),
+			)
+
+			op, err := svc.CancelOperation(ctx, 123, "no longer needed", "alice")
+			if tc.expectErrIs != nil {
+				assert.ErrorIs(t, err, tc.expectErrIs)
+				assert.Nil(t, op)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, operation.StatusCancelled, op.Status)
+			}
+
+			mockOperationRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestServiceCancelOperation_ActiveWorkflow(t *testing.T) {
+	ctx := context.Background()
+	tenantID := int64(123)
+
+	mockTenantRepo := new(MockTenantRepo)
+	mockOperationRepo := new(MockOperationRepo)
+	mockWorkflowFactory := new(MockWorkflowFactory)
+	mockWorkflow := NewMockWorkflow()
+
+	started := make(chan context.Context, 1)
+	mockWorkflow.On("Start", mock.Anything).Run(func(args mock.Arguments) {
+		started <- args.Get(0).(context.Context)
+	})
+	mockWorkflowFactory.On("NewWorkflow", workflow.OperationTypeDelete,
+		mock.AnythingOfType("*tenant.Tenant"), tenantID, mock.AnythingOfType("*operation.Operation")).
+		Return(mockWorkflow)
+	mockTenantRepo.On("LockForOperation", mock.Anything, tenantID).
+		Return(&tenantDomain.Tenant{ID: tenantID, Name: "my-tenant", Status: tenantDomain.StatusActive}, nil)
+	mockTenantRepo.On("TransitionStatus", mock.Anything, tenantID, mock.Anything, tenantDomain.StatusDeleting).
+		Return(true, nil)
+	mockOperationRepo.On("Create", mock.Anything, mock.AnythingOfType("*operation.Operation")).
+		Return(int64(456), nil)
+
+	svc := tenant.NewServiceWithWorkflowFactory(
+		mockTenantRepo,
+		mockOperationRepo,
+		nil,
+		new(MockStepRepo),
+		new(MockIsolationGroupRepo),
+		nil,
+		mockWorkflowFactory,
+		logger.Noop(),
+		noop.NewTracerProvider().Tracer("test"),
+		new(MockProvisioningMetrics),
+	)
+
+	res, err := svc.Delete(ctx, tenantID)
+	require.NoError(t, err)
+	workflowCtx := <-started
+
+	runningOp := &operation.Operation{ID: res.OperationID, TenantID: &tenantID, Status: operation.StatusInProgress}
+	mockOperationRepo.On("FindByID", mock.Anything, res.OperationID).Return(runningOp, nil)
+
+	op, err := svc.CancelOperation(ctx, res.OperationID, "wrong tenant", "alice")
+	require.NoError(t, err)
+	assert.Equal(t, operation.StatusInProgress, op.Status, "workflow records the cancellation itself")
+
+	<-workflowCtx.Done()
+	var cancelErr *workflow.CancellationError
+	require.ErrorAs(t, context.Cause(workflowCtx), &cancelErr)
+	assert.Equal(t, "wrong tenant", cancelErr.Reason)
+	assert.Equal(t, "alice", cancelErr.Actor)
+
+	mockWorkflow.SendResult(workflow.WorkflowResult{Success: false, Error: cancelErr})
+	mockOperationRepo.AssertExpectations(t)
+	mockWorkflowFactory.AssertExpectations(t)
+}
+
+func TestServicePauseOperation(t *testing.T) {
+	ctx := context.Background()
+	tenantID := int64(55)
+
+	newOp := func(opType operation.Op, status operation.Status) *operation.Operation {
+		return &operation.Operation{ID: 123, Type: opType, TenantID: &tenantID, Status: status}
+	}
+
+	testCases := []struct {
+		desc                string
+		mockOperationRepoFn func(*MockOperationRepo)
+		expectErrIs         error
+	}{
+		{
+			desc: "operation not found",
+			mockOperationRepoFn: func(m *MockOperationRepo) {
+				m.On("FindByID", mock.Anything, int64(123)).
+					Return((*operation.Operation)(nil), nil)
+			},
+			expectErrIs: operation.ErrOperationNotFound,
+		},
+		{
+			desc: "operation that is not a migration cannot be paused",
+			mockOperationRepoFn: func(m *MockOperationRepo) {
+				m.On("FindByID", mock.Anything, int64(123)).
+					Return(newOp(operation.OpTenantDelete, operation.StatusInProgress), nil)
+			},
+			expectErrIs: operation.ErrOperationNotPausable,
+		},
+		{
+			desc: "completed migration cannot be paused",
+			mockOperationRepoFn: func(m *MockOperationRepo) {
+				m.On("FindByID", mock.Anything, int64(123)).
+					Return(newOp(operation.OpTenantMigrate, operation.StatusCompleted), nil)
+			},
+			expectErrIs: operation.ErrOperationNotPausable,
+		},
+		{
+			desc: "migration running in another replica is refused",
+			mockOperationRepoFn: func(m *MockOperationRepo) {
+				op := newOp(operation.OpTenantMigrate, operation.StatusInProgress)
+				heartbeat := time.Now()
+				op.UpdatedAt = &heartbeat
+				m.On("FindByID", mock.Anything, int64(123)).Return(op, nil)
+			},
+			expectErrIs: operation.ErrOperationRunsElsewhere,
+		},
+		{
+			desc: "migration without a local workflow is paused directly",
+			mockOperationRepoFn: func(m *MockOperationRepo) {
+				m.On("FindByID", mock.Anything, int64(123)).
+					Return(newOp(operation.OpTenantMigrate, operation.StatusInProgress), nil)
+				m.On("Update", mock.Anything, mock.MatchedBy(func(o *operation.Operation) bool {
+					return o.Status == operation.StatusPaused
+				})).Return(nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mockOperationRepo := new(MockOperationRepo)
+			tc.mockOperationRepoFn(mockOperationRepo)
+
+			svc := tenant.NewService(
+				new(MockTenantRepo),
+				mockOperationRepo,
+				nil,
+				new(MockStepRepo),
+				new(MockIsolationGroupRepo),
+				nil,
+				nil,
+				nil,
+				nil,
+				logger.Noop(),
+				noop.NewTracerProvider().Tracer("test"),
+				new(MockProvisioningMetrics),
+			)
+
+			op, err := svc.PauseOperation(ctx, 123)
+			if tc.expectErrIs != nil {
+				assert.ErrorIs(t, err, tc.expectErrIs)
+				assert.Nil(t, op)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, operation.StatusPaused, op.Status)
+			}
+
+			mockOperationRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestServicePauseOperation_ActiveWorkflow(t *testing.T) {
+	ctx := context.Background()
+	tenantID := int64(123)
+
+	mockTenantRepo := new(MockTenantRepo)
+	mockOperationRepo := new(MockOperationRepo)
+	mockWorkflowFactory := new(MockWorkflowFactory)
+	mockWorkflow := NewMockWorkflow()
+
+	started := make(chan struct{})
+	mockWorkflow.On("Start", mock.Anything).Run(func(mock.Arguments) { close(started) })
+	mockWorkflow.On("RequestPause").Once()
+	mockWorkflowFactory.On("NewWorkflow", workflow.OperationTypeMigrate,
+		mock.AnythingOfType("*tenant.Tenant"), tenantID, mock.AnythingOfType("*operation.Operation")).
+		Return(mockWorkflow)
+	mockTenantRepo.On("LockForOperation", mock.Anything, tenantID).Return(&tenantDomain.Tenant{
+		ID: tenantID, Name: "my-tenant", Region: tenantDomain.RegionUS1, Status: tenantDomain.StatusActive,
+	}, nil)
+	mockOperationRepo.On("Create", mock.Anything, mock.AnythingOfType("*operation.Operation")).
+		Return(int64(456), nil)
+
+	svc := tenant.NewServiceWithWorkflowFactory(
+		mockTenantRepo,
+		mockOperationRepo,
+		nil,
+		new(MockStepRepo),
+		new(MockIsolationGroupRepo),
+		nil,
+		mockWorkflowFactory,
+		logger.Noop(),
+		noop.NewTracerProvider().Tracer("test"),
+		new(MockProvisioningMetrics),
+	)
+
+	res, err := svc.Migrate(ctx, tenantID, tenantDomain.RegionEU1)
+	require.NoError(t, err)
+	<-started
+
+	runningOp := &operation.Operation{
+		ID: res.OperationID, Type: operation.OpTenantMigrate, TenantID: &tenantID, Status: operation.StatusInProgress,
+	}
+	mockOperationRepo.On("FindByID", mock.Anything, res.OperationID).Return(runningOp, nil)
+
+	op, err := svc.PauseOperation(ctx, res.OperationID)
+	require.NoError(t, err)
+	assert.Equal(t, operation.StatusInProgress, op.Status, "workflow records the pause itself")
+
+	mockWorkflow.SendResult(workflow.WorkflowResult{Paused: true, Error: workflow.ErrWorkflowPaused})
+	mockWorkflow.AssertExpectations(t)
+	mockOperationRepo.AssertExpectations(t)
+	mockWorkflowFactory.AssertExpectations(t)
+}
+
+func TestServiceResumeOperation(t *testing.T) {
+	ctx := context.Background()
+	tenantID := int64(42)
+
+	newRetry := func(t *testing.T, opType operation.Op) *operation.Operation {
+		op, err := operation.NewOperation(opType, &tenantID, nil)
+		require.NoError(t, err)
+		op.ID = 7
+		return op
+	}
+
+	t.Run("create retry returns tenant to provisioning", func(t *testing.T) {
+		mockTenantRepo := new(MockTenantRepo)
+		mockWorkflowFactory := new(MockWorkflowFactory)
+		op := newRetry(t, operation.OpTenantCreate)
+		existing := &tenantDomain.Tenant{ID: tenantID, Name: "acme", Status: tenantDomain.StatusError}
+
+		mockTenantRepo.On("FindByID", mock.Anything, tenantID).Return(existing, nil)
+		mockTenantRepo.On("Update", mock.Anything, mock.MatchedBy(func(tn *tenantDomain.Tenant) bool {
+			return tn.Status == tenantDomain.StatusProvisioning
+		})).Return(nil)
+		mockWorkflow := NewMockWorkflow()
+		mockWorkflow.TestMode()
+		mockWorkflowFactory.On("NewWorkflow", workflow.OperationTypeCreate, existing, tenantID, op).Return(mockWorkflow)
+
+		svc := tenant.NewServiceWithWorkflowFactory(
+			mockTenantRepo,
+			new(MockOperationRepo),
+			nil,
+			new(MockStepRepo),
+			new(MockIsolationGroupRepo),
+			nil,
+			mockWorkflowFactory,
+			logger.Noop(),
+			noop.NewTracerProvider().Tracer("test"),
+			new(MockProvisioningMetrics),
+		)
+
+		require.NoError(t, svc.ResumeOperation(ctx, op))
+		mockTenantRepo.AssertExpectations(t)
+		mockWorkflowFactory.AssertExpectations(t)
+	})
+
+	t.Run("missing tenant", func(t *testing.T) {
+		mockTenantRepo := new(MockTenantRepo)
+		mockTenantRepo.On("FindByID", mock.Anything, tenantID).Return(nil, nil)
+
+		svc := tenant.NewServiceWithWorkflowFactory(
+			mockTenantRepo,
+			new(MockOperationRepo),
+			nil,
+			new(MockStepRepo),
+			new(MockIsolationGroupRepo),
+			nil,
+			new(MockWorkflowFactory),
+			logger.Noop(),
+			noop.NewTracerProvider().Tracer("test"),
+			new(MockProvisioningMetrics),
+		)
+
+		err := svc.ResumeOperation(ctx, newRetry(t, operation.OpTenantDelete))
+		assert.ErrorIs(t, err, tenantDomain.ErrTenantNotFound)
+	})
+}
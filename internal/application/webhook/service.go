@@ -0,0 +1,575 @@
+// Package webhook manages webhook subscriptions and delivers the lifecycle
+// events they subscribe to, signed with each subscription's secret.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ahrav/hoglet-hub/internal/domain/outbox"
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+	"github.com/ahrav/hoglet-hub/internal/domain/webhook"
+	"github.com/ahrav/hoglet-hub/pkg/common/cursor"
+	"github.com/ahrav/hoglet-hub/pkg/common/logger"
+)
+
+const (
+	// DefaultDeliveryInterval is how often RunDelivery attempts due deliveries.
+	DefaultDeliveryInterval = 5 * time.Second
+
+	// DefaultDeliveryTimeout bounds each attempt to deliver an event.
+	DefaultDeliveryTimeout = 10 * time.Second
+
+	// deliveryBatchSize is the most deliveries claimed at a time, and
+	// deliveryWorkers how many of them are attempted concurrently.
+	deliveryBatchSize = 50
+	deliveryWorkers   = 8
+
+	// deliveryLease is how long claimed deliveries are hidden from other
+	// processes. Deliveries claimed by a process that dies are attempted again
+	// once it expires.
+	deliveryLease = 5 * time.Minute
+)
+
+// Page size bounds for delivery listings.
+const (
+	DefaultListLimit = 100
+	MaxListLimit     = 500
+)
+
+// CreateParams contains parameters for creating a subscription.
+type CreateParams struct {
+	URL         string
+	Secret      string // Generated if empty
+	EventTypes  []outbox.EventType
+	TenantID    *int64
+	Description string
+}
+
+// DeliveryListParams contains parameters for listing a subscription's deliveries.
+// A zero Limit selects DefaultListLimit.
+type DeliveryListParams struct {
+	SubscriptionID int64
+	Status         *webhook.DeliveryStatus
+	Cursor         string // Opaque token from a previous DeliveryListResult; empty for the first page
+	Limit          int
+}
+
+// DeliveryListResult is a page of deliveries, newest first, along with the token
+// for the following page.
+type DeliveryListResult struct {
+	Deliveries []*webhook.Delivery
+	NextCursor string // Empty when there are no more deliveries
+}
+
+// listCursor is the position encoded into a page token.
+type listCursor struct {
+	BeforeID int64 `json:"before_id"`
+}
+
+// Service manages webhook subscriptions and delivers events to them.
+//
+// It is an outbox sink: each relayed event is queued for every subscription it
+// matches, and RunDelivery then sends the queued deliveries, retrying failures
+// with backoff until they succeed or are dead-lettered.
+type Service struct {
+	repo       webhook.Repository
+	tenantRepo tenant.Repository
+	client     *http.Client
+
+	logger *logger.Logger
+	tracer trace.Tracer
+}
+
+// NewService creates a new webhook service. A nil client selects
+// NewDeliveryClient(DefaultDeliveryTimeout).
+func NewService(
+	repo webhook.Repository,
+	tenantRepo tenant.Repository,
+	client *http.Client,
+	logger *logger.Logger,
+	tracer trace.Tracer,
+) *Service {
+	if client == nil {
+		client = NewDeliveryClient(DefaultDeliveryTimeout)
+	}
+	return &Service{
+		repo:       repo,
+		tenantRepo: tenantRepo,
+		client:     client,
+		logger:     logger.With("component", "webhook_service"),
+		tracer:     tracer,
+	}
+}
+
+// NewDeliveryClient returns a client for delivering to subscribers. It refuses
+// to connect to addresses rejected by webhook.CheckAddress, whatever the
+// subscription's host name resolves to at the time, and returns redirects as
+// responses instead of following them to hosts that were never validated.
+func NewDeliveryClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return err
+			}
+			return webhook.CheckAddress(addrPort.Addr())
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// A proxy would make the dialed address the proxy's, not the subscriber's.
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// CreateSubscription validates and persists a new subscription. The returned
+// subscription carries its secret, which callers need to verify deliveries.
+// Returns tenant.ErrTenantNotFound if the subscription is for a tenant that
+// does not exist.
+func (s *Service) CreateSubscription(ctx context.Context, params CreateParams, actor string) (*webhook.Subscription, error) {
+	ctx, span := s.tracer.Start(ctx, "webhook.CreateSubscription", trace.WithAttributes(
+		attribute.String("url", params.URL),
+	))
+	defer span.End()
+
+	if params.TenantID != nil {
+		if _, err := s.tenantRepo.FindByID(ctx, *params.TenantID); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "error finding tenant")
+			return nil, err
+		}
+	}
+
+	secret := params.Secret
+	if secret == "" {
+		var err error
+		if secret, err = webhook.GenerateSecret(); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "error generating secret")
+			return nil, err
+		}
+	}
+
+	sub, err := webhook.NewSubscription(params.URL, secret, params.EventTypes, params.TenantID, params.Description, actor)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "invalid subscription")
+		return nil, err
+	}
+
+	id, err := s.repo.CreateSubscription(ctx, sub)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error persisting subscription")
+		return nil, fmt.Errorf("failed to persist webhook subscription: %w", err)
+	}
+	sub.ID = id
+
+	span.SetAttributes(attribute.Int64("subscription_id", id))
+	span.SetStatus(codes.Ok, "subscription created")
+	s.logger.Info(ctx, "webhook subscription created", "subscription_id", id, "url", sub.URL)
+	return sub, nil
+}
+
+// GetSubscription retrieves a subscription by ID.
+// Returns webhook.ErrSubscriptionNotFound if the subscription does not exist.
+func (s *Service) GetSubscription(ctx context.Context, id int64) (*webhook.Subscription, error) {
+	ctx, span := s.tracer.Start(ctx, "webhook.GetSubscription", trace.WithAttributes(
+		attribute.Int64("subscription_id", id),
+	))
+	defer span.End()
+
+	sub, err := s.repo.FindSubscription(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error finding subscription")
+		if errors.Is(err, webhook.ErrSubscriptionNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("error finding webhook subscription (%d): %w", id, err)
+	}
+
+	return sub, nil
+}
+
+// ListSubscriptions returns the subscriptions, optionally only those for the
+// tenant, ordered by ID.
+func (s *Service) ListSubscriptions(ctx context.Context, tenantID *int64) ([]*webhook.Subscription, error) {
+	ctx, span := s.tracer.Start(ctx, "webhook.ListSubscriptions")
+	defer span.End()
+
+	subs, err := s.repo.ListSubscriptions(ctx, tenantID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error listing subscriptions")
+		return nil, fmt.Errorf("failed to list webhook subscriptions: %w", err)
+	}
+
+	span.SetStatus(codes.Ok, "subscriptions listed")
+	return subs, nil
+}
+
+// UpdateSubscription applies the changes to a subscription.
+// Returns webhook.ErrSubscriptionNotFound if the subscription does not exist.
+func (s *Service) UpdateSubscription(ctx context.Context, id int64, update webhook.Update) (*webhook.Subscription, error) {
+	ctx, span := s.tracer.Start(ctx, "webhook.UpdateSubscription", trace.WithAttributes(
+		attribute.Int64("subscription_id", id),
+	))
+	defer span.End()
+
+	sub, err := s.GetSubscription(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error finding subscription")
+		return nil, err
+	}
+
+	if err := sub.Apply(update); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "invalid update")
+		return nil, err
+	}
+
+	if err := s.repo.UpdateSubscription(ctx, sub); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error persisting subscription")
+		if errors.Is(err, webhook.ErrSubscriptionNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to update webhook subscription (%d): %w", id, err)
+	}
+	now := time.Now()
+	sub.UpdatedAt = &now
+
+	span.SetStatus(codes.Ok, "subscription updated")
+	s.logger.Info(ctx, "webhook subscription updated", "subscription_id", id, "active", sub.Active)
+	return sub, nil
+}
+
+// DeleteSubscription removes a subscription along with its delivery log.
+// Returns webhook.ErrSubscriptionNotFound if the subscription does not exist.
+func (s *Service) DeleteSubscription(ctx context.Context, id int64) error {
+	ctx, span := s.tracer.Start(ctx, "webhook.DeleteSubscription", trace.WithAttributes(
+		attribute.Int64("subscription_id", id),
+	))
+	defer span.End()
+
+	if err := s.repo.DeleteSubscription(ctx, id); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error deleting subscription")
+		if errors.Is(err, webhook.ErrSubscriptionNotFound) {
+			return err
+		}
+		return fmt.Errorf("failed to delete webhook subscription (%d): %w", id, err)
+	}
+
+	span.SetStatus(codes.Ok, "subscription deleted")
+	s.logger.Info(ctx, "webhook subscription deleted", "subscription_id", id)
+	return nil
+}
+
+// ListDeliveries returns a page of a subscription's delivery log, newest first.
+// Returns webhook.ErrSubscriptionNotFound if the subscription does not exist and
+// webhook.ErrInvalidListParams for an invalid filter or cursor.
+func (s *Service) ListDeliveries(ctx context.Context, params DeliveryListParams) (*DeliveryListResult, error) {
+	ctx, span := s.tracer.Start(ctx, "webhook.ListDeliveries", trace.WithAttributes(
+		attribute.Int64("subscription_id", params.SubscriptionID),
+		attribute.Int("limit", params.Limit),
+	))
+	defer span.End()
+
+	listParams := webhook.DeliveryListParams{
+		SubscriptionID: params.SubscriptionID,
+		Status:         params.Status,
+		Limit:          params.Limit,
+	}
+	if listParams.Limit == 0 {
+		listParams.Limit = DefaultListLimit
+	}
+	listParams.Limit = min(listParams.Limit, MaxListLimit)
+
+	if params.Cursor != "" {
+		var c listCursor
+		if err := cursor.Decode(params.Cursor, &c); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "invalid cursor")
+			return nil, fmt.Errorf("%w: %w", webhook.ErrInvalidListParams, err)
+		}
+		listParams.BeforeID = c.BeforeID
+	}
+
+	if err := listParams.Validate(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "invalid list parameters")
+		return nil, err
+	}
+
+	if _, err := s.GetSubscription(ctx, params.SubscriptionID); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error finding subscription")
+		return nil, err
+	}
+
+	// One delivery more than the page is fetched to learn whether another page follows.
+	pageSize := listParams.Limit
+	listParams.Limit++
+	deliveries, err := s.repo.ListDeliveries(ctx, listParams)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error listing deliveries")
+		return nil, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+
+	result := &DeliveryListResult{Deliveries: deliveries}
+	if len(deliveries) > pageSize {
+		result.Deliveries = deliveries[:pageSize]
+		result.NextCursor, err = cursor.Encode(listCursor{BeforeID: result.Deliveries[pageSize-1].ID})
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "error encoding cursor")
+			return nil, err
+		}
+	}
+
+	span.SetAttributes(attribute.Int("delivery_count", len(result.Deliveries)))
+	span.SetStatus(codes.Ok, "deliveries listed")
+	return result, nil
+}
+
+// Redeliver queues a finished delivery of the subscription, typically a
+// dead-lettered one, to be attempted again.
+// Returns webhook.ErrDeliveryNotFound if the subscription has no such delivery
+// and webhook.ErrDeliveryPending if the delivery has not finished.
+func (s *Service) Redeliver(ctx context.Context, subscriptionID, deliveryID int64) (*webhook.Delivery, error) {
+	ctx, span := s.tracer.Start(ctx, "webhook.Redeliver", trace.WithAttributes(
+		attribute.Int64("subscription_id", subscriptionID),
+		attribute.Int64("delivery_id", deliveryID),
+	))
+	defer span.End()
+
+	d, err := s.repo.FindDelivery(ctx, deliveryID)
+	if err == nil && d.SubscriptionID != subscriptionID {
+		err = webhook.ErrDeliveryNotFound
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error finding delivery")
+		if errors.Is(err, webhook.ErrDeliveryNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("error finding webhook delivery (%d): %w", deliveryID, err)
+	}
+
+	if err := d.Redeliver(time.Now()); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "delivery cannot be redelivered")
+		return nil, err
+	}
+
+	if err := s.repo.UpdateDelivery(ctx, d); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error persisting delivery")
+		return nil, fmt.Errorf("failed to queue webhook delivery (%d): %w", deliveryID, err)
+	}
+
+	span.SetStatus(codes.Ok, "delivery queued")
+	s.logger.Info(ctx, "webhook delivery queued for redelivery", "subscription_id", subscriptionID, "delivery_id", deliveryID)
+	return d, nil
+}
+
+// Name identifies the service as an outbox sink.
+func (s *Service) Name() string { return "webhooks" }
+
+// Publish queues the event for every subscription it matches. The event is
+// relayed again if queuing fails, and subscriptions that already have it
+// queued are not sent it twice.
+func (s *Service) Publish(ctx context.Context, event *outbox.Event) error {
+	ctx, span := s.tracer.Start(ctx, "webhook.Publish", trace.WithAttributes(
+		attribute.Int64("event_id", event.ID),
+		attribute.String("event_type", string(event.Type)),
+	))
+	defer span.End()
+
+	subs, err := s.repo.ListMatchingSubscriptions(ctx, event.Type, event.TenantID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error finding subscriptions")
+		return fmt.Errorf("failed to find webhook subscriptions: %w", err)
+	}
+	if len(subs) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error encoding event")
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	deliveries := make([]*webhook.Delivery, 0, len(subs))
+	for _, sub := range subs {
+		deliveries = append(deliveries, webhook.NewDelivery(sub, event, payload))
+	}
+	if err := s.repo.EnqueueDeliveries(ctx, deliveries...); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error queuing deliveries")
+		return fmt.Errorf("failed to queue webhook deliveries: %w", err)
+	}
+
+	span.SetAttributes(attribute.Int("delivery_count", len(deliveries)))
+	span.SetStatus(codes.Ok, "deliveries queued")
+	return nil
+}
+
+// DeliverDue attempts a batch of due deliveries and returns how many were
+// attempted. Failed attempts are retried with backoff, and deliveries that
+// keep failing, or whose subscription has been deactivated, are dead-lettered.
+func (s *Service) DeliverDue(ctx context.Context) (int, error) {
+	ctx, span := s.tracer.Start(ctx, "webhook.DeliverDue")
+	defer span.End()
+
+	deliveries, err := s.repo.ClaimDueDeliveries(ctx, deliveryBatchSize, time.Now().Add(deliveryLease))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error claiming deliveries")
+		return 0, fmt.Errorf("failed to claim webhook deliveries: %w", err)
+	}
+
+	subs := make(map[int64]*webhook.Subscription)
+	var (
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, deliveryWorkers)
+	)
+	for _, d := range deliveries {
+		sub, ok := subs[d.SubscriptionID]
+		if !ok {
+			sub, err = s.repo.FindSubscription(ctx, d.SubscriptionID)
+			if err != nil && !errors.Is(err, webhook.ErrSubscriptionNotFound) {
+				s.logger.Error(ctx, "failed to find webhook subscription",
+					"subscription_id", d.SubscriptionID, "error", err)
+				continue
+			}
+			subs[d.SubscriptionID] = sub
+		}
+		// Deliveries of deleted subscriptions are deleted along with them.
+		if sub == nil {
+			continue
+		}
+
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() { <-sem; wg.Done() }()
+			s.deliver(ctx, sub, d)
+		}()
+	}
+	wg.Wait()
+
+	span.SetAttributes(attribute.Int("delivery_count", len(deliveries)))
+	span.SetStatus(codes.Ok, "deliveries attempted")
+	return len(deliveries), nil
+}
+
+// deliver attempts a delivery and records the outcome.
+func (s *Service) deliver(ctx context.Context, sub *webhook.Subscription, d *webhook.Delivery) {
+	logger := s.logger.With("subscription_id", sub.ID, "delivery_id", d.ID, "event_type", d.EventType)
+
+	if !sub.Active {
+		d.DeadLetter("subscription is inactive")
+	} else {
+		now := time.Now()
+		statusCode, err := s.send(ctx, sub, d, now)
+		if err != nil {
+			d.RecordFailure(statusCode, err.Error(), now)
+			logger.Warn(ctx, "webhook delivery failed",
+				"attempts", d.Attempts, "status", d.Status, "error", err)
+		} else {
+			d.RecordSuccess(statusCode, now)
+		}
+	}
+
+	if err := s.repo.UpdateDelivery(ctx, d); err != nil {
+		// The delivery is attempted again once its lease expires.
+		logger.Error(ctx, "failed to record webhook delivery", "error", err)
+	}
+}
+
+// send posts the delivery's payload to the subscription, signed with its
+// secret, and returns the status of the response, if one was received.
+// Responses other than 2xx are errors.
+func (s *Service) send(ctx context.Context, sub *webhook.Subscription, d *webhook.Delivery, at time.Time) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.URL, bytes.NewReader(d.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Event-Type", string(d.EventType))
+	req.Header.Set("X-Event-ID", strconv.FormatInt(d.EventID, 10))
+	req.Header.Set("X-Webhook-Delivery", strconv.FormatInt(d.ID, 10))
+	req.Header.Set(webhook.SignatureHeader, webhook.Sign(sub.Secret, at, d.Payload))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to post event: %w", err)
+	}
+	defer resp.Body.Close()
+	// Drain the body so that the connection can be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("endpoint responded with status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// RunDelivery attempts due deliveries immediately and then every interval until
+// ctx is cancelled. A full batch is followed by another straight away, so that
+// a backlog drains without waiting for the interval.
+func (s *Service) RunDelivery(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			attempted, err := s.DeliverDue(ctx)
+			if err != nil {
+				s.logger.Error(ctx, "failed to deliver webhooks", "error", err)
+			}
+			if err != nil || attempted < deliveryBatchSize || ctx.Err() != nil {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
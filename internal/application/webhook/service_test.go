@@ -0,0 +1,375 @@
+package webhook_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	appWebhook "github.com/ahrav/hoglet-hub/internal/application/webhook"
+	"github.com/ahrav/hoglet-hub/internal/domain/outbox"
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+	"github.com/ahrav/hoglet-hub/internal/domain/webhook"
+	"github.com/ahrav/hoglet-hub/pkg/common/logger"
+)
+
+const testSecret = "0123456789abcdef0123456789abcdef"
+
+// MockWebhookRepo is a testify mock for webhook.Repository.
+type MockWebhookRepo struct{ mock.Mock }
+
+func (m *MockWebhookRepo) CreateSubscription(ctx context.Context, sub *webhook.Subscription) (int64, error) {
+	args := m.Called(ctx, sub)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockWebhookRepo) FindSubscription(ctx context.Context, id int64) (*webhook.Subscription, error) {
+	args := m.Called(ctx, id)
+	sub, _ := args.Get(0).(*webhook.Subscription)
+	return sub, args.Error(1)
+}
+
+func (m *MockWebhookRepo) ListSubscriptions(ctx context.Context, tenantID *int64) ([]*webhook.Subscription, error) {
+	args := m.Called(ctx, tenantID)
+	subs, _ := args.Get(0).([]*webhook.Subscription)
+	return subs, args.Error(1)
+}
+
+func (m *MockWebhookRepo) ListMatchingSubscriptions(
+	ctx context.Context,
+	eventType outbox.EventType,
+	tenantID *int64,
+) ([]*webhook.Subscription, error) {
+	args := m.Called(ctx, eventType, tenantID)
+	subs, _ := args.Get(0).([]*webhook.Subscription)
+	return subs, args.Error(1)
+}
+
+func (m *MockWebhookRepo) UpdateSubscription(ctx context.Context, sub *webhook.Subscription) error {
+	return m.Called(ctx, sub).Error(0)
+}
+
+func (m *MockWebhookRepo) DeleteSubscription(ctx context.Context, id int64) error {
+	return m.Called(ctx, id).Error(0)
+}
+
+func (m *MockWebhookRepo) EnqueueDeliveries(ctx context.Context, deliveries ...*webhook.Delivery) error {
+	return m.Called(ctx, deliveries).Error(0)
+}
+
+func (m *MockWebhookRepo) ClaimDueDeliveries(ctx context.Context, limit int, leaseUntil time.Time) ([]*webhook.Delivery, error) {
+	args := m.Called(ctx, limit, leaseUntil)
+	deliveries, _ := args.Get(0).([]*webhook.Delivery)
+	return deliveries, args.Error(1)
+}
+
+func (m *MockWebhookRepo) FindDelivery(ctx context.Context, id int64) (*webhook.Delivery, error) {
+	args := m.Called(ctx, id)
+	d, _ := args.Get(0).(*webhook.Delivery)
+	return d, args.Error(1)
+}
+
+func (m *MockWebhookRepo) ListDeliveries(ctx context.Context, params webhook.DeliveryListParams) ([]*webhook.Delivery, error) {
+	args := m.Called(ctx, params)
+	deliveries, _ := args.Get(0).([]*webhook.Delivery)
+	return deliveries, args.Error(1)
+}
+
+func (m *MockWebhookRepo) UpdateDelivery(ctx context.Context, d *webhook.Delivery) error {
+	return m.Called(ctx, d).Error(0)
+}
+
+// MockTenantRepo is a testify mock for the parts of tenant.Repository used by the service.
+type MockTenantRepo struct {
+	tenant.Repository
+	mock.Mock
+}
+
+func (m *MockTenantRepo) FindByID(ctx context.Context, id int64) (*tenant.Tenant, error) {
+	args := m.Called(ctx, id)
+	t, _ := args.Get(0).(*tenant.Tenant)
+	return t, args.Error(1)
+}
+
+// newService delivers with a plain client: the test endpoints listen on
+// loopback, which the default delivery client refuses to connect to.
+func newService(repo *MockWebhookRepo, tenants *MockTenantRepo) *appWebhook.Service {
+	return appWebhook.NewService(repo, tenants, new(http.Client), logger.Noop(), noop.NewTracerProvider().Tracer("test"))
+}
+
+func TestService_CreateSubscription(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("generates a secret", func(t *testing.T) {
+		repo := new(MockWebhookRepo)
+		repo.On("CreateSubscription", mock.Anything, mock.MatchedBy(func(sub *webhook.Subscription) bool {
+			return sub.URL == "https://billing.example.com/hooks" && len(sub.Secret) == 64 &&
+				sub.CreatedBy == "ops@example.com" && sub.Active
+		})).Return(int64(4), nil)
+
+		sub, err := newService(repo, new(MockTenantRepo)).CreateSubscription(ctx, appWebhook.CreateParams{
+			URL:        "https://billing.example.com/hooks",
+			EventTypes: []outbox.EventType{outbox.EventTenantActivated},
+		}, "ops@example.com")
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), sub.ID)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("unknown tenant", func(t *testing.T) {
+		repo, tenants := new(MockWebhookRepo), new(MockTenantRepo)
+		tenants.On("FindByID", mock.Anything, int64(9)).Return(nil, tenant.ErrTenantNotFound)
+		tenantID := int64(9)
+
+		_, err := newService(repo, tenants).CreateSubscription(ctx, appWebhook.CreateParams{
+			URL:      "https://billing.example.com/hooks",
+			TenantID: &tenantID,
+		}, "ops@example.com")
+		assert.ErrorIs(t, err, tenant.ErrTenantNotFound)
+		repo.AssertNotCalled(t, "CreateSubscription", mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid event type", func(t *testing.T) {
+		repo := new(MockWebhookRepo)
+		_, err := newService(repo, new(MockTenantRepo)).CreateSubscription(ctx, appWebhook.CreateParams{
+			URL:        "https://billing.example.com/hooks",
+			EventTypes: []outbox.EventType{"tenant.renamed"},
+		}, "ops@example.com")
+		assert.ErrorIs(t, err, webhook.ErrInvalidEventType)
+	})
+}
+
+func TestService_UpdateSubscription(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockWebhookRepo)
+	repo.On("FindSubscription", mock.Anything, int64(4)).
+		Return(&webhook.Subscription{ID: 4, URL: "https://example.com", Secret: testSecret, Active: true}, nil)
+	repo.On("UpdateSubscription", mock.Anything, mock.MatchedBy(func(sub *webhook.Subscription) bool {
+		return sub.ID == 4 && !sub.Active
+	})).Return(nil)
+
+	active := false
+	sub, err := newService(repo, new(MockTenantRepo)).UpdateSubscription(ctx, 4, webhook.Update{Active: &active})
+	require.NoError(t, err)
+	assert.False(t, sub.Active)
+	assert.NotNil(t, sub.UpdatedAt)
+	repo.AssertExpectations(t)
+}
+
+func TestService_Publish(t *testing.T) {
+	ctx := context.Background()
+	tenantID := int64(1)
+	event := &outbox.Event{ID: 11, Type: outbox.EventTenantActivated, TenantID: &tenantID}
+
+	t.Run("queues a delivery per matching subscription", func(t *testing.T) {
+		repo := new(MockWebhookRepo)
+		repo.On("ListMatchingSubscriptions", mock.Anything, outbox.EventTenantActivated, &tenantID).
+			Return([]*webhook.Subscription{{ID: 2}, {ID: 3}}, nil)
+		repo.On("EnqueueDeliveries", mock.Anything, mock.MatchedBy(func(ds []*webhook.Delivery) bool {
+			return len(ds) == 2 && ds[0].SubscriptionID == 2 && ds[1].SubscriptionID == 3 &&
+				ds[0].EventID == 11 && string(ds[0].Payload) != ""
+		})).Return(nil)
+
+		require.NoError(t, newService(repo, new(MockTenantRepo)).Publish(ctx, event))
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("no subscriptions", func(t *testing.T) {
+		repo := new(MockWebhookRepo)
+		repo.On("ListMatchingSubscriptions", mock.Anything, outbox.EventTenantActivated, &tenantID).Return(nil, nil)
+
+		require.NoError(t, newService(repo, new(MockTenantRepo)).Publish(ctx, event))
+		repo.AssertNotCalled(t, "EnqueueDeliveries", mock.Anything, mock.Anything)
+	})
+}
+
+// endpoint is a webhook receiver recording the requests it is sent.
+type endpoint struct {
+	mu       sync.Mutex
+	status   int
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func (e *endpoint) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.requests = append(e.requests, r)
+	e.bodies = append(e.bodies, body)
+	w.WriteHeader(e.status)
+}
+
+func TestService_DeliverDue(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(status int, active bool) (*MockWebhookRepo, *endpoint, *webhook.Delivery) {
+		ep := &endpoint{status: status}
+		srv := httptest.NewServer(ep)
+		t.Cleanup(srv.Close)
+
+		sub := &webhook.Subscription{ID: 2, URL: srv.URL, Secret: testSecret, Active: active}
+		d := &webhook.Delivery{
+			ID:             5,
+			SubscriptionID: 2,
+			EventID:        11,
+			EventType:      outbox.EventTenantActivated,
+			Payload:        []byte(`{"id":11,"type":"tenant.activated"}`),
+			Status:         webhook.DeliveryPending,
+		}
+
+		repo := new(MockWebhookRepo)
+		repo.On("ClaimDueDeliveries", mock.Anything, mock.Anything, mock.Anything).Return([]*webhook.Delivery{d}, nil)
+		repo.On("FindSubscription", mock.Anything, int64(2)).Return(sub, nil)
+		repo.On("UpdateDelivery", mock.Anything, d).Return(nil)
+		return repo, ep, d
+	}
+
+	t.Run("signed delivery succeeds", func(t *testing.T) {
+		repo, ep, d := setup(http.StatusNoContent, true)
+
+		attempted, err := newService(repo, new(MockTenantRepo)).DeliverDue(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, attempted)
+
+		require.Len(t, ep.requests, 1)
+		req := ep.requests[0]
+		assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+		assert.Equal(t, "tenant.activated", req.Header.Get("X-Event-Type"))
+		assert.Equal(t, "11", req.Header.Get("X-Event-ID"))
+		assert.Equal(t, "5", req.Header.Get("X-Webhook-Delivery"))
+		assert.Equal(t, d.Payload, ep.bodies[0])
+		assert.NoError(t, webhook.Verify(testSecret, req.Header.Get(webhook.SignatureHeader), ep.bodies[0],
+			time.Now(), time.Minute))
+
+		assert.Equal(t, webhook.DeliverySucceeded, d.Status)
+		assert.Equal(t, 204, *d.ResponseStatus)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("failed delivery is retried", func(t *testing.T) {
+		repo, _, d := setup(http.StatusServiceUnavailable, true)
+
+		_, err := newService(repo, new(MockTenantRepo)).DeliverDue(ctx)
+		require.NoError(t, err)
+
+		assert.Equal(t, webhook.DeliveryPending, d.Status)
+		assert.Equal(t, 1, d.Attempts)
+		assert.Equal(t, 503, *d.ResponseStatus)
+		assert.Contains(t, *d.LastError, "503")
+		assert.True(t, d.NextAttemptAt.After(time.Now()))
+	})
+
+	t.Run("last failed attempt dead-letters", func(t *testing.T) {
+		repo, _, d := setup(http.StatusInternalServerError, true)
+		d.Attempts = webhook.MaxAttempts - 1
+
+		_, err := newService(repo, new(MockTenantRepo)).DeliverDue(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, webhook.DeliveryDeadLetter, d.Status)
+	})
+
+	t.Run("inactive subscription dead-letters", func(t *testing.T) {
+		repo, ep, d := setup(http.StatusNoContent, false)
+
+		_, err := newService(repo, new(MockTenantRepo)).DeliverDue(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, ep.requests)
+		assert.Equal(t, webhook.DeliveryDeadLetter, d.Status)
+	})
+}
+
+func TestNewDeliveryClient(t *testing.T) {
+	t.Run("refuses internal addresses", func(t *testing.T) {
+		ep := &endpoint{status: http.StatusNoContent}
+		srv := httptest.NewServer(ep)
+		t.Cleanup(srv.Close)
+
+		_, err := appWebhook.NewDeliveryClient(time.Second).Get(srv.URL)
+		require.ErrorIs(t, err, webhook.ErrAddressNotAllowed)
+		assert.Empty(t, ep.requests)
+	})
+
+	t.Run("does not follow redirects", func(t *testing.T) {
+		target := &endpoint{status: http.StatusNoContent}
+		targetSrv := httptest.NewServer(target)
+		t.Cleanup(targetSrv.Close)
+		srv := httptest.NewServer(http.RedirectHandler(targetSrv.URL, http.StatusFound))
+		t.Cleanup(srv.Close)
+
+		client := appWebhook.NewDeliveryClient(time.Second)
+		client.Transport = http.DefaultTransport // Reach the loopback test servers.
+
+		resp, err := client.Get(srv.URL)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusFound, resp.StatusCode)
+		assert.Empty(t, target.requests)
+	})
+}
+
+func TestService_Redeliver(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("dead-lettered delivery", func(t *testing.T) {
+		repo := new(MockWebhookRepo)
+		d := &webhook.Delivery{ID: 5, SubscriptionID: 2, Status: webhook.DeliveryDeadLetter, Attempts: webhook.MaxAttempts}
+		repo.On("FindDelivery", mock.Anything, int64(5)).Return(d, nil)
+		repo.On("UpdateDelivery", mock.Anything, d).Return(nil)
+
+		got, err := newService(repo, new(MockTenantRepo)).Redeliver(ctx, 2, 5)
+		require.NoError(t, err)
+		assert.Equal(t, webhook.DeliveryPending, got.Status)
+		assert.Zero(t, got.Attempts)
+	})
+
+	t.Run("delivery of another subscription", func(t *testing.T) {
+		repo := new(MockWebhookRepo)
+		repo.On("FindDelivery", mock.Anything, int64(5)).
+			Return(&webhook.Delivery{ID: 5, SubscriptionID: 3, Status: webhook.DeliveryDeadLetter}, nil)
+
+		_, err := newService(repo, new(MockTenantRepo)).Redeliver(ctx, 2, 5)
+		assert.ErrorIs(t, err, webhook.ErrDeliveryNotFound)
+	})
+
+	t.Run("pending delivery", func(t *testing.T) {
+		repo := new(MockWebhookRepo)
+		repo.On("FindDelivery", mock.Anything, int64(5)).
+			Return(&webhook.Delivery{ID: 5, SubscriptionID: 2, Status: webhook.DeliveryPending}, nil)
+
+		_, err := newService(repo, new(MockTenantRepo)).Redeliver(ctx, 2, 5)
+		assert.ErrorIs(t, err, webhook.ErrDeliveryPending)
+	})
+}
+
+func TestService_ListDeliveries(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockWebhookRepo)
+	repo.On("FindSubscription", mock.Anything, int64(2)).Return(&webhook.Subscription{ID: 2}, nil)
+	repo.On("ListDeliveries", mock.Anything, webhook.DeliveryListParams{SubscriptionID: 2, Limit: 3}).
+		Return([]*webhook.Delivery{{ID: 9}, {ID: 8}, {ID: 7}}, nil)
+	repo.On("ListDeliveries", mock.Anything, webhook.DeliveryListParams{SubscriptionID: 2, BeforeID: 8, Limit: 3}).
+		Return([]*webhook.Delivery{{ID: 7}}, nil)
+	svc := newService(repo, new(MockTenantRepo))
+
+	page, err := svc.ListDeliveries(ctx, appWebhook.DeliveryListParams{SubscriptionID: 2, Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, page.Deliveries, 2)
+	require.NotEmpty(t, page.NextCursor)
+
+	page, err = svc.ListDeliveries(ctx, appWebhook.DeliveryListParams{SubscriptionID: 2, Limit: 2, Cursor: page.NextCursor})
+	require.NoError(t, err)
+	require.Len(t, page.Deliveries, 1)
+	assert.Empty(t, page.NextCursor)
+
+	_, err = svc.ListDeliveries(ctx, appWebhook.DeliveryListParams{SubscriptionID: 2, Cursor: "garbage"})
+	assert.ErrorIs(t, err, webhook.ErrInvalidListParams)
+}
@@ -0,0 +1,22 @@
+package workflow
+
+import (
+	"context"
+	"fmt"
+)
+
+// CancellationError is the cause attached to a workflow context when an operator
+// cancels the workflow, as opposed to it timing out or the process shutting down.
+// It unwraps to context.Canceled so it is treated like any other cancellation.
+type CancellationError struct {
+	Reason string
+	Actor  string
+}
+
+// Error implements the error interface.
+func (e *CancellationError) Error() string {
+	return fmt.Sprintf("cancelled by %s: %s", e.Actor, e.Reason)
+}
+
+// Unwrap reports the cancellation as context.Canceled.
+func (e *CancellationError) Unwrap() error { return context.Canceled }
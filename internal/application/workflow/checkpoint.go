@@ -0,0 +1,82 @@
+package workflow
+
+import (
+	"context"
+	"time"
+
+	"github.com/ahrav/hoglet-hub/internal/domain/operation"
+)
+
+// checkpointTimeout bounds how long recording a step outcome may take once the
+// workflow context is no longer usable.
+const checkpointTimeout = 5 * time.Second
+
+// Checkpointer persists workflow step progress so that a workflow interrupted
+// mid-flight (e.g. by a process restart) can later resume from its last
+// completed step instead of starting over.
+type Checkpointer interface {
+	// CompletedSteps returns the names of the steps that already completed in a previous run.
+	CompletedSteps(ctx context.Context) (map[string]bool, error)
+
+	// StepStarted records that the step at the given index has begun executing.
+	StepStarted(ctx context.Context, index int, name string) error
+
+	// StepFinished records the outcome of the step at the given index.
+	StepFinished(ctx context.Context, index int, result StepResult) error
+
+	// StepCompensated records that the completed step at the given index was undone
+	// by its compensation, so it no longer counts as completed.
+	StepCompensated(ctx context.Context, index int, name string) error
+}
+
+var _ Checkpointer = (*OperationCheckpointer)(nil)
+
+// OperationCheckpointer is a Checkpointer that stores step progress as step
+// states tied to an operation.
+type OperationCheckpointer struct {
+	operationID int64
+	repo        operation.StepRepository
+}
+
+// NewOperationCheckpointer creates a checkpointer for the given operation.
+func NewOperationCheckpointer(operationID int64, repo operation.StepRepository) *OperationCheckpointer {
+	return &OperationCheckpointer{operationID: operationID, repo: repo}
+}
+
+// CompletedSteps returns the names of the operation's steps that have completed.
+func (c *OperationCheckpointer) CompletedSteps(ctx context.Context) (map[string]bool, error) {
+	steps, err := c.repo.FindSteps(ctx, c.operationID)
+	if err != nil {
+		return nil, err
+	}
+
+	completed := make(map[string]bool, len(steps))
+	for _, step := range steps {
+		if step.IsCompleted() {
+			completed[step.Name] = true
+		}
+	}
+	return completed, nil
+}
+
+// StepStarted records that a step of the operation has begun executing.
+func (c *OperationCheckpointer) StepStarted(ctx context.Context, index int, name string) error {
+	return c.repo.StartStep(ctx, operation.NewStepState(c.operationID, index, name))
+}
+
+// StepFinished records the outcome of a step of the operation.
+func (c *OperationCheckpointer) StepFinished(ctx context.Context, index int, result StepResult) error {
+	step := operation.NewStepState(c.operationID, index, result.StepName)
+	step.StartedAt = result.StartedAt
+	if result.Success {
+		step.Complete()
+	} else {
+		step.Fail(result.Error.Error())
+	}
+	return c.repo.FinishStep(ctx, step)
+}
+
+// StepCompensated records that a completed step of the operation was undone.
+func (c *OperationCheckpointer) StepCompensated(ctx context.Context, _ int, name string) error {
+	return c.repo.CompensateStep(ctx, c.operationID, name)
+}
@@ -0,0 +1,105 @@
+package workflow
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidStepGraph is returned when step dependencies do not form a valid
+// directed acyclic graph.
+var ErrInvalidStepGraph = errors.New("invalid step graph")
+
+// DefaultMaxParallelSteps is the default limit on how many independent steps a
+// DAG workflow executes at once.
+const DefaultMaxParallelSteps = 4
+
+// NewDAGWorkflow creates a workflow whose steps declare their dependencies through
+// Step.DependsOn. A step starts as soon as every step it depends on has completed,
+// so independent steps run concurrently, bounded by maxParallel.
+//
+// The workflow still delivers a single result through ResultChan, with one
+// StepResult per executed step in the order the steps finished.
+func NewDAGWorkflow(steps []Step, maxParallel int) (*BaseWorkflow, error) {
+	deps, err := buildStepGraph(steps)
+	if err != nil {
+		return nil, err
+	}
+
+	w := NewBaseWorkflow(steps)
+	w.deps = deps
+	w.maxParallel = max(maxParallel, 1)
+	return w, nil
+}
+
+// sequentialDependencies makes every step depend on the one before it, which
+// reproduces strictly sequential execution.
+func sequentialDependencies(steps []Step) [][]int {
+	deps := make([][]int, len(steps))
+	for i := 1; i < len(steps); i++ {
+		deps[i] = []int{i - 1}
+	}
+	return deps
+}
+
+// buildStepGraph resolves step dependencies by name into indexes and verifies
+// that names are unique, that every dependency exists, and that there are no cycles.
+func buildStepGraph(steps []Step) ([][]int, error) {
+	index := make(map[string]int, len(steps))
+	for i, step := range steps {
+		if _, ok := index[step.Name]; ok {
+			return nil, fmt.Errorf("%w: duplicate step %q", ErrInvalidStepGraph, step.Name)
+		}
+		index[step.Name] = i
+	}
+
+	deps := make([][]int, len(steps))
+	for i, step := range steps {
+		for _, name := range step.DependsOn {
+			dep, ok := index[name]
+			if !ok {
+				return nil, fmt.Errorf("%w: step %q depends on unknown step %q", ErrInvalidStepGraph, step.Name, name)
+			}
+			deps[i] = append(deps[i], dep)
+		}
+	}
+
+	if hasCycle(deps) {
+		return nil, fmt.Errorf("%w: dependency cycle", ErrInvalidStepGraph)
+	}
+
+	return deps, nil
+}
+
+// hasCycle reports whether the dependency graph contains a cycle by attempting
+// a topological ordering of it.
+func hasCycle(deps [][]int) bool {
+	pending := make([]int, len(deps))
+	dependents := make([][]int, len(deps))
+	for i, stepDeps := range deps {
+		pending[i] = len(stepDeps)
+		for _, dep := range stepDeps {
+			dependents[dep] = append(dependents[dep], i)
+		}
+	}
+
+	var ready []int
+	for i, n := range pending {
+		if n == 0 {
+			ready = append(ready, i)
+		}
+	}
+
+	visited := 0
+	for len(ready) > 0 {
+		i := ready[0]
+		ready = ready[1:]
+		visited++
+		for _, dependent := range dependents[i] {
+			if pending[dependent]--; pending[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+
+	return visited != len(deps)
+}
@@ -16,8 +16,9 @@ type ProvisioningMetrics interface {
 	// ObserveProvisioningDuration records how long it took to provision a tenant.
 	ObserveProvisioningDuration(ctx context.Context, tenantTier string, region string, duration time.Duration)
 
-	// ObserveProvisioningStageDuration records how long a specific provisioning stage took.
-	ObserveProvisioningStageDuration(ctx context.Context, stage string, tenantTier string, region string, duration time.Duration)
+	// ObserveProvisioningStageDuration records how long a specific provisioning stage took,
+	// including how many attempts it needed so that flaky stages can be identified.
+	ObserveProvisioningStageDuration(ctx context.Context, stage string, tenantTier string, region string, duration time.Duration, attempts int)
 
 	// SetConcurrentProvisioningOps sets the number of concurrent provisioning operations.
 	// SetConcurrentProvisioningOps(ctx context.Context, count int)
@@ -0,0 +1,71 @@
+package workflow
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+// RetryPolicy controls how a failing step is retried before the workflow gives up on it.
+// A step without a policy is attempted exactly once.
+type RetryPolicy struct {
+	MaxAttempts    int           // Total attempts including the first; values below 1 are treated as 1
+	InitialBackoff time.Duration // Delay before the first retry
+	MaxBackoff     time.Duration // Upper bound on the delay between attempts; zero means unbounded
+	Multiplier     float64       // Growth factor applied to the delay after each retry; values below 1 are treated as 1
+	Jitter         float64       // Fraction of the delay randomized in either direction, between 0 and 1
+
+	// Retryable reports whether an error is transient and worth retrying.
+	// When nil, every error except context cancellation and deadline expiry is retried.
+	Retryable func(error) bool
+}
+
+// DefaultRetryPolicy returns a policy suited to steps that talk to databases and
+// external services, where most failures are short-lived.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 200 * time.Millisecond,
+		MaxBackoff:     5 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+	}
+}
+
+// attempts returns the total number of attempts allowed by the policy.
+func (p *RetryPolicy) attempts() int {
+	if p == nil || p.MaxAttempts < 1 {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+// shouldRetry reports whether err warrants another attempt. Context errors are
+// never retried since the workflow itself is being torn down.
+func (p *RetryPolicy) shouldRetry(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if p == nil || p.Retryable == nil {
+		return true
+	}
+	return p.Retryable(err)
+}
+
+// Backoff returns the delay to wait after the given failed attempt (starting at 1)
+// before making the next one.
+func (p *RetryPolicy) Backoff(attempt int) time.Duration {
+	multiplier := math.Max(p.Multiplier, 1)
+	delay := float64(p.InitialBackoff) * math.Pow(multiplier, float64(max(attempt-1, 0)))
+	if p.MaxBackoff > 0 {
+		delay = math.Min(delay, float64(p.MaxBackoff))
+	}
+
+	if jitter := math.Min(math.Max(p.Jitter, 0), 1); jitter > 0 {
+		delay *= 1 - jitter + 2*jitter*rand.Float64()
+	}
+
+	return time.Duration(delay)
+}
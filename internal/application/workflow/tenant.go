@@ -2,14 +2,21 @@ package workflow
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
 	"time"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 
+	"github.com/ahrav/hoglet-hub/internal/domain/audit"
+	"github.com/ahrav/hoglet-hub/internal/domain/dbnode"
 	"github.com/ahrav/hoglet-hub/internal/domain/operation"
+	"github.com/ahrav/hoglet-hub/internal/domain/resource"
 	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
 	"github.com/ahrav/hoglet-hub/pkg/common/logger"
 )
@@ -27,8 +34,24 @@ const (
 	// This operation handles the decommissioning of tenant resources.
 	OperationTypeDelete OperationType = "delete"
 
+	// OperationTypeUpgrade represents tenant tier change operations, in either direction.
+	// This operation handles resizing tenant resources and quotas for the new tier.
+	OperationTypeUpgrade OperationType = "upgrade"
+
+	// OperationTypeMigrate represents moving a tenant to another region.
+	// This operation runs in phases and can be paused between any two of them.
+	OperationTypeMigrate OperationType = "migrate"
+
+	// OperationTypeSuspend represents suspending an active tenant.
+	// This operation handles disabling access and scaling tenant resources down.
+	OperationTypeSuspend OperationType = "suspend"
+
+	// OperationTypeResume represents resuming a suspended tenant.
+	// This operation handles scaling tenant resources back up and restoring access.
+	OperationTypeResume OperationType = "resume"
+
 	// TODO: Keep going...
-	// Additional operation types like Update, Upgrade, Migrate can be added here
+	// Additional operation types like Update can be added here
 	// without modifying existing workflow implementations.
 )
 
@@ -41,8 +64,20 @@ type TenantOperationWorkflow struct {
 	tenant        *tenant.Tenant
 	tenantID      int64
 	operation     *operation.Operation
+	sourceTier    tenant.Tier   // Tier before a tier change; unset for other operations
+	targetTier    tenant.Tier   // Tier after a tier change; unset for other operations
+	sourceRegion  tenant.Region // Region before a migration; unset for other operations
+	targetRegion  tenant.Region // Region after a migration; unset for other operations
+	reason        string        // Why the tenant is suspended or resumed; unset for other operations
+	requestedBy   string        // Who requested the suspension or resumption; unset for other operations
 	tenantRepo    tenant.Repository
 	operationRepo operation.Repository
+	stepRepo      operation.StepRepository
+	resourceRepo  resource.Repository
+	quotas        QuotaReserver
+	placer        NodePlacer
+	audit         AuditRecorder
+	transactor    Transactor
 
 	logger  *logger.Logger
 	tracer  trace.Tracer
@@ -65,9 +100,78 @@ type TenantOperationConfig struct {
 	// Repositories
 	TenantRepo    tenant.Repository
 	OperationRepo operation.Repository
+
+	// StepRepo enables durable step checkpoints when non-nil, allowing the
+	// workflow to be resumed after a process restart.
+	StepRepo operation.StepRepository
+
+	// ResourceRepo records the resources created for the tenant when non-nil, and
+	// deletion removes the resources recorded there.
+	ResourceRepo resource.Repository
+
+	// Quotas reserves resource quota for created tenants when non-nil. Without it,
+	// resources are created in the region's default project without quota checks.
+	Quotas QuotaReserver
+
+	// Audit records the outcome of each operation in the audit trail when non-nil.
+	Audit AuditRecorder
+
+	// Placer assigns the tenant's database to a node when non-nil. Without it,
+	// tenants are provisioned without a primary node.
+	Placer NodePlacer
+
+	// Transactor makes the writes of a step that belong together atomic when
+	// non-nil. Without it, they are made one after another.
+	Transactor Transactor
+}
+
+// QuotaReserver tracks the resource quota that tenants consume in each project.
+type QuotaReserver interface {
+	// Reserve atomically reserves the footprint in the preferred project, spilling
+	// over to another project in the region if it is full, and returns the project
+	// reserved in. Returns resource.ErrQuotaExhausted if every project is full.
+	Reserve(ctx context.Context, preferred string, region tenant.Region, footprint resource.Footprint) (string, error)
+
+	// Release returns the footprint's quota to the project.
+	Release(ctx context.Context, projectID string, region tenant.Region, footprint resource.Footprint) error
+}
+
+// AuditRecorder appends entries to the audit trail.
+type AuditRecorder interface {
+	// Record completes the entry with the outcome and records it. Failures to
+	// record are handled by the recorder and do not affect the workflow.
+	Record(ctx context.Context, e *audit.Entry, outcome error)
 }
 
-// NewTenantOperationWorkflow creates a new workflow for tenant operations (create/delete).
+// NodePlacer assigns tenants to the database nodes that host their schemas.
+type NodePlacer interface {
+	// Place reserves capacity for the tenant on a node and makes it the tenant's
+	// primary node. A tenant that already has a primary node keeps it.
+	Place(ctx context.Context, t *tenant.Tenant) (*dbnode.Node, error)
+
+	// Release frees the capacity reserved for the tenant on its primary node.
+	Release(ctx context.Context, tenantID int64) error
+}
+
+// Transactor runs units of work atomically. The repositories take part in the
+// unit of work when called with the context passed to it.
+type Transactor interface {
+	// WithinTransaction runs fn in a transaction that is committed if fn returns
+	// nil and rolled back otherwise.
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// DefaultHeartbeatInterval is how often a running tenant workflow refreshes its
+// operation to signal that it is still alive. Recovery only claims operations
+// that have gone several intervals without an update.
+const DefaultHeartbeatInterval = 30 * time.Second
+
+// DefaultCancelGracePeriod is how long a running tenant workflow step may keep
+// going after the operation is cancelled before the workflow abandons it.
+const DefaultCancelGracePeriod = 5 * time.Second
+
+// NewTenantOperationWorkflow creates a new workflow for tenant operations
+// (create/delete/upgrade/migrate/suspend/resume).
 // This factory function dynamically constructs the appropriate workflow based on the operation type.
 func NewTenantOperationWorkflow(
 	cfg TenantOperationConfig,
@@ -82,10 +186,28 @@ func NewTenantOperationWorkflow(
 		operation:     cfg.Operation,
 		tenantRepo:    cfg.TenantRepo,
 		operationRepo: cfg.OperationRepo,
+		stepRepo:      cfg.StepRepo,
+		resourceRepo:  cfg.ResourceRepo,
+		quotas:        cfg.Quotas,
+		placer:        cfg.Placer,
+		audit:         cfg.Audit,
+		transactor:    cfg.Transactor,
 		tracer:        tracer,
 		metrics:       metrics,
 	}
 
+	// Every step talks to a database or an external service, so they share a retry
+	// policy that rides out brief outages. A missing tenant will not reappear on retry,
+	// a status change the tenant lifecycle rejects will be rejected again, and neither
+	// node capacity nor project quota is freed on the timescale of a retry.
+	retry := DefaultRetryPolicy()
+	retry.Retryable = func(err error) bool {
+		return !errors.Is(err, tenant.ErrTenantNotFound) &&
+			!errors.Is(err, tenant.ErrInvalidTransition) &&
+			!errors.Is(err, dbnode.ErrNoCapacity) &&
+			!errors.Is(err, resource.ErrQuotaExhausted)
+	}
+
 	// Define steps based on operation type.
 	var steps []Step
 	var componentName string // Used for tracing and logging.
@@ -98,26 +220,39 @@ func NewTenantOperationWorkflow(
 				Name:        "initialize",
 				Description: "Initialize tenant resources",
 				Execute:     workflow.initializeTenant,
+				Compensate:  workflow.releaseTenant,
+				Retry:       retry,
 			},
 			{
 				Name:        "provision-database",
 				Description: "Provision tenant database schema",
 				Execute:     workflow.provisionDatabase,
+				Compensate:  workflow.dropDatabase,
+				Retry:       retry,
+				DependsOn:   []string{"initialize"},
 			},
 			{
 				Name:        "setup-secrets",
 				Description: "Set up tenant secrets",
 				Execute:     workflow.setupSecrets,
+				Compensate:  workflow.removeSecrets,
+				Retry:       retry,
+				DependsOn:   []string{"initialize"},
 			},
 			{
 				Name:        "deploy-resources",
 				Description: "Deploy tenant resources",
 				Execute:     workflow.deployResources,
+				Compensate:  workflow.undeployResources,
+				Retry:       retry,
+				DependsOn:   []string{"provision-database", "setup-secrets"},
 			},
 			{
 				Name:        "finalize",
 				Description: "Finalize tenant creation",
 				Execute:     workflow.finalizeTenant,
+				Retry:       retry,
+				DependsOn:   []string{"deploy-resources"},
 			},
 		}
 	case OperationTypeDelete:
@@ -127,33 +262,212 @@ func NewTenantOperationWorkflow(
 				Name:        "deactivate",
 				Description: "Deactivate tenant",
 				Execute:     workflow.deactivateTenant,
+				Retry:       retry,
 			},
 			{
 				Name:        "remove-resources",
 				Description: "Remove tenant resources",
 				Execute:     workflow.removeResources,
+				Retry:       retry,
+				DependsOn:   []string{"deactivate"},
 			},
 			{
 				Name:        "cleanup-secrets",
 				Description: "Clean up tenant secrets",
 				Execute:     workflow.cleanupSecrets,
+				Retry:       retry,
+				DependsOn:   []string{"remove-resources"},
 			},
 			{
 				Name:        "remove-database",
 				Description: "Remove tenant database schema",
 				Execute:     workflow.removeDatabase,
+				Retry:       retry,
+				DependsOn:   []string{"remove-resources"},
 			},
 			{
 				Name:        "finalize",
 				Description: "Finalize tenant deletion",
 				Execute:     workflow.finalizeDeletion,
+				Retry:       retry,
+				DependsOn:   []string{"cleanup-secrets", "remove-database"},
+			},
+		}
+	case OperationTypeUpgrade:
+		componentName = "tenant_tier_change_workflow"
+
+		// The tiers come from the operation rather than the tenant so that a resumed
+		// or retried change still knows where it started and where it is going.
+		from, _ := cfg.Operation.Parameters["from_tier"].(string)
+		to, _ := cfg.Operation.Parameters["to_tier"].(string)
+		if from == "" || to == "" {
+			return nil, fmt.Errorf("tier change operation (%d) is missing its source or target tier", cfg.Operation.ID)
+		}
+		workflow.sourceTier = tenant.Tier(from)
+		workflow.targetTier = tenant.Tier(to)
+
+		resize := Step{
+			Name:        "resize-resources",
+			Description: "Resize tenant resources for the new tier",
+			Execute:     workflow.resizeResources,
+			Compensate:  workflow.restoreResourceSize,
+			Retry:       retry,
+		}
+		quotas := Step{
+			Name:        "update-quotas",
+			Description: "Update tenant quotas for the new tier",
+			Execute:     workflow.updateQuotas,
+			Compensate:  workflow.restoreQuotas,
+			Retry:       retry,
+		}
+
+		// Upgrades grow resources before raising quotas, and downgrades lower quotas
+		// before shrinking resources, so usage never exceeds provisioned capacity.
+		first, second := &resize, &quotas
+		if tenant.IsDowngrade(workflow.sourceTier, workflow.targetTier) {
+			first, second = &quotas, &resize
+		}
+		second.DependsOn = []string{first.Name}
+
+		steps = []Step{
+			*first,
+			*second,
+			{
+				Name:        "finalize",
+				Description: "Finalize tier change",
+				Execute:     workflow.finalizeTierChange,
+				Retry:       retry,
+				DependsOn:   []string{second.Name},
+			},
+		}
+	case OperationTypeMigrate:
+		componentName = "tenant_migration_workflow"
+
+		from, _ := cfg.Operation.Parameters["from_region"].(string)
+		to, _ := cfg.Operation.Parameters["to_region"].(string)
+		if from == "" || to == "" {
+			return nil, fmt.Errorf("migration operation (%d) is missing its source or target region", cfg.Operation.ID)
+		}
+		workflow.sourceRegion = tenant.Region(from)
+		workflow.targetRegion = tenant.Region(to)
+
+		// Each phase depends on the previous one, so the migration can be paused
+		// cleanly between any two phases and resumed from the next.
+		steps = []Step{
+			{
+				Name:        "provision-target",
+				Description: "Provision tenant resources in the target region",
+				Execute:     workflow.migrationPhase("provision-target", workflow.provisionTarget),
+				Compensate:  workflow.decommissionTarget,
+				Retry:       retry,
+			},
+			{
+				Name:        "copy-data",
+				Description: "Copy tenant data to the target region",
+				Execute:     workflow.migrationPhase("copy-data", workflow.copyData),
+				Retry:       retry,
+				DependsOn:   []string{"provision-target"},
+			},
+			{
+				Name:        "cut-over",
+				Description: "Switch tenant traffic to the target region",
+				Execute:     workflow.migrationPhase("cut-over", workflow.cutOver),
+				Compensate:  workflow.revertCutOver,
+				Retry:       retry,
+				DependsOn:   []string{"copy-data"},
+			},
+			{
+				Name:        "drain-source",
+				Description: "Drain remaining connections from the source region",
+				Execute:     workflow.migrationPhase("drain-source", workflow.drainSource),
+				Compensate:  workflow.restoreSource,
+				Retry:       retry,
+				DependsOn:   []string{"cut-over"},
+			},
+			{
+				Name:        "decommission-source",
+				Description: "Decommission tenant resources in the source region",
+				Execute:     workflow.migrationPhase("decommission-source", workflow.decommissionSource),
+				Retry:       retry,
+				DependsOn:   []string{"drain-source"},
+			},
+		}
+	case OperationTypeSuspend:
+		componentName = "tenant_suspension_workflow"
+		if err := workflow.parseStateChange(cfg.Operation); err != nil {
+			return nil, err
+		}
+
+		// Access is cut off before resources shrink so that no request is served
+		// by a tenant that is being scaled down underneath it.
+		steps = []Step{
+			{
+				Name:        "disable-access",
+				Description: "Disable access to the tenant",
+				Execute:     workflow.disableAccess,
+				Compensate:  workflow.enableAccess,
+				Retry:       retry,
+			},
+			{
+				Name:        "scale-down-resources",
+				Description: "Scale tenant resources down",
+				Execute:     workflow.scaleDownResources,
+				Compensate:  workflow.scaleUpResources,
+				Retry:       retry,
+				DependsOn:   []string{"disable-access"},
+			},
+			{
+				Name:        "finalize",
+				Description: "Finalize tenant suspension",
+				Execute:     workflow.finalizeSuspension,
+				Retry:       retry,
+				DependsOn:   []string{"scale-down-resources"},
+			},
+		}
+	case OperationTypeResume:
+		componentName = "tenant_resumption_workflow"
+		if err := workflow.parseStateChange(cfg.Operation); err != nil {
+			return nil, err
+		}
+
+		// Resources are back at full size before access is restored.
+		steps = []Step{
+			{
+				Name:        "scale-up-resources",
+				Description: "Scale tenant resources back up",
+				Execute:     workflow.scaleUpResources,
+				Compensate:  workflow.scaleDownResources,
+				Retry:       retry,
+			},
+			{
+				Name:        "enable-access",
+				Description: "Restore access to the tenant",
+				Execute:     workflow.enableAccess,
+				Compensate:  workflow.disableAccess,
+				Retry:       retry,
+				DependsOn:   []string{"scale-up-resources"},
+			},
+			{
+				Name:        "finalize",
+				Description: "Finalize tenant resumption",
+				Execute:     workflow.finalizeResumption,
+				Retry:       retry,
+				DependsOn:   []string{"enable-access"},
 			},
 		}
 	default:
 		return nil, fmt.Errorf("HOW! invalid operation type: %s", cfg.OperationType)
 	}
 
-	workflow.BaseWorkflow = NewBaseWorkflow(steps)
+	base, err := NewDAGWorkflow(steps, DefaultMaxParallelSteps)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s workflow definition: %w", cfg.OperationType, err)
+	}
+	workflow.BaseWorkflow = base
+	workflow.SetCancelGracePeriod(DefaultCancelGracePeriod)
+	if cfg.StepRepo != nil {
+		workflow.SetCheckpointer(NewOperationCheckpointer(cfg.Operation.ID, cfg.StepRepo))
+	}
 	workflow.logger = logger.With(
 		"component", componentName,
 		"tenant_id", cfg.TenantID,
@@ -176,6 +490,16 @@ func NewTenantOperationWorkflow(
 //     request completes
 //  3. Consistent Operation State Management: Updates operation status at defined points
 //     throughout execution
+//  4. Resumability: An operation that is already in progress (e.g. one recovered after
+//     a restart) keeps its original start time, and a heartbeat keeps the operation
+//     marked as live so that other processes do not claim it
+//  5. Failure Handling: Completed steps are compensated by the BaseWorkflow and the tenant
+//     is moved to the error status so it is not left looking provisioning or deleting
+//  6. Cancellation: If ctx is cancelled with a CancellationError as its cause, the
+//     operation is recorded as cancelled rather than failed. The final state is persisted
+//     with a context detached from ctx so that cancellation cannot prevent it
+//  7. Pausing: A workflow paused between steps records the operation as paused and
+//     leaves the tenant and its completed steps as they are, ready to be resumed
 func (w *TenantOperationWorkflow) Start(ctx context.Context) {
 	go func() {
 		logger := logger.NewLoggerContext(w.logger.With(
@@ -194,8 +518,13 @@ func (w *TenantOperationWorkflow) Start(ctx context.Context) {
 		))
 		defer span.End()
 
-		w.operation.Start()
-		span.AddEvent("operation started")
+		if w.operation.IsInProgress() {
+			span.AddEvent("operation resumed")
+			logger.Info(ctx, "resuming operation")
+		} else {
+			w.operation.Start()
+			span.AddEvent("operation started")
+		}
 		if err := w.operationRepo.Update(ctx, w.operation); err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, "error updating operation")
@@ -213,21 +542,55 @@ func (w *TenantOperationWorkflow) Start(ctx context.Context) {
 		span.AddEvent("operation updated")
 		logger.Info(ctx, "operation updated")
 
+		stopHeartbeat := w.startHeartbeat(ctx, logger)
 		result := w.ExecuteSteps(ctx)
+		stopHeartbeat()
+
+		// The workflow context may have been cancelled, but the outcome must still be recorded.
+		cancelCause := context.Cause(ctx)
+		ctx = context.WithoutCancel(ctx)
+
 		span.AddEvent("workflow completed")
 		logger.Info(ctx, "workflow completed")
+		w.recordStageMetrics(ctx, result)
 
 		result.Result["tenant_id"] = w.tenantID
+		// Phase results recorded while the workflow ran are kept in the final result.
+		if phases, ok := w.operation.Result["phases"]; ok {
+			result.Result["phases"] = phases
+		}
 
-		// Update operation based on workflow result.
-		if result.Success {
+		// Update operation based on workflow result. A paused operation has not
+		// finished, so its outcome is audited once it is resumed and runs to the end.
+		var (
+			cancelErr *CancellationError
+			outcome   error
+		)
+		switch {
+		case result.Success:
 			span.AddEvent("operation completed")
 			logger.Info(ctx, "operation completed")
 			w.operation.Complete(result.Result)
-		} else {
+		case result.Paused:
+			span.AddEvent("operation paused")
+			logger.Info(ctx, "operation paused")
+			if err := w.operation.Pause(); err != nil {
+				logger.Warn(ctx, "operation could not be marked paused", "error", err)
+			}
+		case errors.As(cancelCause, &cancelErr):
+			span.AddEvent("operation cancelled")
+			logger.Info(ctx, "operation cancelled", "reason", cancelErr.Reason, "cancelled_by", cancelErr.Actor)
+			if err := w.operation.CancelBy(cancelErr.Reason, cancelErr.Actor); err != nil {
+				logger.Warn(ctx, "operation could not be marked cancelled", "error", err)
+			}
+			w.markTenantError(ctx, logger)
+			outcome = cancelErr
+		default:
 			span.AddEvent("operation failed")
 			logger.Error(ctx, "operation failed", "error", result.Error)
 			w.operation.Fail(result.Error.Error())
+			w.markTenantError(ctx, logger)
+			outcome = result.Error
 		}
 
 		span.AddEvent("persisting operation")
@@ -240,6 +603,10 @@ func (w *TenantOperationWorkflow) Start(ctx context.Context) {
 		logger.Info(ctx, "operation persisted")
 		span.AddEvent("operation persisted")
 
+		if !result.Paused {
+			w.recordAudit(ctx, outcome)
+		}
+
 		w.resultChan <- result
 		close(w.resultChan)
 
@@ -249,72 +616,798 @@ func (w *TenantOperationWorkflow) Start(ctx context.Context) {
 	}()
 }
 
+// recordAudit records the outcome of the operation in the audit trail, crediting
+// whoever requested the operation.
+func (w *TenantOperationWorkflow) recordAudit(ctx context.Context, outcome error) {
+	if w.audit == nil {
+		return
+	}
+
+	actor := audit.SystemActor
+	if w.operation.CreatedBy != nil {
+		actor = *w.operation.CreatedBy
+	}
+	tenantID, operationID := w.tenantID, w.operation.ID
+	e := &audit.Entry{
+		Action:      audit.ActionExecuteOperation,
+		Actor:       actor,
+		TenantID:    &tenantID,
+		OperationID: &operationID,
+		Details:     map[string]any{"operation_type": string(w.operation.Type)},
+	}
+	if w.operation.StartedAt != nil {
+		e.Duration = time.Since(*w.operation.StartedAt)
+	}
+	w.audit.Record(ctx, e, outcome)
+}
+
+// recordStageMetrics reports the duration and attempt count of each provisioning
+// step executed in this run. Resumed steps and compensations are not stages of
+// this run and are skipped.
+func (w *TenantOperationWorkflow) recordStageMetrics(ctx context.Context, result WorkflowResult) {
+	if w.operationType != OperationTypeCreate {
+		return
+	}
+
+	for _, stepResult := range result.StepResults {
+		if stepResult.Resumed || stepResult.Compensation {
+			continue
+		}
+		w.metrics.ObserveProvisioningStageDuration(
+			ctx,
+			stepResult.StepName,
+			string(w.tenant.Tier),
+			string(w.tenant.Region),
+			stepResult.Duration,
+			stepResult.Attempts,
+		)
+	}
+}
+
+// markTenantError moves the tenant to the error status after a failed operation so
+// that it no longer appears to be provisioning or deleting. Compensations have already
+// undone what they could by this point; whatever remains needs operator attention.
+func (w *TenantOperationWorkflow) markTenantError(ctx context.Context, logger *logger.LoggerContext) {
+	span := trace.SpanFromContext(ctx)
+
+	if err := w.tenant.MarkError(); err != nil {
+		span.RecordError(err)
+		logger.Warn(ctx, "tenant could not be marked as errored", "error", err)
+		return
+	}
+	if err := w.persistStatus(ctx); err != nil {
+		span.RecordError(err)
+		logger.Error(ctx, "error marking tenant as errored", "error", err)
+		return
+	}
+	span.AddEvent("tenant marked as errored")
+	logger.Info(ctx, "tenant marked as errored")
+}
+
+// startHeartbeat periodically refreshes the operation while the workflow runs so
+// that recovery in other processes can distinguish it from an orphaned operation.
+// The returned function stops the heartbeat and waits for it to exit.
+func (w *TenantOperationWorkflow) startHeartbeat(ctx context.Context, logger *logger.LoggerContext) func() {
+	if w.stepRepo == nil {
+		return func() {}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(DefaultHeartbeatInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := w.stepRepo.Heartbeat(ctx, w.operation.ID); err != nil {
+					logger.Warn(ctx, "failed to record operation heartbeat", "error", err)
+				}
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// withinTransaction runs fn in a transaction of the workflow's transactor, or
+// directly if the workflow has none.
+func (w *TenantOperationWorkflow) withinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if w.transactor == nil {
+		return fn(ctx)
+	}
+	return w.transactor.WithinTransaction(ctx, fn)
+}
+
+// reconfigurableStatuses are the stored statuses in which a tier change or
+// migration may change the tenant: active, or errored by a failed attempt that
+// is being retried.
+var reconfigurableStatuses = []tenant.Status{tenant.StatusActive, tenant.StatusError}
+
+// persistStatus stores the status the tenant has just moved to, provided its
+// stored status still allows the move. Only the status is written, so that the
+// columns other requests change, such as the isolation group, are left as they are.
+func (w *TenantOperationWorkflow) persistStatus(ctx context.Context) error {
+	to := w.tenant.Status
+	// A step retried after it applied the change finds the tenant moved already.
+	moved, err := w.tenantRepo.TransitionStatus(ctx, w.tenantID, append(tenant.TransitionSources(to), to), to)
+	if err != nil {
+		return err
+	}
+	if !moved {
+		return fmt.Errorf("tenant (%d) can no longer move to %s: %w", w.tenantID, to, tenant.ErrInvalidTransition)
+	}
+	return nil
+}
+
 // TODO: All this stuff...
 
 // Step implementation methods for creating tenants
 func (w *TenantOperationWorkflow) initializeTenant(ctx context.Context) error {
+	if err := w.reserveQuota(ctx); err != nil {
+		return err
+	}
+
 	// This would include generating namespaces, IDs, etc.
 	time.Sleep(100 * time.Millisecond) // Simulate work
 	return nil
 }
 
-func (w *TenantOperationWorkflow) provisionDatabase(ctx context.Context) error {
-	// This would create the tenant schema in the database
+func (w *TenantOperationWorkflow) provisionDatabase(ctx context.Context) (err error) {
+	schema := resourceSpec{
+		Type:     resource.TypeDatabaseSchema,
+		Name:     "tenant_" + strings.ReplaceAll(w.tenant.Name, "-", "_"),
+		Metadata: map[string]any{},
+	}
+	if w.placer != nil {
+		node, err := w.placer.Place(ctx, w.tenant)
+		if err != nil {
+			return err
+		}
+		w.tenant.PrimaryNodeID = &node.ID
+		schema.Metadata["node_id"] = node.ID
+	}
+	defer w.undoOnFailure(ctx, &err, w.dropDatabase)
+
+	resources, err := w.registerResources(ctx, schema)
+	if err != nil {
+		return err
+	}
+
+	// This would create the tenant schema on the primary node
 	time.Sleep(500 * time.Millisecond) // Simulate work
-	return nil
+	return w.activateResources(ctx, resources)
 }
 
-func (w *TenantOperationWorkflow) setupSecrets(ctx context.Context) error {
+func (w *TenantOperationWorkflow) setupSecrets(ctx context.Context) (err error) {
+	defer w.undoOnFailure(ctx, &err, w.removeSecrets)
+
+	prefix := "tenant-" + w.tenant.Name
+	resources, err := w.registerResources(ctx,
+		resourceSpec{Type: resource.TypeSecret, Name: prefix + "-db-credentials"},
+		resourceSpec{Type: resource.TypeSecret, Name: prefix + "-api-key"},
+	)
+	if err != nil {
+		return err
+	}
+
 	// This would set up secrets in the secret manager
 	time.Sleep(300 * time.Millisecond) // Simulate work
-	return nil
+	return w.activateResources(ctx, resources)
 }
 
-func (w *TenantOperationWorkflow) deployResources(ctx context.Context) error {
+func (w *TenantOperationWorkflow) deployResources(ctx context.Context) (err error) {
+	defer w.undoOnFailure(ctx, &err, w.undeployResources)
+
+	prefix := "tenant-" + w.tenant.Name
+	resources, err := w.registerResources(ctx,
+		resourceSpec{Type: resource.TypeKubernetesNamespace, Name: prefix},
+		resourceSpec{Type: resource.TypeGKEDeployment, Name: prefix + "-api"},
+		resourceSpec{Type: resource.TypePubSubTopic, Name: prefix + "-events"},
+	)
+	if err != nil {
+		return err
+	}
+
 	// This would deploy Kubernetes resources
 	time.Sleep(1 * time.Second) // Simulate work
-	return nil
+	return w.activateResources(ctx, resources)
 }
 
 func (w *TenantOperationWorkflow) finalizeTenant(ctx context.Context) error {
 	// Update tenant status to active
-	w.tenant.Activate()
+	if err := w.tenant.Activate(); err != nil {
+		return err
+	}
+
+	return w.persistStatus(ctx)
+}
+
+// Compensation methods for undoing tenant creation steps
+func (w *TenantOperationWorkflow) releaseTenant(ctx context.Context) error {
+	// This would release namespaces, IDs, etc. reserved during initialization
+	time.Sleep(50 * time.Millisecond) // Simulate work
+
+	// The other compensations have run by now and removed what they created from
+	// the ledger, so the whole footprint reserved during initialization is returned.
+	projectID, ok := w.operation.Parameters[reservedProjectParam].(string)
+	if w.quotas == nil || !ok {
+		return nil
+	}
 
-	// Update tenant in repository
-	return w.tenantRepo.Update(ctx, w.tenant)
+	// Forgetting the reservation as it is released keeps a retried compensation
+	// from releasing it twice.
+	err := w.withinTransaction(ctx, func(ctx context.Context) error {
+		if err := w.quotas.Release(ctx, projectID, w.tenant.Region, resource.TenantFootprint); err != nil {
+			return err
+		}
+		delete(w.operation.Parameters, reservedProjectParam)
+		return w.operationRepo.Update(ctx, w.operation)
+	})
+	if err != nil {
+		w.operation.Parameters[reservedProjectParam] = projectID
+	}
+	return err
+}
+
+func (w *TenantOperationWorkflow) dropDatabase(ctx context.Context) error {
+	resources, err := w.markResourcesDeleting(ctx, resource.TypeDatabaseSchema)
+	if err != nil {
+		return err
+	}
+
+	// This would drop the tenant schema created during provisioning
+	time.Sleep(250 * time.Millisecond) // Simulate work
+	if err := w.deleteResources(ctx, resources); err != nil {
+		return err
+	}
+	return w.releaseNode(ctx)
+}
+
+func (w *TenantOperationWorkflow) removeSecrets(ctx context.Context) error {
+	resources, err := w.markResourcesDeleting(ctx, resource.TypeSecret)
+	if err != nil {
+		return err
+	}
+
+	// This would delete the secrets created in the secret manager
+	time.Sleep(150 * time.Millisecond) // Simulate work
+	return w.deleteResources(ctx, resources)
+}
+
+func (w *TenantOperationWorkflow) undeployResources(ctx context.Context) error {
+	resources, err := w.markResourcesDeleting(ctx, deployedResourceTypes...)
+	if err != nil {
+		return err
+	}
+
+	// This would tear down the deployed Kubernetes resources
+	time.Sleep(500 * time.Millisecond) // Simulate work
+	return w.deleteResources(ctx, resources)
 }
 
 // Step implementation methods for deleting tenants
 func (w *TenantOperationWorkflow) deactivateTenant(ctx context.Context) error {
 	// Mark tenant for deletion
-	w.tenant.MarkForDeletion()
+	if err := w.tenant.MarkForDeletion(); err != nil {
+		return err
+	}
 
-	// Update tenant in repository
-	return w.tenantRepo.Update(ctx, w.tenant)
+	return w.persistStatus(ctx)
 }
 
 func (w *TenantOperationWorkflow) removeResources(ctx context.Context) error {
+	resources, err := w.markResourcesDeleting(ctx, deployedResourceTypes...)
+	if err != nil {
+		return err
+	}
+
 	// This would remove Kubernetes resources
 	time.Sleep(1 * time.Second) // Simulate work
-	return nil
+	return w.retireResources(ctx, resources)
 }
 
 func (w *TenantOperationWorkflow) cleanupSecrets(ctx context.Context) error {
+	resources, err := w.markResourcesDeleting(ctx, resource.TypeSecret)
+	if err != nil {
+		return err
+	}
+
 	// This would clean up secrets from the secret manager
 	time.Sleep(300 * time.Millisecond) // Simulate work
-	return nil
+	return w.retireResources(ctx, resources)
 }
 
 func (w *TenantOperationWorkflow) removeDatabase(ctx context.Context) error {
+	resources, err := w.markResourcesDeleting(ctx, resource.TypeDatabaseSchema)
+	if err != nil {
+		return err
+	}
+
 	// This would remove the tenant schema from the database
 	time.Sleep(500 * time.Millisecond) // Simulate work
+	if err := w.retireResources(ctx, resources); err != nil {
+		return err
+	}
+	return w.releaseNode(ctx)
+}
+
+// undoOnFailure runs undo if the step that defers it fails. Only completed steps
+// are compensated, so a failing step gives back what it reserved or created so far
+// itself; a retry of the step starts over. undo runs detached from ctx, which has
+// often ended by the time the step fails.
+func (w *TenantOperationWorkflow) undoOnFailure(
+	ctx context.Context,
+	err *error,
+	undo func(ctx context.Context) error,
+) {
+	if *err == nil {
+		return
+	}
+
+	undoCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), DefaultCompensationTimeout)
+	defer cancel()
+	if undoErr := undo(undoCtx); undoErr != nil {
+		*err = errors.Join(*err, fmt.Errorf("undoing failed step: %w", undoErr))
+	}
+}
+
+// releaseNode frees the tenant's place on its primary database node, if it has one.
+func (w *TenantOperationWorkflow) releaseNode(ctx context.Context) error {
+	if w.placer == nil {
+		return nil
+	}
+	if err := w.placer.Release(ctx, w.tenantID); err != nil {
+		return err
+	}
+	w.tenant.PrimaryNodeID = nil
+	return nil
+}
+
+// deployedResourceTypes are the resources deploy-resources creates and
+// remove-resources tears down.
+var deployedResourceTypes = []resource.Type{
+	resource.TypeKubernetesNamespace,
+	resource.TypeGKEDeployment,
+	resource.TypePubSubTopic,
+}
+
+// resourceSpec describes a resource a step is about to create.
+type resourceSpec struct {
+	Type     resource.Type
+	Name     string
+	Metadata map[string]any
+}
+
+// registerResources records the resources a step is about to create, before it
+// creates them, so that everything that may exist is on record: for the step to
+// remove if it fails partway, and for compensation or deletion to remove once it
+// has completed.
+func (w *TenantOperationWorkflow) registerResources(
+	ctx context.Context,
+	specs ...resourceSpec,
+) ([]*resource.Resource, error) {
+	if w.resourceRepo == nil {
+		return nil, nil
+	}
+
+	projectID := w.projectID()
+	resources := make([]*resource.Resource, 0, len(specs))
+	for _, spec := range specs {
+		r, err := resource.NewResource(w.tenantID, spec.Type, spec.Name, w.tenant.Region, projectID, w.operation.ID)
+		if err != nil {
+			return nil, err
+		}
+		maps.Copy(r.Metadata, spec.Metadata)
+
+		id, err := w.resourceRepo.Register(ctx, r)
+		if err != nil {
+			return nil, fmt.Errorf("failed to register %s %s: %w", spec.Type, spec.Name, err)
+		}
+		r.ID = id
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+// projectID returns the project hosting the tenant's resources: the one quota was
+// reserved in during creation, or the region's default project without quotas.
+func (w *TenantOperationWorkflow) projectID() string {
+	if projectID, ok := w.operation.Parameters["project_id"].(string); ok && projectID != "" {
+		return projectID
+	}
+	return resource.DefaultProjectID(w.tenant.Region)
+}
+
+// reservedProjectParam is the operation parameter recording the project quota
+// was reserved in. It is written in the same transaction as the reservation, so
+// its presence means the operation holds the tenant's footprint there.
+const reservedProjectParam = "reserved_project_id"
+
+// reserveQuota reserves quota for every resource the tenant will be given. The
+// project chosen when the tenant was created is preferred, but if it has filled
+// up since, the reservation spills over to another project. The project reserved
+// in is recorded in the operation along with the reservation, so that later
+// steps, retries, and resumed runs create resources there, and a run that
+// already reserved quota for the operation reuses it rather than reserving again.
+func (w *TenantOperationWorkflow) reserveQuota(ctx context.Context) error {
+	if w.quotas == nil {
+		return nil
+	}
+	if _, ok := w.operation.Parameters[reservedProjectParam].(string); ok {
+		return nil
+	}
+
+	preferred, _ := w.operation.Parameters["project_id"].(string)
+	if w.operation.Parameters == nil {
+		w.operation.Parameters = make(map[string]any)
+	}
+
+	err := w.withinTransaction(ctx, func(ctx context.Context) error {
+		projectID, err := w.quotas.Reserve(ctx, preferred, w.tenant.Region, resource.TenantFootprint)
+		if err != nil {
+			return err
+		}
+
+		w.operation.Parameters["project_id"] = projectID
+		w.operation.Parameters[reservedProjectParam] = projectID
+		if err := w.operationRepo.Update(ctx, w.operation); err != nil {
+			if w.transactor == nil {
+				// Nothing rolls the reservation back, and a retry reserves again.
+				err = errors.Join(err, w.quotas.Release(ctx, projectID, w.tenant.Region, resource.TenantFootprint))
+			}
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		w.operation.Parameters["project_id"] = preferred
+		delete(w.operation.Parameters, reservedProjectParam)
+	}
+	return err
+}
+
+// releaseQuota returns the quota held by deleted resources to their projects.
+func (w *TenantOperationWorkflow) releaseQuota(ctx context.Context, resources []*resource.Resource) error {
+	if w.quotas == nil {
+		return nil
+	}
+
+	type location struct {
+		projectID string
+		region    tenant.Region
+	}
+	held := make(map[location]resource.Footprint)
+	for _, r := range resources {
+		loc := location{projectID: r.ProjectID, region: r.Region}
+		if held[loc] == nil {
+			held[loc] = make(resource.Footprint)
+		}
+		held[loc][r.Type]++
+	}
+
+	for loc, footprint := range held {
+		if err := w.quotas.Release(ctx, loc.projectID, loc.region, footprint); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// retireResources removes torn-down resources from the ledger and returns their
+// quota in one transaction, so that quota is released exactly once for each
+// resource, however often the step is retried. Resources already gone from the
+// ledger had their quota released by an earlier run.
+func (w *TenantOperationWorkflow) retireResources(ctx context.Context, resources []*resource.Resource) error {
+	return w.withinTransaction(ctx, func(ctx context.Context) error {
+		removed := make([]*resource.Resource, 0, len(resources))
+		for _, r := range resources {
+			err := w.resourceRepo.Delete(ctx, r.ID)
+			if errors.Is(err, resource.ErrResourceNotFound) {
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("failed to remove %s %s from the ledger: %w", r.Type, r.Name, err)
+			}
+			removed = append(removed, r)
+		}
+		return w.releaseQuota(ctx, removed)
+	})
+}
+
+// activateResources marks registered resources as created.
+func (w *TenantOperationWorkflow) activateResources(ctx context.Context, resources []*resource.Resource) error {
+	for _, r := range resources {
+		r.Activate()
+		if err := w.resourceRepo.UpdateStatus(ctx, r); err != nil {
+			return fmt.Errorf("failed to activate %s %s: %w", r.Type, r.Name, err)
+		}
+	}
+	return nil
+}
+
+// markResourcesDeleting finds the tenant's recorded resources of the given types
+// and marks them as being torn down. Only resources in the ledger are returned,
+// so a step removes exactly what was created.
+func (w *TenantOperationWorkflow) markResourcesDeleting(
+	ctx context.Context,
+	types ...resource.Type,
+) ([]*resource.Resource, error) {
+	if w.resourceRepo == nil {
+		return nil, nil
+	}
+
+	recorded, err := w.resourceRepo.ListByTenant(ctx, w.tenantID, resource.ListFilter{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tenant resources: %w", err)
+	}
+
+	var resources []*resource.Resource
+	for _, r := range recorded {
+		if !slices.Contains(types, r.Type) {
+			continue
+		}
+		r.MarkDeleting()
+		if err := w.resourceRepo.UpdateStatus(ctx, r); err != nil && !errors.Is(err, resource.ErrResourceNotFound) {
+			return nil, fmt.Errorf("failed to mark %s %s deleting: %w", r.Type, r.Name, err)
+		}
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+// deleteResources removes torn down resources from the ledger. Resources that are
+// already gone, for example because a retried step removed them, are skipped.
+func (w *TenantOperationWorkflow) deleteResources(ctx context.Context, resources []*resource.Resource) error {
+	for _, r := range resources {
+		if err := w.resourceRepo.Delete(ctx, r.ID); err != nil && !errors.Is(err, resource.ErrResourceNotFound) {
+			return fmt.Errorf("failed to remove %s %s from the ledger: %w", r.Type, r.Name, err)
+		}
+	}
 	return nil
 }
 
 func (w *TenantOperationWorkflow) finalizeDeletion(ctx context.Context) error {
 	// Mark tenant as deleted
-	w.tenant.Delete()
+	if err := w.tenant.Delete(); err != nil {
+		return err
+	}
+
+	return w.persistStatus(ctx)
+}
+
+// Step implementation methods for changing a tenant's tier
+func (w *TenantOperationWorkflow) resizeResources(ctx context.Context) error {
+	// This would resize the tenant's Kubernetes resources and database allocation
+	time.Sleep(500 * time.Millisecond) // Simulate work
+	return nil
+}
 
-	// Update tenant in repository
-	return w.tenantRepo.Update(ctx, w.tenant)
+func (w *TenantOperationWorkflow) updateQuotas(ctx context.Context) error {
+	// This would apply the new tier's rate limits and storage quotas
+	time.Sleep(200 * time.Millisecond) // Simulate work
+	return nil
+}
+
+func (w *TenantOperationWorkflow) finalizeTierChange(ctx context.Context) error {
+	if err := w.tenant.UpgradeTier(w.targetTier); err != nil {
+		return err
+	}
+
+	// A retried change may find the tenant in the error state left by the failed attempt.
+	if err := w.tenant.Activate(); err != nil {
+		return err
+	}
+
+	return w.withinTransaction(ctx, func(ctx context.Context) error {
+		changed, err := w.tenantRepo.ChangeTier(ctx, w.tenantID, w.targetTier, reconfigurableStatuses)
+		if err != nil {
+			return err
+		}
+		if !changed {
+			return fmt.Errorf("tenant (%d) can no longer change tier: %w", w.tenantID, tenant.ErrTenantNotActive)
+		}
+		return w.persistStatus(ctx)
+	})
+}
+
+// Compensation methods for undoing tier change steps
+func (w *TenantOperationWorkflow) restoreResourceSize(ctx context.Context) error {
+	// This would resize the tenant's resources back to the source tier
+	time.Sleep(500 * time.Millisecond) // Simulate work
+	return nil
+}
+
+func (w *TenantOperationWorkflow) restoreQuotas(ctx context.Context) error {
+	// This would reapply the source tier's quotas
+	time.Sleep(200 * time.Millisecond) // Simulate work
+	return nil
+}
+
+// migrationPhase wraps a migration phase so that its outcome is recorded in the
+// operation as soon as it completes. Persisting each phase as it finishes lets
+// callers polling the operation follow the migration, including while it is paused.
+func (w *TenantOperationWorkflow) migrationPhase(
+	name string,
+	phase func(ctx context.Context) (map[string]any, error),
+) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		startedAt := time.Now()
+		details, err := phase(ctx)
+		if err != nil {
+			return err
+		}
+		completedAt := time.Now()
+
+		result := map[string]any{
+			"status":       "completed",
+			"started_at":   startedAt.UTC().Format(time.RFC3339),
+			"completed_at": completedAt.UTC().Format(time.RFC3339),
+			"duration_ms":  completedAt.Sub(startedAt).Milliseconds(),
+		}
+		maps.Copy(result, details)
+		w.operation.RecordPhase(name, result)
+
+		return w.operationRepo.Update(ctx, w.operation)
+	}
+}
+
+// Step implementation methods for migrating tenants
+func (w *TenantOperationWorkflow) provisionTarget(ctx context.Context) (map[string]any, error) {
+	// This would provision the database, secrets, and Kubernetes resources in the target region
+	time.Sleep(1 * time.Second) // Simulate work
+	return map[string]any{"region": string(w.targetRegion)}, nil
+}
+
+func (w *TenantOperationWorkflow) copyData(ctx context.Context) (map[string]any, error) {
+	// This would bulk copy the tenant's data while the source keeps serving it.
+	// The tenant stays active in its source region throughout the copy so that it
+	// remains readable; changes made meanwhile are replicated during cut over.
+	time.Sleep(2 * time.Second) // Simulate work
+	return map[string]any{
+		"source_region": string(w.sourceRegion),
+		"target_region": string(w.targetRegion),
+	}, nil
+}
+
+func (w *TenantOperationWorkflow) cutOver(ctx context.Context) (map[string]any, error) {
+	// This would briefly hold writes, replicate the remaining changes, and switch
+	// DNS and routing to the target region.
+	time.Sleep(500 * time.Millisecond) // Simulate work
+
+	if err := w.tenant.ChangeRegion(w.targetRegion); err != nil {
+		return nil, err
+	}
+	if err := w.persistRegion(ctx); err != nil {
+		return nil, err
+	}
+	return map[string]any{"region": string(w.targetRegion)}, nil
+}
+
+func (w *TenantOperationWorkflow) drainSource(ctx context.Context) (map[string]any, error) {
+	// This would wait for connections still open against the source region to close
+	time.Sleep(500 * time.Millisecond) // Simulate work
+	return map[string]any{"region": string(w.sourceRegion)}, nil
+}
+
+func (w *TenantOperationWorkflow) decommissionSource(ctx context.Context) (map[string]any, error) {
+	// This would remove the tenant's resources from the source region
+	time.Sleep(1 * time.Second) // Simulate work
+	return map[string]any{"region": string(w.sourceRegion)}, nil
+}
+
+// Compensation methods for undoing tenant migration steps
+func (w *TenantOperationWorkflow) decommissionTarget(ctx context.Context) error {
+	// This would remove the resources and copied data from the target region
+	time.Sleep(500 * time.Millisecond) // Simulate work
+	return nil
+}
+
+func (w *TenantOperationWorkflow) revertCutOver(ctx context.Context) error {
+	// This would switch DNS and routing back to the source region
+	time.Sleep(250 * time.Millisecond) // Simulate work
+
+	if err := w.tenant.ChangeRegion(w.sourceRegion); err != nil {
+		return err
+	}
+	return w.persistRegion(ctx)
+}
+
+// persistRegion stores the region the tenant has just moved to, writing nothing else.
+func (w *TenantOperationWorkflow) persistRegion(ctx context.Context) error {
+	changed, err := w.tenantRepo.ChangeRegion(ctx, w.tenantID, w.tenant.Region, reconfigurableStatuses)
+	if err != nil {
+		return err
+	}
+	if !changed {
+		return fmt.Errorf("tenant (%d) can no longer change region: %w", w.tenantID, tenant.ErrTenantNotActive)
+	}
+	return nil
+}
+
+func (w *TenantOperationWorkflow) restoreSource(ctx context.Context) error {
+	// This would let the source region accept connections again
+	time.Sleep(100 * time.Millisecond) // Simulate work
+	return nil
+}
+
+// parseStateChange reads the reason and requester of a suspension or resumption.
+// They come from the operation so that a resumed or retried operation records
+// the same entry in the suspension history.
+func (w *TenantOperationWorkflow) parseStateChange(op *operation.Operation) error {
+	reason, _ := op.Parameters["reason"].(string)
+	requestedBy, _ := op.Parameters["requested_by"].(string)
+	if reason == "" || requestedBy == "" {
+		return fmt.Errorf("%s operation (%d) is missing its reason or requester", op.Type, op.ID)
+	}
+	w.reason = reason
+	w.requestedBy = requestedBy
+	return nil
+}
+
+// Step implementation methods for suspending and resuming tenants.
+// Each is the other's compensation, so they must be safe to run on a tenant
+// that is already in the target state.
+func (w *TenantOperationWorkflow) disableAccess(ctx context.Context) error {
+	// This would revoke API credentials and block ingress to the tenant
+	time.Sleep(200 * time.Millisecond) // Simulate work
+	return nil
+}
+
+func (w *TenantOperationWorkflow) enableAccess(ctx context.Context) error {
+	// This would reinstate API credentials and ingress to the tenant
+	time.Sleep(200 * time.Millisecond) // Simulate work
+	return nil
+}
+
+func (w *TenantOperationWorkflow) scaleDownResources(ctx context.Context) error {
+	// This would scale the tenant's deployments to zero and pause its database
+	time.Sleep(500 * time.Millisecond) // Simulate work
+	return nil
+}
+
+func (w *TenantOperationWorkflow) scaleUpResources(ctx context.Context) error {
+	// This would restore the tenant's deployments and database to their tier's size
+	time.Sleep(500 * time.Millisecond) // Simulate work
+	return nil
+}
+
+// finalizeSuspension persists the suspended status together with its entry in
+// the suspension history, so a tenant is never stored as suspended without one.
+// The service accepts no other operation on the tenant while this one runs, so
+// the tenant is still active and has no open suspension.
+func (w *TenantOperationWorkflow) finalizeSuspension(ctx context.Context) error {
+	if err := w.tenant.Suspend(); err != nil {
+		return err
+	}
+
+	suspension := tenant.NewSuspension(w.tenantID, w.reason, w.requestedBy, w.operation.ID)
+	return w.withinTransaction(ctx, func(ctx context.Context) error {
+		if err := w.persistStatus(ctx); err != nil {
+			return err
+		}
+		return w.tenantRepo.RecordSuspension(ctx, suspension)
+	})
+}
+
+// finalizeResumption persists the active status together with the end of the
+// open suspension.
+func (w *TenantOperationWorkflow) finalizeResumption(ctx context.Context) error {
+	if err := w.tenant.Activate(); err != nil {
+		return err
+	}
+
+	return w.withinTransaction(ctx, func(ctx context.Context) error {
+		if err := w.persistStatus(ctx); err != nil {
+			return err
+		}
+		return w.tenantRepo.EndSuspension(ctx, w.tenantID, w.reason, w.requestedBy, w.operation.ID)
+	})
 }
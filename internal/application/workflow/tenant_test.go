@@ -0,0 +1,165 @@
+package workflow
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ahrav/hoglet-hub/internal/domain/dbnode"
+	"github.com/ahrav/hoglet-hub/internal/domain/operation"
+	"github.com/ahrav/hoglet-hub/internal/domain/resource"
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+)
+
+// ledger is an in-memory resource ledger whose activations fail once failActivate
+// is set, so that steps can be made to fail after registering resources.
+type ledger struct {
+	mu           sync.Mutex
+	nextID       int64
+	resources    []*resource.Resource
+	failActivate error
+}
+
+func (l *ledger) Register(ctx context.Context, r *resource.Resource) (int64, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.nextID++
+	stored := *r
+	stored.ID = l.nextID
+	l.resources = append(l.resources, &stored)
+	return stored.ID, nil
+}
+
+func (l *ledger) ListByTenant(ctx context.Context, tenantID int64, _ resource.ListFilter) ([]*resource.Resource, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var found []*resource.Resource
+	for _, r := range l.resources {
+		if r.TenantID == tenantID {
+			stored := *r
+			found = append(found, &stored)
+		}
+	}
+	return found, nil
+}
+
+func (l *ledger) UpdateStatus(ctx context.Context, r *resource.Resource) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if r.Status == resource.StatusActive && l.failActivate != nil {
+		return l.failActivate
+	}
+	for _, stored := range l.resources {
+		if stored.ID == r.ID {
+			stored.Status = r.Status
+			return nil
+		}
+	}
+	return resource.ErrResourceNotFound
+}
+
+func (l *ledger) Delete(ctx context.Context, id int64) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	i := slices.IndexFunc(l.resources, func(r *resource.Resource) bool { return r.ID == id })
+	if i < 0 {
+		return resource.ErrResourceNotFound
+	}
+	l.resources = slices.Delete(l.resources, i, i+1)
+	return nil
+}
+
+func (l *ledger) count() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return len(l.resources)
+}
+
+// nodePlacer places every tenant on the same node and tracks how many it holds.
+type nodePlacer struct {
+	mu     sync.Mutex
+	placed int
+}
+
+func (p *nodePlacer) Place(ctx context.Context, t *tenant.Tenant) (*dbnode.Node, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.placed++
+	return &dbnode.Node{ID: 7}, nil
+}
+
+func (p *nodePlacer) Release(ctx context.Context, tenantID int64) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.placed--
+	return nil
+}
+
+func newStepTestWorkflow(t *testing.T, resources *ledger, placer *nodePlacer) *TenantOperationWorkflow {
+	t.Helper()
+
+	op, err := operation.NewTenantCreateOperation(123, "acme", "us1", "free", nil)
+	require.NoError(t, err)
+	op.ID = 456
+
+	return &TenantOperationWorkflow{
+		operationType: OperationTypeCreate,
+		tenant:        &tenant.Tenant{ID: 123, Name: "acme", Region: tenant.RegionUS1, Tier: tenant.TierFree},
+		tenantID:      123,
+		operation:     op,
+		resourceRepo:  resources,
+		placer:        placer,
+	}
+}
+
+func TestProvisionDatabase_FailureReleasesPlacement(t *testing.T) {
+	errLedger := errors.New("ledger unavailable")
+	resources := &ledger{failActivate: errLedger}
+	placer := new(nodePlacer)
+	w := newStepTestWorkflow(t, resources, placer)
+
+	// Only completed steps are compensated, so the failing step must give back
+	// the node capacity and ledger entry it took before failing.
+	err := w.provisionDatabase(context.Background())
+	require.ErrorIs(t, err, errLedger)
+	assert.Zero(t, placer.placed, "node capacity must be released")
+	assert.Nil(t, w.tenant.PrimaryNodeID)
+	assert.Zero(t, resources.count(), "ledger entries must be removed")
+
+	// A later attempt starts over and keeps what it creates.
+	resources.failActivate = nil
+	require.NoError(t, w.provisionDatabase(context.Background()))
+	assert.Equal(t, 1, placer.placed)
+	assert.Equal(t, 1, resources.count())
+}
+
+func TestCreateSteps_FailureAfterRegisteringRemovesLedgerEntries(t *testing.T) {
+	tests := []struct {
+		desc string
+		step func(w *TenantOperationWorkflow) func(context.Context) error
+	}{
+		{desc: "setup-secrets", step: func(w *TenantOperationWorkflow) func(context.Context) error { return w.setupSecrets }},
+		{desc: "deploy-resources", step: func(w *TenantOperationWorkflow) func(context.Context) error { return w.deployResources }},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			errLedger := errors.New("ledger unavailable")
+			resources := &ledger{failActivate: errLedger}
+			w := newStepTestWorkflow(t, resources, new(nodePlacer))
+
+			// The step registers its resources and then fails. It is not compensated,
+			// so it must remove what it registered itself.
+			err := tc.step(w)(context.Background())
+			require.ErrorIs(t, err, errLedger)
+			assert.Zero(t, resources.count())
+		})
+	}
+}
@@ -2,17 +2,27 @@ package workflow
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync/atomic"
 	"time"
 )
 
 // Step represents a single executable unit in a workflow.
 // Each step has a name, description, and an execution function that will be called
 // during workflow execution.
+//
+// A step may also define a compensation function that undoes its side effects.
+// If a later step fails, the compensations of all previously completed steps are
+// run in reverse order so the workflow does not leave partial state behind.
+// A retry policy lets a step ride out transient failures before it is considered failed.
 type Step struct {
 	Name        string
 	Description string
 	Execute     func(ctx context.Context) error
+	Compensate  func(ctx context.Context) error // Optional rollback of Execute
+	Retry       *RetryPolicy                    // Optional retry policy; nil means a single attempt
+	DependsOn   []string                        // Steps that must complete first; only used by DAG workflows
 }
 
 // WorkflowResult contains the consolidated outcome of a workflow execution.
@@ -24,8 +34,14 @@ type WorkflowResult struct {
 	Error       error
 	StepResults []StepResult
 	Result      map[string]any
+	// Paused is true when the workflow stopped between steps because a pause was
+	// requested. Completed steps are not compensated, so it can be resumed later.
+	Paused bool
 }
 
+// ErrWorkflowPaused is the error of a workflow result that stopped because a pause was requested.
+var ErrWorkflowPaused = errors.New("workflow paused")
+
 // StepResult tracks the execution result of an individual workflow step.
 // It captures performance metrics and error information for reporting and debugging.
 type StepResult struct {
@@ -35,6 +51,13 @@ type StepResult struct {
 	StartedAt   time.Time
 	CompletedAt time.Time
 	Duration    time.Duration
+	Attempts    int // Number of times the step was executed, including retries
+	// Resumed is true when the step was not executed because a checkpoint
+	// from a previous run showed it had already completed.
+	Resumed bool
+	// Compensation is true when the result describes running the step's
+	// compensation function rather than the step itself.
+	Compensation bool
 }
 
 // Workflow defines the common interface for all workflow implementations.
@@ -84,14 +107,29 @@ type Workflow interface {
 	ResultChan() <-chan WorkflowResult
 }
 
+// Pauser is implemented by workflows that can be paused between steps.
+type Pauser interface {
+	// RequestPause asks the workflow to stop before starting its next step.
+	RequestPause()
+}
+
 // BaseWorkflow provides foundational workflow functionality that can be embedded
 // in specific workflow implementations.
 type BaseWorkflow struct {
-	steps      []Step
-	resultChan chan WorkflowResult
-	timeout    time.Duration // Default timeout for workflow execution
+	steps        []Step
+	resultChan   chan WorkflowResult
+	timeout      time.Duration // Default timeout for workflow execution
+	checkpointer Checkpointer  // Optional durable record of step progress
+	deps         [][]int       // Indexes of the steps each step depends on
+	maxParallel  int           // Maximum number of steps executing at once
+	cancelGrace  time.Duration // How long a running step may finish after cancellation
+	pause        atomic.Bool   // Set when a pause has been requested
 }
 
+// DefaultCompensationTimeout bounds how long a single compensation may run.
+// Compensations run even if the workflow context is done, so they need their own limit.
+const DefaultCompensationTimeout = 30 * time.Second
+
 // DefaultTimeout is the default timeout used if none is specified.
 const DefaultTimeout = 5 * time.Minute
 
@@ -108,12 +146,30 @@ func NewBaseWorkflowWithTimeout(steps []Step, timeout time.Duration) *BaseWorkfl
 	}
 
 	return &BaseWorkflow{
-		steps:      steps,
-		resultChan: make(chan WorkflowResult, 1),
-		timeout:    timeout,
+		steps:       steps,
+		resultChan:  make(chan WorkflowResult, 1),
+		timeout:     timeout,
+		deps:        sequentialDependencies(steps),
+		maxParallel: 1,
 	}
 }
 
+// SetCheckpointer enables durable step checkpoints for the workflow.
+// When set, ExecuteSteps records the progress of every step and skips
+// steps that a previous run already completed.
+func (w *BaseWorkflow) SetCheckpointer(c Checkpointer) { w.checkpointer = c }
+
+// SetCancelGracePeriod lets a step that is running when the workflow context is
+// cancelled keep going for up to d before it is abandoned. A step that finishes
+// within the grace period is recorded with its real outcome, so its side effects
+// are known and can be compensated. The default of zero abandons steps immediately.
+func (w *BaseWorkflow) SetCancelGracePeriod(d time.Duration) { w.cancelGrace = d }
+
+// RequestPause asks the workflow to stop before starting its next step. Steps that
+// are already running finish normally. If steps remain once they do, the workflow
+// result is marked Paused and, unlike a failure, completed steps are left in place.
+func (w *BaseWorkflow) RequestPause() { w.pause.Store(true) }
+
 // ResultChan returns the channel that will receive the workflow execution result.
 // This channel will always receive exactly one WorkflowResult, regardless of whether
 // the workflow succeeds, fails, times out, or is cancelled. The Success field and
@@ -135,9 +191,21 @@ func (w *BaseWorkflow) Start(ctx context.Context) {
 	}()
 }
 
-// ExecuteSteps runs all workflow steps in sequence and returns a consolidated result.
-// It stops execution on the first step failure unless the workflow defines different behavior.
+// ExecuteSteps runs all workflow steps in dependency order and returns a consolidated result.
+// Steps of a base workflow run in sequence; steps of a DAG workflow run concurrently once
+// their dependencies complete. No new steps are started after the first step failure,
+// once the context is done, or once a pause is requested, though steps already running
+// are allowed to finish.
 // It also handles context cancellation gracefully by including it in the returned result.
+//
+// If a Checkpointer is configured, steps already completed by a previous run are
+// skipped and every executed step has its start and outcome recorded. Because a
+// step that was in flight when the previous run died is executed again, steps
+// must be idempotent.
+//
+// When a step fails, the compensations of the steps completed before it (including
+// steps completed by a previous run) are executed in reverse order, and each one is
+// appended to the step results.
 func (w *BaseWorkflow) ExecuteSteps(ctx context.Context) WorkflowResult {
 	result := WorkflowResult{
 		Success:     true,
@@ -147,52 +215,261 @@ func (w *BaseWorkflow) ExecuteSteps(ctx context.Context) WorkflowResult {
 
 	if ctx.Err() != nil {
 		result.Success = false
-		result.Error = fmt.Errorf("workflow aborted: %w", ctx.Err())
+		result.Error = fmt.Errorf("workflow aborted: %w", context.Cause(ctx))
 		result.CompletedAt = time.Now()
 		return result
 	}
 
-	for _, step := range w.steps {
-		stepResult := StepResult{
-			StepName:  step.Name,
-			StartedAt: time.Now(),
+	completed := map[string]bool{}
+	if w.checkpointer != nil {
+		var err error
+		if completed, err = w.checkpointer.CompletedSteps(ctx); err != nil {
+			result.Success = false
+			result.Error = fmt.Errorf("loading step checkpoints: %w", err)
+			result.CompletedAt = time.Now()
+			return result
 		}
+	}
 
-		// We run this in a separate goroutine to avoid blocking the main workflow,
-		// and providing us a way to check for context cancellation.
-		// This is necessary because we do not control the execution of the steps,
-		// and they may take an arbitrary amount of time to complete.
-		resultChan := make(chan error, 1)
-		go func(s Step) {
-			resultChan <- s.Execute(ctx)
-		}(step)
+	// Track how many unfinished dependencies each step still has, treating steps
+	// completed by a previous run as already satisfied.
+	pending := make([]int, len(w.steps))
+	dependents := make([][]int, len(w.steps))
+	for i, deps := range w.deps {
+		pending[i] = len(deps)
+		for _, dep := range deps {
+			dependents[dep] = append(dependents[dep], i)
+		}
+	}
 
-		var err error
-		select {
-		case err = <-resultChan:
-			// Step completed.
-		case <-ctx.Done():
-			// Context canceled - we acknowledge it but don't wait for the step.
-			// TODO: Maybe consider giving the step a chance to finish?
-			err = ctx.Err()
+	var done []int // Indexes of completed steps in completion order, for compensation
+	for i, step := range w.steps {
+		if !completed[step.Name] {
+			continue
+		}
+		now := time.Now()
+		result.StepResults = append(result.StepResults, StepResult{
+			StepName:    step.Name,
+			Success:     true,
+			StartedAt:   now,
+			CompletedAt: now,
+			Resumed:     true,
+		})
+		done = append(done, i)
+		for _, dependent := range dependents[i] {
+			pending[dependent]--
 		}
+	}
 
-		stepResult.CompletedAt = time.Now()
-		stepResult.Duration = stepResult.CompletedAt.Sub(stepResult.StartedAt)
+	var ready []int
+	for i, step := range w.steps {
+		if pending[i] == 0 && !completed[step.Name] {
+			ready = append(ready, i)
+		}
+	}
 
-		if err != nil {
-			stepResult.Success = false
-			stepResult.Error = err
-			result.Success = false
-			result.Error = fmt.Errorf("step %s: %w", step.Name, err)
-			result.StepResults = append(result.StepResults, stepResult)
+	type indexedResult struct {
+		index  int
+		result StepResult
+	}
+	finished := make(chan indexedResult)
+	running := 0
+	for {
+		for result.Success && ctx.Err() == nil && !w.pause.Load() && running < w.maxParallel && len(ready) > 0 {
+			i := ready[0]
+			ready = ready[1:]
+			running++
+			go func(i int) {
+				finished <- indexedResult{index: i, result: w.executeStep(ctx, i, w.steps[i])}
+			}(i)
+		}
+		if running == 0 {
 			break
 		}
 
-		stepResult.Success = true
-		result.StepResults = append(result.StepResults, stepResult)
+		r := <-finished
+		running--
+		step := w.steps[r.index]
+		result.StepResults = append(result.StepResults, r.result)
+
+		if !r.result.Success {
+			if result.Success {
+				result.Success = false
+				result.Error = fmt.Errorf("step %s: %w", step.Name, r.result.Error)
+			}
+			continue
+		}
+
+		done = append(done, r.index)
+		for _, dependent := range dependents[r.index] {
+			if pending[dependent]--; pending[dependent] == 0 && !completed[w.steps[dependent].Name] {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+
+	// Every step that ran succeeded, but the context ended or a pause was requested
+	// before the rest could start.
+	if result.Success && len(done) < len(w.steps) {
+		result.Success = false
+		if ctx.Err() == nil && w.pause.Load() {
+			result.Paused = true
+			result.Error = ErrWorkflowPaused
+		} else {
+			result.Error = fmt.Errorf("workflow aborted: %w", context.Cause(ctx))
+		}
+	}
+
+	if !result.Success && !result.Paused {
+		result.StepResults = append(result.StepResults, w.compensate(ctx, done)...)
 	}
 	result.CompletedAt = time.Now()
 
 	return result
 }
+
+// executeStep runs a single step, recording its progress with the checkpointer
+// if one is configured.
+func (w *BaseWorkflow) executeStep(ctx context.Context, index int, step Step) StepResult {
+	stepResult := StepResult{
+		StepName:  step.Name,
+		StartedAt: time.Now(),
+	}
+
+	finish := func(err error) StepResult {
+		stepResult.CompletedAt = time.Now()
+		stepResult.Duration = stepResult.CompletedAt.Sub(stepResult.StartedAt)
+		stepResult.Success = err == nil
+		stepResult.Error = err
+		return stepResult
+	}
+
+	if w.checkpointer != nil {
+		if err := w.checkpointer.StepStarted(ctx, index, step.Name); err != nil {
+			return finish(fmt.Errorf("recording step start: %w", err))
+		}
+	}
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		stepResult.Attempts = attempt
+		err = w.runStep(ctx, step)
+		if err == nil || attempt >= step.Retry.attempts() || !step.Retry.shouldRetry(err) {
+			break
+		}
+
+		// Wait before the next attempt, giving up early if the workflow is cancelled.
+		timer := time.NewTimer(step.Retry.Backoff(attempt))
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			err = context.Cause(ctx)
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	if err != nil && stepResult.Attempts > 1 {
+		err = fmt.Errorf("after %d attempts: %w", stepResult.Attempts, err)
+	}
+
+	stepResult = finish(err)
+
+	if w.checkpointer != nil {
+		// Record the outcome even if the workflow context is done so that the
+		// checkpoint does not remain in progress forever.
+		recordCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), checkpointTimeout)
+		defer cancel()
+		if cpErr := w.checkpointer.StepFinished(recordCtx, index, stepResult); cpErr != nil && err == nil {
+			return finish(fmt.Errorf("recording step completion: %w", cpErr))
+		}
+	}
+
+	return stepResult
+}
+
+// runStep makes a single attempt at executing a step.
+func (w *BaseWorkflow) runStep(ctx context.Context, step Step) error {
+	// We run this in a separate goroutine to avoid blocking the main workflow,
+	// and providing us a way to check for context cancellation.
+	// This is necessary because we do not control the execution of the steps,
+	// and they may take an arbitrary amount of time to complete.
+	resultChan := make(chan error, 1)
+	go func(s Step) {
+		resultChan <- s.Execute(ctx)
+	}(step)
+
+	select {
+	case err := <-resultChan:
+		// Step completed.
+		return err
+	case <-ctx.Done():
+		// Context canceled - give the step the grace period to finish before
+		// abandoning it, so that we know whether its side effects took place.
+		// A step that has already returned keeps its outcome either way.
+		if w.cancelGrace <= 0 {
+			select {
+			case err := <-resultChan:
+				return err
+			default:
+				return context.Cause(ctx)
+			}
+		}
+		timer := time.NewTimer(w.cancelGrace)
+		defer timer.Stop()
+		select {
+		case err := <-resultChan:
+			return err
+		case <-timer.C:
+			return context.Cause(ctx)
+		}
+	}
+}
+
+// compensate runs the compensation functions of the completed steps at the given
+// indexes in reverse order. Compensations use a context detached from the workflow's,
+// since the failure being compensated is often the workflow context itself expiring.
+// A failing compensation does not stop the remaining ones from running.
+//
+// If a Checkpointer is configured, each successfully compensated step is recorded so
+// that a later retry of the workflow runs it again instead of skipping it.
+func (w *BaseWorkflow) compensate(ctx context.Context, completed []int) []StepResult {
+	var results []StepResult
+	for i := len(completed) - 1; i >= 0; i-- {
+		index := completed[i]
+		step := w.steps[index]
+		if step.Compensate == nil {
+			continue
+		}
+
+		stepResult := StepResult{
+			StepName:     step.Name,
+			StartedAt:    time.Now(),
+			Attempts:     1,
+			Compensation: true,
+		}
+
+		compensateCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), DefaultCompensationTimeout)
+		err := step.Compensate(compensateCtx)
+		cancel()
+
+		stepResult.CompletedAt = time.Now()
+		stepResult.Duration = stepResult.CompletedAt.Sub(stepResult.StartedAt)
+		stepResult.Success = err == nil
+		if err != nil {
+			stepResult.Error = fmt.Errorf("compensating step %s: %w", step.Name, err)
+		}
+
+		if err == nil && w.checkpointer != nil {
+			recordCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), checkpointTimeout)
+			if cpErr := w.checkpointer.StepCompensated(recordCtx, index, step.Name); cpErr != nil {
+				stepResult.Success = false
+				stepResult.Error = fmt.Errorf("recording compensation of step %s: %w", step.Name, cpErr)
+			}
+			cancel()
+		}
+		results = append(results, stepResult)
+	}
+	return results
+}
@@ -3,11 +3,14 @@ package workflow_test
 import (
 	"context"
 	"errors"
+	"maps"
+	"sync"
 	"testing"
 	"testing/synctest"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/ahrav/hoglet-hub/internal/application/workflow"
 )
@@ -250,3 +253,576 @@ func TestWorkflow_StepHangs_WithTimeout(t *testing.T) {
 		assert.True(t, duration < timeout+100*time.Millisecond)
 	})
 }
+
+// fakeCheckpointer is an in-memory workflow.Checkpointer that records the
+// step lifecycle events it receives.
+type fakeCheckpointer struct {
+	mu          sync.Mutex
+	completed   map[string]bool
+	started     []string
+	finished    map[string]bool
+	compensated []string
+}
+
+func newFakeCheckpointer(completed ...string) *fakeCheckpointer {
+	c := &fakeCheckpointer{completed: make(map[string]bool), finished: make(map[string]bool)}
+	for _, name := range completed {
+		c.completed[name] = true
+	}
+	return c
+}
+
+func (c *fakeCheckpointer) CompletedSteps(context.Context) (map[string]bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return maps.Clone(c.completed), nil
+}
+
+func (c *fakeCheckpointer) StepStarted(_ context.Context, _ int, name string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.started = append(c.started, name)
+	return nil
+}
+
+func (c *fakeCheckpointer) StepFinished(_ context.Context, _ int, result workflow.StepResult) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.finished[result.StepName] = result.Success
+	return nil
+}
+
+func (c *fakeCheckpointer) StepCompensated(_ context.Context, _ int, name string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.compensated = append(c.compensated, name)
+	return nil
+}
+
+func TestWorkflow_Checkpoint_RecordsCompensatedSteps(t *testing.T) {
+	compensationErr := errors.New("compensation failed")
+	wf := workflow.NewBaseWorkflow([]workflow.Step{
+		{
+			Name:       "step1",
+			Execute:    func(ctx context.Context) error { return nil },
+			Compensate: func(ctx context.Context) error { return nil },
+		},
+		{
+			Name:       "step2",
+			Execute:    func(ctx context.Context) error { return nil },
+			Compensate: func(ctx context.Context) error { return compensationErr },
+		},
+		{
+			Name:    "step3",
+			Execute: func(ctx context.Context) error { return nil },
+		},
+		{
+			Name:    "step4",
+			Execute: func(ctx context.Context) error { return errors.New("boom") },
+		},
+	})
+	checkpointer := newFakeCheckpointer()
+	wf.SetCheckpointer(checkpointer)
+
+	result := wf.ExecuteSteps(context.Background())
+	assert.False(t, result.Success)
+
+	// Only step1 was actually undone: step2's compensation failed and step3 has none,
+	// so their effects remain and they still count as completed.
+	assert.Equal(t, []string{"step1"}, checkpointer.compensated)
+}
+
+func TestWorkflow_Checkpoint_ResumesFromLastCompletedStep(t *testing.T) {
+	var executionOrder []string
+	newStep := func(name string) workflow.Step {
+		return workflow.Step{
+			Name: name,
+			Execute: func(ctx context.Context) error {
+				executionOrder = append(executionOrder, name)
+				return nil
+			},
+		}
+	}
+
+	wf := workflow.NewBaseWorkflow([]workflow.Step{newStep("step1"), newStep("step2"), newStep("step3")})
+	checkpointer := newFakeCheckpointer("step1")
+	wf.SetCheckpointer(checkpointer)
+
+	result := wf.ExecuteSteps(context.Background())
+	assert.True(t, result.Success)
+	assert.Equal(t, []string{"step2", "step3"}, executionOrder)
+
+	assert.Len(t, result.StepResults, 3)
+	assert.True(t, result.StepResults[0].Resumed)
+	assert.True(t, result.StepResults[0].Success)
+	assert.False(t, result.StepResults[1].Resumed)
+
+	assert.Equal(t, []string{"step2", "step3"}, checkpointer.started)
+	assert.Equal(t, map[string]bool{"step2": true, "step3": true}, checkpointer.finished)
+}
+
+func TestWorkflow_Checkpoint_RecordsFailedStep(t *testing.T) {
+	expectedErr := errors.New("boom")
+	wf := workflow.NewBaseWorkflow([]workflow.Step{
+		{Name: "step1", Execute: func(ctx context.Context) error { return nil }},
+		{Name: "step2", Execute: func(ctx context.Context) error { return expectedErr }},
+	})
+	checkpointer := newFakeCheckpointer()
+	wf.SetCheckpointer(checkpointer)
+
+	result := wf.ExecuteSteps(context.Background())
+	assert.False(t, result.Success)
+	assert.ErrorIs(t, result.Error, expectedErr)
+	assert.Equal(t, map[string]bool{"step1": true, "step2": false}, checkpointer.finished)
+}
+
+func TestWorkflow_Compensation_RunsInReverseOrder(t *testing.T) {
+	expectedErr := errors.New("deploy failed")
+	var compensated []string
+	compensate := func(name string) func(context.Context) error {
+		return func(ctx context.Context) error {
+			compensated = append(compensated, name)
+			return nil
+		}
+	}
+
+	wf := workflow.NewBaseWorkflow([]workflow.Step{
+		{Name: "step1", Execute: func(ctx context.Context) error { return nil }, Compensate: compensate("step1")},
+		{Name: "step2", Execute: func(ctx context.Context) error { return nil }},
+		{Name: "step3", Execute: func(ctx context.Context) error { return nil }, Compensate: compensate("step3")},
+		{Name: "step4", Execute: func(ctx context.Context) error { return expectedErr }, Compensate: compensate("step4")},
+		{Name: "step5", Execute: func(ctx context.Context) error { return nil }, Compensate: compensate("step5")},
+	})
+
+	result := wf.ExecuteSteps(context.Background())
+	assert.False(t, result.Success)
+	assert.ErrorIs(t, result.Error, expectedErr)
+
+	// The failed step and the steps after it have nothing to undo.
+	assert.Equal(t, []string{"step3", "step1"}, compensated)
+
+	assert.Len(t, result.StepResults, 6)
+	for i, name := range []string{"step3", "step1"} {
+		stepResult := result.StepResults[4+i]
+		assert.Equal(t, name, stepResult.StepName)
+		assert.True(t, stepResult.Compensation)
+		assert.True(t, stepResult.Success)
+	}
+}
+
+func TestWorkflow_Compensation_ContinuesAfterFailure(t *testing.T) {
+	compensationErr := errors.New("rollback failed")
+	var compensated []string
+
+	wf := workflow.NewBaseWorkflow([]workflow.Step{
+		{
+			Name:    "step1",
+			Execute: func(ctx context.Context) error { return nil },
+			Compensate: func(ctx context.Context) error {
+				compensated = append(compensated, "step1")
+				return nil
+			},
+		},
+		{
+			Name:    "step2",
+			Execute: func(ctx context.Context) error { return nil },
+			Compensate: func(ctx context.Context) error {
+				compensated = append(compensated, "step2")
+				return compensationErr
+			},
+		},
+		{Name: "step3", Execute: func(ctx context.Context) error { return errors.New("boom") }},
+	})
+
+	result := wf.ExecuteSteps(context.Background())
+	assert.False(t, result.Success)
+	assert.Equal(t, []string{"step2", "step1"}, compensated)
+
+	assert.Len(t, result.StepResults, 5)
+	assert.False(t, result.StepResults[3].Success)
+	assert.ErrorIs(t, result.StepResults[3].Error, compensationErr)
+	assert.True(t, result.StepResults[4].Success)
+}
+
+func TestWorkflow_Compensation_RunsAfterCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var compensateCtxErr error
+
+	wf := workflow.NewBaseWorkflow([]workflow.Step{
+		{
+			Name:    "step1",
+			Execute: func(ctx context.Context) error { return nil },
+			Compensate: func(ctx context.Context) error {
+				compensateCtxErr = ctx.Err()
+				return nil
+			},
+		},
+		{
+			Name: "step2",
+			Execute: func(ctx context.Context) error {
+				cancel()
+				return ctx.Err()
+			},
+		},
+	})
+
+	result := wf.ExecuteSteps(ctx)
+	assert.False(t, result.Success)
+	assert.ErrorIs(t, result.Error, context.Canceled)
+	assert.NoError(t, compensateCtxErr)
+	assert.True(t, result.StepResults[len(result.StepResults)-1].Compensation)
+}
+
+func TestWorkflow_Cancellation_StopsBeforeNextStep(t *testing.T) {
+	ctx, cancel := context.WithCancelCause(context.Background())
+	cause := &workflow.CancellationError{Reason: "no longer needed", Actor: "alice"}
+	var compensated, secondRan bool
+
+	wf := workflow.NewBaseWorkflow([]workflow.Step{
+		{
+			Name: "step1",
+			Execute: func(ctx context.Context) error {
+				cancel(cause)
+				return nil
+			},
+			Compensate: func(ctx context.Context) error {
+				compensated = true
+				return nil
+			},
+		},
+		{
+			Name: "step2",
+			Execute: func(ctx context.Context) error {
+				secondRan = true
+				return nil
+			},
+		},
+	})
+
+	result := wf.ExecuteSteps(ctx)
+	assert.False(t, result.Success)
+	assert.False(t, secondRan)
+	assert.True(t, compensated)
+
+	var cancelErr *workflow.CancellationError
+	require.ErrorAs(t, result.Error, &cancelErr)
+	assert.Equal(t, "alice", cancelErr.Actor)
+	assert.ErrorIs(t, result.Error, context.Canceled)
+}
+
+func TestWorkflow_Cancellation_GracePeriodLetsStepFinish(t *testing.T) {
+	ctx, cancel := context.WithCancelCause(context.Background())
+	release := make(chan struct{})
+
+	wf := workflow.NewBaseWorkflow([]workflow.Step{
+		{
+			Name: "slow",
+			Execute: func(ctx context.Context) error {
+				cancel(&workflow.CancellationError{Reason: "stop", Actor: "bob"})
+				<-release
+				return nil
+			},
+		},
+		{
+			Name:    "next",
+			Execute: func(ctx context.Context) error { return nil },
+		},
+	})
+	wf.SetCancelGracePeriod(time.Second)
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		close(release)
+	}()
+
+	result := wf.ExecuteSteps(ctx)
+	assert.False(t, result.Success)
+	require.Len(t, result.StepResults, 1)
+	assert.Equal(t, "slow", result.StepResults[0].StepName)
+	assert.True(t, result.StepResults[0].Success, "step finishing within the grace period keeps its outcome")
+	assert.ErrorContains(t, result.Error, "workflow aborted")
+}
+
+func TestWorkflow_Pause_StopsBeforeNextStepWithoutCompensating(t *testing.T) {
+	var compensated, secondRan bool
+	var wf *workflow.BaseWorkflow
+	wf = workflow.NewBaseWorkflow([]workflow.Step{
+		{
+			Name: "step1",
+			Execute: func(ctx context.Context) error {
+				wf.RequestPause()
+				return nil
+			},
+			Compensate: func(ctx context.Context) error {
+				compensated = true
+				return nil
+			},
+		},
+		{
+			Name: "step2",
+			Execute: func(ctx context.Context) error {
+				secondRan = true
+				return nil
+			},
+		},
+	})
+	checkpointer := newFakeCheckpointer()
+	wf.SetCheckpointer(checkpointer)
+
+	result := wf.ExecuteSteps(context.Background())
+	assert.False(t, result.Success)
+	assert.True(t, result.Paused)
+	assert.ErrorIs(t, result.Error, workflow.ErrWorkflowPaused)
+	assert.False(t, secondRan)
+	assert.False(t, compensated, "paused workflows keep their completed steps")
+	assert.Equal(t, map[string]bool{"step1": true}, checkpointer.finished)
+}
+
+func TestWorkflow_Pause_AfterLastStepCompletes(t *testing.T) {
+	var wf *workflow.BaseWorkflow
+	wf = workflow.NewBaseWorkflow([]workflow.Step{
+		{
+			Name: "only",
+			Execute: func(ctx context.Context) error {
+				wf.RequestPause()
+				return nil
+			},
+		},
+	})
+
+	result := wf.ExecuteSteps(context.Background())
+	assert.True(t, result.Success)
+	assert.False(t, result.Paused)
+}
+
+func TestWorkflow_Retry_SucceedsAfterTransientFailures(t *testing.T) {
+	calls := 0
+	wf := workflow.NewBaseWorkflow([]workflow.Step{
+		{
+			Name: "flaky",
+			Execute: func(ctx context.Context) error {
+				calls++
+				if calls < 3 {
+					return errors.New("transient")
+				}
+				return nil
+			},
+			Retry: &workflow.RetryPolicy{MaxAttempts: 5, InitialBackoff: time.Millisecond},
+		},
+	})
+
+	result := wf.ExecuteSteps(context.Background())
+	assert.True(t, result.Success)
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, 3, result.StepResults[0].Attempts)
+}
+
+func TestWorkflow_Retry_GivesUpAfterMaxAttempts(t *testing.T) {
+	expectedErr := errors.New("still failing")
+	calls := 0
+	wf := workflow.NewBaseWorkflow([]workflow.Step{
+		{
+			Name: "broken",
+			Execute: func(ctx context.Context) error {
+				calls++
+				return expectedErr
+			},
+			Retry: &workflow.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond},
+		},
+	})
+
+	result := wf.ExecuteSteps(context.Background())
+	assert.False(t, result.Success)
+	assert.ErrorIs(t, result.Error, expectedErr)
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, 3, result.StepResults[0].Attempts)
+}
+
+func TestWorkflow_Retry_StopsOnNonRetryableError(t *testing.T) {
+	permanentErr := errors.New("permanent")
+	calls := 0
+	wf := workflow.NewBaseWorkflow([]workflow.Step{
+		{
+			Name: "step1",
+			Execute: func(ctx context.Context) error {
+				calls++
+				return permanentErr
+			},
+			Retry: &workflow.RetryPolicy{
+				MaxAttempts:    5,
+				InitialBackoff: time.Millisecond,
+				Retryable:      func(err error) bool { return !errors.Is(err, permanentErr) },
+			},
+		},
+	})
+
+	result := wf.ExecuteSteps(context.Background())
+	assert.False(t, result.Success)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, 1, result.StepResults[0].Attempts)
+}
+
+func TestWorkflow_Retry_NoPolicyRunsOnce(t *testing.T) {
+	calls := 0
+	wf := workflow.NewBaseWorkflow([]workflow.Step{
+		{
+			Name: "step1",
+			Execute: func(ctx context.Context) error {
+				calls++
+				return errors.New("boom")
+			},
+		},
+	})
+
+	result := wf.ExecuteSteps(context.Background())
+	assert.False(t, result.Success)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, 1, result.StepResults[0].Attempts)
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := &workflow.RetryPolicy{
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+		Multiplier:     2,
+	}
+
+	assert.Equal(t, 100*time.Millisecond, policy.Backoff(1))
+	assert.Equal(t, 200*time.Millisecond, policy.Backoff(2))
+	assert.Equal(t, 400*time.Millisecond, policy.Backoff(3))
+	assert.Equal(t, time.Second, policy.Backoff(10))
+
+	policy.Jitter = 0.5
+	for range 100 {
+		backoff := policy.Backoff(1)
+		assert.GreaterOrEqual(t, backoff, 50*time.Millisecond)
+		assert.LessOrEqual(t, backoff, 150*time.Millisecond)
+	}
+}
+
+func TestNewDAGWorkflow_InvalidGraph(t *testing.T) {
+	noop := func(ctx context.Context) error { return nil }
+
+	testCases := []struct {
+		desc  string
+		steps []workflow.Step
+	}{
+		{
+			desc:  "duplicate step",
+			steps: []workflow.Step{{Name: "a", Execute: noop}, {Name: "a", Execute: noop}},
+		},
+		{
+			desc:  "unknown dependency",
+			steps: []workflow.Step{{Name: "a", Execute: noop, DependsOn: []string{"missing"}}},
+		},
+		{
+			desc: "cycle",
+			steps: []workflow.Step{
+				{Name: "a", Execute: noop, DependsOn: []string{"b"}},
+				{Name: "b", Execute: noop, DependsOn: []string{"a"}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			wf, err := workflow.NewDAGWorkflow(tc.steps, 2)
+			assert.ErrorIs(t, err, workflow.ErrInvalidStepGraph)
+			assert.Nil(t, wf)
+		})
+	}
+}
+
+func TestDAGWorkflow_RunsIndependentStepsConcurrently(t *testing.T) {
+	var (
+		mu         sync.Mutex
+		running    int
+		maxRunning int
+		order      []string
+	)
+	newStep := func(name string, deps ...string) workflow.Step {
+		return workflow.Step{
+			Name:      name,
+			DependsOn: deps,
+			Execute: func(ctx context.Context) error {
+				mu.Lock()
+				running++
+				maxRunning = max(maxRunning, running)
+				order = append(order, name)
+				mu.Unlock()
+
+				time.Sleep(20 * time.Millisecond)
+
+				mu.Lock()
+				running--
+				mu.Unlock()
+				return nil
+			},
+		}
+	}
+
+	wf, err := workflow.NewDAGWorkflow([]workflow.Step{
+		newStep("root"),
+		newStep("left", "root"),
+		newStep("middle", "root"),
+		newStep("right", "root"),
+		newStep("join", "left", "middle", "right"),
+	}, 2)
+	assert.NoError(t, err)
+
+	wf.Start(context.Background())
+	result := <-wf.ResultChan()
+
+	assert.True(t, result.Success)
+	assert.Len(t, result.StepResults, 5)
+	assert.Equal(t, 2, maxRunning, "parallelism should be bounded")
+	assert.Equal(t, "root", order[0])
+	assert.Equal(t, "join", order[4])
+	for _, stepResult := range result.StepResults {
+		assert.Equal(t, 1, stepResult.Attempts)
+		assert.Greater(t, stepResult.Duration, time.Duration(0))
+	}
+}
+
+func TestDAGWorkflow_FailureStopsDependentsAndCompensates(t *testing.T) {
+	expectedErr := errors.New("secrets failed")
+	var (
+		mu          sync.Mutex
+		executed    []string
+		compensated []string
+	)
+	record := func(list *[]string, name string) {
+		mu.Lock()
+		defer mu.Unlock()
+		*list = append(*list, name)
+	}
+	newStep := func(name string, err error, deps ...string) workflow.Step {
+		return workflow.Step{
+			Name:      name,
+			DependsOn: deps,
+			Execute: func(ctx context.Context) error {
+				record(&executed, name)
+				return err
+			},
+			Compensate: func(ctx context.Context) error {
+				record(&compensated, name)
+				return nil
+			},
+		}
+	}
+
+	wf, err := workflow.NewDAGWorkflow([]workflow.Step{
+		newStep("init", nil),
+		newStep("database", nil, "init"),
+		newStep("secrets", expectedErr, "init"),
+		newStep("deploy", nil, "database", "secrets"),
+	}, 2)
+	assert.NoError(t, err)
+
+	result := wf.ExecuteSteps(context.Background())
+	assert.False(t, result.Success)
+	assert.ErrorIs(t, result.Error, expectedErr)
+	assert.NotContains(t, executed, "deploy")
+	assert.ElementsMatch(t, []string{"database", "init"}, compensated)
+	assert.Equal(t, "init", compensated[len(compensated)-1])
+}
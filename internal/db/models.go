@@ -152,6 +152,7 @@ const (
 	OperationStatusCompleted  OperationStatus = "completed"
 	OperationStatusFailed     OperationStatus = "failed"
 	OperationStatusCancelled  OperationStatus = "cancelled"
+	OperationStatusPaused     OperationStatus = "paused"
 )
 
 func (e *OperationStatus) Scan(src interface{}) error {
@@ -189,6 +190,50 @@ func (ns NullOperationStatus) Value() (driver.Value, error) {
 	return string(ns.OperationStatus), nil
 }
 
+type OperationStepStatus string
+
+const (
+	OperationStepStatusInProgress  OperationStepStatus = "in_progress"
+	OperationStepStatusCompleted   OperationStepStatus = "completed"
+	OperationStepStatusFailed      OperationStepStatus = "failed"
+	OperationStepStatusCompensated OperationStepStatus = "compensated"
+)
+
+func (e *OperationStepStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = OperationStepStatus(s)
+	case string:
+		*e = OperationStepStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for OperationStepStatus: %T", src)
+	}
+	return nil
+}
+
+type NullOperationStepStatus struct {
+	OperationStepStatus OperationStepStatus
+	Valid               bool // Valid is true if OperationStepStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullOperationStepStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.OperationStepStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.OperationStepStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullOperationStepStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.OperationStepStatus), nil
+}
+
 type RegionType string
 
 const (
@@ -329,6 +374,49 @@ func (ns NullTenantStatus) Value() (driver.Value, error) {
 	return string(ns.TenantStatus), nil
 }
 
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending    WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusSucceeded  WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryStatusDeadLetter WebhookDeliveryStatus = "dead_letter"
+)
+
+func (e *WebhookDeliveryStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WebhookDeliveryStatus(s)
+	case string:
+		*e = WebhookDeliveryStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WebhookDeliveryStatus: %T", src)
+	}
+	return nil
+}
+
+type NullWebhookDeliveryStatus struct {
+	WebhookDeliveryStatus WebhookDeliveryStatus
+	Valid                 bool // Valid is true if WebhookDeliveryStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWebhookDeliveryStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.WebhookDeliveryStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WebhookDeliveryStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWebhookDeliveryStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WebhookDeliveryStatus), nil
+}
+
 type AuditLog struct {
 	ID           int64
 	Action       string
@@ -351,15 +439,26 @@ type DatabaseNode struct {
 	Region                    RegionType
 	NodeType                  NodeType
 	Status                    DatabaseNodeStatus
-	TenantCount               pgtype.Int4
-	MaxTenants                pgtype.Int4
-	CurrentUtilizationPercent pgtype.Int4
+	TenantCount               int32
+	MaxTenants                int32
+	CurrentUtilizationPercent int32
 	CitusMetadata             []byte
 	CreatedAt                 pgtype.Timestamptz
 	UpdatedAt                 pgtype.Timestamptz
 	CreatedBy                 string
 }
 
+type IdempotencyKey struct {
+	Caller         string
+	Operation      string
+	IdempotencyKey string
+	Fingerprint    string
+	StatusCode     pgtype.Int4
+	ResponseBody   []byte
+	CreatedAt      pgtype.Timestamptz
+	ExpiresAt      pgtype.Timestamptz
+}
+
 type IsolationGroup struct {
 	ID                int64
 	Name              string
@@ -371,18 +470,59 @@ type IsolationGroup struct {
 }
 
 type Operation struct {
-	ID            int64
-	TenantID      pgtype.Int8
-	OperationType string
-	Status        OperationStatus
-	Parameters    []byte
-	Result        []byte
-	ErrorMessage  pgtype.Text
-	CreatedAt     pgtype.Timestamptz
-	UpdatedAt     pgtype.Timestamptz
-	StartedAt     pgtype.Timestamptz
-	CompletedAt   pgtype.Timestamptz
-	CreatedBy     string
+	ID                int64
+	TenantID          pgtype.Int8
+	OperationType     string
+	Status            OperationStatus
+	Parameters        []byte
+	Result            []byte
+	ErrorMessage      pgtype.Text
+	CreatedAt         pgtype.Timestamptz
+	UpdatedAt         pgtype.Timestamptz
+	StartedAt         pgtype.Timestamptz
+	CompletedAt       pgtype.Timestamptz
+	CreatedBy         string
+	CancelledBy       pgtype.Text
+	ParentOperationID pgtype.Int8
+	Attempt           int32
+}
+
+type OperationEvent struct {
+	ID           int64
+	OperationID  int64
+	EventType    string
+	Status       string
+	StepIndex    pgtype.Int4
+	StepName     pgtype.Text
+	ErrorMessage pgtype.Text
+	OccurredAt   pgtype.Timestamptz
+}
+
+type OperationStep struct {
+	ID           int64
+	OperationID  int64
+	StepIndex    int32
+	StepName     string
+	Status       OperationStepStatus
+	Attempts     int32
+	ErrorMessage pgtype.Text
+	StartedAt    pgtype.Timestamptz
+	CompletedAt  pgtype.Timestamptz
+	UpdatedAt    pgtype.Timestamptz
+}
+
+type OutboxEvent struct {
+	ID             int64
+	EventType      string
+	TenantID       pgtype.Int8
+	OperationID    pgtype.Int8
+	Data           []byte
+	OccurredAt     pgtype.Timestamptz
+	PublishedAt    pgtype.Timestamptz
+	Attempts       int32
+	NextAttemptAt  pgtype.Timestamptz
+	LastError      pgtype.Text
+	DeadLetteredAt pgtype.Timestamptz
 }
 
 type Resource struct {
@@ -424,3 +564,46 @@ type Tenant struct {
 	UpdatedAt           pgtype.Timestamptz
 	CreatedBy           string
 }
+
+type TenantSuspension struct {
+	ID                 int64
+	TenantID           int64
+	Reason             string
+	SuspendedBy        string
+	SuspendedAt        pgtype.Timestamptz
+	SuspendOperationID pgtype.Int8
+	ResumedAt          pgtype.Timestamptz
+	ResumedBy          pgtype.Text
+	ResumeReason       pgtype.Text
+	ResumeOperationID  pgtype.Int8
+}
+
+type WebhookDelivery struct {
+	ID             int64
+	SubscriptionID int64
+	EventID        int64
+	EventType      string
+	TenantID       pgtype.Int8
+	Payload        []byte
+	Status         WebhookDeliveryStatus
+	Attempts       int32
+	NextAttemptAt  pgtype.Timestamptz
+	LastAttemptAt  pgtype.Timestamptz
+	ResponseStatus pgtype.Int4
+	LastError      pgtype.Text
+	CreatedAt      pgtype.Timestamptz
+	DeliveredAt    pgtype.Timestamptz
+}
+
+type WebhookSubscription struct {
+	ID          int64
+	Url         string
+	Secret      string
+	EventTypes  []string
+	TenantID    pgtype.Int8
+	Description string
+	Active      bool
+	CreatedBy   string
+	CreatedAt   pgtype.Timestamptz
+	UpdatedAt   pgtype.Timestamptz
+}
@@ -11,6 +11,88 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const changeTenantIsolationGroup = `-- name: ChangeTenantIsolationGroup :execrows
+UPDATE tenants
+SET
+    isolation_group_id = $2,
+    is_isolated = $3,
+    updated_at = NOW()
+WHERE id = $1
+    AND status = 'active'
+    AND region = $4
+    AND NOT EXISTS (
+        SELECT 1 FROM operations
+        WHERE operations.tenant_id = tenants.id
+            AND operations.status IN ('pending', 'in_progress', 'paused')
+    )
+`
+
+type ChangeTenantIsolationGroupParams struct {
+	ID               int64
+	IsolationGroupID pgtype.Int8
+	IsIsolated       pgtype.Bool
+	Region           RegionType
+}
+
+func (q *Queries) ChangeTenantIsolationGroup(ctx context.Context, arg ChangeTenantIsolationGroupParams) (int64, error) {
+	result, err := q.db.Exec(ctx, changeTenantIsolationGroup,
+		arg.ID,
+		arg.IsolationGroupID,
+		arg.IsIsolated,
+		arg.Region,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const changeTenantRegion = `-- name: ChangeTenantRegion :execrows
+UPDATE tenants
+SET
+    region = $1,
+    updated_at = NOW()
+WHERE id = $2
+    AND status::text = ANY($3::text[])
+`
+
+type ChangeTenantRegionParams struct {
+	Region   RegionType
+	ID       int64
+	Statuses []string
+}
+
+func (q *Queries) ChangeTenantRegion(ctx context.Context, arg ChangeTenantRegionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, changeTenantRegion, arg.Region, arg.ID, arg.Statuses)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const changeTenantTier = `-- name: ChangeTenantTier :execrows
+UPDATE tenants
+SET
+    tier = $1,
+    updated_at = NOW()
+WHERE id = $2
+    AND status::text = ANY($3::text[])
+`
+
+type ChangeTenantTierParams struct {
+	Tier     string
+	ID       int64
+	Statuses []string
+}
+
+func (q *Queries) ChangeTenantTier(ctx context.Context, arg ChangeTenantTierParams) (int64, error) {
+	result, err := q.db.Exec(ctx, changeTenantTier, arg.Tier, arg.ID, arg.Statuses)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const createOperation = `-- name: CreateOperation :one
 
 INSERT INTO operations (
@@ -18,17 +100,21 @@ INSERT INTO operations (
     operation_type,
     status,
     parameters,
-    created_by
-) VALUES ($1, $2, $3, $4, $5)
+    created_by,
+    parent_operation_id,
+    attempt
+) VALUES ($1, $2, $3, $4, $5, $6, $7)
 RETURNING id
 `
 
 type CreateOperationParams struct {
-	TenantID      pgtype.Int8
-	OperationType string
-	Status        OperationStatus
-	Parameters    []byte
-	CreatedBy     string
+	TenantID          pgtype.Int8
+	OperationType     string
+	Status            OperationStatus
+	Parameters        []byte
+	CreatedBy         string
+	ParentOperationID pgtype.Int8
+	Attempt           int32
 }
 
 // Operation Queries
@@ -39,6 +125,8 @@ func (q *Queries) CreateOperation(ctx context.Context, arg CreateOperationParams
 		arg.Status,
 		arg.Parameters,
 		arg.CreatedBy,
+		arg.ParentOperationID,
+		arg.Attempt,
 	)
 	var id int64
 	err := row.Scan(&id)
@@ -99,7 +187,7 @@ func (q *Queries) DeleteTenant(ctx context.Context, id int64) error {
 }
 
 const findIncompleteOperations = `-- name: FindIncompleteOperations :many
-SELECT id, tenant_id, operation_type, status, parameters, result, error_message, created_at, updated_at, started_at, completed_at, created_by FROM operations
+SELECT id, tenant_id, operation_type, status, parameters, result, error_message, created_at, updated_at, started_at, completed_at, created_by, cancelled_by, parent_operation_id, attempt FROM operations
 WHERE status IN ('pending', 'in_progress')
 ORDER BY created_at ASC
 `
@@ -126,6 +214,9 @@ func (q *Queries) FindIncompleteOperations(ctx context.Context) ([]Operation, er
 			&i.StartedAt,
 			&i.CompletedAt,
 			&i.CreatedBy,
+			&i.CancelledBy,
+			&i.ParentOperationID,
+			&i.Attempt,
 		); err != nil {
 			return nil, err
 		}
@@ -138,7 +229,7 @@ func (q *Queries) FindIncompleteOperations(ctx context.Context) ([]Operation, er
 }
 
 const findOperationByID = `-- name: FindOperationByID :one
-SELECT id, tenant_id, operation_type, status, parameters, result, error_message, created_at, updated_at, started_at, completed_at, created_by FROM operations
+SELECT id, tenant_id, operation_type, status, parameters, result, error_message, created_at, updated_at, started_at, completed_at, created_by, cancelled_by, parent_operation_id, attempt FROM operations
 WHERE id = $1
 LIMIT 1
 `
@@ -159,12 +250,15 @@ func (q *Queries) FindOperationByID(ctx context.Context, id int64) (Operation, e
 		&i.StartedAt,
 		&i.CompletedAt,
 		&i.CreatedBy,
+		&i.CancelledBy,
+		&i.ParentOperationID,
+		&i.Attempt,
 	)
 	return i, err
 }
 
 const findOperationsByStatus = `-- name: FindOperationsByStatus :many
-SELECT id, tenant_id, operation_type, status, parameters, result, error_message, created_at, updated_at, started_at, completed_at, created_by FROM operations
+SELECT id, tenant_id, operation_type, status, parameters, result, error_message, created_at, updated_at, started_at, completed_at, created_by, cancelled_by, parent_operation_id, attempt FROM operations
 WHERE status = $1
 ORDER BY created_at ASC
 `
@@ -191,6 +285,9 @@ func (q *Queries) FindOperationsByStatus(ctx context.Context, status OperationSt
 			&i.StartedAt,
 			&i.CompletedAt,
 			&i.CreatedBy,
+			&i.CancelledBy,
+			&i.ParentOperationID,
+			&i.Attempt,
 		); err != nil {
 			return nil, err
 		}
@@ -203,7 +300,7 @@ func (q *Queries) FindOperationsByStatus(ctx context.Context, status OperationSt
 }
 
 const findOperationsByTenantID = `-- name: FindOperationsByTenantID :many
-SELECT id, tenant_id, operation_type, status, parameters, result, error_message, created_at, updated_at, started_at, completed_at, created_by FROM operations
+SELECT id, tenant_id, operation_type, status, parameters, result, error_message, created_at, updated_at, started_at, completed_at, created_by, cancelled_by, parent_operation_id, attempt FROM operations
 WHERE tenant_id = $1
 ORDER BY created_at DESC
 `
@@ -230,6 +327,9 @@ func (q *Queries) FindOperationsByTenantID(ctx context.Context, tenantID pgtype.
 			&i.StartedAt,
 			&i.CompletedAt,
 			&i.CreatedBy,
+			&i.CancelledBy,
+			&i.ParentOperationID,
+			&i.Attempt,
 		); err != nil {
 			return nil, err
 		}
@@ -269,6 +369,34 @@ func (q *Queries) FindTenantByID(ctx context.Context, id int64) (Tenant, error)
 	return i, err
 }
 
+const findTenantByIDIncludingDeleted = `-- name: FindTenantByIDIncludingDeleted :one
+SELECT id, name, region, status, tier, database_schema, is_isolated, gke_cluster_name, kubernetes_namespace, isolation_group_id, primary_node_id, created_at, updated_at, created_by FROM tenants
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) FindTenantByIDIncludingDeleted(ctx context.Context, id int64) (Tenant, error) {
+	row := q.db.QueryRow(ctx, findTenantByIDIncludingDeleted, id)
+	var i Tenant
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Region,
+		&i.Status,
+		&i.Tier,
+		&i.DatabaseSchema,
+		&i.IsIsolated,
+		&i.GkeClusterName,
+		&i.KubernetesNamespace,
+		&i.IsolationGroupID,
+		&i.PrimaryNodeID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CreatedBy,
+	)
+	return i, err
+}
+
 const findTenantByName = `-- name: FindTenantByName :one
 SELECT id, name, region, status, tier, database_schema, is_isolated, gke_cluster_name, kubernetes_namespace, isolation_group_id, primary_node_id, created_at, updated_at, created_by FROM tenants
 WHERE name = $1 AND status != 'deleted'
@@ -297,6 +425,309 @@ func (q *Queries) FindTenantByName(ctx context.Context, name string) (Tenant, er
 	return i, err
 }
 
+const listOperations = `-- name: ListOperations :many
+SELECT id, tenant_id, operation_type, status, parameters, result, error_message, created_at, updated_at, started_at, completed_at, created_by, cancelled_by, parent_operation_id, attempt FROM operations
+WHERE ($1::bigint IS NULL OR tenant_id = $1)
+    AND ($2::text IS NULL OR operation_type = $2)
+    AND ($3::operation_status IS NULL OR status = $3)
+    AND ($4::text IS NULL OR created_by = $4)
+    AND ($5::timestamptz IS NULL OR created_at >= $5)
+    AND ($6::timestamptz IS NULL OR created_at < $6)
+    AND (
+        $7::timestamptz IS NULL
+        OR (created_at, id) < ($7, $8::bigint)
+    )
+ORDER BY created_at DESC, id DESC
+LIMIT $9
+`
+
+type ListOperationsParams struct {
+	TenantID        pgtype.Int8
+	OperationType   pgtype.Text
+	Status          NullOperationStatus
+	CreatedBy       pgtype.Text
+	CreatedAfter    pgtype.Timestamptz
+	CreatedBefore   pgtype.Timestamptz
+	CursorCreatedAt pgtype.Timestamptz
+	CursorID        pgtype.Int8
+	PageLimit       int32
+}
+
+func (q *Queries) ListOperations(ctx context.Context, arg ListOperationsParams) ([]Operation, error) {
+	rows, err := q.db.Query(ctx, listOperations,
+		arg.TenantID,
+		arg.OperationType,
+		arg.Status,
+		arg.CreatedBy,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Operation
+	for rows.Next() {
+		var i Operation
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.OperationType,
+			&i.Status,
+			&i.Parameters,
+			&i.Result,
+			&i.ErrorMessage,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.StartedAt,
+			&i.CompletedAt,
+			&i.CreatedBy,
+			&i.CancelledBy,
+			&i.ParentOperationID,
+			&i.Attempt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTenantsByCreatedAt = `-- name: ListTenantsByCreatedAt :many
+SELECT id, name, region, status, tier, database_schema, is_isolated, gke_cluster_name, kubernetes_namespace, isolation_group_id, primary_node_id, created_at, updated_at, created_by FROM tenants
+WHERE (($1::tenant_status IS NULL AND status != 'deleted') OR status = $1)
+    AND ($2::region_type IS NULL OR region = $2)
+    AND ($3::text IS NULL OR tier = $3)
+    AND ($4::bigint IS NULL OR isolation_group_id = $4)
+    AND ($5::text IS NULL OR name LIKE $5 || '%')
+    AND (
+        $6::timestamptz IS NULL
+        OR ($7::boolean AND (created_at, id) < ($6, $8::bigint))
+        OR (NOT $7 AND (created_at, id) > ($6, $8))
+    )
+ORDER BY
+    CASE WHEN $7 THEN created_at END DESC,
+    CASE WHEN $7 THEN id END DESC,
+    CASE WHEN NOT $7 THEN created_at END ASC,
+    CASE WHEN NOT $7 THEN id END ASC
+LIMIT $9
+`
+
+type ListTenantsByCreatedAtParams struct {
+	Status           NullTenantStatus
+	Region           NullRegionType
+	Tier             pgtype.Text
+	IsolationGroupID pgtype.Int8
+	NamePrefix       pgtype.Text
+	CursorCreatedAt  pgtype.Timestamptz
+	Descending       bool
+	CursorID         pgtype.Int8
+	PageLimit        int32
+}
+
+func (q *Queries) ListTenantsByCreatedAt(ctx context.Context, arg ListTenantsByCreatedAtParams) ([]Tenant, error) {
+	rows, err := q.db.Query(ctx, listTenantsByCreatedAt,
+		arg.Status,
+		arg.Region,
+		arg.Tier,
+		arg.IsolationGroupID,
+		arg.NamePrefix,
+		arg.CursorCreatedAt,
+		arg.Descending,
+		arg.CursorID,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Tenant
+	for rows.Next() {
+		var i Tenant
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Region,
+			&i.Status,
+			&i.Tier,
+			&i.DatabaseSchema,
+			&i.IsIsolated,
+			&i.GkeClusterName,
+			&i.KubernetesNamespace,
+			&i.IsolationGroupID,
+			&i.PrimaryNodeID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.CreatedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTenantsByName = `-- name: ListTenantsByName :many
+SELECT id, name, region, status, tier, database_schema, is_isolated, gke_cluster_name, kubernetes_namespace, isolation_group_id, primary_node_id, created_at, updated_at, created_by FROM tenants
+WHERE (($1::tenant_status IS NULL AND status != 'deleted') OR status = $1)
+    AND ($2::region_type IS NULL OR region = $2)
+    AND ($3::text IS NULL OR tier = $3)
+    AND ($4::bigint IS NULL OR isolation_group_id = $4)
+    AND ($5::text IS NULL OR name LIKE $5 || '%')
+    AND (
+        $6::text IS NULL
+        OR ($7::boolean AND name < $6)
+        OR (NOT $7 AND name > $6)
+    )
+ORDER BY
+    CASE WHEN $7 THEN name END DESC,
+    CASE WHEN NOT $7 THEN name END ASC
+LIMIT $8
+`
+
+type ListTenantsByNameParams struct {
+	Status           NullTenantStatus
+	Region           NullRegionType
+	Tier             pgtype.Text
+	IsolationGroupID pgtype.Int8
+	NamePrefix       pgtype.Text
+	CursorName       pgtype.Text
+	Descending       bool
+	PageLimit        int32
+}
+
+func (q *Queries) ListTenantsByName(ctx context.Context, arg ListTenantsByNameParams) ([]Tenant, error) {
+	rows, err := q.db.Query(ctx, listTenantsByName,
+		arg.Status,
+		arg.Region,
+		arg.Tier,
+		arg.IsolationGroupID,
+		arg.NamePrefix,
+		arg.CursorName,
+		arg.Descending,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Tenant
+	for rows.Next() {
+		var i Tenant
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Region,
+			&i.Status,
+			&i.Tier,
+			&i.DatabaseSchema,
+			&i.IsIsolated,
+			&i.GkeClusterName,
+			&i.KubernetesNamespace,
+			&i.IsolationGroupID,
+			&i.PrimaryNodeID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.CreatedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockOperationStatus = `-- name: LockOperationStatus :one
+SELECT status FROM operations
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) LockOperationStatus(ctx context.Context, id int64) (OperationStatus, error) {
+	row := q.db.QueryRow(ctx, lockOperationStatus, id)
+	var status OperationStatus
+	err := row.Scan(&status)
+	return status, err
+}
+
+const lockTenant = `-- name: LockTenant :one
+SELECT id, name, region, status, tier, database_schema, is_isolated, gke_cluster_name, kubernetes_namespace, isolation_group_id, primary_node_id, created_at, updated_at, created_by FROM tenants
+WHERE id = $1 AND status != 'deleted'
+FOR UPDATE
+`
+
+func (q *Queries) LockTenant(ctx context.Context, id int64) (Tenant, error) {
+	row := q.db.QueryRow(ctx, lockTenant, id)
+	var i Tenant
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Region,
+		&i.Status,
+		&i.Tier,
+		&i.DatabaseSchema,
+		&i.IsIsolated,
+		&i.GkeClusterName,
+		&i.KubernetesNamespace,
+		&i.IsolationGroupID,
+		&i.PrimaryNodeID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CreatedBy,
+	)
+	return i, err
+}
+
+const tenantHasIncompleteOperation = `-- name: TenantHasIncompleteOperation :one
+SELECT EXISTS (
+    SELECT 1 FROM operations
+    WHERE tenant_id = $1
+        AND status IN ('pending', 'in_progress', 'paused')
+)
+`
+
+func (q *Queries) TenantHasIncompleteOperation(ctx context.Context, tenantID pgtype.Int8) (bool, error) {
+	row := q.db.QueryRow(ctx, tenantHasIncompleteOperation, tenantID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const transitionTenantStatus = `-- name: TransitionTenantStatus :execrows
+UPDATE tenants
+SET
+    status = $1,
+    updated_at = NOW()
+WHERE id = $2
+    AND status::text = ANY($3::text[])
+`
+
+type TransitionTenantStatusParams struct {
+	ToStatus     TenantStatus
+	ID           int64
+	FromStatuses []string
+}
+
+func (q *Queries) TransitionTenantStatus(ctx context.Context, arg TransitionTenantStatusParams) (int64, error) {
+	result, err := q.db.Exec(ctx, transitionTenantStatus, arg.ToStatus, arg.ID, arg.FromStatuses)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateOperation = `-- name: UpdateOperation :exec
 UPDATE operations
 SET
@@ -305,6 +736,8 @@ SET
     error_message = $4,
     started_at = $5,
     completed_at = $6,
+    cancelled_by = $7,
+    parameters = $8,
     updated_at = NOW()
 WHERE id = $1
 `
@@ -316,6 +749,8 @@ type UpdateOperationParams struct {
 	ErrorMessage pgtype.Text
 	StartedAt    pgtype.Timestamptz
 	CompletedAt  pgtype.Timestamptz
+	CancelledBy  pgtype.Text
+	Parameters   []byte
 }
 
 func (q *Queries) UpdateOperation(ctx context.Context, arg UpdateOperationParams) error {
@@ -326,6 +761,8 @@ func (q *Queries) UpdateOperation(ctx context.Context, arg UpdateOperationParams
 		arg.ErrorMessage,
 		arg.StartedAt,
 		arg.CompletedAt,
+		arg.CancelledBy,
+		arg.Parameters,
 	)
 	return err
 }
@@ -340,6 +777,7 @@ SET
     database_schema = $6,
     kubernetes_namespace = $7,
     primary_node_id = $8,
+    region = $9,
     updated_at = NOW()
 WHERE id = $1
 `
@@ -353,6 +791,7 @@ type UpdateTenantParams struct {
 	DatabaseSchema      pgtype.Text
 	KubernetesNamespace pgtype.Text
 	PrimaryNodeID       pgtype.Int8
+	Region              RegionType
 }
 
 func (q *Queries) UpdateTenant(ctx context.Context, arg UpdateTenantParams) error {
@@ -365,6 +804,7 @@ func (q *Queries) UpdateTenant(ctx context.Context, arg UpdateTenantParams) erro
 		arg.DatabaseSchema,
 		arg.KubernetesNamespace,
 		arg.PrimaryNodeID,
+		arg.Region,
 	)
 	return err
 }
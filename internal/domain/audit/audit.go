@@ -0,0 +1,126 @@
+// Package audit models the audit trail of actions taken against tenants and
+// their operations, whether requested through the API or carried out by workflows.
+package audit
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"time"
+)
+
+// Common errors that can be returned by audit functions.
+var (
+	ErrInvalidStatus     = errors.New("invalid audit status")
+	ErrInvalidListParams = errors.New("invalid audit list parameters")
+)
+
+// Status is the outcome of an audited action.
+type Status string
+
+const (
+	StatusSuccess Status = "success"
+	StatusFailure Status = "failure"
+)
+
+// IsValid reports whether s is a known audit status.
+func (s Status) IsValid() bool {
+	switch s {
+	case StatusSuccess, StatusFailure:
+		return true
+	default:
+		return false
+	}
+}
+
+// Action names an audited action.
+type Action string
+
+// Actions requested through the API.
+const (
+	ActionCreateTenant               Action = "create_tenant"
+	ActionDeleteTenant               Action = "delete_tenant"
+	ActionChangeTenantTier           Action = "change_tenant_tier"
+	ActionMigrateTenant              Action = "migrate_tenant"
+	ActionSuspendTenant              Action = "suspend_tenant"
+	ActionResumeTenant               Action = "resume_tenant"
+	ActionChangeTenantIsolationGroup Action = "change_tenant_isolation_group"
+	ActionCancelOperation            Action = "cancel_operation"
+	ActionPauseOperation             Action = "pause_operation"
+	ActionResumeOperation            Action = "resume_operation"
+	ActionRetryOperation             Action = "retry_operation"
+	ActionCreateWebhookSubscription  Action = "create_webhook_subscription"
+	ActionUpdateWebhookSubscription  Action = "update_webhook_subscription"
+	ActionDeleteWebhookSubscription  Action = "delete_webhook_subscription"
+	ActionRedeliverWebhook           Action = "redeliver_webhook"
+)
+
+// ActionExecuteOperation is recorded by workflows once an operation has run to
+// completion, failed, or been cancelled.
+const ActionExecuteOperation Action = "execute_operation"
+
+// SystemActor is recorded as the actor of actions nobody can be credited with.
+const SystemActor = "system"
+
+// Entry is a single record in the audit trail.
+type Entry struct {
+	ID          int64
+	Action      Action
+	Timestamp   time.Time
+	Status      Status
+	Actor       string      // Who performed the action
+	ActorIP     *netip.Addr // Where the request came from; nil for workflows
+	TenantID    *int64      // Affected tenant, if any
+	ResourceID  *int64      // Affected resource, if any
+	OperationID *int64      // Related operation, if any
+	Details     map[string]any
+	Error       *string       // Why the action failed; nil on success
+	Duration    time.Duration // How long the action took; zero if unknown
+}
+
+// SetOutcome stamps the entry with the current time and the status implied by
+// err, recording the error message if the action failed.
+func (e *Entry) SetOutcome(err error) {
+	e.Timestamp = time.Now()
+	e.Status = StatusSuccess
+	if err != nil {
+		e.Status = StatusFailure
+		msg := err.Error()
+		e.Error = &msg
+	}
+}
+
+// ListFilter narrows an audit listing. Nil fields do not filter.
+type ListFilter struct {
+	Actor       *string
+	Action      *Action
+	Status      *Status
+	TenantID    *int64
+	OperationID *int64
+	Since       *time.Time // Inclusive
+	Until       *time.Time // Exclusive
+}
+
+// ListParams selects a page of entries, newest first.
+type ListParams struct {
+	Filter   ListFilter
+	BeforeID int64 // Only entries older than this one; zero starts from the newest
+	Limit    int
+}
+
+// Validate checks the filter values and page size.
+func (p ListParams) Validate() error {
+	if p.Limit < 1 {
+		return fmt.Errorf("%w: limit must be positive", ErrInvalidListParams)
+	}
+	if p.BeforeID < 0 {
+		return fmt.Errorf("%w: invalid cursor position", ErrInvalidListParams)
+	}
+	if p.Filter.Status != nil && !p.Filter.Status.IsValid() {
+		return fmt.Errorf("%w: %w", ErrInvalidListParams, ErrInvalidStatus)
+	}
+	if p.Filter.Since != nil && p.Filter.Until != nil && !p.Filter.Since.Before(*p.Filter.Until) {
+		return fmt.Errorf("%w: since must be before until", ErrInvalidListParams)
+	}
+	return nil
+}
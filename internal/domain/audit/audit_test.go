@@ -0,0 +1,61 @@
+package audit
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntry_SetOutcome(t *testing.T) {
+	succeeded := &Entry{Action: ActionCreateTenant}
+	succeeded.SetOutcome(nil)
+	assert.Equal(t, StatusSuccess, succeeded.Status)
+	assert.Nil(t, succeeded.Error)
+	assert.False(t, succeeded.Timestamp.IsZero())
+
+	failed := &Entry{Action: ActionDeleteTenant}
+	failed.SetOutcome(errors.New("tenant not found"))
+	assert.Equal(t, StatusFailure, failed.Status)
+	require.NotNil(t, failed.Error)
+	assert.Equal(t, "tenant not found", *failed.Error)
+}
+
+func TestListParams_Validate(t *testing.T) {
+	now := time.Now()
+	earlier := now.Add(-time.Hour)
+	unknown := Status("pending")
+
+	tests := []struct {
+		name    string
+		params  ListParams
+		wantErr error
+	}{
+		{name: "valid", params: ListParams{Filter: ListFilter{Since: &earlier, Until: &now}, Limit: 10}},
+		{name: "zero limit", params: ListParams{}, wantErr: ErrInvalidListParams},
+		{name: "negative cursor", params: ListParams{BeforeID: -1, Limit: 10}, wantErr: ErrInvalidListParams},
+		{
+			name:    "unknown status",
+			params:  ListParams{Filter: ListFilter{Status: &unknown}, Limit: 10},
+			wantErr: ErrInvalidStatus,
+		},
+		{
+			name:    "since after until",
+			params:  ListParams{Filter: ListFilter{Since: &now, Until: &earlier}, Limit: 10},
+			wantErr: ErrInvalidListParams,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package audit
+
+import "context"
+
+// Repository defines the interface for audit trail data access operations.
+// The trail is append-only: entries are never updated or deleted.
+type Repository interface {
+	// Create appends the entry to the trail and returns its ID.
+	Create(ctx context.Context, e *Entry) (int64, error)
+
+	// List retrieves up to params.Limit entries matching the filter, newest first.
+	List(ctx context.Context, params ListParams) ([]*Entry, error)
+}
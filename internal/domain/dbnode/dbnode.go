@@ -0,0 +1,166 @@
+// Package dbnode models the Citus database nodes that host tenant schemas and
+// the rules for placing tenants on them.
+package dbnode
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+)
+
+// Common errors that can be returned by database node functions.
+var (
+	ErrNodeNotFound      = errors.New("database node not found")
+	ErrNodeAlreadyExists = errors.New("database node already exists")
+	ErrInvalidHostname   = errors.New("invalid database node hostname")
+	ErrInvalidPort       = errors.New("invalid database node port")
+	ErrInvalidNodeType   = errors.New("invalid database node type")
+	ErrInvalidCapacity   = errors.New("invalid database node capacity")
+	ErrInvalidTransition = errors.New("invalid database node status transition")
+	ErrNodeNotEmpty      = errors.New("database node still hosts tenants")
+	ErrNoCapacity        = errors.New("no database node has capacity for the tenant")
+)
+
+// Type categorizes a node by the workloads it is sized for.
+type Type string
+
+const (
+	TypeStandard    Type = "standard"
+	TypeHighMemory  Type = "high-memory"
+	TypeIsolated    Type = "isolated"
+	TypeCoordinator Type = "coordinator"
+)
+
+// IsValid reports whether t is a known node type.
+func (t Type) IsValid() bool {
+	switch t {
+	case TypeStandard, TypeHighMemory, TypeIsolated, TypeCoordinator:
+		return true
+	default:
+		return false
+	}
+}
+
+// Status represents the operational state of a node.
+type Status string
+
+const (
+	StatusActive         Status = "active"
+	StatusDraining       Status = "draining"
+	StatusMaintenance    Status = "maintenance"
+	StatusOffline        Status = "offline"
+	StatusProvisioning   Status = "provisioning"
+	StatusDecommissioned Status = "decommissioned"
+)
+
+// DefaultMaxTenants is the recommended number of tenants per node when none is given.
+const DefaultMaxTenants = 10000
+
+// Node is a database node in the Citus cluster.
+type Node struct {
+	ID                 int64         // Unique identifier
+	Hostname           string        // Full hostname of the node
+	Port               int32         // PostgreSQL port
+	Region             tenant.Region // Region the node is deployed in
+	Type               Type          // Workloads the node is sized for
+	Status             Status        // Current operational state
+	TenantCount        int32         // Tenants whose primary node this is
+	MaxTenants         int32         // Tenants the node can host
+	UtilizationPercent int32         // TenantCount as a percentage of MaxTenants
+	CreatedBy          string        // Who registered the node
+	CreatedAt          time.Time     // Registration timestamp
+	UpdatedAt          *time.Time    // Last update timestamp
+}
+
+var validHostnamePattern = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9.-]{0,126}[a-zA-Z0-9])?$`)
+
+// NewNode creates a new active node with validation of all fields.
+// A zero maxTenants selects DefaultMaxTenants.
+func NewNode(
+	hostname string,
+	port int32,
+	region tenant.Region,
+	nodeType Type,
+	maxTenants int32,
+	createdBy string,
+) (*Node, error) {
+	if !validHostnamePattern.MatchString(hostname) {
+		return nil, ErrInvalidHostname
+	}
+	if port <= 0 || port > 65535 {
+		return nil, ErrInvalidPort
+	}
+	if !region.IsValid() {
+		return nil, tenant.ErrInvalidRegion
+	}
+	if !nodeType.IsValid() {
+		return nil, ErrInvalidNodeType
+	}
+	if maxTenants == 0 {
+		maxTenants = DefaultMaxTenants
+	}
+	if maxTenants < 0 {
+		return nil, ErrInvalidCapacity
+	}
+
+	return &Node{
+		Hostname:   hostname,
+		Port:       port,
+		Region:     region,
+		Type:       nodeType,
+		Status:     StatusActive,
+		MaxTenants: maxTenants,
+		CreatedBy:  createdBy,
+		CreatedAt:  time.Now(),
+	}, nil
+}
+
+// statusTransitions lists the statuses an operator can move a node to from each status.
+// Decommissioned is terminal.
+var statusTransitions = map[Status][]Status{
+	StatusProvisioning: {StatusActive, StatusMaintenance, StatusDecommissioned},
+	StatusActive:       {StatusDraining, StatusMaintenance},
+	StatusDraining:     {StatusActive, StatusMaintenance, StatusDecommissioned},
+	StatusMaintenance:  {StatusActive, StatusDraining, StatusDecommissioned},
+	StatusOffline:      {StatusActive, StatusMaintenance, StatusDecommissioned},
+}
+
+// transitionTo moves the node to the target status if the transition is allowed.
+func (n *Node) transitionTo(target Status) error {
+	for _, allowed := range statusTransitions[n.Status] {
+		if allowed == target {
+			n.Status = target
+			now := time.Now()
+			n.UpdatedAt = &now
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s to %s", ErrInvalidTransition, n.Status, target)
+}
+
+// Activate returns the node to service so that new tenants can be placed on it.
+func (n *Node) Activate() error { return n.transitionTo(StatusActive) }
+
+// Drain stops new tenants from being placed on the node while its existing
+// tenants are moved elsewhere.
+func (n *Node) Drain() error { return n.transitionTo(StatusDraining) }
+
+// StartMaintenance takes the node out of placement for maintenance.
+func (n *Node) StartMaintenance() error { return n.transitionTo(StatusMaintenance) }
+
+// Decommission permanently retires the node. It must have been taken out of
+// placement first and must no longer host any tenants.
+func (n *Node) Decommission() error {
+	if n.TenantCount > 0 {
+		return fmt.Errorf("%w: %d tenants remain", ErrNodeNotEmpty, n.TenantCount)
+	}
+	return n.transitionTo(StatusDecommissioned)
+}
+
+// HasCapacity reports whether new tenants can be placed on the node.
+func (n *Node) HasCapacity() bool {
+	return n.Status == StatusActive && n.TenantCount < n.MaxTenants
+}
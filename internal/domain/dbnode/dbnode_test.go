@@ -0,0 +1,154 @@
+package dbnode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+)
+
+func TestNewNode(t *testing.T) {
+	tests := []struct {
+		name       string
+		hostname   string
+		port       int32
+		region     tenant.Region
+		nodeType   Type
+		maxTenants int32
+		wantMax    int32
+		wantErr    error
+	}{
+		{
+			name: "valid node", hostname: "citus-worker-1.eu1.internal", port: 5432,
+			region: tenant.RegionEU1, nodeType: TypeStandard, maxTenants: 500, wantMax: 500,
+		},
+		{
+			name: "default capacity", hostname: "citus-worker-2", port: 5432,
+			region: tenant.RegionEU1, nodeType: TypeHighMemory, wantMax: DefaultMaxTenants,
+		},
+		{
+			name: "invalid hostname", hostname: "-worker", port: 5432,
+			region: tenant.RegionEU1, nodeType: TypeStandard, wantErr: ErrInvalidHostname,
+		},
+		{
+			name: "port out of range", hostname: "citus-worker-1", port: 70000,
+			region: tenant.RegionEU1, nodeType: TypeStandard, wantErr: ErrInvalidPort,
+		},
+		{
+			name: "unknown region", hostname: "citus-worker-1", port: 5432,
+			region: tenant.Region("mars1"), nodeType: TypeStandard, wantErr: tenant.ErrInvalidRegion,
+		},
+		{
+			name: "unknown node type", hostname: "citus-worker-1", port: 5432,
+			region: tenant.RegionEU1, nodeType: Type("gpu"), wantErr: ErrInvalidNodeType,
+		},
+		{
+			name: "negative capacity", hostname: "citus-worker-1", port: 5432,
+			region: tenant.RegionEU1, nodeType: TypeStandard, maxTenants: -1, wantErr: ErrInvalidCapacity,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			node, err := NewNode(tc.hostname, tc.port, tc.region, tc.nodeType, tc.maxTenants, "ops@example.com")
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.Nil(t, node)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, StatusActive, node.Status)
+			assert.Equal(t, tc.wantMax, node.MaxTenants)
+			assert.True(t, node.HasCapacity())
+		})
+	}
+}
+
+func TestNode_StatusTransitions(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    Status
+		apply   func(*Node) error
+		want    Status
+		wantErr error
+	}{
+		{name: "drain active node", from: StatusActive, apply: (*Node).Drain, want: StatusDraining},
+		{name: "maintain active node", from: StatusActive, apply: (*Node).StartMaintenance, want: StatusMaintenance},
+		{name: "reactivate drained node", from: StatusDraining, apply: (*Node).Activate, want: StatusActive},
+		{name: "activate provisioned node", from: StatusProvisioning, apply: (*Node).Activate, want: StatusActive},
+		{name: "decommission drained node", from: StatusDraining, apply: (*Node).Decommission, want: StatusDecommissioned},
+		{
+			name: "decommission active node", from: StatusActive, apply: (*Node).Decommission,
+			want: StatusActive, wantErr: ErrInvalidTransition,
+		},
+		{
+			name: "reactivate decommissioned node", from: StatusDecommissioned, apply: (*Node).Activate,
+			want: StatusDecommissioned, wantErr: ErrInvalidTransition,
+		},
+		{
+			name: "drain node twice", from: StatusDraining, apply: (*Node).Drain,
+			want: StatusDraining, wantErr: ErrInvalidTransition,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			node := &Node{Status: tc.from, MaxTenants: 10}
+			err := tc.apply(node)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.NotNil(t, node.UpdatedAt)
+			}
+			assert.Equal(t, tc.want, node.Status)
+		})
+	}
+}
+
+func TestNode_DecommissionRequiresNoTenants(t *testing.T) {
+	node := &Node{Status: StatusDraining, TenantCount: 3, MaxTenants: 10}
+
+	assert.ErrorIs(t, node.Decommission(), ErrNodeNotEmpty)
+	assert.Equal(t, StatusDraining, node.Status)
+}
+
+func TestNode_HasCapacity(t *testing.T) {
+	assert.True(t, (&Node{Status: StatusActive, TenantCount: 9, MaxTenants: 10}).HasCapacity())
+	assert.False(t, (&Node{Status: StatusActive, TenantCount: 10, MaxTenants: 10}).HasCapacity())
+	assert.False(t, (&Node{Status: StatusDraining, MaxTenants: 10}).HasCapacity())
+}
+
+func TestPlacementCriteria(t *testing.T) {
+	groupID := int64(7)
+	tests := []struct {
+		name   string
+		tenant *tenant.Tenant
+		want   []Type
+	}{
+		{name: "free tenant", tenant: &tenant.Tenant{Region: tenant.RegionUS1, Tier: tenant.TierFree}, want: []Type{TypeStandard}},
+		{
+			name:   "enterprise tenant",
+			tenant: &tenant.Tenant{Region: tenant.RegionUS1, Tier: tenant.TierEnterprise},
+			want:   []Type{TypeHighMemory, TypeStandard},
+		},
+		{
+			name:   "isolated tenant",
+			tenant: &tenant.Tenant{Region: tenant.RegionUS1, Tier: tenant.TierEnterprise, IsolationGroupID: &groupID},
+			want:   []Type{TypeIsolated},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			criteria := PlacementCriteria(tc.tenant)
+			require.Len(t, criteria, len(tc.want))
+			for i, c := range criteria {
+				assert.Equal(t, tenant.RegionUS1, c.Region)
+				assert.Equal(t, tc.want[i], c.Type)
+			}
+		})
+	}
+}
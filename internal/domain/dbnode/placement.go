@@ -0,0 +1,32 @@
+package dbnode
+
+import "github.com/ahrav/hoglet-hub/internal/domain/tenant"
+
+// Criteria describes the nodes a tenant may be placed on.
+type Criteria struct {
+	Region tenant.Region
+	Type   Type
+}
+
+// PlacementCriteria returns the criteria for placing the tenant, in order of preference.
+//
+// Tenants in an isolation group only share nodes reserved for isolated workloads.
+// Enterprise tenants prefer high-memory nodes but fall back to standard ones so
+// that a region without high-memory capacity can still host them.
+func PlacementCriteria(t *tenant.Tenant) []Criteria {
+	var types []Type
+	switch {
+	case t.IsolationGroupID != nil:
+		types = []Type{TypeIsolated}
+	case t.Tier == tenant.TierEnterprise:
+		types = []Type{TypeHighMemory, TypeStandard}
+	default:
+		types = []Type{TypeStandard}
+	}
+
+	criteria := make([]Criteria, 0, len(types))
+	for _, typ := range types {
+		criteria = append(criteria, Criteria{Region: t.Region, Type: typ})
+	}
+	return criteria
+}
@@ -0,0 +1,43 @@
+package dbnode
+
+import (
+	"context"
+
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+)
+
+// ListFilter narrows a node listing. Nil fields do not filter.
+type ListFilter struct {
+	Region *tenant.Region
+	Type   *Type
+	Status *Status
+}
+
+// Repository defines the interface for database node data access operations.
+type Repository interface {
+	// Create persists a new node and returns its ID.
+	// Returns ErrNodeAlreadyExists if a node with the same hostname exists.
+	Create(ctx context.Context, node *Node) (int64, error)
+
+	// FindByID retrieves a node by its unique identifier.
+	// Returns ErrNodeNotFound if the node does not exist.
+	FindByID(ctx context.Context, id int64) (*Node, error)
+
+	// List retrieves the nodes matching the filter, ordered by region and hostname.
+	List(ctx context.Context, filter ListFilter) ([]*Node, error)
+
+	// UpdateStatus persists the node's current status.
+	// Returns ErrNodeNotFound if the node does not exist.
+	UpdateStatus(ctx context.Context, node *Node) error
+
+	// Place assigns the tenant a primary node matching the criteria and reserves
+	// capacity for it in a single transaction. The least utilized active node with
+	// room to spare is chosen. If the tenant already has a primary node, that node
+	// is returned and no further capacity is reserved, so placement can be retried.
+	// Returns ErrNoCapacity if no node matches.
+	Place(ctx context.Context, tenantID int64, criteria Criteria) (*Node, error)
+
+	// Release clears the tenant's primary node and frees the capacity reserved on
+	// it in a single transaction. Releasing a tenant without a primary node is a no-op.
+	Release(ctx context.Context, tenantID int64) error
+}
@@ -0,0 +1,63 @@
+// Package idempotency models the keys clients send with mutating requests so
+// that retrying a request cannot carry it out twice.
+package idempotency
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Common errors that can be returned by idempotency functions.
+var (
+	ErrInvalidKey  = errors.New("invalid idempotency key")
+	ErrKeyNotFound = errors.New("idempotency key not found")
+)
+
+// MaxKeyLength is the longest key a client may send.
+const MaxKeyLength = 255
+
+// ValidateKey checks that key is non-empty, at most MaxKeyLength bytes long and
+// made up of printable ASCII characters.
+func ValidateKey(key string) error {
+	if key == "" {
+		return fmt.Errorf("%w: key is empty", ErrInvalidKey)
+	}
+	if len(key) > MaxKeyLength {
+		return fmt.Errorf("%w: key is longer than %d characters", ErrInvalidKey, MaxKeyLength)
+	}
+	for i := 0; i < len(key); i++ {
+		if key[i] < 0x20 || key[i] > 0x7e {
+			return fmt.Errorf("%w: key contains characters other than printable ASCII", ErrInvalidKey)
+		}
+	}
+	return nil
+}
+
+// Scope is the namespace of a key. Different callers, and the same caller
+// calling different operations, may use the same key without colliding.
+type Scope struct {
+	Caller    string // Subject of the authenticated caller; empty for anonymous callers
+	Operation string // Name of the API operation
+}
+
+// Response is the original response to the request a key was first used with.
+type Response struct {
+	StatusCode int
+	Body       []byte // JSON response body
+}
+
+// Record is a key together with the request it was first used with and, once
+// that request has been handled, its response.
+type Record struct {
+	Scope       Scope
+	Key         string
+	Fingerprint string    // Hash of the request, identifying retries of it
+	Response    *Response // Nil while the request is in progress
+	CreatedAt   time.Time
+	ExpiresAt   time.Time // After which the key may be used for another request
+}
+
+// Completed reports whether the request the key was first used with has been
+// handled and its response recorded.
+func (r *Record) Completed() bool { return r.Response != nil }
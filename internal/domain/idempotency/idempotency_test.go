@@ -0,0 +1,35 @@
+package idempotency
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		wantErr bool
+	}{
+		{name: "uuid", key: "4f1c2a8e-6a0b-4c4e-9d1f-0b7a3f2e5c91"},
+		{name: "printable punctuation", key: "create tenant #42 (retry)"},
+		{name: "longest allowed", key: strings.Repeat("k", MaxKeyLength)},
+		{name: "empty", key: "", wantErr: true},
+		{name: "too long", key: strings.Repeat("k", MaxKeyLength+1), wantErr: true},
+		{name: "control character", key: "key\n", wantErr: true},
+		{name: "non-ascii", key: "clé", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateKey(tc.key)
+			if tc.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidKey)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+)
+
+// Repository defines the interface for idempotency key data access operations.
+type Repository interface {
+	// Claim stores the record unless an unexpired record with the same scope and
+	// key exists. It reports whether the record was stored; an expired record is
+	// replaced.
+	Claim(ctx context.Context, r *Record) (bool, error)
+
+	// Find retrieves the record for the key, returning ErrKeyNotFound if there
+	// is none.
+	Find(ctx context.Context, scope Scope, key string) (*Record, error)
+
+	// Complete records the response to the request the key was claimed for and
+	// keeps the key until expiresAt. Returns ErrKeyNotFound if the key is not
+	// claimed by a request in progress.
+	Complete(ctx context.Context, scope Scope, key string, resp Response, expiresAt time.Time) error
+
+	// Release deletes the key if its request is still in progress, so that the
+	// request can be retried with the same key after failing.
+	Release(ctx context.Context, scope Scope, key string) error
+
+	// DeleteExpired deletes records that expired at or before the given time and
+	// returns how many were deleted.
+	DeleteExpired(ctx context.Context, before time.Time) (int64, error)
+}
@@ -0,0 +1,61 @@
+// Package isolationgroup models groups of tenants that share dedicated resources
+// apart from the rest of the fleet. A group lives in a single region, and only
+// tenants in that region can join it.
+package isolationgroup
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+)
+
+// Common errors that can be returned by isolation group functions.
+var (
+	ErrGroupNotFound      = errors.New("isolation group not found")
+	ErrGroupAlreadyExists = errors.New("isolation group already exists")
+	ErrInvalidName        = errors.New("invalid isolation group name")
+	ErrRegionMismatch     = errors.New("tenant region does not match isolation group region")
+	ErrGroupNotEmpty      = errors.New("isolation group still has tenants")
+)
+
+// IsolationGroup is a set of tenants colocated on resources reserved for them.
+type IsolationGroup struct {
+	ID                int64         // Unique identifier
+	Name              string        // Unique group name
+	Region            tenant.Region // Region all of the group's tenants are deployed in
+	CitusColocationID *int32        // Citus colocation group backing the group, once assigned
+	CreatedBy         string        // Who created the group
+	CreatedAt         time.Time     // Creation timestamp
+	UpdatedAt         *time.Time    // Last update timestamp
+}
+
+var validNamePattern = regexp.MustCompile(`^[a-z0-9-]{2,64}$`)
+
+// NewIsolationGroup creates a new isolation group with validation of all fields.
+func NewIsolationGroup(name string, region tenant.Region, createdBy string) (*IsolationGroup, error) {
+	if !validNamePattern.MatchString(name) {
+		return nil, ErrInvalidName
+	}
+	if !region.IsValid() {
+		return nil, tenant.ErrInvalidRegion
+	}
+
+	return &IsolationGroup{
+		Name:      name,
+		Region:    region,
+		CreatedBy: createdBy,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// ValidateMember checks whether a tenant deployed in the given region may join the group.
+// A group's resources live in its region, so its tenants must live there too.
+func (g *IsolationGroup) ValidateMember(region tenant.Region) error {
+	if region != g.Region {
+		return fmt.Errorf("%w: tenant is in %s, group %q is in %s", ErrRegionMismatch, region, g.Name, g.Region)
+	}
+	return nil
+}
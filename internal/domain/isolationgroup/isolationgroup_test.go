@@ -0,0 +1,48 @@
+package isolationgroup
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+)
+
+func TestNewIsolationGroup(t *testing.T) {
+	tests := []struct {
+		name      string
+		groupName string
+		region    tenant.Region
+		wantErr   error
+	}{
+		{name: "valid group", groupName: "payments-eu", region: tenant.RegionEU1},
+		{name: "uppercase name", groupName: "Payments", region: tenant.RegionEU1, wantErr: ErrInvalidName},
+		{name: "name too short", groupName: "p", region: tenant.RegionEU1, wantErr: ErrInvalidName},
+		{name: "name too long", groupName: strings.Repeat("p", 65), region: tenant.RegionEU1, wantErr: ErrInvalidName},
+		{name: "unknown region", groupName: "payments", region: tenant.Region("mars1"), wantErr: tenant.ErrInvalidRegion},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			group, err := NewIsolationGroup(tc.groupName, tc.region, "ops@example.com")
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.Nil(t, group)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.groupName, group.Name)
+			assert.Equal(t, tc.region, group.Region)
+			assert.Equal(t, "ops@example.com", group.CreatedBy)
+		})
+	}
+}
+
+func TestIsolationGroup_ValidateMember(t *testing.T) {
+	group := &IsolationGroup{Name: "payments", Region: tenant.RegionEU1}
+
+	assert.NoError(t, group.ValidateMember(tenant.RegionEU1))
+	assert.ErrorIs(t, group.ValidateMember(tenant.RegionUS1), ErrRegionMismatch)
+}
@@ -0,0 +1,33 @@
+package isolationgroup
+
+import (
+	"context"
+
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+)
+
+// ListFilter narrows an isolation group listing. Nil fields do not filter.
+type ListFilter struct {
+	Region *tenant.Region
+}
+
+// Repository defines the interface for isolation group data access operations.
+type Repository interface {
+	// Create persists a new isolation group and returns its ID.
+	// Returns ErrGroupAlreadyExists if a group with the same name exists.
+	Create(ctx context.Context, group *IsolationGroup) (int64, error)
+
+	// FindByID retrieves an isolation group by its unique identifier.
+	// Returns ErrGroupNotFound if the group does not exist.
+	FindByID(ctx context.Context, id int64) (*IsolationGroup, error)
+
+	// List retrieves the isolation groups matching the filter, ordered by name.
+	List(ctx context.Context, filter ListFilter) ([]*IsolationGroup, error)
+
+	// CountTenants returns the number of tenants in the group that have not been deleted.
+	CountTenants(ctx context.Context, id int64) (int64, error)
+
+	// Delete permanently removes an isolation group.
+	// Returns ErrGroupNotFound if the group does not exist.
+	Delete(ctx context.Context, id int64) error
+}
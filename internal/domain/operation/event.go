@@ -0,0 +1,76 @@
+package operation
+
+import "time"
+
+// EventType identifies the kind of change an operation event records.
+type EventType string
+
+// Predefined event types that make up an operation's timeline.
+const (
+	EventStatusChanged   EventType = "status_changed"
+	EventStepStarted     EventType = "step_started"
+	EventStepFinished    EventType = "step_finished"
+	EventStepCompensated EventType = "step_compensated"
+)
+
+// Event is an entry in the timeline of an operation, recorded as its status
+// changes and as its workflow steps run. Events of an operation are ordered by
+// ID, so a reader can resume after the last event it saw.
+type Event struct {
+	ID           int64
+	OperationID  int64
+	Type         EventType
+	Status       string  // New operation status, or step status for step events
+	StepIndex    *int    // Position of the step within the workflow, for step start and finish events
+	StepName     *string // Name of the step, for step events
+	ErrorMessage *string // Why the operation or step failed
+	OccurredAt   time.Time
+}
+
+// NewStatusEvent creates the event recording that an operation reached its
+// current status.
+func NewStatusEvent(op *Operation) *Event {
+	return &Event{
+		OperationID:  op.ID,
+		Type:         EventStatusChanged,
+		Status:       string(op.Status),
+		ErrorMessage: op.ErrorMessage,
+		OccurredAt:   time.Now(),
+	}
+}
+
+// NewStepEvent creates an event of the given type recording the progress of a step.
+func NewStepEvent(eventType EventType, step *StepState) *Event {
+	return &Event{
+		OperationID:  step.OperationID,
+		Type:         eventType,
+		Status:       string(step.Status),
+		StepIndex:    &step.Index,
+		StepName:     &step.Name,
+		ErrorMessage: step.ErrorMessage,
+		OccurredAt:   time.Now(),
+	}
+}
+
+// NewStepCompensatedEvent creates the event recording that a completed step was
+// undone by its compensation.
+func NewStepCompensatedEvent(operationID int64, name string) *Event {
+	return &Event{
+		OperationID: operationID,
+		Type:        EventStepCompensated,
+		Status:      string(StepStatusCompensated),
+		StepName:    &name,
+		OccurredAt:  time.Now(),
+	}
+}
+
+// IsTerminal reports whether the event records the operation reaching a
+// terminal status. Workflows compensate their steps before recording it, so it
+// is the last event of the operation.
+func (e *Event) IsTerminal() bool {
+	if e.Type != EventStatusChanged {
+		return false
+	}
+	op := Operation{Status: Status(e.Status)}
+	return op.IsTerminal()
+}
@@ -0,0 +1,53 @@
+package operation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewStatusEvent(t *testing.T) {
+	errMsg := "schema creation failed"
+	op := &Operation{ID: 7, Status: StatusFailed, ErrorMessage: &errMsg}
+
+	e := NewStatusEvent(op)
+	assert.Equal(t, int64(7), e.OperationID)
+	assert.Equal(t, EventStatusChanged, e.Type)
+	assert.Equal(t, "failed", e.Status)
+	assert.Equal(t, &errMsg, e.ErrorMessage)
+	assert.Nil(t, e.StepName)
+	assert.False(t, e.OccurredAt.IsZero())
+}
+
+func TestNewStepEvent(t *testing.T) {
+	step := NewStepState(7, 2, "create-schema")
+
+	e := NewStepEvent(EventStepStarted, step)
+	assert.Equal(t, int64(7), e.OperationID)
+	assert.Equal(t, EventStepStarted, e.Type)
+	assert.Equal(t, "in_progress", e.Status)
+	assert.Equal(t, 2, *e.StepIndex)
+	assert.Equal(t, "create-schema", *e.StepName)
+	assert.Nil(t, e.ErrorMessage)
+}
+
+func TestEventIsTerminal(t *testing.T) {
+	tests := []struct {
+		name     string
+		event    Event
+		expected bool
+	}{
+		{"completed", Event{Type: EventStatusChanged, Status: string(StatusCompleted)}, true},
+		{"failed", Event{Type: EventStatusChanged, Status: string(StatusFailed)}, true},
+		{"cancelled", Event{Type: EventStatusChanged, Status: string(StatusCancelled)}, true},
+		{"in progress", Event{Type: EventStatusChanged, Status: string(StatusInProgress)}, false},
+		{"paused", Event{Type: EventStatusChanged, Status: string(StatusPaused)}, false},
+		{"step completed", Event{Type: EventStepFinished, Status: string(StepStatusCompleted)}, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.event.IsTerminal())
+		})
+	}
+}
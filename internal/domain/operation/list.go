@@ -0,0 +1,72 @@
+package operation
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrInvalidListParams is returned when an operation listing is requested with
+// a malformed filter or page size.
+var ErrInvalidListParams = errors.New("invalid list parameters")
+
+// ListFilter narrows an operation listing. Nil or empty fields do not filter.
+type ListFilter struct {
+	TenantID      *int64
+	Type          *Op
+	Status        *Status
+	CreatedBy     string
+	CreatedAfter  *time.Time // Inclusive lower bound on the creation time
+	CreatedBefore *time.Time // Exclusive upper bound on the creation time
+}
+
+// Cursor marks the last operation of a page so the next page can resume after it.
+type Cursor struct {
+	ID        int64
+	CreatedAt time.Time
+}
+
+// CursorAt returns a cursor positioned at the given operation.
+func CursorAt(op *Operation) *Cursor {
+	return &Cursor{ID: op.ID, CreatedAt: op.CreatedAt}
+}
+
+// ListParams describes a single page of an operation listing.
+// Operations are listed newest first.
+type ListParams struct {
+	Filter ListFilter
+	After  *Cursor // Start after this operation; nil starts at the newest
+	Limit  int     // Maximum number of operations in the page
+}
+
+// Validate checks that the limit and filter values are supported.
+func (p ListParams) Validate() error {
+	if p.Limit < 1 {
+		return fmt.Errorf("%w: limit must be positive", ErrInvalidListParams)
+	}
+
+	if p.Filter.Type != nil && !p.Filter.Type.IsValid() {
+		return fmt.Errorf("%w: unknown operation type %q", ErrInvalidListParams, *p.Filter.Type)
+	}
+
+	if p.Filter.Status != nil {
+		switch *p.Filter.Status {
+		case StatusPending, StatusInProgress, StatusCompleted, StatusFailed, StatusCancelled, StatusPaused:
+		default:
+			return fmt.Errorf("%w: unknown status %q", ErrInvalidListParams, *p.Filter.Status)
+		}
+	}
+
+	if p.Filter.CreatedAfter != nil && p.Filter.CreatedBefore != nil &&
+		!p.Filter.CreatedAfter.Before(*p.Filter.CreatedBefore) {
+		return fmt.Errorf("%w: created_after must be before created_before", ErrInvalidListParams)
+	}
+
+	return nil
+}
+
+// Page is a single page of an operation listing.
+type Page struct {
+	Operations []*Operation
+	Next       *Cursor // Cursor for the following page; nil on the last page
+}
@@ -3,6 +3,7 @@ package operation
 import (
 	"errors"
 	"fmt"
+	"maps"
 	"time"
 )
 
@@ -12,6 +13,13 @@ var (
 	ErrOperationFailed    = errors.New("operation failed")
 	ErrOperationCancelled = errors.New("operation cancelled")
 	ErrOperationCompleted = errors.New("operation completed")
+
+	ErrOperationNotCancellable = errors.New("operation cannot be cancelled")
+	ErrOperationNotRetryable   = errors.New("operation cannot be retried")
+	ErrOperationAlreadyRetried = errors.New("operation has already been retried")
+	ErrOperationNotPausable    = errors.New("operation cannot be paused")
+	ErrOperationNotPaused      = errors.New("operation is not paused")
+	ErrOperationRunsElsewhere  = errors.New("operation is running in another process")
 )
 
 // Op represents the operation type in the system.
@@ -20,18 +28,21 @@ type Op string
 
 // Predefined operation types supported by the system.
 const (
-	OpTenantCreate Op = "tenant.create"
-	OpTenantDelete Op = "tenant.delete"
+	OpTenantCreate  Op = "tenant.create"
+	OpTenantDelete  Op = "tenant.delete"
+	OpTenantUpgrade Op = "tenant.upgrade" // Changes the tenant's tier, up or down
+	OpTenantMigrate Op = "tenant.migrate" // Moves the tenant to another region
+	OpTenantSuspend Op = "tenant.suspend" // Disables access and scales the tenant's resources down
+	OpTenantResume  Op = "tenant.resume"  // Scales a suspended tenant's resources back up
 	// OpTenantUpdate  Op = "tenant.update"
-	// OpTenantUpgrade Op = "tenant.upgrade"
-	// OpTenantMigrate Op = "tenant.migrate"
 )
 
 // IsValid checks if the operation type is valid by comparing it against
 // the predefined set of supported operations.
 func (t Op) IsValid() bool {
 	switch t {
-	case OpTenantCreate, OpTenantDelete:
+	case OpTenantCreate, OpTenantDelete, OpTenantUpgrade, OpTenantMigrate,
+		OpTenantSuspend, OpTenantResume:
 		return true
 	default:
 		return false
@@ -79,6 +90,7 @@ const (
 	StatusCompleted  Status = "completed"
 	StatusFailed     Status = "failed"
 	StatusCancelled  Status = "cancelled"
+	StatusPaused     Status = "paused" // Stopped between steps until resumed
 )
 
 // Operation represents an asynchronous operation in the system.
@@ -94,9 +106,15 @@ type Operation struct {
 	CompletedAt  *time.Time
 	UpdatedAt    *time.Time
 	CreatedBy    *string
+	CancelledBy  *string
 	ErrorMessage *string
 	Parameters   map[string]any
 	Result       map[string]any
+
+	// ParentID links a retry to the failed operation it retries.
+	ParentID *int64
+	// Attempt is 1 for an original operation and is incremented by each retry.
+	Attempt int
 }
 
 // NewTenantCreateOperation creates a new tenant creation operation.
@@ -131,6 +149,58 @@ func NewTenantDeleteOperation(tenantID int64) (*Operation, error) {
 	return NewOperation(OpTenantDelete, &tenantID, params)
 }
 
+// NewTenantUpgradeOperation creates a new tier change operation.
+// The source and target tiers are recorded so that a resumed or retried
+// operation changes the tier in the same direction.
+func NewTenantUpgradeOperation(tenantID int64, fromTier, toTier string) (*Operation, error) {
+	params := map[string]any{
+		"tenant_id": tenantID,
+		"from_tier": fromTier,
+		"to_tier":   toTier,
+	}
+
+	return NewOperation(OpTenantUpgrade, &tenantID, params)
+}
+
+// NewTenantMigrateOperation creates a new cross-region migration operation.
+// The source and target regions are recorded so that a paused, resumed, or
+// retried migration keeps moving the tenant between the same regions.
+func NewTenantMigrateOperation(tenantID int64, fromRegion, toRegion string) (*Operation, error) {
+	params := map[string]any{
+		"tenant_id":   tenantID,
+		"from_region": fromRegion,
+		"to_region":   toRegion,
+	}
+
+	return NewOperation(OpTenantMigrate, &tenantID, params)
+}
+
+// NewTenantSuspendOperation creates a new suspension operation.
+// The reason and requester are recorded so that the workflow can add them to
+// the tenant's suspension history.
+func NewTenantSuspendOperation(tenantID int64, reason, requestedBy string) (*Operation, error) {
+	params := map[string]any{
+		"tenant_id":    tenantID,
+		"reason":       reason,
+		"requested_by": requestedBy,
+	}
+
+	return NewOperation(OpTenantSuspend, &tenantID, params)
+}
+
+// NewTenantResumeOperation creates a new operation resuming a suspended tenant.
+// The reason and requester are recorded so that the workflow can close the
+// tenant's open suspension with them.
+func NewTenantResumeOperation(tenantID int64, reason, requestedBy string) (*Operation, error) {
+	params := map[string]any{
+		"tenant_id":    tenantID,
+		"reason":       reason,
+		"requested_by": requestedBy,
+	}
+
+	return NewOperation(OpTenantResume, &tenantID, params)
+}
+
 // NewOperation creates a new operation with the given type, tenant ID, and parameters.
 // It initializes the operation in the pending state with the current timestamp.
 func NewOperation(opType Op, tenantID *int64, params map[string]any) (*Operation, error) {
@@ -145,9 +215,28 @@ func NewOperation(opType Op, tenantID *int64, params map[string]any) (*Operation
 		Status:     StatusPending,
 		Parameters: params,
 		CreatedAt:  now,
+		Attempt:    1,
 	}, nil
 }
 
+// NewRetry creates a pending operation that retries this failed operation.
+// The retry performs the same operation with the same parameters and is linked to
+// this operation as its parent. Returns ErrOperationNotRetryable if IsRetryable is false.
+func (o *Operation) NewRetry() (*Operation, error) {
+	if !o.IsRetryable() {
+		return nil, ErrOperationNotRetryable
+	}
+
+	retry, err := NewOperation(o.Type, o.TenantID, maps.Clone(o.Parameters))
+	if err != nil {
+		return nil, err
+	}
+	parentID := o.ID
+	retry.ParentID = &parentID
+	retry.Attempt = max(o.Attempt, 1) + 1
+	return retry, nil
+}
+
 // isValidType checks if the operation type is valid.
 // This is an internal helper function.
 func isValidType(opType Op) bool {
@@ -193,6 +282,71 @@ func (o *Operation) Cancel(reason string) {
 	o.UpdatedAt = &now
 }
 
+// CancelBy marks the operation as cancelled on behalf of the given actor.
+// Returns ErrOperationNotCancellable if the operation has already finished.
+func (o *Operation) CancelBy(reason, actor string) error {
+	if o.IsTerminal() {
+		return ErrOperationNotCancellable
+	}
+
+	o.Cancel(reason)
+	o.CancelledBy = &actor
+	return nil
+}
+
+// IsPausable checks if the operation can be paused between its steps.
+// Only in-progress migrations, whose phases can each take hours, support pausing.
+func (o *Operation) IsPausable() bool {
+	return o.Type == OpTenantMigrate && o.Status == StatusInProgress
+}
+
+// Pause marks the operation as paused. The workflow stops before its next step
+// and the operation keeps its start time so it can later be resumed.
+// Returns ErrOperationNotPausable if IsPausable is false.
+func (o *Operation) Pause() error {
+	if !o.IsPausable() {
+		return ErrOperationNotPausable
+	}
+
+	o.Status = StatusPaused
+	now := time.Now()
+	o.UpdatedAt = &now
+	return nil
+}
+
+// Resume moves a paused operation back to in progress so that its workflow can
+// pick up from the first step that has not completed.
+// Returns ErrOperationNotPaused if the operation is not paused.
+func (o *Operation) Resume() error {
+	if o.Status != StatusPaused {
+		return ErrOperationNotPaused
+	}
+
+	o.Status = StatusInProgress
+	now := time.Now()
+	o.UpdatedAt = &now
+	return nil
+}
+
+// IsPaused checks if the operation is paused between steps.
+func (o *Operation) IsPaused() bool {
+	return o.Status == StatusPaused
+}
+
+// RecordPhase stores the result of a completed phase under Result["phases"],
+// keyed by phase name, so that progress is visible while the operation runs.
+func (o *Operation) RecordPhase(name string, result map[string]any) {
+	if o.Result == nil {
+		o.Result = make(map[string]any)
+	}
+	phases, ok := o.Result["phases"].(map[string]any)
+	if !ok {
+		phases = make(map[string]any)
+		o.Result["phases"] = phases
+	}
+	phases[name] = result
+}
+
 // IsTerminal checks if the operation is in a terminal state (completed, failed, or cancelled).
 // Terminal operations cannot transition to other states.
 func (o *Operation) IsTerminal() bool {
@@ -235,6 +389,12 @@ func (o *Operation) EstimateCompletionTime() *time.Time {
 		durationEstimate = 5 * time.Minute
 	case OpTenantDelete:
 		durationEstimate = 3 * time.Minute
+	case OpTenantUpgrade:
+		durationEstimate = 2 * time.Minute
+	case OpTenantMigrate:
+		durationEstimate = 30 * time.Minute
+	case OpTenantSuspend, OpTenantResume:
+		durationEstimate = 1 * time.Minute
 	default:
 		durationEstimate = 5 * time.Minute
 	}
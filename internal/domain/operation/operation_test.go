@@ -16,6 +16,10 @@ func TestOpIsValid(t *testing.T) {
 	}{
 		{"Valid - tenant create", OpTenantCreate, true},
 		{"Valid - tenant delete", OpTenantDelete, true},
+		{"Valid - tenant upgrade", OpTenantUpgrade, true},
+		{"Valid - tenant migrate", OpTenantMigrate, true},
+		{"Valid - tenant suspend", OpTenantSuspend, true},
+		{"Valid - tenant resume", OpTenantResume, true},
 		{"Invalid - empty string", Op(""), false},
 		{"Invalid - unsupported op", Op("unsupported.operation"), false},
 	}
@@ -36,6 +40,8 @@ func TestParseType_Success(t *testing.T) {
 	}{
 		{"tenant create", "tenant.create", OpTenantCreate},
 		{"tenant delete", "tenant.delete", OpTenantDelete},
+		{"tenant upgrade", "tenant.upgrade", OpTenantUpgrade},
+		{"tenant migrate", "tenant.migrate", OpTenantMigrate},
 	}
 
 	for _, tc := range tests {
@@ -275,6 +281,46 @@ func TestNewTenantDeleteOperation_Success(t *testing.T) {
 	}
 }
 
+func TestNewTenantUpgradeOperation(t *testing.T) {
+	op, err := NewTenantUpgradeOperation(1234, "free", "pro")
+
+	assert.NoError(t, err)
+	assert.Equal(t, OpTenantUpgrade, op.Type)
+	assert.Equal(t, int64(1234), *op.TenantID)
+	assert.Equal(t, "free", op.Parameters["from_tier"])
+	assert.Equal(t, "pro", op.Parameters["to_tier"])
+}
+
+func TestNewTenantMigrateOperation(t *testing.T) {
+	op, err := NewTenantMigrateOperation(1234, "us1", "eu1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, OpTenantMigrate, op.Type)
+	assert.Equal(t, int64(1234), *op.TenantID)
+	assert.Equal(t, "us1", op.Parameters["from_region"])
+	assert.Equal(t, "eu1", op.Parameters["to_region"])
+}
+
+func TestNewTenantSuspendOperation(t *testing.T) {
+	op, err := NewTenantSuspendOperation(1234, "unpaid invoice", "ops@example.com")
+
+	assert.NoError(t, err)
+	assert.Equal(t, OpTenantSuspend, op.Type)
+	assert.Equal(t, int64(1234), *op.TenantID)
+	assert.Equal(t, "unpaid invoice", op.Parameters["reason"])
+	assert.Equal(t, "ops@example.com", op.Parameters["requested_by"])
+}
+
+func TestNewTenantResumeOperation(t *testing.T) {
+	op, err := NewTenantResumeOperation(1234, "invoice paid", "ops@example.com")
+
+	assert.NoError(t, err)
+	assert.Equal(t, OpTenantResume, op.Type)
+	assert.Equal(t, int64(1234), *op.TenantID)
+	assert.Equal(t, "invoice paid", op.Parameters["reason"])
+	assert.Equal(t, "ops@example.com", op.Parameters["requested_by"])
+}
+
 func TestOperationStateTransition_ToInProgress(t *testing.T) {
 	op, _ := NewTenantCreateOperation(int64(1234), "test-tenant", "us-west", "standard", nil)
 
@@ -367,6 +413,124 @@ func TestOperationStateTransition_ToCancelled(t *testing.T) {
 	})
 }
 
+func TestOperation_CancelBy(t *testing.T) {
+	t.Run("records reason and actor", func(t *testing.T) {
+		op, _ := NewTenantCreateOperation(int64(1234), "test-tenant", "us-west", "standard", nil)
+		op.Start()
+
+		err := op.CancelBy("no longer needed", "alice@example.com")
+		assert.NoError(t, err)
+		assert.Equal(t, StatusCancelled, op.Status)
+		assert.Equal(t, "no longer needed", *op.ErrorMessage)
+		assert.Equal(t, "alice@example.com", *op.CancelledBy)
+		assert.NotNil(t, op.CompletedAt)
+	})
+
+	t.Run("rejects terminal operations", func(t *testing.T) {
+		op, _ := NewTenantCreateOperation(int64(1234), "test-tenant", "us-west", "standard", nil)
+		op.Start()
+		op.Complete(nil)
+
+		err := op.CancelBy("too late", "alice@example.com")
+		assert.ErrorIs(t, err, ErrOperationNotCancellable)
+		assert.Equal(t, StatusCompleted, op.Status)
+		assert.Nil(t, op.CancelledBy)
+	})
+}
+
+func TestOperation_PauseResume(t *testing.T) {
+	t.Run("pauses and resumes an in-progress migration", func(t *testing.T) {
+		op, _ := NewTenantMigrateOperation(int64(1234), "us1", "eu1")
+		op.Start()
+		startedAt := op.StartedAt
+
+		assert.NoError(t, op.Pause())
+		assert.Equal(t, StatusPaused, op.Status)
+		assert.True(t, op.IsPaused())
+		assert.False(t, op.IsTerminal())
+
+		assert.NoError(t, op.Resume())
+		assert.Equal(t, StatusInProgress, op.Status)
+		assert.Equal(t, startedAt, op.StartedAt)
+	})
+
+	t.Run("rejects operations that are not migrations", func(t *testing.T) {
+		op, _ := NewTenantDeleteOperation(int64(1234))
+		op.Start()
+
+		assert.ErrorIs(t, op.Pause(), ErrOperationNotPausable)
+		assert.Equal(t, StatusInProgress, op.Status)
+	})
+
+	t.Run("rejects migrations that are not running", func(t *testing.T) {
+		op, _ := NewTenantMigrateOperation(int64(1234), "us1", "eu1")
+		assert.ErrorIs(t, op.Pause(), ErrOperationNotPausable)
+
+		op.Start()
+		op.Complete(nil)
+		assert.ErrorIs(t, op.Pause(), ErrOperationNotPausable)
+	})
+
+	t.Run("rejects resuming an operation that is not paused", func(t *testing.T) {
+		op, _ := NewTenantMigrateOperation(int64(1234), "us1", "eu1")
+		op.Start()
+
+		assert.ErrorIs(t, op.Resume(), ErrOperationNotPaused)
+	})
+}
+
+func TestOperation_RecordPhase(t *testing.T) {
+	op, _ := NewTenantMigrateOperation(int64(1234), "us1", "eu1")
+
+	op.RecordPhase("provision-target", map[string]any{"region": "eu1"})
+	op.RecordPhase("copy-data", map[string]any{"status": "completed"})
+
+	phases, ok := op.Result["phases"].(map[string]any)
+	assert.True(t, ok)
+	assert.Equal(t, map[string]any{"region": "eu1"}, phases["provision-target"])
+	assert.Equal(t, map[string]any{"status": "completed"}, phases["copy-data"])
+}
+
+func TestOperation_NewRetry(t *testing.T) {
+	t.Run("links retry to failed operation", func(t *testing.T) {
+		isolationGroupID := int64(7)
+		op, _ := NewTenantCreateOperation(int64(1234), "test-tenant", "us-west", "standard", &isolationGroupID)
+		op.ID = 42
+		op.Start()
+		op.Fail("database unavailable")
+
+		retry, err := op.NewRetry()
+		assert.NoError(t, err)
+		assert.Equal(t, OpTenantCreate, retry.Type)
+		assert.Equal(t, StatusPending, retry.Status)
+		assert.Equal(t, op.TenantID, retry.TenantID)
+		assert.Equal(t, op.Parameters, retry.Parameters)
+		assert.Equal(t, int64(42), *retry.ParentID)
+		assert.Equal(t, 2, retry.Attempt)
+		assert.Nil(t, retry.ErrorMessage)
+
+		// The retry owns its parameters.
+		retry.Parameters["name"] = "changed"
+		assert.Equal(t, "test-tenant", op.Parameters["name"])
+
+		retry.ID = 43
+		retry.Fail("still unavailable")
+		second, err := retry.NewRetry()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(43), *second.ParentID)
+		assert.Equal(t, 3, second.Attempt)
+	})
+
+	t.Run("rejects operations that are not retryable", func(t *testing.T) {
+		op, _ := NewTenantCreateOperation(int64(1234), "test-tenant", "us-west", "standard", nil)
+		op.Start()
+
+		retry, err := op.NewRetry()
+		assert.ErrorIs(t, err, ErrOperationNotRetryable)
+		assert.Nil(t, retry)
+	})
+}
+
 func TestOperation_IsPending(t *testing.T) {
 	// Pending operation should return true.
 	t.Run("when pending", func(t *testing.T) {
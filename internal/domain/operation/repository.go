@@ -1,6 +1,9 @@
 package operation
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // Repository defines the interface for operation data access operations.
 // This interface abstracts the underlying storage mechanism to allow
@@ -14,6 +17,12 @@ type Repository interface {
 	// The operation must already exist in the system or an error will be returned.
 	Update(ctx context.Context, op *Operation) error
 
+	// TransitionStatus modifies the operation like Update, provided its stored
+	// status is still from. It returns false without changing anything otherwise,
+	// so that of several concurrent requests making the same transition only one
+	// succeeds.
+	TransitionStatus(ctx context.Context, op *Operation, from Status) (bool, error)
+
 	// FindByID retrieves an operation by its unique identifier.
 	// Returns nil if no operation is found with the given ID.
 	FindByID(ctx context.Context, id int64) (*Operation, error)
@@ -31,4 +40,48 @@ type Repository interface {
 	// This is particularly useful for finding operations that might need attention
 	// or are still in progress.
 	FindIncomplete(ctx context.Context) ([]*Operation, error)
+
+	// List retrieves a page of operations matching the filter, newest first.
+	// Ties in creation time are broken by ID so that paging is stable.
+	List(ctx context.Context, params ListParams) (*Page, error)
+}
+
+// StepRepository defines the interface for persisting workflow step checkpoints
+// and the liveness of the operations that own them. Together these allow an
+// operation orphaned by a process restart to be detected and resumed.
+type StepRepository interface {
+	// StartStep records that a step has begun executing.
+	// Restarting a previously recorded step increments its attempt count.
+	StartStep(ctx context.Context, step *StepState) error
+
+	// FinishStep records the terminal outcome (completed or failed) of a step.
+	FinishStep(ctx context.Context, step *StepState) error
+
+	// CompensateStep records that a completed step's effects were undone by its compensation.
+	CompensateStep(ctx context.Context, operationID int64, name string) error
+
+	// FindSteps retrieves all step checkpoints for an operation ordered by step index.
+	FindSteps(ctx context.Context, operationID int64) ([]*StepState, error)
+
+	// CopyCompletedSteps copies the completed step checkpoints of one operation to
+	// another, so that a retry skips the steps whose effects are still in place.
+	CopyCompletedSteps(ctx context.Context, fromOperationID, toOperationID int64) error
+
+	// Heartbeat refreshes the operation's last update time to signal that
+	// a live process is still executing it.
+	Heartbeat(ctx context.Context, operationID int64) error
+
+	// ClaimStale atomically takes ownership of an incomplete operation that has
+	// not been updated since staleBefore. It returns false if the operation is
+	// terminal or was updated more recently, e.g. by another live process.
+	ClaimStale(ctx context.Context, operationID int64, staleBefore time.Time) (bool, error)
+}
+
+// EventRepository provides access to the timeline of operation events. Events
+// are recorded by Repository and StepRepository in the same transaction as the
+// changes they describe.
+type EventRepository interface {
+	// ListEvents retrieves up to limit events of an operation with IDs greater
+	// than afterID, oldest first.
+	ListEvents(ctx context.Context, operationID, afterID int64, limit int) ([]*Event, error)
 }
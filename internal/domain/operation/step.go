@@ -0,0 +1,69 @@
+package operation
+
+import "time"
+
+// StepStatus represents the current state of a single workflow step
+// belonging to an operation.
+type StepStatus string
+
+// Predefined step statuses that represent the lifecycle of a workflow step.
+const (
+	StepStatusInProgress StepStatus = "in_progress"
+	StepStatusCompleted  StepStatus = "completed"
+	StepStatusFailed     StepStatus = "failed"
+	// StepStatusCompensated marks a completed step whose effects were undone by
+	// its compensation, so a retry has to run it again.
+	StepStatusCompensated StepStatus = "compensated"
+)
+
+// StepState is a durable checkpoint of a workflow step's progress.
+// Step states are persisted alongside their operation so that a workflow
+// interrupted by a process restart can resume from its last completed step.
+type StepState struct {
+	OperationID  int64
+	Index        int
+	Name         string
+	Status       StepStatus
+	Attempts     int
+	ErrorMessage *string
+	StartedAt    time.Time
+	CompletedAt  *time.Time
+}
+
+// NewStepState creates a checkpoint for a step that is about to begin executing.
+func NewStepState(operationID int64, index int, name string) *StepState {
+	return &StepState{
+		OperationID: operationID,
+		Index:       index,
+		Name:        name,
+		Status:      StepStatusInProgress,
+		Attempts:    1,
+		StartedAt:   time.Now(),
+	}
+}
+
+// Complete marks the step as successfully finished.
+func (s *StepState) Complete() {
+	s.Status = StepStatusCompleted
+	s.ErrorMessage = nil
+	now := time.Now()
+	s.CompletedAt = &now
+}
+
+// Fail marks the step as failed with the provided error message.
+func (s *StepState) Fail(errMsg string) {
+	s.Status = StepStatusFailed
+	s.ErrorMessage = &errMsg
+	now := time.Now()
+	s.CompletedAt = &now
+}
+
+// Compensate marks a completed step as undone by its compensation.
+func (s *StepState) Compensate() {
+	s.Status = StepStatusCompensated
+}
+
+// IsCompleted checks if the step finished successfully and can be skipped on resume.
+func (s *StepState) IsCompleted() bool {
+	return s.Status == StepStatusCompleted
+}
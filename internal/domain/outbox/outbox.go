@@ -0,0 +1,100 @@
+// Package outbox models the lifecycle events that are recorded together with the
+// state changes they describe and then relayed to sinks outside the service.
+package outbox
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/ahrav/hoglet-hub/internal/domain/operation"
+)
+
+// EventType names a lifecycle event.
+type EventType string
+
+const (
+	EventOperationStarted   EventType = "operation.started"
+	EventOperationCompleted EventType = "operation.completed"
+	EventOperationFailed    EventType = "operation.failed"
+	EventTenantActivated    EventType = "tenant.activated" // A tenant finished provisioning
+	EventTenantDeleted      EventType = "tenant.deleted"   // A tenant finished deprovisioning
+)
+
+// MaxAttempts is how many times the relay attempts to publish an event before it
+// is dead-lettered, which releases the events after it in the same sequence.
+const MaxAttempts = 20
+
+// Event is a lifecycle event as it is published to sinks. Events about the same
+// tenant are published in the order they occurred.
+type Event struct {
+	ID          int64          `json:"id"`
+	Type        EventType      `json:"type"`
+	TenantID    *int64         `json:"tenant_id,omitempty"`
+	OperationID *int64         `json:"operation_id,omitempty"`
+	Data        map[string]any `json:"data"`
+	OccurredAt  time.Time      `json:"occurred_at"`
+
+	// Delivery state, which is not published.
+	Attempts      int       `json:"-"` // Failed attempts to publish the event
+	NextAttemptAt time.Time `json:"-"` // Earliest time of the next attempt
+}
+
+// OrderingKey returns the key of the sequence the event is published in. Events
+// about a tenant are ordered per tenant, and other events per operation.
+func (e *Event) OrderingKey() string {
+	switch {
+	case e.TenantID != nil:
+		return "tenant:" + strconv.FormatInt(*e.TenantID, 10)
+	case e.OperationID != nil:
+		return "operation:" + strconv.FormatInt(*e.OperationID, 10)
+	default:
+		return ""
+	}
+}
+
+// OperationEvents returns the events describing an operation's change from the
+// previous status to its current one. An operation is started when it leaves
+// pending for in progress; resuming a paused operation does not start it again.
+// Completing a tenant creation activates the tenant and completing a deletion
+// deletes it.
+func OperationEvents(op *operation.Operation, previous operation.Status) []*Event {
+	if op.Status == previous {
+		return nil
+	}
+
+	data := map[string]any{"operation_type": string(op.Type), "status": string(op.Status)}
+	var events []*Event
+	switch op.Status {
+	case operation.StatusInProgress:
+		if previous == operation.StatusPending {
+			events = append(events, newOperationEvent(EventOperationStarted, op, data))
+		}
+
+	case operation.StatusCompleted:
+		events = append(events, newOperationEvent(EventOperationCompleted, op, data))
+		switch op.Type {
+		case operation.OpTenantCreate:
+			events = append(events, newOperationEvent(EventTenantActivated, op, map[string]any{}))
+		case operation.OpTenantDelete:
+			events = append(events, newOperationEvent(EventTenantDeleted, op, map[string]any{}))
+		}
+
+	case operation.StatusFailed:
+		if op.ErrorMessage != nil {
+			data["error"] = *op.ErrorMessage
+		}
+		events = append(events, newOperationEvent(EventOperationFailed, op, data))
+	}
+	return events
+}
+
+// newOperationEvent creates an event caused by the operation.
+func newOperationEvent(eventType EventType, op *operation.Operation, data map[string]any) *Event {
+	operationID := op.ID
+	e := &Event{Type: eventType, OperationID: &operationID, Data: data, OccurredAt: time.Now()}
+	if op.TenantID != nil {
+		tenantID := *op.TenantID
+		e.TenantID = &tenantID
+	}
+	return e
+}
@@ -0,0 +1,84 @@
+package outbox
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ahrav/hoglet-hub/internal/domain/operation"
+)
+
+func TestOperationEvents(t *testing.T) {
+	tenantID := int64(42)
+	failure := "quota exhausted"
+
+	tests := []struct {
+		name     string
+		op       operation.Operation
+		previous operation.Status
+		want     []EventType
+	}{
+		{
+			name:     "start",
+			op:       operation.Operation{Type: operation.OpTenantUpgrade, Status: operation.StatusInProgress},
+			previous: operation.StatusPending,
+			want:     []EventType{EventOperationStarted},
+		},
+		{
+			name:     "resume after pause",
+			op:       operation.Operation{Type: operation.OpTenantUpgrade, Status: operation.StatusInProgress},
+			previous: operation.StatusPaused,
+		},
+		{
+			name:     "unchanged status",
+			op:       operation.Operation{Type: operation.OpTenantUpgrade, Status: operation.StatusInProgress},
+			previous: operation.StatusInProgress,
+		},
+		{
+			name:     "tenant creation completed",
+			op:       operation.Operation{Type: operation.OpTenantCreate, Status: operation.StatusCompleted},
+			previous: operation.StatusInProgress,
+			want:     []EventType{EventOperationCompleted, EventTenantActivated},
+		},
+		{
+			name:     "tenant deletion completed",
+			op:       operation.Operation{Type: operation.OpTenantDelete, Status: operation.StatusCompleted},
+			previous: operation.StatusInProgress,
+			want:     []EventType{EventOperationCompleted, EventTenantDeleted},
+		},
+		{
+			name:     "failure",
+			op:       operation.Operation{Type: operation.OpTenantCreate, Status: operation.StatusFailed, ErrorMessage: &failure},
+			previous: operation.StatusInProgress,
+			want:     []EventType{EventOperationFailed},
+		},
+		{
+			name:     "cancellation",
+			op:       operation.Operation{Type: operation.OpTenantCreate, Status: operation.StatusCancelled},
+			previous: operation.StatusInProgress,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.op.ID = 7
+			tc.op.TenantID = &tenantID
+
+			events := OperationEvents(&tc.op, tc.previous)
+			var got []EventType
+			for _, e := range events {
+				got = append(got, e.Type)
+				require.NotNil(t, e.OperationID)
+				assert.Equal(t, int64(7), *e.OperationID)
+				assert.Equal(t, "tenant:42", e.OrderingKey())
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+
+	failed := OperationEvents(&operation.Operation{ID: 7, Status: operation.StatusFailed, ErrorMessage: &failure}, operation.StatusInProgress)
+	require.Len(t, failed, 1)
+	assert.Equal(t, failure, failed[0].Data["error"])
+	assert.Equal(t, "operation:7", failed[0].OrderingKey())
+}
@@ -0,0 +1,40 @@
+package outbox
+
+import (
+	"context"
+	"time"
+)
+
+// Repository defines the interface for outbox data access operations.
+type Repository interface {
+	// Append records events to be published. Appending within a transaction
+	// records them only if the transaction commits.
+	Append(ctx context.Context, events ...*Event) error
+
+	// ListPending retrieves up to limit events that are due to be published,
+	// oldest first. Only the oldest pending event of each ordering key is
+	// retrieved, and only once its next attempt is due, so an event waiting to
+	// be retried holds back the later events in its sequence. Dead-lettered
+	// events are not pending.
+	ListPending(ctx context.Context, limit int) ([]*Event, error)
+
+	// MarkPublished records that the event has been published.
+	MarkPublished(ctx context.Context, id int64) error
+
+	// MarkFailed records a failed attempt to publish the event and when to
+	// attempt it next.
+	MarkFailed(ctx context.Context, id int64, reason string, nextAttemptAt time.Time) error
+
+	// MarkDeadLettered records a failed attempt to publish the event and gives up
+	// on it, so that it is no longer pending.
+	MarkDeadLettered(ctx context.Context, id int64, reason string) error
+
+	// DeletePublished deletes events published before the given time and returns
+	// how many were deleted.
+	DeletePublished(ctx context.Context, before time.Time) (int64, error)
+
+	// TryLock takes the relay lock, which is held by at most one relay across all
+	// instances of the service so that events are published in order. It reports
+	// whether the lock was taken and, if so, returns the function releasing it.
+	TryLock(ctx context.Context) (release func(), locked bool, err error)
+}
@@ -0,0 +1,65 @@
+package resource
+
+import (
+	"fmt"
+
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+)
+
+// Footprint counts resources by type.
+type Footprint map[Type]int32
+
+// TenantFootprint is the set of resources provisioned for every tenant. It must
+// match what the tenant creation workflow registers in the ledger.
+var TenantFootprint = Footprint{
+	TypeDatabaseSchema:      1,
+	TypeSecret:              2,
+	TypeKubernetesNamespace: 1,
+	TypeGKEDeployment:       1,
+	TypePubSubTopic:         1,
+}
+
+// Quotas caps how many resources of each type a single project may hold in a region.
+// Types without a quota are counted but not limited.
+type Quotas map[Type]int32
+
+// DefaultQuotas reflects the GCP per-project limits that tenant provisioning runs into.
+var DefaultQuotas = Quotas{
+	TypePubSubTopic: 10000,
+}
+
+// Fits reports whether adding the footprint to the current counts keeps every
+// quota-limited type within its quota.
+func (q Quotas) Fits(counts, footprint Footprint) bool {
+	for resourceType, n := range footprint {
+		limit, ok := q[resourceType]
+		if ok && counts[resourceType]+n > limit {
+			return false
+		}
+	}
+	return true
+}
+
+// DefaultProjectsPerRegion is how many GCP projects host tenant resources in each region.
+const DefaultProjectsPerRegion = 3
+
+// DefaultProjectID returns the GCP project that hosts tenant resources in a region
+// until its quotas are exhausted.
+func DefaultProjectID(region tenant.Region) string {
+	return "hoglet-hub-" + string(region)
+}
+
+// ProjectIDs returns the n projects that host tenant resources in a region, in
+// order of preference. The first is DefaultProjectID; each later project takes
+// the overflow once those before it are full.
+func ProjectIDs(region tenant.Region, n int) []string {
+	projects := make([]string, 0, n)
+	for i := range n {
+		if i == 0 {
+			projects = append(projects, DefaultProjectID(region))
+			continue
+		}
+		projects = append(projects, fmt.Sprintf("%s-%d", DefaultProjectID(region), i+1))
+	}
+	return projects
+}
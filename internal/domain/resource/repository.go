@@ -0,0 +1,49 @@
+package resource
+
+import (
+	"context"
+
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+)
+
+// ListFilter narrows a resource listing. Nil fields do not filter.
+type ListFilter struct {
+	Type   *Type
+	Status *Status
+}
+
+// Repository defines the interface for resource ledger data access operations.
+type Repository interface {
+	// Register records a resource and returns its ID. Registering a resource the
+	// tenant already has with the same type and name updates its status and returns
+	// the existing ID, so that a retried step does not record it twice.
+	Register(ctx context.Context, r *Resource) (int64, error)
+
+	// ListByTenant retrieves the tenant's resources matching the filter, in the
+	// order they were registered.
+	ListByTenant(ctx context.Context, tenantID int64, filter ListFilter) ([]*Resource, error)
+
+	// UpdateStatus persists the resource's current status.
+	// Returns ErrResourceNotFound if the resource does not exist.
+	UpdateStatus(ctx context.Context, r *Resource) error
+
+	// Delete removes a resource from the ledger once it has been torn down.
+	// Returns ErrResourceNotFound if the resource does not exist.
+	Delete(ctx context.Context, id int64) error
+}
+
+// QuotaRepository tracks how many resources of each type every project holds per region.
+type QuotaRepository interface {
+	// Counts returns the project's current resource counts in the region.
+	Counts(ctx context.Context, projectID string, region tenant.Region) (Footprint, error)
+
+	// Reserve atomically adds the footprint to the project's counts in the region.
+	// If any count would exceed its quota, nothing is reserved and ErrQuotaExhausted
+	// is returned.
+	Reserve(ctx context.Context, projectID string, region tenant.Region, footprint Footprint, quotas Quotas) error
+
+	// Release subtracts the footprint from the project's counts in the region.
+	// If any count would drop below zero, nothing is released and
+	// ErrQuotaOverRelease is returned.
+	Release(ctx context.Context, projectID string, region tenant.Region, footprint Footprint) error
+}
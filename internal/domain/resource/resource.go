@@ -0,0 +1,137 @@
+// Package resource models the ledger of cloud resources provisioned for tenants.
+// Workflow steps record each resource they create, and deletion consumes the
+// ledger so that exactly what was created is removed.
+package resource
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+)
+
+// Common errors that can be returned by resource functions.
+var (
+	ErrResourceNotFound = errors.New("resource not found")
+	ErrInvalidType      = errors.New("invalid resource type")
+	ErrInvalidName      = errors.New("invalid resource name")
+	ErrInvalidStatus    = errors.New("invalid resource status")
+	ErrInvalidProject   = errors.New("invalid resource project")
+	ErrQuotaExhausted   = errors.New("resource quota exhausted")
+	ErrQuotaOverRelease = errors.New("resource quota released more than reserved")
+)
+
+// Type identifies the kind of cloud resource.
+type Type string
+
+const (
+	TypeDatabaseSchema      Type = "database_schema"
+	TypeSecret              Type = "secret"
+	TypeKubernetesNamespace Type = "kubernetes_namespace"
+	TypeGKEDeployment       Type = "gke_deployment"
+	TypePubSubTopic         Type = "pubsub_topic"
+)
+
+// IsValid reports whether t is a known resource type.
+func (t Type) IsValid() bool {
+	switch t {
+	case TypeDatabaseSchema, TypeSecret, TypeKubernetesNamespace, TypeGKEDeployment, TypePubSubTopic:
+		return true
+	default:
+		return false
+	}
+}
+
+// Status represents the lifecycle state of a resource.
+type Status string
+
+const (
+	StatusProvisioning Status = "provisioning"
+	StatusActive       Status = "active"
+	StatusError        Status = "error"
+	StatusDeleting     Status = "deleting"
+	StatusSuspended    Status = "suspended"
+)
+
+// IsValid reports whether s is a known resource status.
+func (s Status) IsValid() bool {
+	switch s {
+	case StatusProvisioning, StatusActive, StatusError, StatusDeleting, StatusSuspended:
+		return true
+	default:
+		return false
+	}
+}
+
+// maxNameLength is the longest resource name the ledger can store.
+const maxNameLength = 128
+
+// Resource is a cloud resource provisioned for a tenant.
+type Resource struct {
+	ID                   int64          // Unique identifier
+	TenantID             int64          // Owning tenant
+	Type                 Type           // Kind of resource
+	Name                 string         // Name of the resource, unique per tenant and type
+	ExternalID           *string        // Identifier assigned by the provider, if any
+	Region               tenant.Region  // Region the resource is deployed in
+	ProjectID            string         // GCP project the resource is deployed in
+	Status               Status         // Current lifecycle state
+	Metadata             map[string]any // Resource-specific attributes
+	CreatedByOperationID *int64         // Operation that provisioned the resource
+	CreatedAt            time.Time      // Registration timestamp
+	UpdatedAt            *time.Time     // Last update timestamp
+}
+
+// NewResource creates a new resource in the provisioning state with validation of all fields.
+// An operationID of zero records no creating operation.
+func NewResource(
+	tenantID int64,
+	resourceType Type,
+	name string,
+	region tenant.Region,
+	projectID string,
+	operationID int64,
+) (*Resource, error) {
+	if !resourceType.IsValid() {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidType, resourceType)
+	}
+	if name == "" || len(name) > maxNameLength {
+		return nil, ErrInvalidName
+	}
+	if !region.IsValid() {
+		return nil, tenant.ErrInvalidRegion
+	}
+	if projectID == "" {
+		return nil, ErrInvalidProject
+	}
+
+	var createdBy *int64
+	if operationID != 0 {
+		createdBy = &operationID
+	}
+
+	return &Resource{
+		TenantID:             tenantID,
+		Type:                 resourceType,
+		Name:                 name,
+		Region:               region,
+		ProjectID:            projectID,
+		Status:               StatusProvisioning,
+		Metadata:             map[string]any{},
+		CreatedByOperationID: createdBy,
+		CreatedAt:            time.Now(),
+	}, nil
+}
+
+// Activate marks the resource as provisioned and in use.
+func (r *Resource) Activate() { r.setStatus(StatusActive) }
+
+// MarkDeleting marks the resource as being torn down.
+func (r *Resource) MarkDeleting() { r.setStatus(StatusDeleting) }
+
+func (r *Resource) setStatus(status Status) {
+	r.Status = status
+	now := time.Now()
+	r.UpdatedAt = &now
+}
@@ -0,0 +1,91 @@
+package resource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+)
+
+func TestNewResource(t *testing.T) {
+	tests := []struct {
+		name         string
+		resourceType Type
+		resourceName string
+		region       tenant.Region
+		projectID    string
+		wantErr      error
+	}{
+		{
+			name: "valid resource", resourceType: TypePubSubTopic, resourceName: "tenant-acme-events",
+			region: tenant.RegionUS1, projectID: "hoglet-hub-us1",
+		},
+		{
+			name: "unknown type", resourceType: Type("bigtable_instance"), resourceName: "tenant-acme",
+			region: tenant.RegionUS1, projectID: "hoglet-hub-us1", wantErr: ErrInvalidType,
+		},
+		{
+			name: "empty name", resourceType: TypeSecret,
+			region: tenant.RegionUS1, projectID: "hoglet-hub-us1", wantErr: ErrInvalidName,
+		},
+		{
+			name: "unknown region", resourceType: TypeSecret, resourceName: "tenant-acme-api-key",
+			region: tenant.Region("mars1"), projectID: "hoglet-hub-us1", wantErr: tenant.ErrInvalidRegion,
+		},
+		{
+			name: "missing project", resourceType: TypeSecret, resourceName: "tenant-acme-api-key",
+			region: tenant.RegionUS1, wantErr: ErrInvalidProject,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r, err := NewResource(42, tc.resourceType, tc.resourceName, tc.region, tc.projectID, 9)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.Nil(t, r)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, StatusProvisioning, r.Status)
+			require.NotNil(t, r.CreatedByOperationID)
+			assert.Equal(t, int64(9), *r.CreatedByOperationID)
+		})
+	}
+}
+
+func TestNewResource_WithoutOperation(t *testing.T) {
+	r, err := NewResource(42, TypeSecret, "tenant-acme-api-key", tenant.RegionUS1, DefaultProjectID(tenant.RegionUS1), 0)
+	require.NoError(t, err)
+	assert.Nil(t, r.CreatedByOperationID)
+	assert.Equal(t, "hoglet-hub-us1", r.ProjectID)
+}
+
+func TestResource_StatusChanges(t *testing.T) {
+	r := &Resource{Status: StatusProvisioning}
+
+	r.Activate()
+	assert.Equal(t, StatusActive, r.Status)
+	assert.NotNil(t, r.UpdatedAt)
+
+	r.MarkDeleting()
+	assert.Equal(t, StatusDeleting, r.Status)
+}
+
+func TestQuotas_Fits(t *testing.T) {
+	quotas := Quotas{TypePubSubTopic: 10}
+	footprint := Footprint{TypePubSubTopic: 1, TypeSecret: 2}
+
+	assert.True(t, quotas.Fits(Footprint{TypePubSubTopic: 9, TypeSecret: 5000}, footprint))
+	assert.False(t, quotas.Fits(Footprint{TypePubSubTopic: 10}, footprint))
+	assert.True(t, quotas.Fits(nil, footprint))
+}
+
+func TestProjectIDs(t *testing.T) {
+	assert.Equal(t,
+		[]string{"hoglet-hub-eu1", "hoglet-hub-eu1-2", "hoglet-hub-eu1-3"},
+		ProjectIDs(tenant.RegionEU1, DefaultProjectsPerRegion),
+	)
+}
@@ -0,0 +1,100 @@
+package tenant
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrInvalidListParams is returned when a tenant listing is requested with an
+// unknown sort field or order, or a malformed filter.
+var ErrInvalidListParams = errors.New("invalid list parameters")
+
+// SortField identifies the tenant attribute a listing is ordered by.
+type SortField string
+
+// Supported sort fields for tenant listings.
+const (
+	SortByCreatedAt SortField = "created_at"
+	SortByName      SortField = "name"
+)
+
+// SortOrder is the direction in which a listing is ordered.
+type SortOrder string
+
+// Supported sort orders.
+const (
+	SortAsc  SortOrder = "asc"
+	SortDesc SortOrder = "desc"
+)
+
+// ListFilter narrows a tenant listing. Nil or empty fields do not filter.
+type ListFilter struct {
+	Region           *Region
+	Tier             *Tier
+	Status           *Status // When nil, deleted tenants are excluded
+	IsolationGroupID *int64
+	NamePrefix       string
+}
+
+// Cursor marks the last tenant of a page so the next page can resume after it.
+// Only the fields relevant to the listing's sort field are compared.
+type Cursor struct {
+	ID        int64
+	Name      string
+	CreatedAt time.Time
+}
+
+// CursorAt returns a cursor positioned at the given tenant.
+func CursorAt(t *Tenant) *Cursor {
+	return &Cursor{ID: t.ID, Name: t.Name, CreatedAt: t.CreatedAt}
+}
+
+// ListParams describes a single page of a tenant listing.
+type ListParams struct {
+	Filter ListFilter
+	SortBy SortField
+	Order  SortOrder
+	After  *Cursor // Start after this tenant; nil starts at the beginning
+	Limit  int     // Maximum number of tenants in the page
+}
+
+// Validate checks that the sort field, order, limit and filter values are supported.
+func (p ListParams) Validate() error {
+	switch p.SortBy {
+	case SortByCreatedAt, SortByName:
+	default:
+		return fmt.Errorf("%w: unknown sort field %q", ErrInvalidListParams, p.SortBy)
+	}
+
+	switch p.Order {
+	case SortAsc, SortDesc:
+	default:
+		return fmt.Errorf("%w: unknown sort order %q", ErrInvalidListParams, p.Order)
+	}
+
+	if p.Limit < 1 {
+		return fmt.Errorf("%w: limit must be positive", ErrInvalidListParams)
+	}
+
+	if p.Filter.Region != nil && !isValidRegion(*p.Filter.Region) {
+		return fmt.Errorf("%w: %w", ErrInvalidListParams, ErrInvalidRegion)
+	}
+	if p.Filter.Tier != nil && !isValidTier(*p.Filter.Tier) {
+		return fmt.Errorf("%w: %w", ErrInvalidListParams, ErrInvalidTier)
+	}
+
+	// Prefixes are restricted to the characters allowed in names, which also
+	// keeps them free of LIKE wildcards.
+	if p.Filter.NamePrefix != "" && !isValidName(p.Filter.NamePrefix) {
+		return fmt.Errorf("%w: %w", ErrInvalidListParams, ErrInvalidName)
+	}
+
+	return nil
+}
+
+// Page is a single page of a tenant listing.
+type Page struct {
+	Tenants []*Tenant
+	Next    *Cursor // Cursor for the following page; nil on the last page
+}
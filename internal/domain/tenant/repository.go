@@ -15,6 +15,30 @@ type Repository interface {
 	// with the provided values.
 	Update(ctx context.Context, tenant *Tenant) error
 
+	// TransitionStatus moves the tenant to the status to if its stored status is
+	// one of from, and reports whether it did. It lets services claim a tenant
+	// for an operation without racing concurrent requests that read the same status.
+	TransitionStatus(ctx context.Context, id int64, from []Status, to Status) (bool, error)
+
+	// ChangeTier stores the tenant's tier if its stored status is one of from, and
+	// reports whether it did. Only the tier is written.
+	ChangeTier(ctx context.Context, id int64, tier Tier, from []Status) (bool, error)
+
+	// ChangeRegion stores the tenant's region if its stored status is one of from,
+	// and reports whether it did. Only the region is written.
+	ChangeRegion(ctx context.Context, id int64, region Region, from []Status) (bool, error)
+
+	// LockForOperation locks the tenant until the transaction ctx carries ends and
+	// returns it as stored. It returns ErrOperationInProgress if an operation on the
+	// tenant has not finished yet. Services lock the tenant in the transaction that
+	// persists a new operation on it, so that one operation runs on a tenant at a time.
+	LockForOperation(ctx context.Context, id int64) (*Tenant, error)
+
+	// ChangeIsolationGroup stores the tenant's isolation group if the tenant is
+	// still active in its region and has no unfinished operation, and reports
+	// whether it did. Only the group membership is written.
+	ChangeIsolationGroup(ctx context.Context, tenant *Tenant) (bool, error)
+
 	// FindByName retrieves a tenant by its unique name.
 	// Returns nil and an error if the tenant cannot be found.
 	FindByName(ctx context.Context, name string) (*Tenant, error)
@@ -23,6 +47,26 @@ type Repository interface {
 	// Returns nil and an error if the tenant cannot be found.
 	FindByID(ctx context.Context, id int64) (*Tenant, error)
 
+	// FindByIDIncludingDeleted retrieves a tenant by its unique identifier like
+	// FindByID, but also finds tenants that have been deleted.
+	FindByIDIncludingDeleted(ctx context.Context, id int64) (*Tenant, error)
+
+	// List retrieves a page of tenants matching the filter in the requested order.
+	// Ties in the sort field are broken by ID so that paging is stable.
+	List(ctx context.Context, params ListParams) (*Page, error)
+
+	// RecordSuspension adds a suspension to the tenant's suspension history.
+	// Recording a suspension for an operation that already recorded one is a no-op,
+	// so a workflow step that records it can safely be retried.
+	RecordSuspension(ctx context.Context, suspension *Suspension) error
+
+	// EndSuspension closes the tenant's open suspension, recording who resumed the
+	// tenant, why, and by which operation. It is a no-op if no suspension is open.
+	EndSuspension(ctx context.Context, tenantID int64, reason, resumedBy string, operationID int64) error
+
+	// FindSuspensions retrieves the tenant's suspension history, most recent first.
+	FindSuspensions(ctx context.Context, tenantID int64) ([]*Suspension, error)
+
 	// Delete permanently removes a tenant from the storage system.
 	// This operation cannot be undone, so callers should implement
 	// any necessary validation or confirmation before invoking.
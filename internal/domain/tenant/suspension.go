@@ -0,0 +1,34 @@
+package tenant
+
+import "time"
+
+// Suspension is a period during which a tenant was suspended. Together a tenant's
+// suspensions form its suspension history: who suspended it and why, and who
+// brought it back. At most one suspension per tenant is open at a time.
+type Suspension struct {
+	ID                 int64
+	TenantID           int64
+	Reason             string    // Why the tenant was suspended
+	SuspendedBy        string    // Who requested the suspension
+	SuspendedAt        time.Time // When the tenant was suspended
+	SuspendOperationID *int64    // Operation that suspended the tenant
+
+	ResumedAt         *time.Time // When the tenant was resumed; nil while still suspended
+	ResumedBy         *string    // Who requested the resumption
+	ResumeReason      *string    // Why the tenant was resumed
+	ResumeOperationID *int64     // Operation that resumed the tenant
+}
+
+// NewSuspension creates an open suspension of the tenant recorded by the given operation.
+func NewSuspension(tenantID int64, reason, suspendedBy string, operationID int64) *Suspension {
+	return &Suspension{
+		TenantID:           tenantID,
+		Reason:             reason,
+		SuspendedBy:        suspendedBy,
+		SuspendedAt:        time.Now(),
+		SuspendOperationID: &operationID,
+	}
+}
+
+// IsOpen reports whether the tenant is still suspended.
+func (s *Suspension) IsOpen() bool { return s.ResumedAt == nil }
@@ -2,7 +2,10 @@ package tenant
 
 import (
 	"errors"
+	"fmt"
 	"regexp"
+	"slices"
+	"strings"
 	"time"
 )
 
@@ -13,6 +16,16 @@ var (
 	ErrInvalidName         = errors.New("invalid tenant name")
 	ErrInvalidRegion       = errors.New("invalid region")
 	ErrInvalidTier         = errors.New("invalid tier")
+
+	ErrTenantNotActive          = errors.New("tenant is not active")
+	ErrTierUnchanged            = errors.New("tenant is already on the requested tier")
+	ErrTierTransitionNotAllowed = errors.New("tier transition not allowed")
+	ErrRegionUnchanged          = errors.New("tenant is already in the requested region")
+	ErrTenantAlreadySuspended   = errors.New("tenant is already suspended")
+	ErrTenantNotSuspended       = errors.New("tenant is not suspended")
+	ErrReasonRequired           = errors.New("a reason is required")
+	ErrTenantInIsolationGroup   = errors.New("tenant must leave its isolation group first")
+	ErrOperationInProgress      = errors.New("tenant has an operation in progress")
 )
 
 // Region represents a deployment region for tenant resources.
@@ -30,6 +43,9 @@ const (
 	RegionUS4 Region = "us4"
 )
 
+// IsValid reports whether the region is one of the predefined deployment regions.
+func (r Region) IsValid() bool { return isValidRegion(r) }
+
 // Tier represents a tenant's subscription level which determines
 // available features and resource limits.
 type Tier string
@@ -41,6 +57,30 @@ const (
 	TierPro        Tier = "pro"
 )
 
+// IsValid reports whether the tier is one of the predefined subscription tiers.
+func (t Tier) IsValid() bool { return isValidTier(t) }
+
+// tierRank orders tiers from least to most capable.
+var tierRank = map[Tier]int{
+	TierFree:       0,
+	TierPro:        1,
+	TierEnterprise: 2,
+}
+
+// tierTransitions lists the tiers each tier may change to. Upgrades may skip tiers,
+// but downgrades step down one tier at a time so that quotas shrink in stages;
+// an enterprise tenant reaches the free tier via pro.
+var tierTransitions = map[Tier][]Tier{
+	TierFree:       {TierPro, TierEnterprise},
+	TierPro:        {TierFree, TierEnterprise},
+	TierEnterprise: {TierPro},
+}
+
+// IsDowngrade reports whether moving from one tier to another reduces capacity.
+func IsDowngrade(from, to Tier) bool {
+	return tierRank[to] < tierRank[from]
+}
+
 // Status represents the tenant's current lifecycle state.
 type Status string
 
@@ -49,6 +89,8 @@ const (
 	StatusProvisioning Status = "provisioning" // Initial state during setup
 	StatusActive       Status = "active"       // Normal operating state
 	StatusSuspended    Status = "suspended"    // Temporarily disabled
+	StatusError        Status = "error"        // An operation failed and needs attention
+	StatusIsolated     Status = "isolated"     // Cut off from other tenants' shared resources
 	StatusDeleting     Status = "deleting"     // Being removed from the system
 	StatusDeleted      Status = "deleted"      // Logically deleted
 )
@@ -62,6 +104,8 @@ type Tenant struct {
 	Tier             Tier       // Subscription tier
 	Status           Status     // Current lifecycle state
 	IsolationGroupID *int64     // Optional group for resource isolation
+	PrimaryNodeID    *int64     // Database node hosting the tenant's schema, once placed
+	CreatedBy        string     // Who requested the tenant; empty if unknown
 	CreatedAt        time.Time  // Creation timestamp
 	UpdatedAt        *time.Time // Last update timestamp
 	DeletedAt        *time.Time // Deletion timestamp (if deleted)
@@ -124,36 +168,72 @@ func isValidTier(tier Tier) bool {
 }
 
 // Activate marks the tenant as active, indicating it's ready for use.
-// Updates the tenant's status and timestamp.
-func (t *Tenant) Activate() {
-	t.Status = StatusActive
-	now := time.Now()
-	t.UpdatedAt = &now
+// Returns a TransitionError if the tenant cannot become active from its current status.
+func (t *Tenant) Activate() error {
+	return t.transitionTo(StatusActive)
 }
 
 // Suspend marks the tenant as suspended, temporarily disabling access.
 // This is typically used for billing issues or policy violations.
-func (t *Tenant) Suspend() {
-	t.Status = StatusSuspended
-	now := time.Now()
-	t.UpdatedAt = &now
+func (t *Tenant) Suspend() error {
+	return t.transitionTo(StatusSuspended)
+}
+
+// ValidateSuspend checks whether the tenant may be suspended for the given reason.
+// Only active tenants can be suspended, so that a tenant that is still being
+// provisioned or is being deleted is never scaled down underneath its workflow.
+func (t *Tenant) ValidateSuspend(reason string) error {
+	if strings.TrimSpace(reason) == "" {
+		return ErrReasonRequired
+	}
+	if t.Status == StatusSuspended {
+		return ErrTenantAlreadySuspended
+	}
+	if t.Status != StatusActive {
+		return ErrTenantNotActive
+	}
+	return nil
+}
+
+// ValidateResume checks whether the tenant may be resumed for the given reason.
+// Only suspended tenants can be resumed.
+func (t *Tenant) ValidateResume(reason string) error {
+	if strings.TrimSpace(reason) == "" {
+		return ErrReasonRequired
+	}
+	if t.Status != StatusSuspended {
+		return ErrTenantNotSuspended
+	}
+	return nil
+}
+
+// Isolate marks the tenant as isolated from other tenants' shared resources.
+func (t *Tenant) Isolate() error {
+	return t.transitionTo(StatusIsolated)
+}
+
+// MarkError marks the tenant as being in an error state after a failed operation.
+// Tenants in this state require operator attention before they can be used again.
+func (t *Tenant) MarkError() error {
+	return t.transitionTo(StatusError)
+}
+
+// MarkProvisioning returns the tenant to the provisioning state, e.g. when a failed
+// creation is retried.
+func (t *Tenant) MarkProvisioning() error {
+	return t.transitionTo(StatusProvisioning)
 }
 
 // MarkForDeletion changes the tenant status to deleting, indicating
 // that deletion is in progress but not yet complete.
-func (t *Tenant) MarkForDeletion() {
-	t.Status = StatusDeleting
-	now := time.Now()
-	t.UpdatedAt = &now
+func (t *Tenant) MarkForDeletion() error {
+	return t.transitionTo(StatusDeleting)
 }
 
 // Delete marks the tenant as logically deleted in the system.
 // This sets both UpdatedAt and DeletedAt timestamps.
-func (t *Tenant) Delete() {
-	t.Status = StatusDeleted
-	now := time.Now()
-	t.UpdatedAt = &now
-	t.DeletedAt = &now
+func (t *Tenant) Delete() error {
+	return t.transitionTo(StatusDeleted)
 }
 
 // UpgradeTier changes the tenant to a new subscription tier after validation.
@@ -169,6 +249,25 @@ func (t *Tenant) UpgradeTier(newTier Tier) error {
 	return nil
 }
 
+// ValidateTierChange checks whether the tenant may move to newTier.
+// Only active tenants can change tier, and the move must be listed in the
+// tier transition table.
+func (t *Tenant) ValidateTierChange(newTier Tier) error {
+	if !isValidTier(newTier) {
+		return ErrInvalidTier
+	}
+	if t.Status != StatusActive {
+		return ErrTenantNotActive
+	}
+	if t.Tier == newTier {
+		return ErrTierUnchanged
+	}
+	if !slices.Contains(tierTransitions[t.Tier], newTier) {
+		return fmt.Errorf("%w: %s to %s", ErrTierTransitionNotAllowed, t.Tier, newTier)
+	}
+	return nil
+}
+
 // ChangeRegion moves the tenant to a different deployment region after validation.
 // Returns an error if the new region is invalid.
 func (t *Tenant) ChangeRegion(newRegion Region) error {
@@ -182,6 +281,41 @@ func (t *Tenant) ChangeRegion(newRegion Region) error {
 	return nil
 }
 
+// ValidateMigration checks whether the tenant may be migrated to newRegion.
+// Only active tenants can be migrated, and only to a region other than their own.
+// An isolation group lives in a single region, so a tenant in a group must leave
+// it before migrating.
+func (t *Tenant) ValidateMigration(newRegion Region) error {
+	if !isValidRegion(newRegion) {
+		return ErrInvalidRegion
+	}
+	if t.Status != StatusActive {
+		return ErrTenantNotActive
+	}
+	if t.Region == newRegion {
+		return ErrRegionUnchanged
+	}
+	if t.IsolationGroupID != nil {
+		return ErrTenantInIsolationGroup
+	}
+	return nil
+}
+
+// ChangeIsolationGroup moves the tenant into the given isolation group, or out of
+// any group when groupID is nil. Only active tenants can change groups, since a
+// running operation may depend on the tenant's current placement.
+// Checking that the group is in the tenant's region is up to the caller.
+func (t *Tenant) ChangeIsolationGroup(groupID *int64) error {
+	if t.Status != StatusActive {
+		return ErrTenantNotActive
+	}
+
+	t.IsolationGroupID = groupID
+	now := time.Now()
+	t.UpdatedAt = &now
+	return nil
+}
+
 // IsActive checks if the tenant is in the active state and available for use.
 func (t *Tenant) IsActive() bool {
 	return t.Status == StatusActive
@@ -0,0 +1,142 @@
+package tenant
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenant_ValidateTierChange(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  Status
+		from    Tier
+		to      Tier
+		wantErr error
+	}{
+		{name: "upgrade", status: StatusActive, from: TierFree, to: TierPro},
+		{name: "upgrade skipping a tier", status: StatusActive, from: TierFree, to: TierEnterprise},
+		{name: "downgrade one tier", status: StatusActive, from: TierEnterprise, to: TierPro},
+		{name: "downgrade to free", status: StatusActive, from: TierPro, to: TierFree},
+		{name: "downgrade skipping a tier", status: StatusActive, from: TierEnterprise, to: TierFree, wantErr: ErrTierTransitionNotAllowed},
+		{name: "same tier", status: StatusActive, from: TierPro, to: TierPro, wantErr: ErrTierUnchanged},
+		{name: "unknown tier", status: StatusActive, from: TierPro, to: Tier("platinum"), wantErr: ErrInvalidTier},
+		{name: "tenant not active", status: StatusProvisioning, from: TierFree, to: TierPro, wantErr: ErrTenantNotActive},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tenant := &Tenant{Name: "acme", Status: tc.status, Tier: tc.from}
+			err := tenant.ValidateTierChange(tc.to)
+			if tc.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestIsDowngrade(t *testing.T) {
+	assert.True(t, IsDowngrade(TierEnterprise, TierPro))
+	assert.True(t, IsDowngrade(TierPro, TierFree))
+	assert.False(t, IsDowngrade(TierFree, TierEnterprise))
+	assert.False(t, IsDowngrade(TierPro, TierPro))
+}
+
+func TestTenant_ValidateMigration(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  Status
+		groupID *int64
+		to      Region
+		wantErr error
+	}{
+		{name: "other region", status: StatusActive, to: RegionEU1},
+		{name: "same region", status: StatusActive, to: RegionUS1, wantErr: ErrRegionUnchanged},
+		{name: "unknown region", status: StatusActive, to: Region("mars1"), wantErr: ErrInvalidRegion},
+		{name: "tenant not active", status: StatusSuspended, to: RegionEU1, wantErr: ErrTenantNotActive},
+		{name: "tenant in isolation group", status: StatusActive, groupID: ptr(int64(7)), to: RegionEU1, wantErr: ErrTenantInIsolationGroup},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tenant := &Tenant{Name: "acme", Status: tc.status, Region: RegionUS1, IsolationGroupID: tc.groupID}
+			err := tenant.ValidateMigration(tc.to)
+			if tc.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestTenant_ValidateSuspend(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  Status
+		reason  string
+		wantErr error
+	}{
+		{name: "active tenant", status: StatusActive, reason: "unpaid invoice"},
+		{name: "missing reason", status: StatusActive, reason: "  ", wantErr: ErrReasonRequired},
+		{name: "already suspended", status: StatusSuspended, reason: "unpaid invoice", wantErr: ErrTenantAlreadySuspended},
+		{name: "deleting tenant", status: StatusDeleting, reason: "unpaid invoice", wantErr: ErrTenantNotActive},
+		{name: "provisioning tenant", status: StatusProvisioning, reason: "unpaid invoice", wantErr: ErrTenantNotActive},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tenant := &Tenant{Name: "acme", Status: tc.status}
+			err := tenant.ValidateSuspend(tc.reason)
+			if tc.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestTenant_ValidateResume(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  Status
+		reason  string
+		wantErr error
+	}{
+		{name: "suspended tenant", status: StatusSuspended, reason: "invoice paid"},
+		{name: "missing reason", status: StatusSuspended, reason: "", wantErr: ErrReasonRequired},
+		{name: "active tenant", status: StatusActive, reason: "invoice paid", wantErr: ErrTenantNotSuspended},
+		{name: "deleting tenant", status: StatusDeleting, reason: "invoice paid", wantErr: ErrTenantNotSuspended},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tenant := &Tenant{Name: "acme", Status: tc.status}
+			err := tenant.ValidateResume(tc.reason)
+			if tc.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestTenant_ChangeIsolationGroup(t *testing.T) {
+	tenant := &Tenant{Name: "acme", Status: StatusActive}
+	require.NoError(t, tenant.ChangeIsolationGroup(ptr(int64(7))))
+	assert.Equal(t, int64(7), *tenant.IsolationGroupID)
+
+	require.NoError(t, tenant.ChangeIsolationGroup(nil))
+	assert.Nil(t, tenant.IsolationGroupID)
+
+	tenant.Status = StatusSuspended
+	assert.ErrorIs(t, tenant.ChangeIsolationGroup(ptr(int64(7))), ErrTenantNotActive)
+	assert.Nil(t, tenant.IsolationGroupID)
+}
+
+func ptr[T any](v T) *T { return &v }
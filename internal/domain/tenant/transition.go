@@ -0,0 +1,93 @@
+package tenant
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// ErrInvalidTransition is matched by every TransitionError, so callers can test
+// for a rejected status change with errors.Is.
+var ErrInvalidTransition = errors.New("invalid tenant status transition")
+
+// TransitionError reports a status change that the tenant lifecycle does not allow.
+type TransitionError struct {
+	From Status
+	To   Status
+}
+
+// Error implements the error interface.
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("tenant cannot move from %s to %s", e.From, e.To)
+}
+
+// Is reports whether target is ErrInvalidTransition.
+func (e *TransitionError) Is(target error) bool { return target == ErrInvalidTransition }
+
+// statusTransitions lists the statuses each status may move to.
+//
+// Every status other than deleted may move to error, since any operation can fail.
+// A tenant in error may move back to the status a retried operation works towards:
+// provisioning for a creation, active or suspended for a tier change, suspension or
+// resumption, and deleting for a deletion. A tenant that is being provisioned or
+// deleted cannot be deleted, since its running workflow still owns it. Deleted is final.
+var statusTransitions = map[Status][]Status{
+	StatusProvisioning: {StatusActive, StatusError},
+	StatusActive:       {StatusSuspended, StatusIsolated, StatusDeleting, StatusError},
+	StatusSuspended:    {StatusActive, StatusDeleting, StatusError},
+	StatusIsolated:     {StatusActive, StatusDeleting, StatusError},
+	StatusError:        {StatusProvisioning, StatusActive, StatusSuspended, StatusDeleting},
+	StatusDeleting:     {StatusDeleted, StatusError},
+	StatusDeleted:      {},
+}
+
+// CanTransitionTo reports whether the tenant's lifecycle allows it to move from its
+// current status to the given one.
+func (t *Tenant) CanTransitionTo(to Status) bool {
+	return slices.Contains(statusTransitions[t.Status], to)
+}
+
+// TransitionSources returns the statuses the tenant lifecycle allows to move to
+// the given one, so that stores can apply a status change only while the stored
+// status still allows it.
+func TransitionSources(to Status) []Status {
+	var sources []Status
+	for from, targets := range statusTransitions {
+		if slices.Contains(targets, to) {
+			sources = append(sources, from)
+		}
+	}
+	slices.Sort(sources)
+	return sources
+}
+
+// ValidateTransition returns a TransitionError if the tenant may not move from its
+// current status to the given one. Services call it before starting an operation
+// so that an operation is never started against a tenant it cannot apply to.
+func (t *Tenant) ValidateTransition(to Status) error {
+	if !t.CanTransitionTo(to) {
+		return &TransitionError{From: t.Status, To: to}
+	}
+	return nil
+}
+
+// transitionTo moves the tenant to the given status. Moving to the status the tenant
+// already has is a no-op, so that a workflow step that is retried or resumed after
+// it already applied the change succeeds.
+func (t *Tenant) transitionTo(to Status) error {
+	if t.Status == to {
+		return nil
+	}
+	if err := t.ValidateTransition(to); err != nil {
+		return err
+	}
+
+	t.Status = to
+	now := time.Now()
+	t.UpdatedAt = &now
+	if to == StatusDeleted {
+		t.DeletedAt = &now
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package tenant
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenant_Transitions(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    Status
+		apply   func(*Tenant) error
+		want    Status
+		wantErr bool
+	}{
+		{name: "provisioning to active", from: StatusProvisioning, apply: (*Tenant).Activate, want: StatusActive},
+		{name: "active to suspended", from: StatusActive, apply: (*Tenant).Suspend, want: StatusSuspended},
+		{name: "suspended to active", from: StatusSuspended, apply: (*Tenant).Activate, want: StatusActive},
+		{name: "active to isolated", from: StatusActive, apply: (*Tenant).Isolate, want: StatusIsolated},
+		{name: "isolated to deleting", from: StatusIsolated, apply: (*Tenant).MarkForDeletion, want: StatusDeleting},
+		{name: "deleting to error", from: StatusDeleting, apply: (*Tenant).MarkError, want: StatusError},
+		{name: "error to provisioning", from: StatusError, apply: (*Tenant).MarkProvisioning, want: StatusProvisioning},
+		{name: "error to deleting", from: StatusError, apply: (*Tenant).MarkForDeletion, want: StatusDeleting},
+		{name: "deleting to deleted", from: StatusDeleting, apply: (*Tenant).Delete, want: StatusDeleted},
+		{name: "repeated change is a no-op", from: StatusDeleting, apply: (*Tenant).MarkForDeletion, want: StatusDeleting},
+
+		{name: "deleted cannot be reactivated", from: StatusDeleted, apply: (*Tenant).Activate, wantErr: true},
+		{name: "deleted cannot be marked errored", from: StatusDeleted, apply: (*Tenant).MarkError, wantErr: true},
+		{name: "provisioning cannot be deleted", from: StatusProvisioning, apply: (*Tenant).MarkForDeletion, wantErr: true},
+		{name: "deleting cannot be suspended", from: StatusDeleting, apply: (*Tenant).Suspend, wantErr: true},
+		{name: "active cannot skip deleting", from: StatusActive, apply: (*Tenant).Delete, wantErr: true},
+		{name: "isolated cannot be suspended", from: StatusIsolated, apply: (*Tenant).Suspend, wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tenant := &Tenant{Name: "acme", Status: tc.from}
+			err := tc.apply(tenant)
+			if tc.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidTransition)
+				var transitionErr *TransitionError
+				require.ErrorAs(t, err, &transitionErr)
+				assert.Equal(t, tc.from, transitionErr.From)
+				assert.Equal(t, tc.from, tenant.Status, "a rejected transition must leave the status unchanged")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, tenant.Status)
+		})
+	}
+}
+
+func TestTenant_DeleteSetsDeletedAt(t *testing.T) {
+	tenant := &Tenant{Name: "acme", Status: StatusDeleting}
+	require.NoError(t, tenant.Delete())
+
+	require.NotNil(t, tenant.DeletedAt)
+	assert.True(t, tenant.IsDeleted())
+}
+
+func TestTransitionSources(t *testing.T) {
+	assert.Equal(t,
+		[]Status{StatusActive, StatusError, StatusIsolated, StatusSuspended},
+		TransitionSources(StatusDeleting))
+	assert.Equal(t, []Status{StatusDeleting}, TransitionSources(StatusDeleted))
+
+	for _, from := range TransitionSources(StatusDeleting) {
+		assert.True(t, (&Tenant{Status: from}).CanTransitionTo(StatusDeleting))
+	}
+}
+
+func TestStatusTransitions_CoverEveryStatus(t *testing.T) {
+	statuses := []Status{
+		StatusProvisioning, StatusActive, StatusSuspended, StatusError,
+		StatusIsolated, StatusDeleting, StatusDeleted,
+	}
+	for _, from := range statuses {
+		_, ok := statusTransitions[from]
+		assert.True(t, ok, "status %s has no transitions entry", from)
+		for _, to := range statusTransitions[from] {
+			assert.Contains(t, statuses, to)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package webhook
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ahrav/hoglet-hub/internal/domain/outbox"
+)
+
+// DeliveryStatus represents where a delivery is in its lifecycle.
+type DeliveryStatus string
+
+const (
+	DeliveryPending    DeliveryStatus = "pending"     // Waiting for its first or next attempt
+	DeliverySucceeded  DeliveryStatus = "succeeded"   // Accepted by the endpoint
+	DeliveryDeadLetter DeliveryStatus = "dead_letter" // Given up on after MaxAttempts
+)
+
+// IsValid reports whether s is a known delivery status.
+func (s DeliveryStatus) IsValid() bool {
+	switch s {
+	case DeliveryPending, DeliverySucceeded, DeliveryDeadLetter:
+		return true
+	default:
+		return false
+	}
+}
+
+// Retry policy of deliveries.
+const (
+	// MaxAttempts is how many times a delivery is attempted before it is
+	// dead-lettered.
+	MaxAttempts = 8
+
+	// initialRetryDelay is the delay after the first failed attempt, which
+	// doubles after each further one up to maxRetryDelay.
+	initialRetryDelay = 30 * time.Second
+	maxRetryDelay     = time.Hour
+)
+
+// Delivery is an event sent, or to be sent, to a subscription.
+type Delivery struct {
+	ID             int64            // Unique identifier
+	SubscriptionID int64            // Subscription the event is sent to
+	EventID        int64            // Outbox event being delivered
+	EventType      outbox.EventType // Type of the event
+	TenantID       *int64           // Tenant the event is about, if any
+	Payload        []byte           // Request body, signed on each attempt
+	Status         DeliveryStatus   // Current status
+	Attempts       int              // Attempts made so far
+	NextAttemptAt  time.Time        // Earliest time of the next attempt while pending
+	LastAttemptAt  *time.Time       // When the last attempt was made
+	ResponseStatus *int             // HTTP status of the last response, if one was received
+	LastError      *string          // Why the last attempt failed
+	CreatedAt      time.Time        // When the event was queued for the subscription
+	DeliveredAt    *time.Time       // When the endpoint accepted the event
+}
+
+// NewDelivery creates a pending delivery of the event, whose encoding is the
+// payload, to the subscription.
+func NewDelivery(sub *Subscription, e *outbox.Event, payload []byte) *Delivery {
+	now := time.Now()
+	return &Delivery{
+		SubscriptionID: sub.ID,
+		EventID:        e.ID,
+		EventType:      e.Type,
+		TenantID:       e.TenantID,
+		Payload:        payload,
+		Status:         DeliveryPending,
+		NextAttemptAt:  now,
+		CreatedAt:      now,
+	}
+}
+
+// RecordSuccess records an attempt that the endpoint accepted with the status.
+func (d *Delivery) RecordSuccess(statusCode int, at time.Time) {
+	d.Attempts++
+	d.Status = DeliverySucceeded
+	d.LastAttemptAt = &at
+	d.ResponseStatus = &statusCode
+	d.LastError = nil
+	d.DeliveredAt = &at
+}
+
+// RecordFailure records a failed attempt, with the status of the response if one
+// was received (zero otherwise). The delivery is retried after a delay that
+// grows with each attempt, and dead-lettered once MaxAttempts have failed.
+func (d *Delivery) RecordFailure(statusCode int, reason string, at time.Time) {
+	d.Attempts++
+	d.LastAttemptAt = &at
+	d.ResponseStatus = nil
+	if statusCode != 0 {
+		d.ResponseStatus = &statusCode
+	}
+	d.LastError = &reason
+
+	if d.Attempts >= MaxAttempts {
+		d.Status = DeliveryDeadLetter
+		return
+	}
+	d.NextAttemptAt = at.Add(RetryDelay(d.Attempts))
+}
+
+// DeadLetter gives up on the delivery without attempting it again.
+func (d *Delivery) DeadLetter(reason string) {
+	d.Status = DeliveryDeadLetter
+	d.LastError = &reason
+}
+
+// Redeliver queues a delivery that has finished, successfully or not, to be sent
+// again with a fresh set of attempts.
+// Returns ErrDeliveryPending if the delivery has not finished.
+func (d *Delivery) Redeliver(at time.Time) error {
+	if d.Status == DeliveryPending {
+		return fmt.Errorf("%w: delivery %d", ErrDeliveryPending, d.ID)
+	}
+	d.Status = DeliveryPending
+	d.Attempts = 0
+	d.NextAttemptAt = at
+	d.DeliveredAt = nil
+	return nil
+}
+
+// RetryDelay returns how long to wait before attempting a delivery again after
+// the given number of attempts.
+func RetryDelay(attempts int) time.Duration {
+	delay := initialRetryDelay
+	for i := 1; i < attempts && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxRetryDelay)
+}
+
+// DeliveryListParams selects a page of a subscription's deliveries, newest first.
+type DeliveryListParams struct {
+	SubscriptionID int64
+	Status         *DeliveryStatus // Nil for every status
+	BeforeID       int64           // Only deliveries older than this one; zero starts from the newest
+	Limit          int
+}
+
+// Validate checks the filter values and page size.
+func (p DeliveryListParams) Validate() error {
+	if p.Limit < 1 {
+		return fmt.Errorf("%w: limit must be positive", ErrInvalidListParams)
+	}
+	if p.BeforeID < 0 {
+		return fmt.Errorf("%w: invalid cursor position", ErrInvalidListParams)
+	}
+	if p.Status != nil && !p.Status.IsValid() {
+		return fmt.Errorf("%w: unknown status %q", ErrInvalidListParams, *p.Status)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/ahrav/hoglet-hub/internal/domain/outbox"
+)
+
+// Repository defines the interface for webhook subscription and delivery data
+// access operations.
+type Repository interface {
+	// CreateSubscription persists a new subscription and returns its ID.
+	CreateSubscription(ctx context.Context, sub *Subscription) (int64, error)
+
+	// FindSubscription retrieves a subscription by its unique identifier.
+	// Returns ErrSubscriptionNotFound if the subscription does not exist.
+	FindSubscription(ctx context.Context, id int64) (*Subscription, error)
+
+	// ListSubscriptions retrieves the subscriptions, optionally only those for
+	// the tenant, ordered by ID.
+	ListSubscriptions(ctx context.Context, tenantID *int64) ([]*Subscription, error)
+
+	// ListMatchingSubscriptions retrieves the active subscriptions that events
+	// of the type about the tenant are sent to.
+	ListMatchingSubscriptions(ctx context.Context, eventType outbox.EventType, tenantID *int64) ([]*Subscription, error)
+
+	// UpdateSubscription persists the URL, event types, description and active
+	// flag of a subscription.
+	// Returns ErrSubscriptionNotFound if the subscription does not exist.
+	UpdateSubscription(ctx context.Context, sub *Subscription) error
+
+	// DeleteSubscription permanently removes a subscription and its deliveries.
+	// Returns ErrSubscriptionNotFound if the subscription does not exist.
+	DeleteSubscription(ctx context.Context, id int64) error
+
+	// EnqueueDeliveries persists new pending deliveries. A delivery of an event
+	// the subscription was already sent is ignored, so events relayed more than
+	// once are delivered once.
+	EnqueueDeliveries(ctx context.Context, deliveries ...*Delivery) error
+
+	// ClaimDueDeliveries retrieves up to limit pending deliveries that are due,
+	// oldest first, and postpones their next attempt to leaseUntil so that no
+	// other process claims them while they are being attempted.
+	ClaimDueDeliveries(ctx context.Context, limit int, leaseUntil time.Time) ([]*Delivery, error)
+
+	// FindDelivery retrieves a delivery by its unique identifier.
+	// Returns ErrDeliveryNotFound if the delivery does not exist.
+	FindDelivery(ctx context.Context, id int64) (*Delivery, error)
+
+	// ListDeliveries retrieves up to params.Limit of a subscription's
+	// deliveries, newest first.
+	ListDeliveries(ctx context.Context, params DeliveryListParams) ([]*Delivery, error)
+
+	// UpdateDelivery persists the status and attempts of a delivery.
+	// Returns ErrDeliveryNotFound if the delivery does not exist.
+	UpdateDelivery(ctx context.Context, d *Delivery) error
+}
@@ -0,0 +1,24 @@
+package webhook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignVerify(t *testing.T) {
+	body := []byte(`{"id":1,"type":"tenant.activated"}`)
+	now := time.Unix(1_700_000_000, 0)
+	sig := Sign(testSecret, now, body)
+
+	assert.Regexp(t, `^t=1700000000,v1=[0-9a-f]{64}$`, sig)
+	assert.Equal(t, sig, Sign(testSecret, now, body), "signatures are deterministic")
+
+	assert.NoError(t, Verify(testSecret, sig, body, now.Add(time.Minute), 5*time.Minute))
+	assert.ErrorIs(t, Verify("another-secret-of-some-length", sig, body, now, 5*time.Minute), ErrInvalidSignature)
+	assert.ErrorIs(t, Verify(testSecret, sig, []byte(`{"id":2}`), now, 5*time.Minute), ErrInvalidSignature)
+	assert.ErrorIs(t, Verify(testSecret, sig, body, now.Add(time.Hour), 5*time.Minute), ErrInvalidSignature,
+		"old signatures cannot be replayed")
+	assert.ErrorIs(t, Verify(testSecret, "v1=abc", body, now, 5*time.Minute), ErrInvalidSignature)
+}
@@ -0,0 +1,203 @@
+// Package webhook models subscriptions of external endpoints to lifecycle events
+// and the signed deliveries of those events to them.
+package webhook
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ahrav/hoglet-hub/internal/domain/outbox"
+)
+
+// Common errors that can be returned by webhook functions.
+var (
+	ErrSubscriptionNotFound = errors.New("webhook subscription not found")
+	ErrDeliveryNotFound     = errors.New("webhook delivery not found")
+	ErrInvalidURL           = errors.New("invalid webhook URL")
+	ErrAddressNotAllowed    = errors.New("webhook address not allowed")
+	ErrInvalidEventType     = errors.New("invalid webhook event type")
+	ErrInvalidSecret        = errors.New("invalid webhook secret")
+	ErrDeliveryPending      = errors.New("webhook delivery is still pending")
+	ErrInvalidListParams    = errors.New("invalid webhook delivery list parameters")
+)
+
+// Secret length bounds. Generated secrets are 64 characters long.
+const (
+	MinSecretLength = 16
+	MaxSecretLength = 255
+)
+
+// EventTypes are the event types subscriptions can filter on.
+var EventTypes = []outbox.EventType{
+	outbox.EventOperationStarted,
+	outbox.EventOperationCompleted,
+	outbox.EventOperationFailed,
+	outbox.EventTenantActivated,
+	outbox.EventTenantDeleted,
+}
+
+// Subscription is an endpoint that is sent the events it subscribes to.
+type Subscription struct {
+	ID          int64              // Unique identifier
+	URL         string             // Endpoint events are posted to
+	Secret      string             // Key deliveries are signed with
+	EventTypes  []outbox.EventType // Event types sent; empty for all of them
+	TenantID    *int64             // Tenant events are sent for; nil for all tenants
+	Description string             // What the subscription is for
+	Active      bool               // Inactive subscriptions are sent nothing
+	CreatedBy   string             // Who created the subscription
+	CreatedAt   time.Time          // Creation timestamp
+	UpdatedAt   *time.Time         // Last update timestamp
+}
+
+// NewSubscription creates a new active subscription with validation of all fields.
+func NewSubscription(
+	rawURL string,
+	secret string,
+	eventTypes []outbox.EventType,
+	tenantID *int64,
+	description string,
+	createdBy string,
+) (*Subscription, error) {
+	if err := ValidateURL(rawURL); err != nil {
+		return nil, err
+	}
+	if err := ValidateSecret(secret); err != nil {
+		return nil, err
+	}
+	if err := ValidateEventTypes(eventTypes); err != nil {
+		return nil, err
+	}
+
+	return &Subscription{
+		URL:         rawURL,
+		Secret:      secret,
+		EventTypes:  eventTypes,
+		TenantID:    tenantID,
+		Description: description,
+		Active:      true,
+		CreatedBy:   createdBy,
+		CreatedAt:   time.Now(),
+	}, nil
+}
+
+// Update holds changes to a subscription. Nil fields are left unchanged.
+type Update struct {
+	URL         *string
+	EventTypes  *[]outbox.EventType
+	Description *string
+	Active      *bool
+}
+
+// Apply validates and applies the changes to the subscription. Nothing is
+// changed if any of them is invalid.
+func (s *Subscription) Apply(u Update) error {
+	if u.URL != nil {
+		if err := ValidateURL(*u.URL); err != nil {
+			return err
+		}
+	}
+	if u.EventTypes != nil {
+		if err := ValidateEventTypes(*u.EventTypes); err != nil {
+			return err
+		}
+	}
+
+	if u.URL != nil {
+		s.URL = *u.URL
+	}
+	if u.EventTypes != nil {
+		s.EventTypes = *u.EventTypes
+	}
+	if u.Description != nil {
+		s.Description = *u.Description
+	}
+	if u.Active != nil {
+		s.Active = *u.Active
+	}
+	return nil
+}
+
+// Matches reports whether the event should be delivered to the subscription.
+func (s *Subscription) Matches(e *outbox.Event) bool {
+	if !s.Active {
+		return false
+	}
+	if s.TenantID != nil && (e.TenantID == nil || *e.TenantID != *s.TenantID) {
+		return false
+	}
+	return len(s.EventTypes) == 0 || slices.Contains(s.EventTypes, e.Type)
+}
+
+// ValidateURL checks that the URL is an absolute http or https URL whose host
+// is not local or, when it is an IP address, passes CheckAddress. Host names
+// are only resolved when delivering, so deliveries check addresses again.
+func ValidateURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidURL, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("%w: scheme must be http or https", ErrInvalidURL)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("%w: host is required", ErrInvalidURL)
+	}
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return fmt.Errorf("%w: host must not be local", ErrInvalidURL)
+	}
+	if addr, err := netip.ParseAddr(host); err == nil {
+		if err := CheckAddress(addr); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidURL, err)
+		}
+	}
+	return nil
+}
+
+// CheckAddress rejects addresses deliveries must not reach: loopback, private,
+// link-local, multicast and unspecified addresses, which belong to this host or
+// its internal network rather than to a subscriber.
+func CheckAddress(addr netip.Addr) error {
+	addr = addr.Unmap()
+	if addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() ||
+		addr.IsLinkLocalMulticast() || addr.IsInterfaceLocalMulticast() ||
+		addr.IsMulticast() || addr.IsUnspecified() {
+		return fmt.Errorf("%w: %s", ErrAddressNotAllowed, addr)
+	}
+	return nil
+}
+
+// ValidateSecret checks the length of a signing secret.
+func ValidateSecret(secret string) error {
+	if len(secret) < MinSecretLength || len(secret) > MaxSecretLength {
+		return fmt.Errorf("%w: must be %d to %d characters long", ErrInvalidSecret, MinSecretLength, MaxSecretLength)
+	}
+	return nil
+}
+
+// ValidateEventTypes checks that every event type can be subscribed to.
+func ValidateEventTypes(types []outbox.EventType) error {
+	for _, t := range types {
+		if !slices.Contains(EventTypes, t) {
+			return fmt.Errorf("%w: %q", ErrInvalidEventType, t)
+		}
+	}
+	return nil
+}
+
+// GenerateSecret returns a random signing secret.
+func GenerateSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
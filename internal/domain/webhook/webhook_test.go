@@ -0,0 +1,194 @@
+package webhook
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ahrav/hoglet-hub/internal/domain/outbox"
+)
+
+const testSecret = "0123456789abcdef0123456789abcdef"
+
+func TestNewSubscription(t *testing.T) {
+	tests := []struct {
+		name       string
+		url        string
+		secret     string
+		eventTypes []outbox.EventType
+		wantErr    error
+	}{
+		{name: "valid subscription", url: "https://billing.example.com/hooks", secret: testSecret},
+		{
+			name:       "event type filter",
+			url:        "http://onboarding.internal/hooks",
+			secret:     testSecret,
+			eventTypes: []outbox.EventType{outbox.EventTenantActivated, outbox.EventTenantDeleted},
+		},
+		{name: "relative URL", url: "/hooks", secret: testSecret, wantErr: ErrInvalidURL},
+		{name: "unsupported scheme", url: "ftp://example.com/hooks", secret: testSecret, wantErr: ErrInvalidURL},
+		{name: "public address", url: "https://203.0.113.10/hooks", secret: testSecret},
+		{name: "localhost", url: "http://localhost:8080/hooks", secret: testSecret, wantErr: ErrInvalidURL},
+		{name: "loopback address", url: "http://127.0.0.1/hooks", secret: testSecret, wantErr: ErrAddressNotAllowed},
+		{name: "IPv6 loopback address", url: "http://[::1]/hooks", secret: testSecret, wantErr: ErrAddressNotAllowed},
+		{name: "private address", url: "https://10.0.0.5/hooks", secret: testSecret, wantErr: ErrAddressNotAllowed},
+		{name: "mapped private address", url: "https://[::ffff:192.168.1.1]/hooks", secret: testSecret, wantErr: ErrAddressNotAllowed},
+		{name: "link-local address", url: "http://169.254.169.254/latest", secret: testSecret, wantErr: ErrAddressNotAllowed},
+		{name: "unspecified address", url: "http://0.0.0.0/hooks", secret: testSecret, wantErr: ErrAddressNotAllowed},
+		{name: "secret too short", url: "https://example.com", secret: "short", wantErr: ErrInvalidSecret},
+		{name: "secret too long", url: "https://example.com", secret: strings.Repeat("s", 256), wantErr: ErrInvalidSecret},
+		{
+			name:       "unknown event type",
+			url:        "https://example.com",
+			secret:     testSecret,
+			eventTypes: []outbox.EventType{"tenant.renamed"},
+			wantErr:    ErrInvalidEventType,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			sub, err := NewSubscription(tc.url, tc.secret, tc.eventTypes, nil, "billing", "ops@example.com")
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.Nil(t, sub)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.url, sub.URL)
+			assert.Equal(t, tc.eventTypes, sub.EventTypes)
+			assert.True(t, sub.Active)
+			assert.Equal(t, "ops@example.com", sub.CreatedBy)
+		})
+	}
+}
+
+func TestSubscription_Apply(t *testing.T) {
+	sub, err := NewSubscription("https://example.com/a", testSecret, nil, nil, "", "ops@example.com")
+	require.NoError(t, err)
+
+	badURL := "not a url"
+	active := false
+	err = sub.Apply(Update{URL: &badURL, Active: &active})
+	assert.ErrorIs(t, err, ErrInvalidURL)
+	assert.True(t, sub.Active, "nothing is changed when an update is invalid")
+
+	newURL := "https://example.com/b"
+	types := []outbox.EventType{outbox.EventTenantDeleted}
+	require.NoError(t, sub.Apply(Update{URL: &newURL, EventTypes: &types, Active: &active}))
+	assert.Equal(t, newURL, sub.URL)
+	assert.Equal(t, types, sub.EventTypes)
+	assert.False(t, sub.Active)
+}
+
+func TestSubscription_Matches(t *testing.T) {
+	tenantID, otherTenantID := int64(1), int64(2)
+	activated := &outbox.Event{Type: outbox.EventTenantActivated, TenantID: &tenantID}
+
+	tests := []struct {
+		name  string
+		sub   Subscription
+		event *outbox.Event
+		want  bool
+	}{
+		{name: "all events", sub: Subscription{Active: true}, event: activated, want: true},
+		{name: "inactive", sub: Subscription{Active: false}, event: activated, want: false},
+		{
+			name:  "matching event type",
+			sub:   Subscription{Active: true, EventTypes: []outbox.EventType{outbox.EventTenantActivated}},
+			event: activated,
+			want:  true,
+		},
+		{
+			name:  "other event type",
+			sub:   Subscription{Active: true, EventTypes: []outbox.EventType{outbox.EventTenantDeleted}},
+			event: activated,
+			want:  false,
+		},
+		{name: "matching tenant", sub: Subscription{Active: true, TenantID: &tenantID}, event: activated, want: true},
+		{name: "other tenant", sub: Subscription{Active: true, TenantID: &otherTenantID}, event: activated, want: false},
+		{
+			name:  "event without tenant",
+			sub:   Subscription{Active: true, TenantID: &tenantID},
+			event: &outbox.Event{Type: outbox.EventOperationFailed},
+			want:  false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.sub.Matches(tc.event))
+		})
+	}
+}
+
+func TestGenerateSecret(t *testing.T) {
+	a, err := GenerateSecret()
+	require.NoError(t, err)
+	b, err := GenerateSecret()
+	require.NoError(t, err)
+
+	assert.Len(t, a, 64)
+	assert.NoError(t, ValidateSecret(a))
+	assert.NotEqual(t, a, b)
+}
+
+func TestDelivery_Attempts(t *testing.T) {
+	tenantID := int64(1)
+	sub := &Subscription{ID: 3}
+	d := NewDelivery(sub, &outbox.Event{ID: 9, Type: outbox.EventTenantActivated, TenantID: &tenantID}, []byte(`{}`))
+	assert.Equal(t, DeliveryPending, d.Status)
+	assert.Equal(t, int64(3), d.SubscriptionID)
+	assert.Equal(t, int64(9), d.EventID)
+
+	now := time.Now()
+	d.RecordFailure(503, "service unavailable", now)
+	assert.Equal(t, DeliveryPending, d.Status)
+	assert.Equal(t, 1, d.Attempts)
+	assert.Equal(t, 503, *d.ResponseStatus)
+	assert.Equal(t, now.Add(RetryDelay(1)), d.NextAttemptAt)
+
+	d.RecordFailure(0, "connection refused", now)
+	assert.Nil(t, d.ResponseStatus, "no status is recorded without a response")
+
+	d.RecordSuccess(204, now)
+	assert.Equal(t, DeliverySucceeded, d.Status)
+	assert.Equal(t, 3, d.Attempts)
+	assert.Nil(t, d.LastError)
+	assert.Equal(t, &now, d.DeliveredAt)
+}
+
+func TestDelivery_DeadLetter(t *testing.T) {
+	d := &Delivery{Status: DeliveryPending}
+	now := time.Now()
+	for range MaxAttempts - 1 {
+		d.RecordFailure(500, "internal server error", now)
+		require.Equal(t, DeliveryPending, d.Status)
+	}
+	d.RecordFailure(500, "internal server error", now)
+	assert.Equal(t, DeliveryDeadLetter, d.Status)
+	assert.Equal(t, MaxAttempts, d.Attempts)
+
+	require.NoError(t, d.Redeliver(now))
+	assert.Equal(t, DeliveryPending, d.Status)
+	assert.Zero(t, d.Attempts)
+	assert.ErrorIs(t, d.Redeliver(now), ErrDeliveryPending)
+}
+
+func TestRetryDelay(t *testing.T) {
+	assert.Equal(t, 30*time.Second, RetryDelay(1))
+	assert.Equal(t, time.Minute, RetryDelay(2))
+	assert.Equal(t, 8*time.Minute, RetryDelay(5))
+	assert.Equal(t, time.Hour, RetryDelay(20))
+}
+
+func TestDeliveryListParams_Validate(t *testing.T) {
+	unknown := DeliveryStatus("lost")
+	assert.NoError(t, DeliveryListParams{SubscriptionID: 1, Limit: 10}.Validate())
+	assert.ErrorIs(t, DeliveryListParams{Limit: 0}.Validate(), ErrInvalidListParams)
+	assert.ErrorIs(t, DeliveryListParams{Limit: 1, BeforeID: -1}.Validate(), ErrInvalidListParams)
+	assert.ErrorIs(t, DeliveryListParams{Limit: 1, Status: &unknown}.Validate(), ErrInvalidListParams)
+}
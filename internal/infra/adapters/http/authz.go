@@ -0,0 +1,189 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/ahrav/hoglet-hub/api/v1/server"
+	"github.com/ahrav/hoglet-hub/internal/application/sdk/authz"
+	"github.com/ahrav/hoglet-hub/internal/domain/operation"
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+)
+
+// TenantFinder looks up the tenants requests act on, including deleted ones,
+// whose operations and other records remain readable.
+type TenantFinder interface {
+	GetIncludingDeleted(ctx context.Context, tenantID int64) (*tenant.Tenant, error)
+}
+
+// OperationFinder looks up the operations requests act on.
+type OperationFinder interface {
+	GetByID(ctx context.Context, operationID int64) (*operation.Operation, error)
+}
+
+// AuthorizeMiddleware returns strict server middleware that authorizes every
+// operation with the authorizer before it reaches its handler. Requests the
+// caller may not make are rejected with the authorizer's error.
+func AuthorizeMiddleware(
+	authorizer *authz.Authorizer,
+	tenants TenantFinder,
+	operations OperationFinder,
+) server.StrictMiddlewareFunc {
+	resolver := &scopeResolver{tenants: tenants, operations: operations}
+
+	return func(next server.StrictHandlerFunc, operationID string) server.StrictHandlerFunc {
+		return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request any) (any, error) {
+			resolve := func(ctx context.Context) (authz.Scope, error) { return resolver.scope(ctx, request) }
+			if err := authorizer.Authorize(ctx, operationID, resolve); err != nil {
+				return nil, err
+			}
+			return next(ctx, w, r, request)
+		}
+	}
+}
+
+// scopeResolver determines the tenant regions and tiers a request acts on.
+type scopeResolver struct {
+	tenants    TenantFinder
+	operations OperationFinder
+}
+
+// scope returns the scope of a generated request object. Requests that change a
+// tenant's region or tier include the requested value as well as the current one.
+// Listings span every tenant unless filtered to a region, tier or tenant. Other
+// requests that do not act on particular tenants, or whose tenant does not exist,
+// have an empty scope.
+func (s *scopeResolver) scope(ctx context.Context, request any) (authz.Scope, error) {
+	switch req := request.(type) {
+	case server.CreateTenantRequestObject:
+		if req.Body == nil {
+			return authz.Scope{}, nil
+		}
+		tier := tenant.TierFree
+		if req.Body.Tier != nil {
+			tier = tenant.Tier(*req.Body.Tier)
+		}
+		return authz.Scope{Regions: []tenant.Region{tenant.Region(req.Body.Region)}, Tiers: []tenant.Tier{tier}}, nil
+
+	case server.CreateIsolationGroupRequestObject:
+		if req.Body == nil {
+			return authz.Scope{}, nil
+		}
+		return authz.Scope{Regions: []tenant.Region{tenant.Region(req.Body.Region)}}, nil
+
+	case server.MigrateTenantRequestObject:
+		scope, err := s.tenantScope(ctx, req.TenantId)
+		if err == nil && req.Body != nil {
+			scope.Regions = append(scope.Regions, tenant.Region(req.Body.Region))
+		}
+		return scope, err
+
+	case server.ChangeTenantTierRequestObject:
+		scope, err := s.tenantScope(ctx, req.TenantId)
+		if err == nil && req.Body != nil {
+			scope.Tiers = append(scope.Tiers, tenant.Tier(req.Body.Tier))
+		}
+		return scope, err
+
+	case server.GetTenantRequestObject:
+		return s.tenantScope(ctx, req.TenantId)
+	case server.DeleteTenantRequestObject:
+		return s.tenantScope(ctx, req.TenantId)
+	case server.SuspendTenantRequestObject:
+		return s.tenantScope(ctx, req.TenantId)
+	case server.ResumeTenantRequestObject:
+		return s.tenantScope(ctx, req.TenantId)
+	case server.ChangeTenantIsolationGroupRequestObject:
+		return s.tenantScope(ctx, req.TenantId)
+	case server.ListTenantOperationsRequestObject:
+		return s.tenantScope(ctx, req.TenantId)
+	case server.ListTenantResourcesRequestObject:
+		return s.tenantScope(ctx, req.TenantId)
+	case server.ListTenantSuspensionsRequestObject:
+		return s.tenantScope(ctx, req.TenantId)
+
+	case server.ListTenantsRequestObject:
+		var region *tenant.Region
+		if req.Params.Region != nil {
+			r := tenant.Region(*req.Params.Region)
+			region = &r
+		}
+		var tier *tenant.Tier
+		if req.Params.Tier != nil {
+			t := tenant.Tier(*req.Params.Tier)
+			tier = &t
+		}
+		return authz.ListingScope(region, tier), nil
+	case server.ListOperationsRequestObject:
+		if req.Params.TenantId == nil {
+			return authz.ListingScope(nil, nil), nil
+		}
+		return listingScope(s.tenantScope(ctx, *req.Params.TenantId))
+	case server.ListAuditLogsRequestObject:
+		switch {
+		case req.Params.TenantId != nil:
+			return listingScope(s.tenantScope(ctx, *req.Params.TenantId))
+		case req.Params.OperationId != nil:
+			return listingScope(s.operationScope(ctx, *req.Params.OperationId))
+		default:
+			return authz.ListingScope(nil, nil), nil
+		}
+
+	case server.GetOperationRequestObject:
+		return s.operationScope(ctx, req.OperationId)
+	case server.CancelOperationRequestObject:
+		return s.operationScope(ctx, req.OperationId)
+	case server.PauseOperationRequestObject:
+		return s.operationScope(ctx, req.OperationId)
+	case server.ResumeOperationRequestObject:
+		return s.operationScope(ctx, req.OperationId)
+	case server.RetryOperationRequestObject:
+		return s.operationScope(ctx, req.OperationId)
+	case server.StreamOperationEventsRequestObject:
+		return s.operationScope(ctx, req.OperationId)
+
+	default:
+		return authz.Scope{}, nil
+	}
+}
+
+// listingScope returns the scope of a listing filtered to the tenant or operation
+// whose scope was resolved. Records of tenants that no longer exist, and of
+// operations without a tenant, remain listed, so an empty scope spans them all.
+func listingScope(scope authz.Scope, err error) (authz.Scope, error) {
+	if err == nil && scope.IsZero() {
+		return authz.ListingScope(nil, nil), nil
+	}
+	return scope, err
+}
+
+// tenantScope returns the scope of a request acting on the tenant. A deleted
+// tenant keeps its scope, so that grants restricted to other regions or tiers
+// cannot read its records.
+func (s *scopeResolver) tenantScope(ctx context.Context, tenantID int64) (authz.Scope, error) {
+	t, err := s.tenants.GetIncludingDeleted(ctx, tenantID)
+	if errors.Is(err, tenant.ErrTenantNotFound) {
+		return authz.Scope{}, nil
+	}
+	if err != nil {
+		return authz.Scope{}, err
+	}
+	return authz.TenantScope(t), nil
+}
+
+// operationScope returns the scope of a request acting on the operation, which is
+// that of the operation's tenant.
+func (s *scopeResolver) operationScope(ctx context.Context, operationID int64) (authz.Scope, error) {
+	op, err := s.operations.GetByID(ctx, operationID)
+	if errors.Is(err, operation.ErrOperationNotFound) {
+		return authz.Scope{}, nil
+	}
+	if err != nil {
+		return authz.Scope{}, err
+	}
+	if op.TenantID == nil {
+		return authz.Scope{}, nil
+	}
+	return s.tenantScope(ctx, *op.TenantID)
+}
@@ -0,0 +1,166 @@
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ahrav/hoglet-hub/api/v1/server"
+	"github.com/ahrav/hoglet-hub/internal/application/sdk/auth"
+	"github.com/ahrav/hoglet-hub/internal/application/sdk/authz"
+	"github.com/ahrav/hoglet-hub/internal/application/sdk/errs"
+	"github.com/ahrav/hoglet-hub/internal/domain/operation"
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+	httpServer "github.com/ahrav/hoglet-hub/internal/infra/adapters/http"
+	"github.com/ahrav/hoglet-hub/pkg/common/logger"
+)
+
+const listingPolicy = `{
+	"roles": {
+		"eu-viewer": {"role": "viewer", "regions": ["eu1"]},
+		"eu-admin": {"role": "admin", "regions": ["eu1"]}
+	}
+}`
+
+type fakeTenants map[int64]*tenant.Tenant
+
+func (f fakeTenants) GetIncludingDeleted(_ context.Context, id int64) (*tenant.Tenant, error) {
+	if t, ok := f[id]; ok {
+		return t, nil
+	}
+	return nil, tenant.ErrTenantNotFound
+}
+
+type fakeOperations map[int64]*operation.Operation
+
+func (f fakeOperations) GetByID(_ context.Context, id int64) (*operation.Operation, error) {
+	if op, ok := f[id]; ok {
+		return op, nil
+	}
+	return nil, operation.ErrOperationNotFound
+}
+
+func TestAuthorizeMiddleware_Listings(t *testing.T) {
+	policy, err := authz.ParsePolicy([]byte(listingPolicy))
+	require.NoError(t, err)
+
+	euTenant, usTenant, deletedUSTenant := int64(1), int64(2), int64(3)
+	tenants := fakeTenants{
+		euTenant:        {ID: euTenant, Region: tenant.RegionEU1, Tier: tenant.TierPro},
+		usTenant:        {ID: usTenant, Region: tenant.RegionUS1, Tier: tenant.TierPro},
+		deletedUSTenant: {ID: deletedUSTenant, Region: tenant.RegionUS1, Tier: tenant.TierPro, Status: tenant.StatusDeleted},
+	}
+	euOperation, usOperation, deletedUSOperation := int64(10), int64(20), int64(30)
+	operations := fakeOperations{
+		euOperation:        {ID: euOperation, TenantID: &euTenant},
+		usOperation:        {ID: usOperation, TenantID: &usTenant},
+		deletedUSOperation: {ID: deletedUSOperation, TenantID: &deletedUSTenant},
+	}
+	middleware := httpServer.AuthorizeMiddleware(authz.NewAuthorizer(policy, logger.Noop()), tenants, operations)
+
+	region := func(r tenant.Region) *server.Region {
+		v := server.Region(r)
+		return &v
+	}
+	id := func(v int64) *int64 { return &v }
+
+	testCases := []struct {
+		desc      string
+		role      string
+		operation string
+		request   any
+		allowed   bool
+	}{
+		{
+			desc: "every tenant", role: "eu-viewer", operation: "ListTenants",
+			request: server.ListTenantsRequestObject{},
+		},
+		{
+			desc: "tenants in another region", role: "eu-viewer", operation: "ListTenants",
+			request: server.ListTenantsRequestObject{Params: server.ListTenantsParams{Region: region(tenant.RegionUS1)}},
+		},
+		{
+			desc: "tenants in the granted region", role: "eu-viewer", operation: "ListTenants",
+			request: server.ListTenantsRequestObject{Params: server.ListTenantsParams{Region: region(tenant.RegionEU1)}},
+			allowed: true,
+		},
+		{
+			desc: "unrestricted viewer lists every tenant", role: "viewer", operation: "ListTenants",
+			request: server.ListTenantsRequestObject{}, allowed: true,
+		},
+		{
+			desc: "every operation", role: "eu-viewer", operation: "ListOperations",
+			request: server.ListOperationsRequestObject{},
+		},
+		{
+			desc: "operations of a tenant in another region", role: "eu-viewer", operation: "ListOperations",
+			request: server.ListOperationsRequestObject{Params: server.ListOperationsParams{TenantId: id(usTenant)}},
+		},
+		{
+			desc: "operations of a tenant that no longer exists", role: "eu-viewer", operation: "ListOperations",
+			request: server.ListOperationsRequestObject{Params: server.ListOperationsParams{TenantId: id(99)}},
+		},
+		{
+			desc: "operations of a deleted tenant in another region", role: "eu-viewer", operation: "ListOperations",
+			request: server.ListOperationsRequestObject{Params: server.ListOperationsParams{TenantId: id(deletedUSTenant)}},
+		},
+		{
+			desc: "operation of a deleted tenant in another region", role: "eu-viewer", operation: "GetOperation",
+			request: server.GetOperationRequestObject{OperationId: deletedUSOperation},
+		},
+		{
+			desc: "event stream of a deleted tenant's operation in another region", role: "eu-viewer",
+			operation: "StreamOperationEvents",
+			request:   server.StreamOperationEventsRequestObject{OperationId: deletedUSOperation},
+		},
+		{
+			desc: "operations of a tenant in the granted region", role: "eu-viewer", operation: "ListOperations",
+			request: server.ListOperationsRequestObject{Params: server.ListOperationsParams{TenantId: id(euTenant)}},
+			allowed: true,
+		},
+		{
+			desc: "every audit log", role: "eu-admin", operation: "ListAuditLogs",
+			request: server.ListAuditLogsRequestObject{},
+		},
+		{
+			desc: "audit logs of an operation in another region", role: "eu-admin", operation: "ListAuditLogs",
+			request: server.ListAuditLogsRequestObject{Params: server.ListAuditLogsParams{OperationId: id(usOperation)}},
+		},
+		{
+			desc: "audit logs of an operation in the granted region", role: "eu-admin", operation: "ListAuditLogs",
+			request: server.ListAuditLogsRequestObject{Params: server.ListAuditLogsParams{OperationId: id(euOperation)}},
+			allowed: true,
+		},
+		{
+			desc: "audit logs of a tenant in the granted region", role: "eu-admin", operation: "ListAuditLogs",
+			request: server.ListAuditLogsRequestObject{Params: server.ListAuditLogsParams{TenantId: id(euTenant)}},
+			allowed: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			ctx := auth.WithClaims(context.Background(), &auth.Claims{Subject: "alice@example.com", Roles: []string{tc.role}})
+
+			called := false
+			next := func(context.Context, http.ResponseWriter, *http.Request, any) (any, error) {
+				called = true
+				return nil, nil
+			}
+			_, err := middleware(next, tc.operation)(ctx, nil, nil, tc.request)
+
+			if tc.allowed {
+				require.NoError(t, err)
+				assert.True(t, called)
+				return
+			}
+			var appErr *errs.Error
+			require.ErrorAs(t, err, &appErr)
+			assert.Equal(t, errs.PermissionDenied, appErr.Code)
+			assert.False(t, called)
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package httphandler
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/ahrav/hoglet-hub/api/v1/server"
+	appAudit "github.com/ahrav/hoglet-hub/internal/application/audit"
+	"github.com/ahrav/hoglet-hub/internal/domain/audit"
+)
+
+// AuditHandler implements the audit trail API endpoints.
+type AuditHandler struct{ auditService *appAudit.Service }
+
+// NewAuditHandler creates a new audit handler with the provided service.
+func NewAuditHandler(auditService *appAudit.Service) *AuditHandler {
+	return &AuditHandler{auditService: auditService}
+}
+
+// ListAuditLogs handles requests for a filtered page of the audit trail, newest first.
+func (h *AuditHandler) ListAuditLogs(
+	ctx context.Context,
+	req server.ListAuditLogsRequestObject,
+) (server.ListAuditLogsResponseObject, error) {
+	params := appAudit.ListParams{
+		Filter: audit.ListFilter{
+			Actor:       req.Params.Actor,
+			TenantID:    req.Params.TenantId,
+			OperationID: req.Params.OperationId,
+			Since:       req.Params.Since,
+			Until:       req.Params.Until,
+		},
+	}
+	if req.Params.Action != nil {
+		action := audit.Action(*req.Params.Action)
+		params.Filter.Action = &action
+	}
+	if req.Params.Status != nil {
+		status := audit.Status(*req.Params.Status)
+		params.Filter.Status = &status
+	}
+	if req.Params.Cursor != nil {
+		params.Cursor = *req.Params.Cursor
+	}
+	if req.Params.Limit != nil {
+		params.Limit = *req.Params.Limit
+	}
+
+	result, err := h.auditService.List(ctx, params)
+	if err != nil {
+		switch {
+		case errors.Is(err, audit.ErrInvalidListParams):
+			return server.ListAuditLogs400JSONResponse{
+				Error:   "invalid_list_parameters",
+				Message: "Invalid filter or cursor specified",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		default:
+			return server.ListAuditLogs500JSONResponse{
+				Error:   "internal_error",
+				Message: "An internal error occurred",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		}
+	}
+
+	entries := make([]server.AuditLogEntry, 0, len(result.Entries))
+	for _, e := range result.Entries {
+		entries = append(entries, toAPIAuditLogEntry(e))
+	}
+
+	links := server.Links{"self": "/audit"}
+	var nextCursor *string
+	if result.NextCursor != "" {
+		nextCursor = &result.NextCursor
+		links["next"] = "/audit?" + nextAuditQuery(req.Params, result.NextCursor).Encode()
+	}
+
+	return server.ListAuditLogs200JSONResponse{
+		Entries:    entries,
+		Links:      links,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// nextAuditQuery rebuilds the query string of an audit listing with the cursor
+// advanced to the next page.
+func nextAuditQuery(params server.ListAuditLogsParams, cursor string) url.Values {
+	q := url.Values{}
+	if params.Actor != nil {
+		q.Set("actor", *params.Actor)
+	}
+	if params.Action != nil {
+		q.Set("action", *params.Action)
+	}
+	if params.Status != nil {
+		q.Set("status", string(*params.Status))
+	}
+	if params.TenantId != nil {
+		q.Set("tenant_id", strconv.FormatInt(*params.TenantId, 10))
+	}
+	if params.OperationId != nil {
+		q.Set("operation_id", strconv.FormatInt(*params.OperationId, 10))
+	}
+	if params.Since != nil {
+		q.Set("since", params.Since.Format(time.RFC3339Nano))
+	}
+	if params.Until != nil {
+		q.Set("until", params.Until.Format(time.RFC3339Nano))
+	}
+	if params.Limit != nil {
+		q.Set("limit", strconv.Itoa(*params.Limit))
+	}
+	q.Set("cursor", cursor)
+	return q
+}
+
+// toAPIAuditLogEntry maps a domain audit entry to its API representation.
+func toAPIAuditLogEntry(e *audit.Entry) server.AuditLogEntry {
+	resp := server.AuditLogEntry{
+		Action:      string(e.Action),
+		Actor:       e.Actor,
+		Details:     e.Details,
+		Error:       e.Error,
+		Id:          e.ID,
+		OperationId: e.OperationID,
+		ResourceId:  e.ResourceID,
+		Status:      server.AuditStatus(e.Status),
+		TenantId:    e.TenantID,
+		Timestamp:   e.Timestamp,
+	}
+	if e.ActorIP != nil {
+		ip := e.ActorIP.String()
+		resp.ActorIp = &ip
+	}
+	if e.Duration > 0 {
+		ms := e.Duration.Milliseconds()
+		resp.DurationMs = &ms
+	}
+	return resp
+}
@@ -0,0 +1,327 @@
+package httphandler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ahrav/hoglet-hub/api/v1/server"
+	appDBNode "github.com/ahrav/hoglet-hub/internal/application/dbnode"
+	"github.com/ahrav/hoglet-hub/internal/domain/dbnode"
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+)
+
+// DatabaseNodeHandler implements the database node registry API endpoints.
+type DatabaseNodeHandler struct{ nodeService *appDBNode.Service }
+
+// NewDatabaseNodeHandler creates a new database node handler with the provided service.
+func NewDatabaseNodeHandler(nodeService *appDBNode.Service) *DatabaseNodeHandler {
+	return &DatabaseNodeHandler{nodeService: nodeService}
+}
+
+// RegisterDatabaseNode handles requests to add a database node to the registry.
+func (h *DatabaseNodeHandler) RegisterDatabaseNode(
+	ctx context.Context,
+	req server.RegisterDatabaseNodeRequestObject,
+) (server.RegisterDatabaseNodeResponseObject, error) {
+	if req.Body == nil {
+		return server.RegisterDatabaseNode400JSONResponse{
+			Error:   "invalid_request",
+			Message: "Missing request body",
+		}, nil
+	}
+
+	params := appDBNode.RegisterParams{
+		Hostname: req.Body.Hostname,
+		Port:     5432,
+		Region:   tenant.Region(req.Body.Region),
+		Type:     dbnode.Type(req.Body.NodeType),
+	}
+	if req.Body.Port != nil {
+		params.Port = *req.Body.Port
+	}
+	if req.Body.MaxTenants != nil {
+		params.MaxTenants = *req.Body.MaxTenants
+	}
+
+	node, err := h.nodeService.Register(ctx, params, requestActor(ctx))
+	if err != nil {
+		switch {
+		case errors.Is(err, dbnode.ErrNodeAlreadyExists):
+			return server.RegisterDatabaseNode409JSONResponse{
+				Error:   "database_node_already_exists",
+				Message: "A database node with this hostname is already registered",
+			}, nil
+		case errors.Is(err, dbnode.ErrInvalidHostname),
+			errors.Is(err, dbnode.ErrInvalidPort),
+			errors.Is(err, dbnode.ErrInvalidNodeType),
+			errors.Is(err, dbnode.ErrInvalidCapacity),
+			errors.Is(err, tenant.ErrInvalidRegion):
+			return server.RegisterDatabaseNode400JSONResponse{
+				Error:   "invalid_database_node",
+				Message: "Invalid database node specified",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		default:
+			return server.RegisterDatabaseNode500JSONResponse{
+				Error:   "internal_error",
+				Message: "An internal error occurred",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		}
+	}
+
+	return server.RegisterDatabaseNode201JSONResponse(toAPIDatabaseNode(node)), nil
+}
+
+// GetDatabaseNode handles requests for a single database node.
+func (h *DatabaseNodeHandler) GetDatabaseNode(
+	ctx context.Context,
+	req server.GetDatabaseNodeRequestObject,
+) (server.GetDatabaseNodeResponseObject, error) {
+	node, err := h.nodeService.Get(ctx, req.NodeId)
+	if err != nil {
+		switch {
+		case errors.Is(err, dbnode.ErrNodeNotFound):
+			return server.GetDatabaseNode404JSONResponse{
+				Error:   "database_node_not_found",
+				Message: "The specified database node does not exist",
+			}, nil
+		default:
+			return server.GetDatabaseNode500JSONResponse{
+				Error:   "internal_error",
+				Message: "An internal error occurred",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		}
+	}
+
+	return server.GetDatabaseNode200JSONResponse(toAPIDatabaseNode(node)), nil
+}
+
+// ListDatabaseNodes handles requests to list database nodes by region, type and status.
+func (h *DatabaseNodeHandler) ListDatabaseNodes(
+	ctx context.Context,
+	req server.ListDatabaseNodesRequestObject,
+) (server.ListDatabaseNodesResponseObject, error) {
+	var filter dbnode.ListFilter
+	if req.Params.Region != nil {
+		region := tenant.Region(*req.Params.Region)
+		filter.Region = &region
+	}
+	if req.Params.NodeType != nil {
+		nodeType := dbnode.Type(*req.Params.NodeType)
+		filter.Type = &nodeType
+	}
+	if req.Params.Status != nil {
+		status := dbnode.Status(*req.Params.Status)
+		filter.Status = &status
+	}
+
+	nodes, err := h.nodeService.List(ctx, filter)
+	if err != nil {
+		switch {
+		case errors.Is(err, tenant.ErrInvalidRegion):
+			return server.ListDatabaseNodes400JSONResponse{
+				Error:   "invalid_region",
+				Message: "Invalid region specified",
+			}, nil
+		case errors.Is(err, dbnode.ErrInvalidNodeType):
+			return server.ListDatabaseNodes400JSONResponse{
+				Error:   "invalid_node_type",
+				Message: "Invalid node type specified",
+			}, nil
+		default:
+			return server.ListDatabaseNodes500JSONResponse{
+				Error:   "internal_error",
+				Message: "An internal error occurred",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		}
+	}
+
+	resp := server.ListDatabaseNodes200JSONResponse{
+		DatabaseNodes: make([]server.DatabaseNodeResponse, 0, len(nodes)),
+		Links:         server.Links{"self": "/database-nodes"},
+	}
+	for _, n := range nodes {
+		resp.DatabaseNodes = append(resp.DatabaseNodes, toAPIDatabaseNode(n))
+	}
+
+	return resp, nil
+}
+
+// DrainDatabaseNode handles requests that drains a database node so that no new tenants are placed on it.
+func (h *DatabaseNodeHandler) DrainDatabaseNode(
+	ctx context.Context,
+	req server.DrainDatabaseNodeRequestObject,
+) (server.DrainDatabaseNodeResponseObject, error) {
+	node, err := h.nodeService.Drain(ctx, req.NodeId)
+	if err != nil {
+		switch {
+		case errors.Is(err, dbnode.ErrNodeNotFound):
+			return server.DrainDatabaseNode404JSONResponse{
+				Error:   "database_node_not_found",
+				Message: "The specified database node does not exist",
+			}, nil
+		case errors.Is(err, dbnode.ErrInvalidTransition):
+			return server.DrainDatabaseNode409JSONResponse{
+				Error:   "invalid_database_node_state",
+				Message: "The database node cannot make this change in its current state",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		default:
+			return server.DrainDatabaseNode500JSONResponse{
+				Error:   "internal_error",
+				Message: "An internal error occurred",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		}
+	}
+
+	return server.DrainDatabaseNode200JSONResponse(toAPIDatabaseNode(node)), nil
+}
+
+// StartDatabaseNodeMaintenance handles requests that takes a database node out of placement for maintenance.
+func (h *DatabaseNodeHandler) StartDatabaseNodeMaintenance(
+	ctx context.Context,
+	req server.StartDatabaseNodeMaintenanceRequestObject,
+) (server.StartDatabaseNodeMaintenanceResponseObject, error) {
+	node, err := h.nodeService.StartMaintenance(ctx, req.NodeId)
+	if err != nil {
+		switch {
+		case errors.Is(err, dbnode.ErrNodeNotFound):
+			return server.StartDatabaseNodeMaintenance404JSONResponse{
+				Error:   "database_node_not_found",
+				Message: "The specified database node does not exist",
+			}, nil
+		case errors.Is(err, dbnode.ErrInvalidTransition):
+			return server.StartDatabaseNodeMaintenance409JSONResponse{
+				Error:   "invalid_database_node_state",
+				Message: "The database node cannot make this change in its current state",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		default:
+			return server.StartDatabaseNodeMaintenance500JSONResponse{
+				Error:   "internal_error",
+				Message: "An internal error occurred",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		}
+	}
+
+	return server.StartDatabaseNodeMaintenance200JSONResponse(toAPIDatabaseNode(node)), nil
+}
+
+// ActivateDatabaseNode handles requests that returns a database node to service.
+func (h *DatabaseNodeHandler) ActivateDatabaseNode(
+	ctx context.Context,
+	req server.ActivateDatabaseNodeRequestObject,
+) (server.ActivateDatabaseNodeResponseObject, error) {
+	node, err := h.nodeService.Activate(ctx, req.NodeId)
+	if err != nil {
+		switch {
+		case errors.Is(err, dbnode.ErrNodeNotFound):
+			return server.ActivateDatabaseNode404JSONResponse{
+				Error:   "database_node_not_found",
+				Message: "The specified database node does not exist",
+			}, nil
+		case errors.Is(err, dbnode.ErrInvalidTransition):
+			return server.ActivateDatabaseNode409JSONResponse{
+				Error:   "invalid_database_node_state",
+				Message: "The database node cannot make this change in its current state",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		default:
+			return server.ActivateDatabaseNode500JSONResponse{
+				Error:   "internal_error",
+				Message: "An internal error occurred",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		}
+	}
+
+	return server.ActivateDatabaseNode200JSONResponse(toAPIDatabaseNode(node)), nil
+}
+
+// DecommissionDatabaseNode handles requests that permanently retires a database node that no longer hosts tenants.
+func (h *DatabaseNodeHandler) DecommissionDatabaseNode(
+	ctx context.Context,
+	req server.DecommissionDatabaseNodeRequestObject,
+) (server.DecommissionDatabaseNodeResponseObject, error) {
+	node, err := h.nodeService.Decommission(ctx, req.NodeId)
+	if err != nil {
+		switch {
+		case errors.Is(err, dbnode.ErrNodeNotFound):
+			return server.DecommissionDatabaseNode404JSONResponse{
+				Error:   "database_node_not_found",
+				Message: "The specified database node does not exist",
+			}, nil
+		case errors.Is(err, dbnode.ErrNodeNotEmpty):
+			return server.DecommissionDatabaseNode409JSONResponse{
+				Error:   "database_node_not_empty",
+				Message: "The database node still hosts tenants",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		case errors.Is(err, dbnode.ErrInvalidTransition):
+			return server.DecommissionDatabaseNode409JSONResponse{
+				Error:   "invalid_database_node_state",
+				Message: "The database node cannot make this change in its current state",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		default:
+			return server.DecommissionDatabaseNode500JSONResponse{
+				Error:   "internal_error",
+				Message: "An internal error occurred",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		}
+	}
+
+	return server.DecommissionDatabaseNode200JSONResponse(toAPIDatabaseNode(node)), nil
+}
+
+// toAPIDatabaseNode maps a domain database node to its API representation.
+func toAPIDatabaseNode(n *dbnode.Node) server.DatabaseNodeResponse {
+	return server.DatabaseNodeResponse{
+		Links:              server.Links{"self": fmt.Sprintf("/database-nodes/%d", n.ID)},
+		CreatedAt:          n.CreatedAt,
+		CreatedBy:          n.CreatedBy,
+		Hostname:           n.Hostname,
+		Id:                 n.ID,
+		MaxTenants:         n.MaxTenants,
+		NodeType:           server.DatabaseNodeType(n.Type),
+		Port:               n.Port,
+		Region:             server.Region(n.Region),
+		Status:             server.DatabaseNodeStatus(n.Status),
+		TenantCount:        n.TenantCount,
+		UpdatedAt:          n.UpdatedAt,
+		UtilizationPercent: n.UtilizationPercent,
+	}
+}
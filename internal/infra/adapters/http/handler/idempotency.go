@@ -0,0 +1,89 @@
+package httphandler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/ahrav/hoglet-hub/api/v1/server"
+	appIdempotency "github.com/ahrav/hoglet-hub/internal/application/idempotency"
+	"github.com/ahrav/hoglet-hub/internal/application/sdk/auth"
+	"github.com/ahrav/hoglet-hub/internal/domain/idempotency"
+)
+
+// idempotentRequest is a request whose idempotency key has been claimed. A nil
+// idempotentRequest stands for a request sent without a key.
+type idempotentRequest struct {
+	service *appIdempotency.Service
+	scope   idempotency.Scope
+	key     string
+}
+
+// beginIdempotent claims the idempotency key, if any, for the request to the
+// operation. Keys are scoped to the authenticated caller.
+//
+// If the key was already used for an identical request that has been handled,
+// the original response is returned and the request must not be handled again.
+// Otherwise the returned request must be finished once the request is handled.
+func beginIdempotent(
+	ctx context.Context,
+	service *appIdempotency.Service,
+	operation string,
+	key *string,
+	request any,
+) (*idempotentRequest, *idempotency.Response, error) {
+	if key == nil {
+		return nil, nil, nil
+	}
+
+	scope := idempotency.Scope{Caller: auth.Subject(ctx), Operation: operation}
+	replayed, err := service.Begin(ctx, scope, *key, request)
+	if err != nil || replayed != nil {
+		return nil, replayed, err
+	}
+	return &idempotentRequest{service: service, scope: scope, key: *key}, nil, nil
+}
+
+// finish records accepted as the response replayed to retries of the request.
+// A nil accepted means the request was not carried out, so the key is released
+// and the request may be retried with it.
+func (r *idempotentRequest) finish(ctx context.Context, accepted any) {
+	if r == nil {
+		return
+	}
+	if accepted == nil {
+		r.service.Release(ctx, r.scope, r.key)
+		return
+	}
+	r.service.Complete(ctx, r.scope, r.key, http.StatusAccepted, accepted)
+}
+
+// idempotencyError maps an error from claiming an idempotency key to the status
+// code and body of the response rejecting the request.
+func idempotencyError(err error) (int, server.Error) {
+	switch {
+	case errors.Is(err, idempotency.ErrInvalidKey):
+		return http.StatusBadRequest, server.Error{
+			Error:   "invalid_idempotency_key",
+			Message: "The Idempotency-Key header must be 1 to 255 printable ASCII characters",
+		}
+	case errors.Is(err, appIdempotency.ErrKeyReused):
+		return http.StatusUnprocessableEntity, server.Error{
+			Error:   "idempotency_key_reused",
+			Message: "The idempotency key was already used for a different request",
+		}
+	case errors.Is(err, appIdempotency.ErrRequestInProgress):
+		return http.StatusConflict, server.Error{
+			Error:   "idempotency_key_in_progress",
+			Message: "A request with this idempotency key is still in progress",
+		}
+	default:
+		return http.StatusInternalServerError, server.Error{
+			Error:   "internal_error",
+			Message: "An internal error occurred",
+			Details: &map[string]any{
+				"error": err.Error(),
+			},
+		}
+	}
+}
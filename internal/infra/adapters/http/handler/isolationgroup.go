@@ -0,0 +1,251 @@
+package httphandler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ahrav/hoglet-hub/api/v1/server"
+	appAudit "github.com/ahrav/hoglet-hub/internal/application/audit"
+	appIsolationGroup "github.com/ahrav/hoglet-hub/internal/application/isolationgroup"
+	"github.com/ahrav/hoglet-hub/internal/domain/audit"
+	"github.com/ahrav/hoglet-hub/internal/domain/isolationgroup"
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+)
+
+// IsolationGroupHandler implements the isolation group API endpoints, including
+// moving tenants between groups. Tenant moves are recorded in the audit trail.
+type IsolationGroupHandler struct {
+	groupService *appIsolationGroup.Service
+	auditService *appAudit.Service
+}
+
+// NewIsolationGroupHandler creates a new isolation group handler with the provided services.
+func NewIsolationGroupHandler(
+	groupService *appIsolationGroup.Service,
+	auditService *appAudit.Service,
+) *IsolationGroupHandler {
+	return &IsolationGroupHandler{groupService: groupService, auditService: auditService}
+}
+
+// CreateIsolationGroup handles requests to create an isolation group in a region.
+func (h *IsolationGroupHandler) CreateIsolationGroup(
+	ctx context.Context,
+	req server.CreateIsolationGroupRequestObject,
+) (server.CreateIsolationGroupResponseObject, error) {
+	if req.Body == nil {
+		return server.CreateIsolationGroup400JSONResponse{
+			Error:   "invalid_request",
+			Message: "Missing request body",
+		}, nil
+	}
+
+	group, err := h.groupService.Create(ctx, req.Body.Name, tenant.Region(req.Body.Region), requestActor(ctx))
+	if err != nil {
+		switch {
+		case errors.Is(err, isolationgroup.ErrGroupAlreadyExists):
+			return server.CreateIsolationGroup409JSONResponse{
+				Error:   "isolation_group_already_exists",
+				Message: "An isolation group with this name already exists",
+			}, nil
+		case errors.Is(err, isolationgroup.ErrInvalidName):
+			return server.CreateIsolationGroup400JSONResponse{
+				Error:   "invalid_isolation_group_name",
+				Message: "Isolation group name must contain only lowercase letters, numbers, and hyphens",
+			}, nil
+		case errors.Is(err, tenant.ErrInvalidRegion):
+			return server.CreateIsolationGroup400JSONResponse{
+				Error:   "invalid_region",
+				Message: "Invalid region specified",
+			}, nil
+		default:
+			return server.CreateIsolationGroup500JSONResponse{
+				Error:   "internal_error",
+				Message: "An internal error occurred",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		}
+	}
+
+	return server.CreateIsolationGroup201JSONResponse(toAPIIsolationGroup(group)), nil
+}
+
+// GetIsolationGroup handles requests for a single isolation group.
+func (h *IsolationGroupHandler) GetIsolationGroup(
+	ctx context.Context,
+	req server.GetIsolationGroupRequestObject,
+) (server.GetIsolationGroupResponseObject, error) {
+	group, err := h.groupService.Get(ctx, req.GroupId)
+	if err != nil {
+		switch {
+		case errors.Is(err, isolationgroup.ErrGroupNotFound):
+			return server.GetIsolationGroup404JSONResponse{
+				Error:   "isolation_group_not_found",
+				Message: "The specified isolation group does not exist",
+			}, nil
+		default:
+			return server.GetIsolationGroup500JSONResponse{
+				Error:   "internal_error",
+				Message: "An internal error occurred",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		}
+	}
+
+	return server.GetIsolationGroup200JSONResponse(toAPIIsolationGroup(group)), nil
+}
+
+// ListIsolationGroups handles requests to list isolation groups, optionally by region.
+func (h *IsolationGroupHandler) ListIsolationGroups(
+	ctx context.Context,
+	req server.ListIsolationGroupsRequestObject,
+) (server.ListIsolationGroupsResponseObject, error) {
+	var filter isolationgroup.ListFilter
+	if req.Params.Region != nil {
+		region := tenant.Region(*req.Params.Region)
+		filter.Region = &region
+	}
+
+	groups, err := h.groupService.List(ctx, filter)
+	if err != nil {
+		switch {
+		case errors.Is(err, tenant.ErrInvalidRegion):
+			return server.ListIsolationGroups400JSONResponse{
+				Error:   "invalid_region",
+				Message: "Invalid region specified",
+			}, nil
+		default:
+			return server.ListIsolationGroups500JSONResponse{
+				Error:   "internal_error",
+				Message: "An internal error occurred",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		}
+	}
+
+	resp := server.ListIsolationGroups200JSONResponse{
+		IsolationGroups: make([]server.IsolationGroupResponse, 0, len(groups)),
+		Links:           server.Links{"self": "/isolation-groups"},
+	}
+	for _, g := range groups {
+		resp.IsolationGroups = append(resp.IsolationGroups, toAPIIsolationGroup(g))
+	}
+
+	return resp, nil
+}
+
+// DeleteIsolationGroup handles requests to delete an isolation group without tenants.
+func (h *IsolationGroupHandler) DeleteIsolationGroup(
+	ctx context.Context,
+	req server.DeleteIsolationGroupRequestObject,
+) (server.DeleteIsolationGroupResponseObject, error) {
+	if err := h.groupService.Delete(ctx, req.GroupId); err != nil {
+		switch {
+		case errors.Is(err, isolationgroup.ErrGroupNotFound):
+			return server.DeleteIsolationGroup404JSONResponse{
+				Error:   "isolation_group_not_found",
+				Message: "The specified isolation group does not exist",
+			}, nil
+		case errors.Is(err, isolationgroup.ErrGroupNotEmpty):
+			return server.DeleteIsolationGroup409JSONResponse{
+				Error:   "isolation_group_not_empty",
+				Message: "The isolation group still has tenants",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		default:
+			return server.DeleteIsolationGroup500JSONResponse{
+				Error:   "internal_error",
+				Message: "An internal error occurred",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		}
+	}
+
+	return server.DeleteIsolationGroup204Response{}, nil
+}
+
+// ChangeTenantIsolationGroup handles requests to move a tenant into an isolation
+// group, or out of its current one when no group is given.
+func (h *IsolationGroupHandler) ChangeTenantIsolationGroup(
+	ctx context.Context,
+	req server.ChangeTenantIsolationGroupRequestObject,
+) (server.ChangeTenantIsolationGroupResponseObject, error) {
+	if req.Body == nil {
+		return server.ChangeTenantIsolationGroup400JSONResponse{
+			Error:   "invalid_request",
+			Message: "Missing request body",
+		}, nil
+	}
+
+	start := time.Now()
+	t, err := h.groupService.MoveTenant(ctx, req.TenantId, req.Body.IsolationGroupId)
+	h.auditService.Record(ctx, &audit.Entry{
+		Action:   audit.ActionChangeTenantIsolationGroup,
+		TenantID: &req.TenantId,
+		Details:  map[string]any{"isolation_group_id": req.Body.IsolationGroupId},
+		Duration: time.Since(start),
+	}, err)
+	if err != nil {
+		switch {
+		case errors.Is(err, tenant.ErrTenantNotFound):
+			return server.ChangeTenantIsolationGroup404JSONResponse{
+				Error:   "tenant_not_found",
+				Message: "The specified tenant does not exist",
+			}, nil
+		case errors.Is(err, isolationgroup.ErrGroupNotFound):
+			return server.ChangeTenantIsolationGroup404JSONResponse{
+				Error:   "isolation_group_not_found",
+				Message: "The specified isolation group does not exist",
+			}, nil
+		case errors.Is(err, isolationgroup.ErrRegionMismatch):
+			return server.ChangeTenantIsolationGroup409JSONResponse{
+				Error:   "region_mismatch",
+				Message: "The isolation group is in a different region than the tenant",
+			}, nil
+		case errors.Is(err, tenant.ErrTenantNotActive):
+			return server.ChangeTenantIsolationGroup409JSONResponse{
+				Error:   "tenant_not_active",
+				Message: "The tenant must be active to change its isolation group",
+			}, nil
+		case errors.Is(err, tenant.ErrOperationInProgress):
+			return server.ChangeTenantIsolationGroup409JSONResponse{
+				Error:   "operation_in_progress",
+				Message: "The tenant's isolation group cannot change while an operation on it is in progress",
+			}, nil
+		default:
+			return server.ChangeTenantIsolationGroup500JSONResponse{
+				Error:   "internal_error",
+				Message: "An internal error occurred",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		}
+	}
+
+	return server.ChangeTenantIsolationGroup200JSONResponse(toAPITenant(t)), nil
+}
+
+// toAPIIsolationGroup maps a domain isolation group to its API representation.
+func toAPIIsolationGroup(g *isolationgroup.IsolationGroup) server.IsolationGroupResponse {
+	return server.IsolationGroupResponse{
+		Links:     server.Links{"self": fmt.Sprintf("/isolation-groups/%d", g.ID)},
+		CreatedAt: g.CreatedAt,
+		CreatedBy: g.CreatedBy,
+		Id:        g.ID,
+		Name:      g.Name,
+		Region:    server.Region(g.Region),
+		UpdatedAt: g.UpdatedAt,
+	}
+}
@@ -4,23 +4,63 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	openapi_types "github.com/oapi-codegen/runtime/types"
 
 	"github.com/ahrav/hoglet-hub/api/v1/server"
+	appAudit "github.com/ahrav/hoglet-hub/internal/application/audit"
 	appOperation "github.com/ahrav/hoglet-hub/internal/application/operation"
+	"github.com/ahrav/hoglet-hub/internal/application/sdk/auth"
+	appTenant "github.com/ahrav/hoglet-hub/internal/application/tenant"
+	"github.com/ahrav/hoglet-hub/internal/domain/audit"
 	"github.com/ahrav/hoglet-hub/internal/domain/operation"
 )
 
 // OperationHandler implements the operation-related API endpoints.
 // It serves as the HTTP interface layer for operation management functionalities,
 // translating between HTTP requests/responses and application service calls.
-type OperationHandler struct{ operationService *appOperation.Service }
+// Operation commands are recorded in the audit trail, whether or not they succeed.
+type OperationHandler struct {
+	operationService *appOperation.Service
+	tenantService    *appTenant.Service
+	auditService     *appAudit.Service
 
-// NewOperationHandler creates a new operation handler with the given operation service.
-// The operation service is required to handle business logic operations.
-func NewOperationHandler(operationService *appOperation.Service) *OperationHandler {
-	return &OperationHandler{operationService: operationService}
+	streamsClosed chan struct{} // Closed to end the open operation event streams
+	closeStreams  sync.Once
+}
+
+// NewOperationHandler creates a new operation handler with the given services.
+// The operation service handles operation lookups, while the tenant service owns the
+// workflows that operation commands such as cancellation act upon.
+func NewOperationHandler(
+	operationService *appOperation.Service,
+	tenantService *appTenant.Service,
+	auditService *appAudit.Service,
+) *OperationHandler {
+	return &OperationHandler{
+		operationService: operationService,
+		tenantService:    tenantService,
+		auditService:     auditService,
+		streamsClosed:    make(chan struct{}),
+	}
+}
+
+// systemActor identifies who performed a command when the request carries no
+// authenticated identity, as when authentication is disabled.
+const systemActor = "system@hoglet-hub.com"
+
+// requestActor identifies who performed a command: the subject of the request's
+// bearer token, or systemActor if the request was not authenticated.
+func requestActor(ctx context.Context) string {
+	if subject := auth.Subject(ctx); subject != "" {
+		return subject
+	}
+	return systemActor
 }
 
 // GetOperation handles HTTP requests for retrieving operation details by ID.
@@ -47,21 +87,417 @@ func (h *OperationHandler) GetOperation(ctx context.Context, req server.GetOpera
 		}
 	}
 
-	var status server.OperationStatus
-	switch op.Status {
-	case operation.StatusPending:
-		status = server.Pending
-	case operation.StatusInProgress:
-		status = server.InProgress
-	case operation.StatusCompleted:
-		status = server.Completed
-	case operation.StatusFailed:
-		status = server.Failed
-	case operation.StatusCancelled:
-		status = server.Cancelled
+	return server.GetOperation200JSONResponse(toAPIOperation(op)), nil
+}
+
+// CancelOperation handles requests to cancel a pending or in-progress operation.
+// Cancellation is asynchronous: the response reflects the operation's status when
+// the request was accepted, and the operation reaches the cancelled status once its
+// workflow has wound down.
+func (h *OperationHandler) CancelOperation(
+	ctx context.Context,
+	req server.CancelOperationRequestObject,
+) (server.CancelOperationResponseObject, error) {
+	if req.Body == nil || strings.TrimSpace(req.Body.Reason) == "" {
+		return server.CancelOperation400JSONResponse{
+			Error:   "invalid_request",
+			Message: "A cancellation reason is required",
+		}, nil
+	}
+
+	start := time.Now()
+	op, err := h.tenantService.CancelOperation(ctx, req.OperationId, req.Body.Reason, requestActor(ctx))
+	h.auditService.Record(ctx, &audit.Entry{
+		Action:      audit.ActionCancelOperation,
+		TenantID:    operationTenantID(op),
+		OperationID: &req.OperationId,
+		Details:     map[string]any{"reason": req.Body.Reason},
+		Duration:    time.Since(start),
+	}, err)
+	if err != nil {
+		switch {
+		case errors.Is(err, operation.ErrOperationNotFound):
+			return server.CancelOperation404JSONResponse{
+				Error:   "operation_not_found",
+				Message: "The specified operation does not exist",
+			}, nil
+		case errors.Is(err, operation.ErrOperationNotCancellable):
+			return server.CancelOperation409JSONResponse{
+				Error:   "operation_not_cancellable",
+				Message: "The operation has already finished and cannot be cancelled",
+			}, nil
+		case errors.Is(err, operation.ErrOperationRunsElsewhere):
+			return server.CancelOperation409JSONResponse{
+				Error:   "operation_runs_elsewhere",
+				Message: "The operation is running in another replica; retry the request",
+			}, nil
+		default:
+			return server.CancelOperation500JSONResponse{
+				Error:   "internal_error",
+				Message: "An internal error occurred",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		}
+	}
+
+	links := server.Links{
+		"self": fmt.Sprintf("/operations/%d", op.ID),
+	}
+	if op.TenantID != nil {
+		links["tenant"] = fmt.Sprintf("/tenants/%d", *op.TenantID)
+	}
+
+	return server.CancelOperation202JSONResponse{
+		Links:       links,
+		OperationId: op.ID,
+		Status:      toAPIOperationStatus(op.Status),
+		TenantId:    op.TenantID,
+	}, nil
+}
+
+// RetryOperation handles requests to retry a failed operation. It starts a new,
+// linked operation that resumes from the failed step and returns it for tracking.
+func (h *OperationHandler) RetryOperation(
+	ctx context.Context,
+	req server.RetryOperationRequestObject,
+) (server.RetryOperationResponseObject, error) {
+	start := time.Now()
+	retry, err := h.operationService.Retry(ctx, req.OperationId)
+	entry := &audit.Entry{
+		Action:      audit.ActionRetryOperation,
+		TenantID:    operationTenantID(retry),
+		OperationID: &req.OperationId,
+		Duration:    time.Since(start),
+	}
+	if retry != nil {
+		entry.Details = map[string]any{"retry_operation_id": retry.ID}
+	}
+	h.auditService.Record(ctx, entry, err)
+	if err != nil {
+		switch {
+		case errors.Is(err, operation.ErrOperationNotFound):
+			return server.RetryOperation404JSONResponse{
+				Error:   "operation_not_found",
+				Message: "The specified operation does not exist",
+			}, nil
+		case errors.Is(err, operation.ErrOperationNotRetryable):
+			return server.RetryOperation409JSONResponse{
+				Error:   "operation_not_retryable",
+				Message: "Only failed operations without lasting effects can be retried",
+			}, nil
+		case errors.Is(err, operation.ErrOperationAlreadyRetried):
+			return server.RetryOperation409JSONResponse{
+				Error:   "operation_already_retried",
+				Message: "The operation has already been retried; retry the latest attempt instead",
+			}, nil
+		default:
+			return server.RetryOperation500JSONResponse{
+				Error:   "internal_error",
+				Message: "An internal error occurred",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		}
+	}
+
+	links := server.Links{
+		"self":   fmt.Sprintf("/operations/%d", retry.ID),
+		"parent": fmt.Sprintf("/operations/%d", req.OperationId),
+	}
+	if retry.TenantID != nil {
+		links["tenant"] = fmt.Sprintf("/tenants/%d", *retry.TenantID)
+	}
+
+	return server.RetryOperation202JSONResponse{
+		Links:       links,
+		OperationId: retry.ID,
+		Status:      toAPIOperationStatus(retry.Status),
+		TenantId:    retry.TenantID,
+	}, nil
+}
+
+// PauseOperation handles requests to pause an in-progress migration between phases.
+// Pausing is asynchronous: the response reflects the operation's status when the
+// request was accepted, and the operation reaches the paused status once its current
+// phase has completed.
+func (h *OperationHandler) PauseOperation(
+	ctx context.Context,
+	req server.PauseOperationRequestObject,
+) (server.PauseOperationResponseObject, error) {
+	start := time.Now()
+	op, err := h.tenantService.PauseOperation(ctx, req.OperationId)
+	h.auditService.Record(ctx, &audit.Entry{
+		Action:      audit.ActionPauseOperation,
+		TenantID:    operationTenantID(op),
+		OperationID: &req.OperationId,
+		Duration:    time.Since(start),
+	}, err)
+	if err != nil {
+		switch {
+		case errors.Is(err, operation.ErrOperationNotFound):
+			return server.PauseOperation404JSONResponse{
+				Error:   "operation_not_found",
+				Message: "The specified operation does not exist",
+			}, nil
+		case errors.Is(err, operation.ErrOperationNotPausable):
+			return server.PauseOperation409JSONResponse{
+				Error:   "operation_not_pausable",
+				Message: "Only in-progress migrations can be paused",
+			}, nil
+		case errors.Is(err, operation.ErrOperationRunsElsewhere):
+			return server.PauseOperation409JSONResponse{
+				Error:   "operation_runs_elsewhere",
+				Message: "The operation is running in another replica; retry the request",
+			}, nil
+		default:
+			return server.PauseOperation500JSONResponse{
+				Error:   "internal_error",
+				Message: "An internal error occurred",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		}
+	}
+
+	links := server.Links{
+		"self": fmt.Sprintf("/operations/%d", op.ID),
+	}
+	if op.TenantID != nil {
+		links["tenant"] = fmt.Sprintf("/tenants/%d", *op.TenantID)
+	}
+
+	return server.PauseOperation202JSONResponse{
+		Links:       links,
+		OperationId: op.ID,
+		Status:      toAPIOperationStatus(op.Status),
+		TenantId:    op.TenantID,
+	}, nil
+}
+
+// ResumeOperation handles requests to continue a paused operation from the first
+// phase that has not completed.
+func (h *OperationHandler) ResumeOperation(
+	ctx context.Context,
+	req server.ResumeOperationRequestObject,
+) (server.ResumeOperationResponseObject, error) {
+	start := time.Now()
+	op, err := h.operationService.Resume(ctx, req.OperationId)
+	h.auditService.Record(ctx, &audit.Entry{
+		Action:      audit.ActionResumeOperation,
+		TenantID:    operationTenantID(op),
+		OperationID: &req.OperationId,
+		Duration:    time.Since(start),
+	}, err)
+	if err != nil {
+		switch {
+		case errors.Is(err, operation.ErrOperationNotFound):
+			return server.ResumeOperation404JSONResponse{
+				Error:   "operation_not_found",
+				Message: "The specified operation does not exist",
+			}, nil
+		case errors.Is(err, operation.ErrOperationNotPaused):
+			return server.ResumeOperation409JSONResponse{
+				Error:   "operation_not_paused",
+				Message: "Only paused operations can be resumed",
+			}, nil
+		default:
+			return server.ResumeOperation500JSONResponse{
+				Error:   "internal_error",
+				Message: "An internal error occurred",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		}
+	}
+
+	links := server.Links{
+		"self": fmt.Sprintf("/operations/%d", op.ID),
+	}
+	if op.TenantID != nil {
+		links["tenant"] = fmt.Sprintf("/tenants/%d", *op.TenantID)
+	}
+
+	return server.ResumeOperation202JSONResponse{
+		Links:       links,
+		OperationId: op.ID,
+		Status:      toAPIOperationStatus(op.Status),
+		TenantId:    op.TenantID,
+	}, nil
+}
+
+// ListOperations handles requests for a filtered page of operations across all tenants.
+// The response carries an opaque cursor for the next page.
+func (h *OperationHandler) ListOperations(
+	ctx context.Context,
+	req server.ListOperationsRequestObject,
+) (server.ListOperationsResponseObject, error) {
+	filter := operation.ListFilter{
+		TenantID:      req.Params.TenantId,
+		CreatedAfter:  req.Params.CreatedAfter,
+		CreatedBefore: req.Params.CreatedBefore,
+	}
+	if req.Params.OperationType != nil {
+		opType := operation.Op(*req.Params.OperationType)
+		filter.Type = &opType
+	}
+	if req.Params.Status != nil {
+		status := operation.Status(*req.Params.Status)
+		filter.Status = &status
+	}
+	if req.Params.CreatedBy != nil {
+		filter.CreatedBy = *req.Params.CreatedBy
+	}
+
+	result, err := h.operationService.List(ctx, listParams(filter, req.Params.Cursor, req.Params.Limit))
+	if err != nil {
+		switch {
+		case errors.Is(err, operation.ErrInvalidListParams):
+			return server.ListOperations400JSONResponse{
+				Error:   "invalid_list_parameters",
+				Message: "Invalid filter or cursor specified",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		default:
+			return server.ListOperations500JSONResponse{
+				Error:   "internal_error",
+				Message: "An internal error occurred",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		}
+	}
+
+	q := url.Values{}
+	if req.Params.TenantId != nil {
+		q.Set("tenant_id", strconv.FormatInt(*req.Params.TenantId, 10))
+	}
+	if req.Params.CreatedBy != nil {
+		q.Set("created_by", *req.Params.CreatedBy)
+	}
+	if req.Params.CreatedAfter != nil {
+		q.Set("created_after", req.Params.CreatedAfter.Format(time.RFC3339Nano))
+	}
+	if req.Params.CreatedBefore != nil {
+		q.Set("created_before", req.Params.CreatedBefore.Format(time.RFC3339Nano))
 	}
 
-	// Construct HATEOAS links for API discoverability.
+	return server.ListOperations200JSONResponse(
+		toAPIOperationList(result, "/operations", q, req.Params.OperationType, req.Params.Status, req.Params.Limit),
+	), nil
+}
+
+// ListTenantOperations handles requests for a page of a single tenant's operations.
+// Operations of deleted tenants remain listed, so the tenant's existence is not checked.
+func (h *OperationHandler) ListTenantOperations(
+	ctx context.Context,
+	req server.ListTenantOperationsRequestObject,
+) (server.ListTenantOperationsResponseObject, error) {
+	tenantID := req.TenantId
+	filter := operation.ListFilter{TenantID: &tenantID}
+	if req.Params.OperationType != nil {
+		opType := operation.Op(*req.Params.OperationType)
+		filter.Type = &opType
+	}
+	if req.Params.Status != nil {
+		status := operation.Status(*req.Params.Status)
+		filter.Status = &status
+	}
+
+	result, err := h.operationService.List(ctx, listParams(filter, req.Params.Cursor, req.Params.Limit))
+	if err != nil {
+		switch {
+		case errors.Is(err, operation.ErrInvalidListParams):
+			return server.ListTenantOperations400JSONResponse{
+				Error:   "invalid_list_parameters",
+				Message: "Invalid filter or cursor specified",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		default:
+			return server.ListTenantOperations500JSONResponse{
+				Error:   "internal_error",
+				Message: "An internal error occurred",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		}
+	}
+
+	return server.ListTenantOperations200JSONResponse(toAPIOperationList(
+		result,
+		fmt.Sprintf("/tenants/%d/operations", tenantID),
+		url.Values{},
+		req.Params.OperationType,
+		req.Params.Status,
+		req.Params.Limit,
+	)), nil
+}
+
+// listParams builds the application list parameters from the optional paging
+// query parameters shared by the operation listings.
+func listParams(filter operation.ListFilter, cursor *string, limit *int) appOperation.ListParams {
+	params := appOperation.ListParams{Filter: filter}
+	if cursor != nil {
+		params.Cursor = *cursor
+	}
+	if limit != nil {
+		params.Limit = *limit
+	}
+	return params
+}
+
+// toAPIOperationList maps a page of operations to its API representation. The next
+// link repeats the listing's query with the cursor advanced to the following page.
+func toAPIOperationList(
+	result *appOperation.ListResult,
+	path string,
+	query url.Values,
+	opType *string,
+	status *server.OperationStatus,
+	limit *int,
+) server.OperationList {
+	ops := make([]server.OperationResponse, 0, len(result.Operations))
+	for _, op := range result.Operations {
+		ops = append(ops, toAPIOperation(op))
+	}
+
+	links := server.Links{"self": path}
+	var nextCursor *string
+	if result.NextCursor != "" {
+		nextCursor = &result.NextCursor
+		if opType != nil {
+			query.Set("operation_type", *opType)
+		}
+		if status != nil {
+			query.Set("status", string(*status))
+		}
+		if limit != nil {
+			query.Set("limit", strconv.Itoa(*limit))
+		}
+		query.Set("cursor", result.NextCursor)
+		links["next"] = path + "?" + query.Encode()
+	}
+
+	return server.OperationList{
+		Links:      links,
+		NextCursor: nextCursor,
+		Operations: ops,
+	}
+}
+
+// toAPIOperation maps a domain operation to its API representation,
+// including HATEOAS links for API discoverability.
+func toAPIOperation(op *operation.Operation) server.OperationResponse {
 	links := server.Links{
 		"self": fmt.Sprintf("/operations/%d", op.ID),
 	}
@@ -75,19 +511,50 @@ func (h *OperationHandler) GetOperation(ctx context.Context, req server.GetOpera
 		createdBy = &email
 	}
 
-	return server.GetOperation200JSONResponse{
-		Links:         links,
-		Id:            op.ID,
-		OperationType: op.Type.String(),
-		Status:        status,
-		TenantId:      op.TenantID,
-		CreatedAt:     op.CreatedAt,
-		StartedAt:     op.StartedAt,
-		CompletedAt:   op.CompletedAt,
-		UpdatedAt:     op.UpdatedAt,
-		ErrorMessage:  op.ErrorMessage,
-		Parameters:    &op.Parameters,
-		Result:        &op.Result,
-		CreatedBy:     createdBy,
-	}, nil
+	return server.OperationResponse{
+		Links:             links,
+		Id:                op.ID,
+		OperationType:     op.Type.String(),
+		Status:            toAPIOperationStatus(op.Status),
+		TenantId:          op.TenantID,
+		CreatedAt:         op.CreatedAt,
+		StartedAt:         op.StartedAt,
+		CompletedAt:       op.CompletedAt,
+		UpdatedAt:         op.UpdatedAt,
+		ErrorMessage:      op.ErrorMessage,
+		Parameters:        &op.Parameters,
+		Result:            &op.Result,
+		CreatedBy:         createdBy,
+		CancelledBy:       op.CancelledBy,
+		ParentOperationId: op.ParentID,
+		Attempt:           &op.Attempt,
+	}
+}
+
+// toAPIOperationStatus maps a domain operation status to its API representation.
+func toAPIOperationStatus(status operation.Status) server.OperationStatus {
+	switch status {
+	case operation.StatusPending:
+		return server.OperationStatusPending
+	case operation.StatusInProgress:
+		return server.OperationStatusInProgress
+	case operation.StatusCompleted:
+		return server.OperationStatusCompleted
+	case operation.StatusFailed:
+		return server.OperationStatusFailed
+	case operation.StatusCancelled:
+		return server.OperationStatusCancelled
+	case operation.StatusPaused:
+		return server.OperationStatusPaused
+	}
+	return ""
+}
+
+// operationTenantID returns the tenant an operation acts on, or nil if the
+// operation is unknown or not tied to a tenant.
+func operationTenantID(op *operation.Operation) *int64 {
+	if op == nil {
+		return nil
+	}
+	return op.TenantID
 }
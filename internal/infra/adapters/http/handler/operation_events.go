@@ -0,0 +1,172 @@
+package httphandler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ahrav/hoglet-hub/api/v1/server"
+	appOperation "github.com/ahrav/hoglet-hub/internal/application/operation"
+	"github.com/ahrav/hoglet-hub/internal/domain/operation"
+)
+
+const (
+	// eventStreamRetry is how long clients wait before reconnecting to a stream
+	// that ended before the operation finished.
+	eventStreamRetry = 3 * time.Second
+
+	// eventStreamKeepAlive is how often an idle stream sends a comment, so that
+	// proxies do not close the connection while a long step runs.
+	eventStreamKeepAlive = 15 * time.Second
+)
+
+// StreamOperationEvents handles requests to follow an operation's progress as
+// Server-Sent Events. The operation is looked up before the stream starts so a
+// missing operation is answered with a 404 rather than an empty stream.
+func (h *OperationHandler) StreamOperationEvents(
+	ctx context.Context,
+	req server.StreamOperationEventsRequestObject,
+) (server.StreamOperationEventsResponseObject, error) {
+	if _, err := h.operationService.GetByID(ctx, req.OperationId); err != nil {
+		switch {
+		case errors.Is(err, operation.ErrOperationNotFound):
+			return server.StreamOperationEvents404JSONResponse{
+				Error:   "operation_not_found",
+				Message: "The specified operation does not exist",
+			}, nil
+		default:
+			return server.StreamOperationEvents500JSONResponse{
+				Error:   "internal_error",
+				Message: "An internal error occurred",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		}
+	}
+
+	var afterID int64
+	if req.Params.LastEventID != nil {
+		afterID = *req.Params.LastEventID
+	}
+
+	return operationEventStream{
+		ctx:         ctx,
+		service:     h.operationService,
+		operationID: req.OperationId,
+		afterID:     afterID,
+		closed:      h.streamsClosed,
+	}, nil
+}
+
+// CloseStreams ends the open operation event streams, and any started afterwards
+// end straight away. It is meant to run when the server shuts down, which would
+// otherwise wait for streams to finish on their own. Clients reconnect and
+// resume from the last event they received.
+func (h *OperationHandler) CloseStreams() {
+	h.closeStreams.Do(func() { close(h.streamsClosed) })
+}
+
+// operationEventStream writes the events of an operation to the response as
+// they are recorded. Strict response objects are written after the handler
+// returns, so the stream keeps the request's context to know when to stop.
+type operationEventStream struct {
+	ctx         context.Context
+	service     *appOperation.Service
+	operationID int64
+	afterID     int64
+	closed      <-chan struct{}
+}
+
+// VisitStreamOperationEventsResponse writes the stream until the operation
+// finishes, the client goes away or the server closes its streams. Once the
+// stream has started its status can no longer change, so a failure to read
+// events is sent as an error event that ends the stream.
+func (s operationEventStream) VisitStreamOperationEventsResponse(w http.ResponseWriter) error {
+	rc := http.NewResponseController(w)
+	// The stream lasts as long as the operation, well past the server's write timeout.
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	var mu sync.Mutex
+	write := func(format string, args ...any) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if _, err := fmt.Fprintf(w, format, args...); err != nil {
+			return err
+		}
+		return rc.Flush()
+	}
+	if err := write("retry: %d\n\n", eventStreamRetry.Milliseconds()); err != nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	defer cancel()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(eventStreamKeepAlive)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-s.closed:
+				cancel()
+				return
+			case <-ticker.C:
+				if err := write(": keep-alive\n\n"); err != nil {
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+
+	err := s.service.Watch(ctx, s.operationID, s.afterID, func(e *operation.Event) error {
+		data, err := json.Marshal(toAPIOperationEvent(e))
+		if err != nil {
+			return err
+		}
+		return write("id: %d\nevent: %s\ndata: %s\n\n", e.ID, e.Type, data)
+	})
+	if err != nil && ctx.Err() == nil {
+		data, _ := json.Marshal(server.Error{
+			Error:   "internal_error",
+			Message: "An internal error occurred",
+			Details: &map[string]any{
+				"error": err.Error(),
+			},
+		})
+		_ = write("event: error\ndata: %s\n\n", data)
+	}
+	return nil
+}
+
+// toAPIOperationEvent maps a domain operation event to its API representation.
+func toAPIOperationEvent(e *operation.Event) server.OperationEvent {
+	return server.OperationEvent{
+		Id:           e.ID,
+		OperationId:  e.OperationID,
+		Type:         server.OperationEventType(e.Type),
+		Status:       e.Status,
+		StepIndex:    e.StepIndex,
+		StepName:     e.StepName,
+		ErrorMessage: e.ErrorMessage,
+		OccurredAt:   e.OccurredAt,
+	}
+}
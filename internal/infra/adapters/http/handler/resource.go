@@ -0,0 +1,95 @@
+package httphandler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ahrav/hoglet-hub/api/v1/server"
+	appResource "github.com/ahrav/hoglet-hub/internal/application/resource"
+	"github.com/ahrav/hoglet-hub/internal/domain/resource"
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+)
+
+// ResourceHandler implements the API endpoints for the tenant resource ledger.
+type ResourceHandler struct{ resourceService *appResource.Service }
+
+// NewResourceHandler creates a new resource handler with the provided service.
+func NewResourceHandler(resourceService *appResource.Service) *ResourceHandler {
+	return &ResourceHandler{resourceService: resourceService}
+}
+
+// ListTenantResources handles requests for the resources provisioned for a tenant.
+func (h *ResourceHandler) ListTenantResources(
+	ctx context.Context,
+	req server.ListTenantResourcesRequestObject,
+) (server.ListTenantResourcesResponseObject, error) {
+	var filter resource.ListFilter
+	if req.Params.ResourceType != nil {
+		resourceType := resource.Type(*req.Params.ResourceType)
+		filter.Type = &resourceType
+	}
+	if req.Params.Status != nil {
+		status := resource.Status(*req.Params.Status)
+		filter.Status = &status
+	}
+
+	resources, err := h.resourceService.ListForTenant(ctx, req.TenantId, filter)
+	if err != nil {
+		switch {
+		case errors.Is(err, tenant.ErrTenantNotFound):
+			return server.ListTenantResources404JSONResponse{
+				Error:   "tenant_not_found",
+				Message: "The specified tenant does not exist",
+			}, nil
+		case errors.Is(err, resource.ErrInvalidType):
+			return server.ListTenantResources400JSONResponse{
+				Error:   "invalid_resource_type",
+				Message: "Invalid resource type specified",
+			}, nil
+		case errors.Is(err, resource.ErrInvalidStatus):
+			return server.ListTenantResources400JSONResponse{
+				Error:   "invalid_resource_status",
+				Message: "Invalid resource status specified",
+			}, nil
+		default:
+			return server.ListTenantResources500JSONResponse{
+				Error:   "internal_error",
+				Message: "An internal error occurred",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		}
+	}
+
+	resp := server.ListTenantResources200JSONResponse{
+		Resources: make([]server.TenantResource, 0, len(resources)),
+		Links: server.Links{
+			"self":   fmt.Sprintf("/tenants/%d/resources", req.TenantId),
+			"tenant": fmt.Sprintf("/tenants/%d", req.TenantId),
+		},
+	}
+	for _, r := range resources {
+		resp.Resources = append(resp.Resources, toAPIResource(r))
+	}
+
+	return resp, nil
+}
+
+// toAPIResource maps a domain resource to its API representation.
+func toAPIResource(r *resource.Resource) server.TenantResource {
+	return server.TenantResource{
+		CreatedAt:            r.CreatedAt,
+		CreatedByOperationId: r.CreatedByOperationID,
+		ExternalId:           r.ExternalID,
+		Id:                   r.ID,
+		Metadata:             &r.Metadata,
+		Name:                 r.Name,
+		ProjectId:            r.ProjectID,
+		Region:               server.Region(r.Region),
+		ResourceType:         server.TenantResourceType(r.Type),
+		Status:               server.TenantResourceStatus(r.Status),
+		UpdatedAt:            r.UpdatedAt,
+	}
+}
@@ -2,28 +2,88 @@ package httphandler
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/ahrav/hoglet-hub/api/v1/server"
+	appAudit "github.com/ahrav/hoglet-hub/internal/application/audit"
+	appIdempotency "github.com/ahrav/hoglet-hub/internal/application/idempotency"
+	"github.com/ahrav/hoglet-hub/internal/application/sdk/errs"
 	appTenant "github.com/ahrav/hoglet-hub/internal/application/tenant"
+	"github.com/ahrav/hoglet-hub/internal/domain/audit"
+	"github.com/ahrav/hoglet-hub/internal/domain/isolationgroup"
 	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
 )
 
 // TenantHandler implements the tenant-related API endpoints by translating
 // HTTP requests to application service calls and mapping responses back to HTTP.
-type TenantHandler struct{ tenantService *appTenant.Service }
+// Every tenant mutation is recorded in the audit trail, whether or not it succeeds.
+// Creations and deletions sent with an idempotency key are carried out at most
+// once per key, retries being answered with the original response.
+type TenantHandler struct {
+	tenantService      *appTenant.Service
+	auditService       *appAudit.Service
+	idempotencyService *appIdempotency.Service
+}
 
 // NewTenantHandler creates a new tenant handler with the provided tenant service.
 // The tenant service is used to execute the business logic for tenant operations.
-func NewTenantHandler(tenantService *appTenant.Service) *TenantHandler {
-	return &TenantHandler{tenantService: tenantService}
+func NewTenantHandler(
+	tenantService *appTenant.Service,
+	auditService *appAudit.Service,
+	idempotencyService *appIdempotency.Service,
+) *TenantHandler {
+	return &TenantHandler{
+		tenantService:      tenantService,
+		auditService:       auditService,
+		idempotencyService: idempotencyService,
+	}
 }
 
-// CreateTenant handles tenant creation requests by validating input,
-// transforming API models to domain models, and delegating to the tenant service.
-// It returns appropriate HTTP responses based on the operation result.
+// CreateTenant handles tenant creation requests. A request sent with an
+// idempotency key that was already used for the same request body is answered
+// with the original response rather than creating the tenant again.
 func (h *TenantHandler) CreateTenant(ctx context.Context, req server.CreateTenantRequestObject) (server.CreateTenantResponseObject, error) {
+	idem, replayed, err := beginIdempotent(ctx, h.idempotencyService, "CreateTenant", req.Params.IdempotencyKey, req.Body)
+	if err != nil {
+		switch status, body := idempotencyError(err); status {
+		case http.StatusBadRequest:
+			return server.CreateTenant400JSONResponse(body), nil
+		case http.StatusConflict:
+			return server.CreateTenant409JSONResponse(body), nil
+		case http.StatusUnprocessableEntity:
+			return server.CreateTenant422JSONResponse(body), nil
+		default:
+			return server.CreateTenant500JSONResponse(body), nil
+		}
+	}
+	if replayed != nil {
+		var resp server.CreateTenant202JSONResponse
+		if err := json.Unmarshal(replayed.Body, &resp); err != nil {
+			return nil, fmt.Errorf("error decoding replayed response: %w", err)
+		}
+		return resp, nil
+	}
+
+	resp, err := h.createTenant(ctx, req)
+	if accepted, ok := resp.(server.CreateTenant202JSONResponse); ok {
+		idem.finish(ctx, accepted)
+	} else {
+		idem.finish(ctx, nil)
+	}
+	return resp, err
+}
+
+// createTenant validates the creation request, transforms API models to domain
+// models, and delegates to the tenant service. It returns appropriate HTTP
+// responses based on the operation result.
+func (h *TenantHandler) createTenant(ctx context.Context, req server.CreateTenantRequestObject) (server.CreateTenantResponseObject, error) {
 	if req.Body == nil {
 		return server.CreateTenant400JSONResponse{
 			Error:   "invalid_request",
@@ -77,7 +137,18 @@ func (h *TenantHandler) CreateTenant(ctx context.Context, req server.CreateTenan
 	}
 
 	// Delegate to application service and handle domain-specific errors.
+	start := time.Now()
 	result, err := h.tenantService.Create(ctx, params)
+	entry := &audit.Entry{
+		Action:      audit.ActionCreateTenant,
+		OperationID: operationID(result),
+		Details:     map[string]any{"name": params.Name, "region": params.Region, "tier": params.Tier},
+		Duration:    time.Since(start),
+	}
+	if result != nil {
+		entry.TenantID = &result.TenantID
+	}
+	h.auditService.Record(ctx, entry, err)
 	if err != nil {
 		switch {
 		case errors.Is(err, tenant.ErrTenantAlreadyExists):
@@ -100,6 +171,21 @@ func (h *TenantHandler) CreateTenant(ctx context.Context, req server.CreateTenan
 				Error:   "invalid_tier",
 				Message: "Invalid tier specified",
 			}, nil
+		case errors.Is(err, isolationgroup.ErrGroupNotFound):
+			return server.CreateTenant400JSONResponse{
+				Error:   "isolation_group_not_found",
+				Message: "The specified isolation group does not exist",
+			}, nil
+		case errors.Is(err, isolationgroup.ErrRegionMismatch):
+			return server.CreateTenant400JSONResponse{
+				Error:   "region_mismatch",
+				Message: "The isolation group is in a different region than the tenant",
+			}, nil
+		case hasErrCode(err, errs.ResourceExhausted):
+			return server.CreateTenant429JSONResponse{
+				Error:   "resource_exhausted",
+				Message: err.Error(),
+			}, nil
 		default:
 			return server.CreateTenant500JSONResponse{
 				Error:   "internal_error",
@@ -118,19 +204,63 @@ func (h *TenantHandler) CreateTenant(ctx context.Context, req server.CreateTenan
 		},
 		Name:        req.Body.Name,
 		OperationId: result.OperationID,
-		Status:      server.Pending,
+		Status:      server.OperationStatusPending,
 		TenantId:    result.TenantID,
 	}, nil
 }
 
-// DeleteTenant handles tenant deletion requests by delegating to the tenant service
-// and mapping the result to appropriate HTTP responses. It initiates an asynchronous
-// deletion operation and returns information about the operation.
+// DeleteTenant handles tenant deletion requests. A request sent with an
+// idempotency key that was already used to delete the same tenant is answered
+// with the original response rather than starting another deletion.
 func (h *TenantHandler) DeleteTenant(
 	ctx context.Context,
 	req server.DeleteTenantRequestObject,
 ) (server.DeleteTenantResponseObject, error) {
+	idem, replayed, err := beginIdempotent(ctx, h.idempotencyService, "DeleteTenant", req.Params.IdempotencyKey, req.TenantId)
+	if err != nil {
+		switch status, body := idempotencyError(err); status {
+		case http.StatusBadRequest:
+			return server.DeleteTenant400JSONResponse(body), nil
+		case http.StatusConflict:
+			return server.DeleteTenant409JSONResponse(body), nil
+		case http.StatusUnprocessableEntity:
+			return server.DeleteTenant422JSONResponse(body), nil
+		default:
+			return server.DeleteTenant500JSONResponse(body), nil
+		}
+	}
+	if replayed != nil {
+		var resp server.DeleteTenant202JSONResponse
+		if err := json.Unmarshal(replayed.Body, &resp); err != nil {
+			return nil, fmt.Errorf("error decoding replayed response: %w", err)
+		}
+		return resp, nil
+	}
+
+	resp, err := h.deleteTenant(ctx, req)
+	if accepted, ok := resp.(server.DeleteTenant202JSONResponse); ok {
+		idem.finish(ctx, accepted)
+	} else {
+		idem.finish(ctx, nil)
+	}
+	return resp, err
+}
+
+// deleteTenant delegates the deletion to the tenant service and maps the result
+// to appropriate HTTP responses. It initiates an asynchronous deletion operation
+// and returns information about the operation.
+func (h *TenantHandler) deleteTenant(
+	ctx context.Context,
+	req server.DeleteTenantRequestObject,
+) (server.DeleteTenantResponseObject, error) {
+	start := time.Now()
 	result, err := h.tenantService.Delete(ctx, req.TenantId)
+	h.auditService.Record(ctx, &audit.Entry{
+		Action:      audit.ActionDeleteTenant,
+		TenantID:    &req.TenantId,
+		OperationID: operationID(result),
+		Duration:    time.Since(start),
+	}, err)
 	if err != nil {
 		switch {
 		case errors.Is(err, tenant.ErrTenantNotFound):
@@ -138,6 +268,19 @@ func (h *TenantHandler) DeleteTenant(
 				Error:   "tenant_not_found",
 				Message: "The specified tenant does not exist",
 			}, nil
+		case errors.Is(err, tenant.ErrOperationInProgress):
+			return server.DeleteTenant409JSONResponse{
+				Error:   "operation_in_progress",
+				Message: "Another operation on the tenant is still in progress",
+			}, nil
+		case errors.Is(err, tenant.ErrInvalidTransition):
+			return server.DeleteTenant409JSONResponse{
+				Error:   "invalid_tenant_state",
+				Message: "The tenant cannot be deleted in its current state",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
 		default:
 			return server.DeleteTenant500JSONResponse{
 				Error:   "internal_error",
@@ -156,7 +299,523 @@ func (h *TenantHandler) DeleteTenant(
 			"tenant": fmt.Sprintf("/tenants/%d", tenantID),
 		},
 		OperationId: result.OperationID,
-		Status:      server.Pending,
+		Status:      server.OperationStatusPending,
+		TenantId:    &tenantID,
+	}, nil
+}
+
+// ChangeTenantTier handles tier change requests by delegating to the tenant service.
+// It initiates an asynchronous operation that resizes the tenant for the new tier and
+// maps transitions the tenant cannot make from its current tier or state to conflicts.
+func (h *TenantHandler) ChangeTenantTier(
+	ctx context.Context,
+	req server.ChangeTenantTierRequestObject,
+) (server.ChangeTenantTierResponseObject, error) {
+	if req.Body == nil {
+		return server.ChangeTenantTier400JSONResponse{
+			Error:   "invalid_request",
+			Message: "Missing request body",
+		}, nil
+	}
+
+	start := time.Now()
+	result, err := h.tenantService.ChangeTier(ctx, req.TenantId, tenant.Tier(req.Body.Tier))
+	h.auditService.Record(ctx, &audit.Entry{
+		Action:      audit.ActionChangeTenantTier,
+		TenantID:    &req.TenantId,
+		OperationID: operationID(result),
+		Details:     map[string]any{"tier": req.Body.Tier},
+		Duration:    time.Since(start),
+	}, err)
+	if err != nil {
+		switch {
+		case errors.Is(err, tenant.ErrTenantNotFound):
+			return server.ChangeTenantTier404JSONResponse{
+				Error:   "tenant_not_found",
+				Message: "The specified tenant does not exist",
+			}, nil
+		case errors.Is(err, tenant.ErrInvalidTier):
+			return server.ChangeTenantTier400JSONResponse{
+				Error:   "invalid_tier",
+				Message: "Invalid tier specified",
+			}, nil
+		case errors.Is(err, tenant.ErrTenantNotActive):
+			return server.ChangeTenantTier409JSONResponse{
+				Error:   "tenant_not_active",
+				Message: "The tenant must be active to change its tier",
+			}, nil
+		case errors.Is(err, tenant.ErrTierUnchanged):
+			return server.ChangeTenantTier409JSONResponse{
+				Error:   "tier_unchanged",
+				Message: "The tenant is already on the requested tier",
+			}, nil
+		case errors.Is(err, tenant.ErrOperationInProgress):
+			return server.ChangeTenantTier409JSONResponse{
+				Error:   "operation_in_progress",
+				Message: "Another operation on the tenant is still in progress",
+			}, nil
+		case errors.Is(err, tenant.ErrTierTransitionNotAllowed):
+			return server.ChangeTenantTier409JSONResponse{
+				Error:   "tier_transition_not_allowed",
+				Message: "The tenant cannot move directly to the requested tier",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		default:
+			return server.ChangeTenantTier500JSONResponse{
+				Error:   "internal_error",
+				Message: "An internal error occurred",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		}
+	}
+
+	tenantID := req.TenantId
+	return server.ChangeTenantTier202JSONResponse{
+		Links: server.Links{
+			"self":   fmt.Sprintf("/operations/%d", result.OperationID),
+			"tenant": fmt.Sprintf("/tenants/%d", tenantID),
+		},
+		OperationId: result.OperationID,
+		Status:      server.OperationStatusPending,
+		TenantId:    &tenantID,
+	}, nil
+}
+
+// MigrateTenant handles requests to move a tenant to another region by delegating to
+// the tenant service. It initiates an asynchronous, pausable migration and returns the
+// operation that tracks it.
+func (h *TenantHandler) MigrateTenant(
+	ctx context.Context,
+	req server.MigrateTenantRequestObject,
+) (server.MigrateTenantResponseObject, error) {
+	if req.Body == nil {
+		return server.MigrateTenant400JSONResponse{
+			Error:   "invalid_request",
+			Message: "Missing request body",
+		}, nil
+	}
+
+	start := time.Now()
+	result, err := h.tenantService.Migrate(ctx, req.TenantId, tenant.Region(req.Body.Region))
+	h.auditService.Record(ctx, &audit.Entry{
+		Action:      audit.ActionMigrateTenant,
+		TenantID:    &req.TenantId,
+		OperationID: operationID(result),
+		Details:     map[string]any{"region": req.Body.Region},
+		Duration:    time.Since(start),
+	}, err)
+	if err != nil {
+		switch {
+		case errors.Is(err, tenant.ErrTenantNotFound):
+			return server.MigrateTenant404JSONResponse{
+				Error:   "tenant_not_found",
+				Message: "The specified tenant does not exist",
+			}, nil
+		case errors.Is(err, tenant.ErrInvalidRegion):
+			return server.MigrateTenant400JSONResponse{
+				Error:   "invalid_region",
+				Message: "Invalid region specified",
+			}, nil
+		case errors.Is(err, tenant.ErrTenantNotActive):
+			return server.MigrateTenant409JSONResponse{
+				Error:   "tenant_not_active",
+				Message: "The tenant must be active to be migrated",
+			}, nil
+		case errors.Is(err, tenant.ErrRegionUnchanged):
+			return server.MigrateTenant409JSONResponse{
+				Error:   "region_unchanged",
+				Message: "The tenant is already in the requested region",
+			}, nil
+		case errors.Is(err, tenant.ErrOperationInProgress):
+			return server.MigrateTenant409JSONResponse{
+				Error:   "operation_in_progress",
+				Message: "Another operation on the tenant is still in progress",
+			}, nil
+		case errors.Is(err, tenant.ErrTenantInIsolationGroup):
+			return server.MigrateTenant409JSONResponse{
+				Error:   "tenant_in_isolation_group",
+				Message: "The tenant must leave its isolation group before it can be migrated",
+			}, nil
+		default:
+			return server.MigrateTenant500JSONResponse{
+				Error:   "internal_error",
+				Message: "An internal error occurred",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		}
+	}
+
+	tenantID := req.TenantId
+	return server.MigrateTenant202JSONResponse{
+		Links: server.Links{
+			"self":   fmt.Sprintf("/operations/%d", result.OperationID),
+			"tenant": fmt.Sprintf("/tenants/%d", tenantID),
+		},
+		OperationId: result.OperationID,
+		Status:      server.OperationStatusPending,
+		TenantId:    &tenantID,
+	}, nil
+}
+
+// SuspendTenant handles requests to suspend an active tenant for a given reason.
+// The suspension runs asynchronously; the response identifies the operation to poll.
+func (h *TenantHandler) SuspendTenant(
+	ctx context.Context,
+	req server.SuspendTenantRequestObject,
+) (server.SuspendTenantResponseObject, error) {
+	if req.Body == nil || strings.TrimSpace(req.Body.Reason) == "" {
+		return server.SuspendTenant400JSONResponse{
+			Error:   "invalid_request",
+			Message: "A suspension reason is required",
+		}, nil
+	}
+
+	start := time.Now()
+	result, err := h.tenantService.Suspend(ctx, req.TenantId, req.Body.Reason, requestActor(ctx))
+	h.auditService.Record(ctx, &audit.Entry{
+		Action:      audit.ActionSuspendTenant,
+		TenantID:    &req.TenantId,
+		OperationID: operationID(result),
+		Details:     map[string]any{"reason": req.Body.Reason},
+		Duration:    time.Since(start),
+	}, err)
+	if err != nil {
+		switch {
+		case errors.Is(err, tenant.ErrTenantNotFound):
+			return server.SuspendTenant404JSONResponse{
+				Error:   "tenant_not_found",
+				Message: "The specified tenant does not exist",
+			}, nil
+		case errors.Is(err, tenant.ErrReasonRequired):
+			return server.SuspendTenant400JSONResponse{
+				Error:   "invalid_request",
+				Message: "A suspension reason is required",
+			}, nil
+		case errors.Is(err, tenant.ErrTenantAlreadySuspended):
+			return server.SuspendTenant409JSONResponse{
+				Error:   "tenant_already_suspended",
+				Message: "The tenant is already suspended",
+			}, nil
+		case errors.Is(err, tenant.ErrOperationInProgress):
+			return server.SuspendTenant409JSONResponse{
+				Error:   "operation_in_progress",
+				Message: "Another operation on the tenant is still in progress",
+			}, nil
+		case errors.Is(err, tenant.ErrTenantNotActive):
+			return server.SuspendTenant409JSONResponse{
+				Error:   "tenant_not_active",
+				Message: "The tenant must be active to be suspended",
+			}, nil
+		default:
+			return server.SuspendTenant500JSONResponse{
+				Error:   "internal_error",
+				Message: "An internal error occurred",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		}
+	}
+
+	tenantID := req.TenantId
+	return server.SuspendTenant202JSONResponse{
+		Links: server.Links{
+			"self":   fmt.Sprintf("/operations/%d", result.OperationID),
+			"tenant": fmt.Sprintf("/tenants/%d", tenantID),
+		},
+		OperationId: result.OperationID,
+		Status:      server.OperationStatusPending,
+		TenantId:    &tenantID,
+	}, nil
+}
+
+// ResumeTenant handles requests to resume a suspended tenant for a given reason.
+// The resumption runs asynchronously; the response identifies the operation to poll.
+func (h *TenantHandler) ResumeTenant(
+	ctx context.Context,
+	req server.ResumeTenantRequestObject,
+) (server.ResumeTenantResponseObject, error) {
+	if req.Body == nil || strings.TrimSpace(req.Body.Reason) == "" {
+		return server.ResumeTenant400JSONResponse{
+			Error:   "invalid_request",
+			Message: "A resumption reason is required",
+		}, nil
+	}
+
+	start := time.Now()
+	result, err := h.tenantService.Resume(ctx, req.TenantId, req.Body.Reason, requestActor(ctx))
+	h.auditService.Record(ctx, &audit.Entry{
+		Action:      audit.ActionResumeTenant,
+		TenantID:    &req.TenantId,
+		OperationID: operationID(result),
+		Details:     map[string]any{"reason": req.Body.Reason},
+		Duration:    time.Since(start),
+	}, err)
+	if err != nil {
+		switch {
+		case errors.Is(err, tenant.ErrTenantNotFound):
+			return server.ResumeTenant404JSONResponse{
+				Error:   "tenant_not_found",
+				Message: "The specified tenant does not exist",
+			}, nil
+		case errors.Is(err, tenant.ErrReasonRequired):
+			return server.ResumeTenant400JSONResponse{
+				Error:   "invalid_request",
+				Message: "A resumption reason is required",
+			}, nil
+		case errors.Is(err, tenant.ErrOperationInProgress):
+			return server.ResumeTenant409JSONResponse{
+				Error:   "operation_in_progress",
+				Message: "Another operation on the tenant is still in progress",
+			}, nil
+		case errors.Is(err, tenant.ErrTenantNotSuspended):
+			return server.ResumeTenant409JSONResponse{
+				Error:   "tenant_not_suspended",
+				Message: "The tenant is not suspended",
+			}, nil
+		default:
+			return server.ResumeTenant500JSONResponse{
+				Error:   "internal_error",
+				Message: "An internal error occurred",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		}
+	}
+
+	tenantID := req.TenantId
+	return server.ResumeTenant202JSONResponse{
+		Links: server.Links{
+			"self":   fmt.Sprintf("/operations/%d", result.OperationID),
+			"tenant": fmt.Sprintf("/tenants/%d", tenantID),
+		},
+		OperationId: result.OperationID,
+		Status:      server.OperationStatusPending,
 		TenantId:    &tenantID,
 	}, nil
 }
+
+// ListTenantSuspensions handles requests for a tenant's suspension history.
+func (h *TenantHandler) ListTenantSuspensions(
+	ctx context.Context,
+	req server.ListTenantSuspensionsRequestObject,
+) (server.ListTenantSuspensionsResponseObject, error) {
+	suspensions, err := h.tenantService.Suspensions(ctx, req.TenantId)
+	if err != nil {
+		switch {
+		case errors.Is(err, tenant.ErrTenantNotFound):
+			return server.ListTenantSuspensions404JSONResponse{
+				Error:   "tenant_not_found",
+				Message: "The specified tenant does not exist",
+			}, nil
+		default:
+			return server.ListTenantSuspensions500JSONResponse{
+				Error:   "internal_error",
+				Message: "An internal error occurred",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		}
+	}
+
+	resp := server.ListTenantSuspensions200JSONResponse{
+		Suspensions: make([]server.TenantSuspension, 0, len(suspensions)),
+		Links: server.Links{
+			"self":   fmt.Sprintf("/tenants/%d/suspensions", req.TenantId),
+			"tenant": fmt.Sprintf("/tenants/%d", req.TenantId),
+		},
+	}
+	for _, s := range suspensions {
+		resp.Suspensions = append(resp.Suspensions, server.TenantSuspension{
+			Id:                 s.ID,
+			Reason:             s.Reason,
+			SuspendedBy:        s.SuspendedBy,
+			SuspendedAt:        s.SuspendedAt,
+			SuspendOperationId: s.SuspendOperationID,
+			ResumedAt:          s.ResumedAt,
+			ResumedBy:          s.ResumedBy,
+			ResumeReason:       s.ResumeReason,
+			ResumeOperationId:  s.ResumeOperationID,
+		})
+	}
+
+	return resp, nil
+}
+
+// GetTenant handles requests for a single tenant by delegating to the tenant service
+// and mapping the domain tenant to its API representation.
+func (h *TenantHandler) GetTenant(ctx context.Context, req server.GetTenantRequestObject) (server.GetTenantResponseObject, error) {
+	t, err := h.tenantService.Get(ctx, req.TenantId)
+	if err != nil {
+		switch {
+		case errors.Is(err, tenant.ErrTenantNotFound):
+			return server.GetTenant404JSONResponse{
+				Error:   "tenant_not_found",
+				Message: "The specified tenant does not exist",
+			}, nil
+		default:
+			return server.GetTenant500JSONResponse{
+				Error:   "internal_error",
+				Message: "An internal error occurred",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		}
+	}
+
+	return server.GetTenant200JSONResponse(toAPITenant(t)), nil
+}
+
+// ListTenants handles tenant listing requests by translating query parameters into
+// a filtered, sorted page request. The response carries an opaque cursor for the
+// next page, which the client passes back together with the same sort and order.
+func (h *TenantHandler) ListTenants(ctx context.Context, req server.ListTenantsRequestObject) (server.ListTenantsResponseObject, error) {
+	params := appTenant.ListParams{}
+	if req.Params.Region != nil {
+		region := tenant.Region(*req.Params.Region)
+		params.Filter.Region = &region
+	}
+	if req.Params.Tier != nil {
+		tier := tenant.Tier(*req.Params.Tier)
+		params.Filter.Tier = &tier
+	}
+	if req.Params.Status != nil {
+		status := tenant.Status(*req.Params.Status)
+		params.Filter.Status = &status
+	}
+	params.Filter.IsolationGroupID = req.Params.IsolationGroupId
+	if req.Params.NamePrefix != nil {
+		params.Filter.NamePrefix = *req.Params.NamePrefix
+	}
+	if req.Params.Sort != nil {
+		params.SortBy = tenant.SortField(*req.Params.Sort)
+	}
+	if req.Params.Order != nil {
+		params.Order = tenant.SortOrder(*req.Params.Order)
+	}
+	if req.Params.Cursor != nil {
+		params.Cursor = *req.Params.Cursor
+	}
+	if req.Params.Limit != nil {
+		params.Limit = *req.Params.Limit
+	}
+
+	result, err := h.tenantService.List(ctx, params)
+	if err != nil {
+		switch {
+		case errors.Is(err, tenant.ErrInvalidListParams):
+			return server.ListTenants400JSONResponse{
+				Error:   "invalid_list_parameters",
+				Message: "Invalid filter, sort or cursor specified",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		default:
+			return server.ListTenants500JSONResponse{
+				Error:   "internal_error",
+				Message: "An internal error occurred",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		}
+	}
+
+	tenants := make([]server.TenantResponse, 0, len(result.Tenants))
+	for _, t := range result.Tenants {
+		tenants = append(tenants, toAPITenant(t))
+	}
+
+	links := server.Links{"self": "/tenants"}
+	var nextCursor *string
+	if result.NextCursor != "" {
+		nextCursor = &result.NextCursor
+		links["next"] = "/tenants?" + nextTenantsQuery(req.Params, result.NextCursor).Encode()
+	}
+
+	return server.ListTenants200JSONResponse{
+		Links:      links,
+		NextCursor: nextCursor,
+		Tenants:    tenants,
+	}, nil
+}
+
+// nextTenantsQuery rebuilds the query string of a tenant listing with the cursor
+// advanced to the next page.
+func nextTenantsQuery(params server.ListTenantsParams, cursor string) url.Values {
+	q := url.Values{}
+	if params.Region != nil {
+		q.Set("region", string(*params.Region))
+	}
+	if params.Tier != nil {
+		q.Set("tier", string(*params.Tier))
+	}
+	if params.Status != nil {
+		q.Set("status", string(*params.Status))
+	}
+	if params.IsolationGroupId != nil {
+		q.Set("isolation_group_id", strconv.FormatInt(*params.IsolationGroupId, 10))
+	}
+	if params.NamePrefix != nil {
+		q.Set("name_prefix", *params.NamePrefix)
+	}
+	if params.Sort != nil {
+		q.Set("sort", string(*params.Sort))
+	}
+	if params.Order != nil {
+		q.Set("order", string(*params.Order))
+	}
+	if params.Limit != nil {
+		q.Set("limit", strconv.Itoa(*params.Limit))
+	}
+	q.Set("cursor", cursor)
+	return q
+}
+
+// toAPITenant maps a domain tenant to its API representation.
+func toAPITenant(t *tenant.Tenant) server.TenantResponse {
+	resp := server.TenantResponse{
+		Links: server.Links{
+			"self":        fmt.Sprintf("/tenants/%d", t.ID),
+			"operations":  fmt.Sprintf("/tenants/%d/operations", t.ID),
+			"suspensions": fmt.Sprintf("/tenants/%d/suspensions", t.ID),
+		},
+		CreatedAt:        t.CreatedAt,
+		Id:               t.ID,
+		IsolationGroupId: t.IsolationGroupID,
+		Name:             t.Name,
+		Region:           server.Region(t.Region),
+		Status:           server.TenantStatus(t.Status),
+		Tier:             server.TenantResponseTier(t.Tier),
+		UpdatedAt:        t.UpdatedAt,
+	}
+	if t.IsolationGroupID != nil {
+		resp.Links["isolation_group"] = fmt.Sprintf("/isolation-groups/%d", *t.IsolationGroupID)
+	}
+	return resp
+}
+
+// operationID returns the ID of the operation a tenant request started, or nil if
+// the request failed before starting one.
+func operationID(result *appTenant.OperationResult) *int64 {
+	if result == nil {
+		return nil
+	}
+	return &result.OperationID
+}
+
+// hasErrCode reports whether err is an application error with the given code.
+func hasErrCode(err error, code errs.ErrCode) bool {
+	var appErr *errs.Error
+	return errors.As(err, &appErr) && appErr.Code.Equal(code)
+}
@@ -0,0 +1,459 @@
+package httphandler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/ahrav/hoglet-hub/api/v1/server"
+	appAudit "github.com/ahrav/hoglet-hub/internal/application/audit"
+	appWebhook "github.com/ahrav/hoglet-hub/internal/application/webhook"
+	"github.com/ahrav/hoglet-hub/internal/domain/audit"
+	"github.com/ahrav/hoglet-hub/internal/domain/outbox"
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+	"github.com/ahrav/hoglet-hub/internal/domain/webhook"
+)
+
+// WebhookHandler implements the webhook subscription and delivery log API
+// endpoints. Changes to subscriptions are recorded in the audit trail.
+type WebhookHandler struct {
+	webhookService *appWebhook.Service
+	auditService   *appAudit.Service
+}
+
+// NewWebhookHandler creates a new webhook handler with the provided services.
+func NewWebhookHandler(webhookService *appWebhook.Service, auditService *appAudit.Service) *WebhookHandler {
+	return &WebhookHandler{webhookService: webhookService, auditService: auditService}
+}
+
+// CreateWebhookSubscription handles requests to subscribe an endpoint to lifecycle events.
+func (h *WebhookHandler) CreateWebhookSubscription(
+	ctx context.Context,
+	req server.CreateWebhookSubscriptionRequestObject,
+) (server.CreateWebhookSubscriptionResponseObject, error) {
+	if req.Body == nil {
+		return server.CreateWebhookSubscription400JSONResponse{
+			Error:   "invalid_request",
+			Message: "Missing request body",
+		}, nil
+	}
+
+	params := appWebhook.CreateParams{URL: req.Body.Url, TenantID: req.Body.TenantId}
+	if req.Body.Secret != nil {
+		params.Secret = *req.Body.Secret
+	}
+	if req.Body.EventTypes != nil {
+		params.EventTypes = fromAPIEventTypes(*req.Body.EventTypes)
+	}
+	if req.Body.Description != nil {
+		params.Description = *req.Body.Description
+	}
+
+	start := time.Now()
+	sub, err := h.webhookService.CreateSubscription(ctx, params, requestActor(ctx))
+	details := map[string]any{"url": params.URL, "event_types": params.EventTypes}
+	if sub != nil {
+		details["subscription_id"] = sub.ID
+	}
+	h.auditService.Record(ctx, &audit.Entry{
+		Action:   audit.ActionCreateWebhookSubscription,
+		TenantID: params.TenantID,
+		Details:  details,
+		Duration: time.Since(start),
+	}, err)
+	if err != nil {
+		if resp, ok := invalidSubscription(err); ok {
+			return server.CreateWebhookSubscription400JSONResponse(resp), nil
+		}
+		switch {
+		case errors.Is(err, tenant.ErrTenantNotFound):
+			return server.CreateWebhookSubscription404JSONResponse{
+				Error:   "tenant_not_found",
+				Message: "The specified tenant does not exist",
+			}, nil
+		default:
+			return server.CreateWebhookSubscription500JSONResponse{
+				Error:   "internal_error",
+				Message: "An internal error occurred",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		}
+	}
+
+	resp := toAPIWebhookSubscription(sub)
+	resp.Secret = &sub.Secret
+	return server.CreateWebhookSubscription201JSONResponse(resp), nil
+}
+
+// GetWebhookSubscription handles requests for a single webhook subscription.
+func (h *WebhookHandler) GetWebhookSubscription(
+	ctx context.Context,
+	req server.GetWebhookSubscriptionRequestObject,
+) (server.GetWebhookSubscriptionResponseObject, error) {
+	sub, err := h.webhookService.GetSubscription(ctx, req.SubscriptionId)
+	if err != nil {
+		switch {
+		case errors.Is(err, webhook.ErrSubscriptionNotFound):
+			return server.GetWebhookSubscription404JSONResponse{
+				Error:   "webhook_subscription_not_found",
+				Message: "The specified webhook subscription does not exist",
+			}, nil
+		default:
+			return server.GetWebhookSubscription500JSONResponse{
+				Error:   "internal_error",
+				Message: "An internal error occurred",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		}
+	}
+
+	return server.GetWebhookSubscription200JSONResponse(toAPIWebhookSubscription(sub)), nil
+}
+
+// ListWebhookSubscriptions handles requests to list webhook subscriptions,
+// optionally only those for a tenant.
+func (h *WebhookHandler) ListWebhookSubscriptions(
+	ctx context.Context,
+	req server.ListWebhookSubscriptionsRequestObject,
+) (server.ListWebhookSubscriptionsResponseObject, error) {
+	subs, err := h.webhookService.ListSubscriptions(ctx, req.Params.TenantId)
+	if err != nil {
+		return server.ListWebhookSubscriptions500JSONResponse{
+			Error:   "internal_error",
+			Message: "An internal error occurred",
+			Details: &map[string]any{
+				"error": err.Error(),
+			},
+		}, nil
+	}
+
+	resp := server.ListWebhookSubscriptions200JSONResponse{
+		Subscriptions: make([]server.WebhookSubscriptionResponse, 0, len(subs)),
+		Links:         server.Links{"self": "/webhooks"},
+	}
+	for _, sub := range subs {
+		resp.Subscriptions = append(resp.Subscriptions, toAPIWebhookSubscription(sub))
+	}
+
+	return resp, nil
+}
+
+// UpdateWebhookSubscription handles requests to change a webhook subscription.
+func (h *WebhookHandler) UpdateWebhookSubscription(
+	ctx context.Context,
+	req server.UpdateWebhookSubscriptionRequestObject,
+) (server.UpdateWebhookSubscriptionResponseObject, error) {
+	if req.Body == nil {
+		return server.UpdateWebhookSubscription400JSONResponse{
+			Error:   "invalid_request",
+			Message: "Missing request body",
+		}, nil
+	}
+
+	update := webhook.Update{
+		URL:         req.Body.Url,
+		Description: req.Body.Description,
+		Active:      req.Body.Active,
+	}
+	details := map[string]any{"subscription_id": req.SubscriptionId}
+	if req.Body.Url != nil {
+		details["url"] = *req.Body.Url
+	}
+	if req.Body.EventTypes != nil {
+		eventTypes := fromAPIEventTypes(*req.Body.EventTypes)
+		update.EventTypes = &eventTypes
+		details["event_types"] = eventTypes
+	}
+	if req.Body.Active != nil {
+		details["active"] = *req.Body.Active
+	}
+
+	start := time.Now()
+	sub, err := h.webhookService.UpdateSubscription(ctx, req.SubscriptionId, update)
+	entry := &audit.Entry{
+		Action:   audit.ActionUpdateWebhookSubscription,
+		Details:  details,
+		Duration: time.Since(start),
+	}
+	if sub != nil {
+		entry.TenantID = sub.TenantID
+	}
+	h.auditService.Record(ctx, entry, err)
+	if err != nil {
+		if resp, ok := invalidSubscription(err); ok {
+			return server.UpdateWebhookSubscription400JSONResponse(resp), nil
+		}
+		switch {
+		case errors.Is(err, webhook.ErrSubscriptionNotFound):
+			return server.UpdateWebhookSubscription404JSONResponse{
+				Error:   "webhook_subscription_not_found",
+				Message: "The specified webhook subscription does not exist",
+			}, nil
+		default:
+			return server.UpdateWebhookSubscription500JSONResponse{
+				Error:   "internal_error",
+				Message: "An internal error occurred",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		}
+	}
+
+	return server.UpdateWebhookSubscription200JSONResponse(toAPIWebhookSubscription(sub)), nil
+}
+
+// DeleteWebhookSubscription handles requests to delete a webhook subscription
+// and its delivery log.
+func (h *WebhookHandler) DeleteWebhookSubscription(
+	ctx context.Context,
+	req server.DeleteWebhookSubscriptionRequestObject,
+) (server.DeleteWebhookSubscriptionResponseObject, error) {
+	start := time.Now()
+	err := h.webhookService.DeleteSubscription(ctx, req.SubscriptionId)
+	h.auditService.Record(ctx, &audit.Entry{
+		Action:   audit.ActionDeleteWebhookSubscription,
+		Details:  map[string]any{"subscription_id": req.SubscriptionId},
+		Duration: time.Since(start),
+	}, err)
+	if err != nil {
+		switch {
+		case errors.Is(err, webhook.ErrSubscriptionNotFound):
+			return server.DeleteWebhookSubscription404JSONResponse{
+				Error:   "webhook_subscription_not_found",
+				Message: "The specified webhook subscription does not exist",
+			}, nil
+		default:
+			return server.DeleteWebhookSubscription500JSONResponse{
+				Error:   "internal_error",
+				Message: "An internal error occurred",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		}
+	}
+
+	return server.DeleteWebhookSubscription204Response{}, nil
+}
+
+// ListWebhookDeliveries handles requests for a page of a subscription's
+// delivery log, newest first.
+func (h *WebhookHandler) ListWebhookDeliveries(
+	ctx context.Context,
+	req server.ListWebhookDeliveriesRequestObject,
+) (server.ListWebhookDeliveriesResponseObject, error) {
+	params := appWebhook.DeliveryListParams{SubscriptionID: req.SubscriptionId}
+	if req.Params.Status != nil {
+		status := webhook.DeliveryStatus(*req.Params.Status)
+		params.Status = &status
+	}
+	if req.Params.Cursor != nil {
+		params.Cursor = *req.Params.Cursor
+	}
+	if req.Params.Limit != nil {
+		params.Limit = *req.Params.Limit
+	}
+
+	result, err := h.webhookService.ListDeliveries(ctx, params)
+	if err != nil {
+		switch {
+		case errors.Is(err, webhook.ErrInvalidListParams):
+			return server.ListWebhookDeliveries400JSONResponse{
+				Error:   "invalid_list_parameters",
+				Message: "Invalid filter or cursor specified",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		case errors.Is(err, webhook.ErrSubscriptionNotFound):
+			return server.ListWebhookDeliveries404JSONResponse{
+				Error:   "webhook_subscription_not_found",
+				Message: "The specified webhook subscription does not exist",
+			}, nil
+		default:
+			return server.ListWebhookDeliveries500JSONResponse{
+				Error:   "internal_error",
+				Message: "An internal error occurred",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		}
+	}
+
+	deliveries := make([]server.WebhookDelivery, 0, len(result.Deliveries))
+	for _, d := range result.Deliveries {
+		deliveries = append(deliveries, toAPIWebhookDelivery(d))
+	}
+
+	self := fmt.Sprintf("/webhooks/%d/deliveries", req.SubscriptionId)
+	links := server.Links{"self": self}
+	var nextCursor *string
+	if result.NextCursor != "" {
+		nextCursor = &result.NextCursor
+		links["next"] = self + "?" + nextDeliveryQuery(req.Params, result.NextCursor).Encode()
+	}
+
+	return server.ListWebhookDeliveries200JSONResponse{
+		Deliveries: deliveries,
+		Links:      links,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// RedeliverWebhookDelivery handles requests to send a finished delivery again.
+func (h *WebhookHandler) RedeliverWebhookDelivery(
+	ctx context.Context,
+	req server.RedeliverWebhookDeliveryRequestObject,
+) (server.RedeliverWebhookDeliveryResponseObject, error) {
+	start := time.Now()
+	d, err := h.webhookService.Redeliver(ctx, req.SubscriptionId, req.DeliveryId)
+	entry := &audit.Entry{
+		Action: audit.ActionRedeliverWebhook,
+		Details: map[string]any{
+			"subscription_id": req.SubscriptionId,
+			"delivery_id":     req.DeliveryId,
+		},
+		Duration: time.Since(start),
+	}
+	if d != nil {
+		entry.TenantID = d.TenantID
+	}
+	h.auditService.Record(ctx, entry, err)
+	if err != nil {
+		switch {
+		case errors.Is(err, webhook.ErrDeliveryNotFound):
+			return server.RedeliverWebhookDelivery404JSONResponse{
+				Error:   "webhook_delivery_not_found",
+				Message: "The specified webhook delivery does not exist",
+			}, nil
+		case errors.Is(err, webhook.ErrDeliveryPending):
+			return server.RedeliverWebhookDelivery409JSONResponse{
+				Error:   "webhook_delivery_pending",
+				Message: "The delivery is still pending",
+			}, nil
+		default:
+			return server.RedeliverWebhookDelivery500JSONResponse{
+				Error:   "internal_error",
+				Message: "An internal error occurred",
+				Details: &map[string]any{
+					"error": err.Error(),
+				},
+			}, nil
+		}
+	}
+
+	return server.RedeliverWebhookDelivery202JSONResponse(toAPIWebhookDelivery(d)), nil
+}
+
+// invalidSubscription maps a subscription validation error to a 400 response body.
+func invalidSubscription(err error) (server.Error, bool) {
+	switch {
+	case errors.Is(err, webhook.ErrInvalidURL):
+		return server.Error{
+			Error:   "invalid_webhook_url",
+			Message: "Webhook URL must be an absolute http or https URL",
+		}, true
+	case errors.Is(err, webhook.ErrInvalidEventType):
+		return server.Error{
+			Error:   "invalid_webhook_event_type",
+			Message: "Invalid webhook event type specified",
+			Details: &map[string]any{
+				"error": err.Error(),
+			},
+		}, true
+	case errors.Is(err, webhook.ErrInvalidSecret):
+		return server.Error{
+			Error:   "invalid_webhook_secret",
+			Message: fmt.Sprintf("Webhook secret must be %d to %d characters long", webhook.MinSecretLength, webhook.MaxSecretLength),
+		}, true
+	default:
+		return server.Error{}, false
+	}
+}
+
+// nextDeliveryQuery rebuilds the query string of a delivery listing with the
+// cursor advanced to the next page.
+func nextDeliveryQuery(params server.ListWebhookDeliveriesParams, cursor string) url.Values {
+	q := url.Values{}
+	if params.Status != nil {
+		q.Set("status", string(*params.Status))
+	}
+	if params.Limit != nil {
+		q.Set("limit", strconv.Itoa(*params.Limit))
+	}
+	q.Set("cursor", cursor)
+	return q
+}
+
+// fromAPIEventTypes maps API event types to domain event types.
+func fromAPIEventTypes(types []server.WebhookEventType) []outbox.EventType {
+	eventTypes := make([]outbox.EventType, 0, len(types))
+	for _, t := range types {
+		eventTypes = append(eventTypes, outbox.EventType(t))
+	}
+	return eventTypes
+}
+
+// toAPIWebhookSubscription maps a domain subscription to its API representation
+// without its secret.
+func toAPIWebhookSubscription(sub *webhook.Subscription) server.WebhookSubscriptionResponse {
+	eventTypes := make([]server.WebhookEventType, 0, len(sub.EventTypes))
+	for _, t := range sub.EventTypes {
+		eventTypes = append(eventTypes, server.WebhookEventType(t))
+	}
+
+	return server.WebhookSubscriptionResponse{
+		Links: server.Links{
+			"self":       fmt.Sprintf("/webhooks/%d", sub.ID),
+			"deliveries": fmt.Sprintf("/webhooks/%d/deliveries", sub.ID),
+		},
+		Active:      sub.Active,
+		CreatedAt:   sub.CreatedAt,
+		CreatedBy:   sub.CreatedBy,
+		Description: sub.Description,
+		EventTypes:  eventTypes,
+		Id:          sub.ID,
+		TenantId:    sub.TenantID,
+		UpdatedAt:   sub.UpdatedAt,
+		Url:         sub.URL,
+	}
+}
+
+// toAPIWebhookDelivery maps a domain delivery to its API representation.
+func toAPIWebhookDelivery(d *webhook.Delivery) server.WebhookDelivery {
+	var payload map[string]any
+	if err := json.Unmarshal(d.Payload, &payload); err != nil {
+		payload = map[string]any{}
+	}
+
+	return server.WebhookDelivery{
+		Links: server.Links{
+			"self":         fmt.Sprintf("/webhooks/%d/deliveries/%d", d.SubscriptionID, d.ID),
+			"subscription": fmt.Sprintf("/webhooks/%d", d.SubscriptionID),
+		},
+		Attempts:       d.Attempts,
+		CreatedAt:      d.CreatedAt,
+		DeliveredAt:    d.DeliveredAt,
+		EventId:        d.EventID,
+		EventType:      server.WebhookEventType(d.EventType),
+		Id:             d.ID,
+		LastAttemptAt:  d.LastAttemptAt,
+		LastError:      d.LastError,
+		NextAttemptAt:  d.NextAttemptAt,
+		Payload:        payload,
+		ResponseStatus: d.ResponseStatus,
+		Status:         server.WebhookDeliveryStatus(d.Status),
+		SubscriptionId: d.SubscriptionID,
+		TenantId:       d.TenantID,
+	}
+}
@@ -3,9 +3,13 @@ package http
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"net/http"
+	"slices"
 
 	"github.com/ahrav/hoglet-hub/api/v1/server"
+	"github.com/ahrav/hoglet-hub/internal/application/sdk/errs"
 	handler "github.com/ahrav/hoglet-hub/internal/infra/adapters/http/handler"
 )
 
@@ -13,41 +17,365 @@ import (
 // to the appropriate domain-specific handlers. It serves as an adapter between
 // the generated API server and our business logic handlers.
 type ServerAdapter struct {
-	tenantHandler    *handler.TenantHandler
-	operationHandler *handler.OperationHandler
+	tenantHandler         *handler.TenantHandler
+	operationHandler      *handler.OperationHandler
+	isolationGroupHandler *handler.IsolationGroupHandler
+	dbNodeHandler         *handler.DatabaseNodeHandler
+	resourceHandler       *handler.ResourceHandler
+	auditHandler          *handler.AuditHandler
+	webhookHandler        *handler.WebhookHandler
 }
 
 // NewServerAdapter creates a new server adapter with the provided handlers.
 // This constructor ensures all required handlers are properly initialized.
-func NewServerAdapter(tenantHandler *handler.TenantHandler, operationHandler *handler.OperationHandler) *ServerAdapter {
+func NewServerAdapter(
+	tenantHandler *handler.TenantHandler,
+	operationHandler *handler.OperationHandler,
+	isolationGroupHandler *handler.IsolationGroupHandler,
+	dbNodeHandler *handler.DatabaseNodeHandler,
+	resourceHandler *handler.ResourceHandler,
+	auditHandler *handler.AuditHandler,
+	webhookHandler *handler.WebhookHandler,
+) *ServerAdapter {
 	return &ServerAdapter{
-		tenantHandler:    tenantHandler,
-		operationHandler: operationHandler,
+		tenantHandler:         tenantHandler,
+		operationHandler:      operationHandler,
+		isolationGroupHandler: isolationGroupHandler,
+		dbNodeHandler:         dbNodeHandler,
+		resourceHandler:       resourceHandler,
+		auditHandler:          auditHandler,
+		webhookHandler:        webhookHandler,
 	}
 }
 
+// ListOperations delegates operation listing requests to the specialized operation handler.
+// It implements part of the StrictServerInterface contract.
+func (a *ServerAdapter) ListOperations(ctx context.Context, req server.ListOperationsRequestObject) (server.ListOperationsResponseObject, error) {
+	return a.operationHandler.ListOperations(ctx, req)
+}
+
 // GetOperation delegates operation retrieval requests to the specialized operation handler.
 // It implements part of the StrictServerInterface contract.
 func (a *ServerAdapter) GetOperation(ctx context.Context, req server.GetOperationRequestObject) (server.GetOperationResponseObject, error) {
 	return a.operationHandler.GetOperation(ctx, req)
 }
 
+// CancelOperation delegates operation cancellation requests to the specialized operation handler.
+// It implements part of the StrictServerInterface contract.
+func (a *ServerAdapter) CancelOperation(ctx context.Context, req server.CancelOperationRequestObject) (server.CancelOperationResponseObject, error) {
+	return a.operationHandler.CancelOperation(ctx, req)
+}
+
+// RetryOperation delegates operation retry requests to the specialized operation handler.
+// It implements part of the StrictServerInterface contract.
+func (a *ServerAdapter) RetryOperation(ctx context.Context, req server.RetryOperationRequestObject) (server.RetryOperationResponseObject, error) {
+	return a.operationHandler.RetryOperation(ctx, req)
+}
+
+// StreamOperationEvents delegates operation event stream requests to the specialized operation handler.
+// It implements part of the StrictServerInterface contract.
+func (a *ServerAdapter) StreamOperationEvents(
+	ctx context.Context,
+	req server.StreamOperationEventsRequestObject,
+) (server.StreamOperationEventsResponseObject, error) {
+	return a.operationHandler.StreamOperationEvents(ctx, req)
+}
+
+// PauseOperation delegates operation pause requests to the specialized operation handler.
+// It implements part of the StrictServerInterface contract.
+func (a *ServerAdapter) PauseOperation(ctx context.Context, req server.PauseOperationRequestObject) (server.PauseOperationResponseObject, error) {
+	return a.operationHandler.PauseOperation(ctx, req)
+}
+
+// ResumeOperation delegates paused operation resume requests to the specialized operation handler.
+// It implements part of the StrictServerInterface contract.
+func (a *ServerAdapter) ResumeOperation(ctx context.Context, req server.ResumeOperationRequestObject) (server.ResumeOperationResponseObject, error) {
+	return a.operationHandler.ResumeOperation(ctx, req)
+}
+
 // CreateTenant delegates tenant creation requests to the specialized tenant handler.
 // It implements part of the StrictServerInterface contract.
 func (a *ServerAdapter) CreateTenant(ctx context.Context, req server.CreateTenantRequestObject) (server.CreateTenantResponseObject, error) {
 	return a.tenantHandler.CreateTenant(ctx, req)
 }
 
+// ListTenants delegates tenant listing requests to the specialized tenant handler.
+// It implements part of the StrictServerInterface contract.
+func (a *ServerAdapter) ListTenants(ctx context.Context, req server.ListTenantsRequestObject) (server.ListTenantsResponseObject, error) {
+	return a.tenantHandler.ListTenants(ctx, req)
+}
+
+// GetTenant delegates tenant retrieval requests to the specialized tenant handler.
+// It implements part of the StrictServerInterface contract.
+func (a *ServerAdapter) GetTenant(ctx context.Context, req server.GetTenantRequestObject) (server.GetTenantResponseObject, error) {
+	return a.tenantHandler.GetTenant(ctx, req)
+}
+
+// ListTenantOperations delegates requests for a tenant's operations to the specialized operation handler.
+// It implements part of the StrictServerInterface contract.
+func (a *ServerAdapter) ListTenantOperations(
+	ctx context.Context,
+	req server.ListTenantOperationsRequestObject,
+) (server.ListTenantOperationsResponseObject, error) {
+	return a.operationHandler.ListTenantOperations(ctx, req)
+}
+
 // DeleteTenant delegates tenant deletion requests to the specialized tenant handler.
 // It implements part of the StrictServerInterface contract.
 func (a *ServerAdapter) DeleteTenant(ctx context.Context, req server.DeleteTenantRequestObject) (server.DeleteTenantResponseObject, error) {
 	return a.tenantHandler.DeleteTenant(ctx, req)
 }
 
+// ChangeTenantTier delegates tenant tier change requests to the specialized tenant handler.
+// It implements part of the StrictServerInterface contract.
+func (a *ServerAdapter) ChangeTenantTier(ctx context.Context, req server.ChangeTenantTierRequestObject) (server.ChangeTenantTierResponseObject, error) {
+	return a.tenantHandler.ChangeTenantTier(ctx, req)
+}
+
+// MigrateTenant delegates tenant migration requests to the specialized tenant handler.
+// It implements part of the StrictServerInterface contract.
+func (a *ServerAdapter) MigrateTenant(ctx context.Context, req server.MigrateTenantRequestObject) (server.MigrateTenantResponseObject, error) {
+	return a.tenantHandler.MigrateTenant(ctx, req)
+}
+
+// SuspendTenant delegates tenant suspension requests to the specialized tenant handler.
+// It implements part of the StrictServerInterface contract.
+func (a *ServerAdapter) SuspendTenant(ctx context.Context, req server.SuspendTenantRequestObject) (server.SuspendTenantResponseObject, error) {
+	return a.tenantHandler.SuspendTenant(ctx, req)
+}
+
+// ResumeTenant delegates tenant resumption requests to the specialized tenant handler.
+// It implements part of the StrictServerInterface contract.
+func (a *ServerAdapter) ResumeTenant(ctx context.Context, req server.ResumeTenantRequestObject) (server.ResumeTenantResponseObject, error) {
+	return a.tenantHandler.ResumeTenant(ctx, req)
+}
+
+// ListTenantSuspensions delegates suspension history requests to the specialized tenant handler.
+// It implements part of the StrictServerInterface contract.
+func (a *ServerAdapter) ListTenantSuspensions(
+	ctx context.Context,
+	req server.ListTenantSuspensionsRequestObject,
+) (server.ListTenantSuspensionsResponseObject, error) {
+	return a.tenantHandler.ListTenantSuspensions(ctx, req)
+}
+
+// ChangeTenantIsolationGroup delegates requests to move a tenant between isolation groups
+// to the specialized isolation group handler.
+// It implements part of the StrictServerInterface contract.
+func (a *ServerAdapter) ChangeTenantIsolationGroup(
+	ctx context.Context,
+	req server.ChangeTenantIsolationGroupRequestObject,
+) (server.ChangeTenantIsolationGroupResponseObject, error) {
+	return a.isolationGroupHandler.ChangeTenantIsolationGroup(ctx, req)
+}
+
+// ListIsolationGroups delegates isolation group listing requests to the specialized isolation group handler.
+// It implements part of the StrictServerInterface contract.
+func (a *ServerAdapter) ListIsolationGroups(
+	ctx context.Context,
+	req server.ListIsolationGroupsRequestObject,
+) (server.ListIsolationGroupsResponseObject, error) {
+	return a.isolationGroupHandler.ListIsolationGroups(ctx, req)
+}
+
+// CreateIsolationGroup delegates isolation group creation requests to the specialized isolation group handler.
+// It implements part of the StrictServerInterface contract.
+func (a *ServerAdapter) CreateIsolationGroup(
+	ctx context.Context,
+	req server.CreateIsolationGroupRequestObject,
+) (server.CreateIsolationGroupResponseObject, error) {
+	return a.isolationGroupHandler.CreateIsolationGroup(ctx, req)
+}
+
+// GetIsolationGroup delegates isolation group retrieval requests to the specialized isolation group handler.
+// It implements part of the StrictServerInterface contract.
+func (a *ServerAdapter) GetIsolationGroup(
+	ctx context.Context,
+	req server.GetIsolationGroupRequestObject,
+) (server.GetIsolationGroupResponseObject, error) {
+	return a.isolationGroupHandler.GetIsolationGroup(ctx, req)
+}
+
+// DeleteIsolationGroup delegates isolation group deletion requests to the specialized isolation group handler.
+// It implements part of the StrictServerInterface contract.
+func (a *ServerAdapter) DeleteIsolationGroup(
+	ctx context.Context,
+	req server.DeleteIsolationGroupRequestObject,
+) (server.DeleteIsolationGroupResponseObject, error) {
+	return a.isolationGroupHandler.DeleteIsolationGroup(ctx, req)
+}
+
+// ListDatabaseNodes delegates database node listing requests to the specialized database node handler.
+// It implements part of the StrictServerInterface contract.
+func (a *ServerAdapter) ListDatabaseNodes(
+	ctx context.Context,
+	req server.ListDatabaseNodesRequestObject,
+) (server.ListDatabaseNodesResponseObject, error) {
+	return a.dbNodeHandler.ListDatabaseNodes(ctx, req)
+}
+
+// RegisterDatabaseNode delegates database node registration requests to the specialized database node handler.
+// It implements part of the StrictServerInterface contract.
+func (a *ServerAdapter) RegisterDatabaseNode(
+	ctx context.Context,
+	req server.RegisterDatabaseNodeRequestObject,
+) (server.RegisterDatabaseNodeResponseObject, error) {
+	return a.dbNodeHandler.RegisterDatabaseNode(ctx, req)
+}
+
+// GetDatabaseNode delegates database node retrieval requests to the specialized database node handler.
+// It implements part of the StrictServerInterface contract.
+func (a *ServerAdapter) GetDatabaseNode(
+	ctx context.Context,
+	req server.GetDatabaseNodeRequestObject,
+) (server.GetDatabaseNodeResponseObject, error) {
+	return a.dbNodeHandler.GetDatabaseNode(ctx, req)
+}
+
+// DrainDatabaseNode delegates database node drain requests to the specialized database node handler.
+// It implements part of the StrictServerInterface contract.
+func (a *ServerAdapter) DrainDatabaseNode(
+	ctx context.Context,
+	req server.DrainDatabaseNodeRequestObject,
+) (server.DrainDatabaseNodeResponseObject, error) {
+	return a.dbNodeHandler.DrainDatabaseNode(ctx, req)
+}
+
+// StartDatabaseNodeMaintenance delegates database node maintenance requests to the specialized database node handler.
+// It implements part of the StrictServerInterface contract.
+func (a *ServerAdapter) StartDatabaseNodeMaintenance(
+	ctx context.Context,
+	req server.StartDatabaseNodeMaintenanceRequestObject,
+) (server.StartDatabaseNodeMaintenanceResponseObject, error) {
+	return a.dbNodeHandler.StartDatabaseNodeMaintenance(ctx, req)
+}
+
+// ActivateDatabaseNode delegates database node activation requests to the specialized database node handler.
+// It implements part of the StrictServerInterface contract.
+func (a *ServerAdapter) ActivateDatabaseNode(
+	ctx context.Context,
+	req server.ActivateDatabaseNodeRequestObject,
+) (server.ActivateDatabaseNodeResponseObject, error) {
+	return a.dbNodeHandler.ActivateDatabaseNode(ctx, req)
+}
+
+// DecommissionDatabaseNode delegates database node decommissioning requests to the specialized database node handler.
+// It implements part of the StrictServerInterface contract.
+func (a *ServerAdapter) DecommissionDatabaseNode(
+	ctx context.Context,
+	req server.DecommissionDatabaseNodeRequestObject,
+) (server.DecommissionDatabaseNodeResponseObject, error) {
+	return a.dbNodeHandler.DecommissionDatabaseNode(ctx, req)
+}
+
+// ListTenantResources delegates tenant resource listing requests to the specialized resource handler.
+// It implements part of the StrictServerInterface contract.
+func (a *ServerAdapter) ListTenantResources(
+	ctx context.Context,
+	req server.ListTenantResourcesRequestObject,
+) (server.ListTenantResourcesResponseObject, error) {
+	return a.resourceHandler.ListTenantResources(ctx, req)
+}
+
+// ListAuditLogs delegates audit trail listing requests to the specialized audit handler.
+// It implements part of the StrictServerInterface contract.
+func (a *ServerAdapter) ListAuditLogs(ctx context.Context, req server.ListAuditLogsRequestObject) (server.ListAuditLogsResponseObject, error) {
+	return a.auditHandler.ListAuditLogs(ctx, req)
+}
+
+// ListWebhookSubscriptions delegates webhook subscription listing requests to the specialized webhook handler.
+// It implements part of the StrictServerInterface contract.
+func (a *ServerAdapter) ListWebhookSubscriptions(
+	ctx context.Context,
+	req server.ListWebhookSubscriptionsRequestObject,
+) (server.ListWebhookSubscriptionsResponseObject, error) {
+	return a.webhookHandler.ListWebhookSubscriptions(ctx, req)
+}
+
+// CreateWebhookSubscription delegates webhook subscription creation requests to the specialized webhook handler.
+// It implements part of the StrictServerInterface contract.
+func (a *ServerAdapter) CreateWebhookSubscription(
+	ctx context.Context,
+	req server.CreateWebhookSubscriptionRequestObject,
+) (server.CreateWebhookSubscriptionResponseObject, error) {
+	return a.webhookHandler.CreateWebhookSubscription(ctx, req)
+}
+
+// GetWebhookSubscription delegates webhook subscription retrieval requests to the specialized webhook handler.
+// It implements part of the StrictServerInterface contract.
+func (a *ServerAdapter) GetWebhookSubscription(
+	ctx context.Context,
+	req server.GetWebhookSubscriptionRequestObject,
+) (server.GetWebhookSubscriptionResponseObject, error) {
+	return a.webhookHandler.GetWebhookSubscription(ctx, req)
+}
+
+// UpdateWebhookSubscription delegates webhook subscription update requests to the specialized webhook handler.
+// It implements part of the StrictServerInterface contract.
+func (a *ServerAdapter) UpdateWebhookSubscription(
+	ctx context.Context,
+	req server.UpdateWebhookSubscriptionRequestObject,
+) (server.UpdateWebhookSubscriptionResponseObject, error) {
+	return a.webhookHandler.UpdateWebhookSubscription(ctx, req)
+}
+
+// DeleteWebhookSubscription delegates webhook subscription deletion requests to the specialized webhook handler.
+// It implements part of the StrictServerInterface contract.
+func (a *ServerAdapter) DeleteWebhookSubscription(
+	ctx context.Context,
+	req server.DeleteWebhookSubscriptionRequestObject,
+) (server.DeleteWebhookSubscriptionResponseObject, error) {
+	return a.webhookHandler.DeleteWebhookSubscription(ctx, req)
+}
+
+// ListWebhookDeliveries delegates webhook delivery log listing requests to the specialized webhook handler.
+// It implements part of the StrictServerInterface contract.
+func (a *ServerAdapter) ListWebhookDeliveries(
+	ctx context.Context,
+	req server.ListWebhookDeliveriesRequestObject,
+) (server.ListWebhookDeliveriesResponseObject, error) {
+	return a.webhookHandler.ListWebhookDeliveries(ctx, req)
+}
+
+// RedeliverWebhookDelivery delegates webhook redelivery requests to the specialized webhook handler.
+// It implements part of the StrictServerInterface contract.
+func (a *ServerAdapter) RedeliverWebhookDelivery(
+	ctx context.Context,
+	req server.RedeliverWebhookDeliveryRequestObject,
+) (server.RedeliverWebhookDeliveryResponseObject, error) {
+	return a.webhookHandler.RedeliverWebhookDelivery(ctx, req)
+}
+
 // NewHTTPServer creates a configured HTTP server using the provided adapter.
 // It wraps the server adapter with a strict handler to ensure request validation
-// and proper error handling according to the API specification.
-func NewHTTPServer(serverAdapter *ServerAdapter) http.Handler {
-	strictHandler := server.NewStrictHandler(serverAdapter, nil)
+// and proper error handling according to the API specification. The middlewares
+// run around every operation, in the order given.
+func NewHTTPServer(serverAdapter *ServerAdapter, middlewares ...server.StrictMiddlewareFunc) http.Handler {
+	// The generated handler applies middlewares in order, each wrapping the last,
+	// so reverse them to have the first one run outermost.
+	middlewares = slices.Clone(middlewares)
+	slices.Reverse(middlewares)
+
+	strictHandler := server.NewStrictHandlerWithOptions(serverAdapter, middlewares, server.StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: writeResponseError,
+	})
 	return server.Handler(strictHandler)
 }
+
+// writeResponseError responds to a request that failed outside its handler's
+// typed responses. Application errors, such as those rejecting unauthorized
+// requests, are written in the API's error format with their status code.
+func writeResponseError(w http.ResponseWriter, r *http.Request, err error) {
+	var appErr *errs.Error
+	if !errors.As(err, &appErr) {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(appErr.HTTPStatus())
+	_ = json.NewEncoder(w).Encode(server.Error{Error: appErr.Code.String(), Message: appErr.Message})
+}
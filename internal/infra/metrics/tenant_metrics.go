@@ -118,11 +118,19 @@ func (m *tenantMetrics) ObserveProvisioningDuration(ctx context.Context, tenantT
 	))
 }
 
-func (m *tenantMetrics) ObserveProvisioningStageDuration(ctx context.Context, stage string, tenantTier string, region string, duration time.Duration) {
+func (m *tenantMetrics) ObserveProvisioningStageDuration(
+	ctx context.Context,
+	stage string,
+	tenantTier string,
+	region string,
+	duration time.Duration,
+	attempts int,
+) {
 	m.provisioningStageDuration.Record(ctx, duration.Seconds(), metric.WithAttributes(
 		attribute.String("stage", stage),
 		attribute.String("tier", tenantTier),
 		attribute.String("region", region),
+		attribute.Int("attempts", attempts),
 	))
 }
 
@@ -0,0 +1,93 @@
+package sink
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ahrav/hoglet-hub/internal/domain/outbox"
+)
+
+func testEvent(id int64) *outbox.Event {
+	tenantID := int64(7)
+	return &outbox.Event{
+		ID:       id,
+		Type:     outbox.EventTenantActivated,
+		TenantID: &tenantID,
+		Data:     map[string]any{},
+	}
+}
+
+func TestWebhookSink_Publish(t *testing.T) {
+	var (
+		header http.Header
+		body   []byte
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	err := NewWebhookSink(srv.URL, 0).Publish(context.Background(), testEvent(42))
+	require.NoError(t, err)
+
+	assert.Equal(t, "application/json", header.Get("Content-Type"))
+	assert.Equal(t, "tenant.activated", header.Get("X-Event-Type"))
+	assert.Equal(t, "42", header.Get("X-Event-ID"))
+
+	var got outbox.Event
+	require.NoError(t, json.Unmarshal(body, &got))
+	assert.Equal(t, int64(42), got.ID)
+	assert.Equal(t, outbox.EventTenantActivated, got.Type)
+	assert.Equal(t, int64(7), *got.TenantID)
+}
+
+func TestWebhookSink_Publish_ErrorStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	err := NewWebhookSink(srv.URL, 0).Publish(context.Background(), testEvent(1))
+	assert.ErrorContains(t, err, "503")
+}
+
+func TestFileSink_Publish(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+
+	s, err := NewFileSink(path)
+	require.NoError(t, err)
+	require.NoError(t, s.Publish(context.Background(), testEvent(1)))
+	require.NoError(t, s.Close())
+
+	// Reopening appends rather than truncating.
+	s, err = NewFileSink(path)
+	require.NoError(t, err)
+	require.NoError(t, s.Publish(context.Background(), testEvent(2)))
+	require.NoError(t, s.Close())
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var ids []int64
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e outbox.Event
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &e))
+		ids = append(ids, e.ID)
+	}
+	require.NoError(t, scanner.Err())
+	assert.Equal(t, []int64{1, 2}, ids)
+}
@@ -0,0 +1,67 @@
+// Package sink provides the destinations outbox events are relayed to.
+package sink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ahrav/hoglet-hub/internal/domain/outbox"
+)
+
+// DefaultWebhookTimeout bounds each delivery to a webhook.
+const DefaultWebhookTimeout = 10 * time.Second
+
+// WebhookSink publishes each event as a JSON POST request to a URL. The event's
+// type and ID are also sent in the X-Event-Type and X-Event-ID headers, the
+// latter allowing receivers to discard events delivered more than once.
+type WebhookSink struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookSink creates a new WebhookSink posting to url. A non-positive
+// timeout selects DefaultWebhookTimeout.
+func NewWebhookSink(url string, timeout time.Duration) *WebhookSink {
+	if timeout <= 0 {
+		timeout = DefaultWebhookTimeout
+	}
+	return &WebhookSink{url: url, client: &http.Client{Timeout: timeout}}
+}
+
+// Name identifies the sink.
+func (s *WebhookSink) Name() string { return "webhook" }
+
+// Publish posts the event. Responses other than 2xx are errors.
+func (s *WebhookSink) Publish(ctx context.Context, event *outbox.Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Event-Type", string(event.Type))
+	req.Header.Set("X-Event-ID", strconv.FormatInt(event.ID, 10))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post event: %w", err)
+	}
+	defer resp.Body.Close()
+	// Drain the body so that the connection can be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
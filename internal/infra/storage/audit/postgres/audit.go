@@ -0,0 +1,181 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ahrav/hoglet-hub/internal/db"
+	"github.com/ahrav/hoglet-hub/internal/domain/audit"
+	"github.com/ahrav/hoglet-hub/internal/infra/storage"
+)
+
+var _ audit.Repository = (*auditStore)(nil)
+
+// auditStore implements audit.Repository using Postgres and sqlc-generated queries.
+type auditStore struct {
+	q      *db.Queries
+	pool   *pgxpool.Pool
+	tracer trace.Tracer
+}
+
+// NewAuditStore creates an audit.Repository backed by PostgreSQL.
+func NewAuditStore(pool *pgxpool.Pool, tracer trace.Tracer) audit.Repository {
+	return &auditStore{q: db.New(pool), pool: pool, tracer: tracer}
+}
+
+// defaultDBAttributes defines standard OpenTelemetry attributes for database operations.
+var defaultDBAttributes = []attribute.KeyValue{attribute.String("db.system", "postgresql")}
+
+// Create appends the entry to the audit trail and returns its ID.
+func (s *auditStore) Create(ctx context.Context, e *audit.Entry) (int64, error) {
+	dbAttrs := append(defaultDBAttributes,
+		attribute.String("audit.action", string(e.Action)),
+		attribute.String("audit.status", string(e.Status)),
+	)
+
+	var id int64
+	err := storage.ExecuteAndTrace(ctx, s.tracer, "auditStore.Create", dbAttrs, func(ctx context.Context) error {
+		details := e.Details
+		if details == nil {
+			details = map[string]any{}
+		}
+		detailsJSON, err := json.Marshal(details)
+		if err != nil {
+			return err
+		}
+
+		params := db.CreateAuditLogParams{
+			Action:      string(e.Action),
+			Timestamp:   pgtype.Timestamptz{Time: e.Timestamp, Valid: true},
+			Status:      db.AuditStatus(e.Status),
+			Actor:       e.Actor,
+			ActorIp:     e.ActorIP,
+			TenantID:    toInt8(e.TenantID),
+			ResourceID:  toInt8(e.ResourceID),
+			OperationID: toInt8(e.OperationID),
+			Details:     detailsJSON,
+		}
+		if e.Error != nil {
+			params.ErrorDetails = pgtype.Text{String: *e.Error, Valid: true}
+		}
+		if e.Duration > 0 {
+			params.DurationMs = pgtype.Int4{Int32: int32(e.Duration.Milliseconds()), Valid: true}
+		}
+
+		id, err = s.q.CreateAuditLog(ctx, params)
+		return err
+	})
+
+	return id, err
+}
+
+// List retrieves up to params.Limit entries matching the filter, newest first.
+func (s *auditStore) List(ctx context.Context, params audit.ListParams) ([]*audit.Entry, error) {
+	dbAttrs := append(defaultDBAttributes, attribute.Int("limit", params.Limit))
+	filter := params.Filter
+	dbParams := db.ListAuditLogsParams{
+		TenantID:    toInt8(filter.TenantID),
+		OperationID: toInt8(filter.OperationID),
+		RowLimit:    int32(params.Limit),
+	}
+	if filter.Actor != nil {
+		dbParams.Actor = pgtype.Text{String: *filter.Actor, Valid: true}
+	}
+	if filter.Action != nil {
+		dbParams.Action = pgtype.Text{String: string(*filter.Action), Valid: true}
+		dbAttrs = append(dbAttrs, attribute.String("audit.action", string(*filter.Action)))
+	}
+	if filter.Status != nil {
+		dbParams.Status = db.NullAuditStatus{AuditStatus: db.AuditStatus(*filter.Status), Valid: true}
+		dbAttrs = append(dbAttrs, attribute.String("audit.status", string(*filter.Status)))
+	}
+	if filter.Since != nil {
+		dbParams.Since = pgtype.Timestamptz{Time: *filter.Since, Valid: true}
+	}
+	if filter.Until != nil {
+		dbParams.Until = pgtype.Timestamptz{Time: *filter.Until, Valid: true}
+	}
+	if params.BeforeID > 0 {
+		dbParams.BeforeID = pgtype.Int8{Int64: params.BeforeID, Valid: true}
+	}
+
+	var dbEntries []db.AuditLog
+	err := storage.ExecuteAndTrace(ctx, s.tracer, "auditStore.List", dbAttrs, func(ctx context.Context) error {
+		var err error
+		dbEntries, err = s.q.ListAuditLogs(ctx, dbParams)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*audit.Entry, 0, len(dbEntries))
+	for _, dbEntry := range dbEntries {
+		e, err := mapDBAuditLogToDomain(dbEntry)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+
+	return entries, nil
+}
+
+// toInt8 converts an optional ID to its nullable database representation.
+func toInt8(id *int64) pgtype.Int8 {
+	if id == nil {
+		return pgtype.Int8{}
+	}
+	return pgtype.Int8{Int64: *id, Valid: true}
+}
+
+// fromInt8 converts a nullable database ID to an optional ID.
+func fromInt8(id pgtype.Int8) *int64 {
+	if !id.Valid {
+		return nil
+	}
+	val := id.Int64
+	return &val
+}
+
+// mapDBAuditLogToDomain converts a database audit record to a domain audit entry.
+func mapDBAuditLogToDomain(dbEntry db.AuditLog) (*audit.Entry, error) {
+	details := map[string]any{}
+	if len(dbEntry.Details) > 0 {
+		if err := json.Unmarshal(dbEntry.Details, &details); err != nil {
+			return nil, err
+		}
+	}
+
+	var errDetails *string
+	if dbEntry.ErrorDetails.Valid {
+		val := dbEntry.ErrorDetails.String
+		errDetails = &val
+	}
+
+	var duration time.Duration
+	if dbEntry.DurationMs.Valid {
+		duration = time.Duration(dbEntry.DurationMs.Int32) * time.Millisecond
+	}
+
+	return &audit.Entry{
+		ID:          dbEntry.ID,
+		Action:      audit.Action(dbEntry.Action),
+		Timestamp:   dbEntry.Timestamp.Time,
+		Status:      audit.Status(dbEntry.Status),
+		Actor:       dbEntry.Actor,
+		ActorIP:     dbEntry.ActorIp,
+		TenantID:    fromInt8(dbEntry.TenantID),
+		ResourceID:  fromInt8(dbEntry.ResourceID),
+		OperationID: fromInt8(dbEntry.OperationID),
+		Details:     details,
+		Error:       errDetails,
+		Duration:    duration,
+	}, nil
+}
@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"testing"
+	"time"
+
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/ahrav/hoglet-hub/internal/db"
+	"github.com/ahrav/hoglet-hub/internal/domain/audit"
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+	tenantStore "github.com/ahrav/hoglet-hub/internal/infra/storage/tenant/postgres"
+	"github.com/ahrav/hoglet-hub/internal/infra/storage/testutil"
+)
+
+func setupAuditTest(t *testing.T) (context.Context, *auditStore, int64, func()) {
+	t.Helper()
+
+	pool, cleanup := testutil.SetupTestContainer(t)
+	tracer := noop.NewTracerProvider().Tracer("test")
+	store := &auditStore{q: db.New(pool), pool: pool, tracer: tracer}
+	ctx := context.Background()
+
+	audited, err := tenant.NewTenant("audited", tenant.RegionEU1, tenant.TierPro, nil)
+	require.NoError(t, err)
+	tenantID, err := tenantStore.NewTenantStore(pool, tracer).Create(ctx, audited)
+	require.NoError(t, err)
+
+	return ctx, store, tenantID, cleanup
+}
+
+func TestAuditStore_CreateAndList(t *testing.T) {
+	t.Parallel()
+
+	ctx, store, tenantID, cleanup := setupAuditTest(t)
+	defer cleanup()
+
+	ip := netip.MustParseAddr("203.0.113.7")
+	created := &audit.Entry{
+		Action:   audit.ActionCreateTenant,
+		Actor:    "alice@example.com",
+		ActorIP:  &ip,
+		TenantID: &tenantID,
+		Details:  map[string]any{"tier": "pro"},
+		Duration: 120 * time.Millisecond,
+	}
+	created.SetOutcome(nil)
+	_, err := store.Create(ctx, created)
+	require.NoError(t, err)
+
+	suspended := &audit.Entry{Action: audit.ActionSuspendTenant, Actor: "bob@example.com", TenantID: &tenantID}
+	suspended.SetOutcome(errors.New("tenant not active"))
+	_, err = store.Create(ctx, suspended)
+	require.NoError(t, err)
+
+	entries, err := store.List(ctx, audit.ListParams{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, audit.ActionSuspendTenant, entries[0].Action, "newest entries come first")
+	assert.Equal(t, audit.StatusFailure, entries[0].Status)
+	require.NotNil(t, entries[0].Error)
+	assert.Equal(t, "tenant not active", *entries[0].Error)
+
+	first := entries[1]
+	assert.Equal(t, audit.StatusSuccess, first.Status)
+	require.NotNil(t, first.ActorIP)
+	assert.Equal(t, ip, *first.ActorIP)
+	assert.Equal(t, "pro", first.Details["tier"])
+	assert.Equal(t, 120*time.Millisecond, first.Duration)
+	assert.Nil(t, first.Error)
+}
+
+func TestAuditStore_ListFilters(t *testing.T) {
+	t.Parallel()
+
+	ctx, store, tenantID, cleanup := setupAuditTest(t)
+	defer cleanup()
+
+	var ids []int64
+	for _, actor := range []string{"alice@example.com", "bob@example.com", "alice@example.com"} {
+		e := &audit.Entry{Action: audit.ActionChangeTenantTier, Actor: actor, TenantID: &tenantID}
+		e.SetOutcome(nil)
+		id, err := store.Create(ctx, e)
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+
+	alice := "alice@example.com"
+	entries, err := store.List(ctx, audit.ListParams{Filter: audit.ListFilter{Actor: &alice}, Limit: 10})
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+
+	entries, err = store.List(ctx, audit.ListParams{BeforeID: ids[2], Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, ids[1], entries[0].ID)
+
+	failure := audit.StatusFailure
+	entries, err = store.List(ctx, audit.ListParams{Filter: audit.ListFilter{Status: &failure}, Limit: 10})
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
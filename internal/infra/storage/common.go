@@ -2,7 +2,10 @@ package storage
 
 import (
 	"context"
+	"errors"
 
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
@@ -37,3 +40,16 @@ func ExecuteAndTrace(
 	}
 	return nil
 }
+
+// IsUniqueViolation reports whether err is a Postgres unique constraint violation.
+// Stores use it to translate duplicate inserts into domain errors.
+func IsUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation
+}
+
+// IsCheckViolation reports whether err is a Postgres check constraint violation.
+func IsCheckViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == pgerrcode.CheckViolation
+}
@@ -0,0 +1,264 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ahrav/hoglet-hub/internal/db"
+	"github.com/ahrav/hoglet-hub/internal/domain/dbnode"
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+	"github.com/ahrav/hoglet-hub/internal/infra/storage"
+)
+
+var _ dbnode.Repository = (*nodeStore)(nil)
+
+// nodeStore implements dbnode.Repository using Postgres and sqlc-generated queries.
+type nodeStore struct {
+	q      *db.Queries
+	pool   *pgxpool.Pool
+	tracer trace.Tracer
+}
+
+// NewNodeStore creates a dbnode.Repository backed by PostgreSQL.
+func NewNodeStore(pool *pgxpool.Pool, tracer trace.Tracer) dbnode.Repository {
+	return &nodeStore{q: db.New(pool), pool: pool, tracer: tracer}
+}
+
+// defaultDBAttributes defines standard OpenTelemetry attributes for database operations.
+var defaultDBAttributes = []attribute.KeyValue{attribute.String("db.system", "postgresql")}
+
+// Create persists a new node and returns its ID.
+// A duplicate hostname is reported as dbnode.ErrNodeAlreadyExists.
+func (s *nodeStore) Create(ctx context.Context, n *dbnode.Node) (int64, error) {
+	dbAttrs := append(defaultDBAttributes,
+		attribute.String("node.hostname", n.Hostname),
+		attribute.String("node.region", string(n.Region)),
+		attribute.String("node.type", string(n.Type)),
+	)
+
+	var id int64
+	err := storage.ExecuteAndTrace(ctx, s.tracer, "nodeStore.Create", dbAttrs, func(ctx context.Context) error {
+		var err error
+		id, err = s.q.CreateDatabaseNode(ctx, db.CreateDatabaseNodeParams{
+			Hostname:   n.Hostname,
+			Port:       n.Port,
+			Region:     db.RegionType(n.Region),
+			NodeType:   db.NodeType(n.Type),
+			Status:     db.DatabaseNodeStatus(n.Status),
+			MaxTenants: n.MaxTenants,
+			CreatedBy:  n.CreatedBy,
+		})
+		if storage.IsUniqueViolation(err) {
+			return dbnode.ErrNodeAlreadyExists
+		}
+		return err
+	})
+
+	return id, err
+}
+
+// FindByID retrieves a node by ID.
+// Returns dbnode.ErrNodeNotFound if the node doesn't exist.
+func (s *nodeStore) FindByID(ctx context.Context, id int64) (*dbnode.Node, error) {
+	dbAttrs := append(defaultDBAttributes, attribute.Int64("node.id", id))
+
+	var dbNode db.DatabaseNode
+	err := storage.ExecuteAndTrace(ctx, s.tracer, "nodeStore.FindByID", dbAttrs, func(ctx context.Context) error {
+		var err error
+		dbNode, err = s.q.FindDatabaseNodeByID(ctx, id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return dbnode.ErrNodeNotFound
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapDBNodeToDomain(dbNode), nil
+}
+
+// List retrieves the nodes matching the filter, ordered by region and hostname.
+func (s *nodeStore) List(ctx context.Context, filter dbnode.ListFilter) ([]*dbnode.Node, error) {
+	dbAttrs := defaultDBAttributes
+	var params db.ListDatabaseNodesParams
+	if filter.Region != nil {
+		params.Region = db.NullRegionType{RegionType: db.RegionType(*filter.Region), Valid: true}
+		dbAttrs = append(dbAttrs, attribute.String("node.region", string(*filter.Region)))
+	}
+	if filter.Type != nil {
+		params.NodeType = db.NullNodeType{NodeType: db.NodeType(*filter.Type), Valid: true}
+		dbAttrs = append(dbAttrs, attribute.String("node.type", string(*filter.Type)))
+	}
+	if filter.Status != nil {
+		params.Status = db.NullDatabaseNodeStatus{DatabaseNodeStatus: db.DatabaseNodeStatus(*filter.Status), Valid: true}
+		dbAttrs = append(dbAttrs, attribute.String("node.status", string(*filter.Status)))
+	}
+
+	var dbNodes []db.DatabaseNode
+	err := storage.ExecuteAndTrace(ctx, s.tracer, "nodeStore.List", dbAttrs, func(ctx context.Context) error {
+		var err error
+		dbNodes, err = s.q.ListDatabaseNodes(ctx, params)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]*dbnode.Node, 0, len(dbNodes))
+	for _, dbNode := range dbNodes {
+		nodes = append(nodes, mapDBNodeToDomain(dbNode))
+	}
+
+	return nodes, nil
+}
+
+// UpdateStatus persists the node's current status.
+// Returns dbnode.ErrNodeNotFound if the node doesn't exist.
+func (s *nodeStore) UpdateStatus(ctx context.Context, n *dbnode.Node) error {
+	dbAttrs := append(defaultDBAttributes,
+		attribute.Int64("node.id", n.ID),
+		attribute.String("node.status", string(n.Status)),
+	)
+
+	return storage.ExecuteAndTrace(ctx, s.tracer, "nodeStore.UpdateStatus", dbAttrs, func(ctx context.Context) error {
+		rows, err := s.q.UpdateDatabaseNodeStatus(ctx, db.UpdateDatabaseNodeStatusParams{
+			ID:     n.ID,
+			Status: db.DatabaseNodeStatus(n.Status),
+		})
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return dbnode.ErrNodeNotFound
+		}
+		return nil
+	})
+}
+
+// Place assigns the tenant a primary node matching the criteria and reserves capacity
+// for it. The tenant row is locked before the candidate node so that concurrent
+// placements and releases of the same tenant serialize, and both lock in the same order.
+func (s *nodeStore) Place(ctx context.Context, tenantID int64, criteria dbnode.Criteria) (*dbnode.Node, error) {
+	dbAttrs := append(defaultDBAttributes,
+		attribute.Int64("tenant.id", tenantID),
+		attribute.String("node.region", string(criteria.Region)),
+		attribute.String("node.type", string(criteria.Type)),
+	)
+
+	var dbNode db.DatabaseNode
+	err := storage.ExecuteAndTrace(ctx, s.tracer, "nodeStore.Place", dbAttrs, func(ctx context.Context) error {
+		return pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+			q := s.q.WithTx(tx)
+
+			current, err := q.LockTenantPrimaryNode(ctx, tenantID)
+			if errors.Is(err, pgx.ErrNoRows) {
+				return tenant.ErrTenantNotFound
+			}
+			if err != nil {
+				return err
+			}
+
+			// A tenant that was already placed keeps its node; this makes a retried
+			// placement a no-op instead of reserving capacity twice.
+			if current.Valid {
+				dbNode, err = q.FindDatabaseNodeByID(ctx, current.Int64)
+				return err
+			}
+
+			candidate, err := q.FindPlacementCandidate(ctx, db.FindPlacementCandidateParams{
+				Region:   db.RegionType(criteria.Region),
+				NodeType: db.NodeType(criteria.Type),
+			})
+			if errors.Is(err, pgx.ErrNoRows) {
+				return fmt.Errorf("%w: no active %s node in %s", dbnode.ErrNoCapacity, criteria.Type, criteria.Region)
+			}
+			if err != nil {
+				return err
+			}
+
+			if err := q.AdjustDatabaseNodeTenantCount(ctx, db.AdjustDatabaseNodeTenantCountParams{
+				Delta: 1,
+				ID:    candidate.ID,
+			}); err != nil {
+				return err
+			}
+			if err := q.SetTenantPrimaryNode(ctx, db.SetTenantPrimaryNodeParams{
+				ID:            tenantID,
+				PrimaryNodeID: pgtype.Int8{Int64: candidate.ID, Valid: true},
+			}); err != nil {
+				return err
+			}
+
+			dbNode, err = q.FindDatabaseNodeByID(ctx, candidate.ID)
+			return err
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapDBNodeToDomain(dbNode), nil
+}
+
+// Release clears the tenant's primary node and frees the capacity reserved on it.
+func (s *nodeStore) Release(ctx context.Context, tenantID int64) error {
+	dbAttrs := append(defaultDBAttributes, attribute.Int64("tenant.id", tenantID))
+
+	return storage.ExecuteAndTrace(ctx, s.tracer, "nodeStore.Release", dbAttrs, func(ctx context.Context) error {
+		return pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+			q := s.q.WithTx(tx)
+
+			current, err := q.LockTenantPrimaryNode(ctx, tenantID)
+			if errors.Is(err, pgx.ErrNoRows) {
+				return tenant.ErrTenantNotFound
+			}
+			if err != nil {
+				return err
+			}
+			if !current.Valid {
+				return nil
+			}
+
+			if err := q.SetTenantPrimaryNode(ctx, db.SetTenantPrimaryNodeParams{ID: tenantID}); err != nil {
+				return err
+			}
+			return q.AdjustDatabaseNodeTenantCount(ctx, db.AdjustDatabaseNodeTenantCountParams{
+				Delta: -1,
+				ID:    current.Int64,
+			})
+		})
+	})
+}
+
+// mapDBNodeToDomain converts a database node record to a domain node.
+func mapDBNodeToDomain(dbNode db.DatabaseNode) *dbnode.Node {
+	var updatedAt *time.Time
+	if !dbNode.UpdatedAt.Time.Equal(dbNode.CreatedAt.Time) {
+		val := dbNode.UpdatedAt.Time
+		updatedAt = &val
+	}
+
+	return &dbnode.Node{
+		ID:                 dbNode.ID,
+		Hostname:           dbNode.Hostname,
+		Port:               dbNode.Port,
+		Region:             tenant.Region(dbNode.Region),
+		Type:               dbnode.Type(dbNode.NodeType),
+		Status:             dbnode.Status(dbNode.Status),
+		TenantCount:        dbNode.TenantCount,
+		MaxTenants:         dbNode.MaxTenants,
+		UtilizationPercent: dbNode.CurrentUtilizationPercent,
+		CreatedBy:          dbNode.CreatedBy,
+		CreatedAt:          dbNode.CreatedAt.Time,
+		UpdatedAt:          updatedAt,
+	}
+}
@@ -0,0 +1,176 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/ahrav/hoglet-hub/internal/db"
+	"github.com/ahrav/hoglet-hub/internal/domain/dbnode"
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+	tenantStore "github.com/ahrav/hoglet-hub/internal/infra/storage/tenant/postgres"
+	"github.com/ahrav/hoglet-hub/internal/infra/storage/testutil"
+)
+
+func setupNodeTest(t *testing.T) (context.Context, *nodeStore, tenant.Repository, func()) {
+	t.Helper()
+
+	pool, cleanup := testutil.SetupTestContainer(t)
+	tracer := noop.NewTracerProvider().Tracer("test")
+	store := &nodeStore{q: db.New(pool), pool: pool, tracer: tracer}
+	ctx := context.Background()
+
+	return ctx, store, tenantStore.NewTenantStore(pool, tracer), cleanup
+}
+
+func createNode(
+	ctx context.Context,
+	t *testing.T,
+	store *nodeStore,
+	hostname string,
+	nodeType dbnode.Type,
+	maxTenants int32,
+) int64 {
+	t.Helper()
+
+	n, err := dbnode.NewNode(hostname, 5432, tenant.RegionEU1, nodeType, maxTenants, "ops@example.com")
+	require.NoError(t, err)
+	id, err := store.Create(ctx, n)
+	require.NoError(t, err)
+	return id
+}
+
+func createTenant(ctx context.Context, t *testing.T, tenants tenant.Repository, name string) int64 {
+	t.Helper()
+
+	tn, err := tenant.NewTenant(name, tenant.RegionEU1, tenant.TierPro, nil)
+	require.NoError(t, err)
+	id, err := tenants.Create(ctx, tn)
+	require.NoError(t, err)
+	return id
+}
+
+func TestNodeStore_CreateFindAndUpdateStatus(t *testing.T) {
+	t.Parallel()
+
+	ctx, store, _, cleanup := setupNodeTest(t)
+	defer cleanup()
+
+	id := createNode(ctx, t, store, "citus-worker-1", dbnode.TypeStandard, 100)
+
+	found, err := store.FindByID(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, "citus-worker-1", found.Hostname)
+	assert.Equal(t, dbnode.StatusActive, found.Status)
+	assert.Equal(t, int32(100), found.MaxTenants)
+	assert.Zero(t, found.TenantCount)
+
+	_, err = store.Create(ctx, found)
+	assert.ErrorIs(t, err, dbnode.ErrNodeAlreadyExists)
+
+	require.NoError(t, found.Drain())
+	require.NoError(t, store.UpdateStatus(ctx, found))
+
+	found, err = store.FindByID(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, dbnode.StatusDraining, found.Status)
+
+	_, err = store.FindByID(ctx, id+1000)
+	assert.ErrorIs(t, err, dbnode.ErrNodeNotFound)
+	assert.ErrorIs(t, store.UpdateStatus(ctx, &dbnode.Node{ID: id + 1000, Status: dbnode.StatusActive}), dbnode.ErrNodeNotFound)
+}
+
+func TestNodeStore_List(t *testing.T) {
+	t.Parallel()
+
+	ctx, store, _, cleanup := setupNodeTest(t)
+	defer cleanup()
+
+	createNode(ctx, t, store, "citus-worker-2", dbnode.TypeStandard, 100)
+	createNode(ctx, t, store, "citus-worker-1", dbnode.TypeHighMemory, 100)
+
+	nodes, err := store.List(ctx, dbnode.ListFilter{})
+	require.NoError(t, err)
+	require.Len(t, nodes, 2)
+	assert.Equal(t, "citus-worker-1", nodes[0].Hostname)
+
+	highMemory := dbnode.TypeHighMemory
+	nodes, err = store.List(ctx, dbnode.ListFilter{Type: &highMemory})
+	require.NoError(t, err)
+	require.Len(t, nodes, 1)
+	assert.Equal(t, "citus-worker-1", nodes[0].Hostname)
+}
+
+func TestNodeStore_PlaceAndRelease(t *testing.T) {
+	t.Parallel()
+
+	ctx, store, tenants, cleanup := setupNodeTest(t)
+	defer cleanup()
+
+	busy := createNode(ctx, t, store, "citus-worker-1", dbnode.TypeStandard, 2)
+	idle := createNode(ctx, t, store, "citus-worker-2", dbnode.TypeStandard, 4)
+	criteria := dbnode.Criteria{Region: tenant.RegionEU1, Type: dbnode.TypeStandard}
+
+	first := createTenant(ctx, t, tenants, "tenant-one")
+	placed, err := store.Place(ctx, first, criteria)
+	require.NoError(t, err)
+	assert.Equal(t, busy, placed.ID, "ties in utilization go to the older node")
+	assert.Equal(t, int32(1), placed.TenantCount)
+	assert.Equal(t, int32(50), placed.UtilizationPercent)
+
+	// Placing the same tenant again keeps it where it is.
+	again, err := store.Place(ctx, first, criteria)
+	require.NoError(t, err)
+	assert.Equal(t, busy, again.ID)
+	assert.Equal(t, int32(1), again.TenantCount)
+
+	second := createTenant(ctx, t, tenants, "tenant-two")
+	placed, err = store.Place(ctx, second, criteria)
+	require.NoError(t, err)
+	assert.Equal(t, idle, placed.ID, "the least utilized node is chosen")
+
+	found, err := tenants.FindByID(ctx, second)
+	require.NoError(t, err)
+	require.NotNil(t, found.PrimaryNodeID)
+	assert.Equal(t, idle, *found.PrimaryNodeID)
+
+	require.NoError(t, store.Release(ctx, second))
+	require.NoError(t, store.Release(ctx, second), "releasing twice is a no-op")
+
+	n, err := store.FindByID(ctx, idle)
+	require.NoError(t, err)
+	assert.Zero(t, n.TenantCount)
+
+	found, err = tenants.FindByID(ctx, second)
+	require.NoError(t, err)
+	assert.Nil(t, found.PrimaryNodeID)
+}
+
+func TestNodeStore_PlaceWithoutCapacity(t *testing.T) {
+	t.Parallel()
+
+	ctx, store, tenants, cleanup := setupNodeTest(t)
+	defer cleanup()
+
+	full := createNode(ctx, t, store, "citus-worker-1", dbnode.TypeStandard, 1)
+	draining := createNode(ctx, t, store, "citus-worker-2", dbnode.TypeStandard, 10)
+	n, err := store.FindByID(ctx, draining)
+	require.NoError(t, err)
+	require.NoError(t, n.Drain())
+	require.NoError(t, store.UpdateStatus(ctx, n))
+
+	criteria := dbnode.Criteria{Region: tenant.RegionEU1, Type: dbnode.TypeStandard}
+	placed, err := store.Place(ctx, createTenant(ctx, t, tenants, "tenant-one"), criteria)
+	require.NoError(t, err)
+	assert.Equal(t, full, placed.ID)
+
+	_, err = store.Place(ctx, createTenant(ctx, t, tenants, "tenant-two"), criteria)
+	assert.ErrorIs(t, err, dbnode.ErrNoCapacity)
+
+	_, err = store.Place(ctx, 999999, criteria)
+	assert.ErrorIs(t, err, tenant.ErrTenantNotFound)
+}
@@ -0,0 +1,157 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ahrav/hoglet-hub/internal/db"
+	"github.com/ahrav/hoglet-hub/internal/domain/idempotency"
+	"github.com/ahrav/hoglet-hub/internal/infra/storage"
+)
+
+var _ idempotency.Repository = (*idempotencyStore)(nil)
+
+// idempotencyStore implements idempotency.Repository using Postgres and
+// sqlc-generated queries.
+type idempotencyStore struct {
+	q      *db.Queries
+	pool   *pgxpool.Pool
+	tracer trace.Tracer
+}
+
+// NewIdempotencyStore creates an idempotency.Repository backed by PostgreSQL.
+func NewIdempotencyStore(pool *pgxpool.Pool, tracer trace.Tracer) idempotency.Repository {
+	return &idempotencyStore{q: db.New(pool), pool: pool, tracer: tracer}
+}
+
+// defaultDBAttributes defines standard OpenTelemetry attributes for database operations.
+var defaultDBAttributes = []attribute.KeyValue{attribute.String("db.system", "postgresql")}
+
+// Claim stores the record unless an unexpired record with the same scope and key
+// exists, replacing an expired one. It reports whether the record was stored.
+func (s *idempotencyStore) Claim(ctx context.Context, r *idempotency.Record) (bool, error) {
+	dbAttrs := append(defaultDBAttributes, attribute.String("idempotency.operation", r.Scope.Operation))
+
+	var claimed bool
+	err := storage.ExecuteAndTrace(ctx, s.tracer, "idempotencyStore.Claim", dbAttrs, func(ctx context.Context) error {
+		rows, err := s.q.ClaimIdempotencyKey(ctx, db.ClaimIdempotencyKeyParams{
+			Caller:         r.Scope.Caller,
+			Operation:      r.Scope.Operation,
+			IdempotencyKey: r.Key,
+			Fingerprint:    r.Fingerprint,
+			ExpiresAt:      pgtype.Timestamptz{Time: r.ExpiresAt, Valid: true},
+		})
+		claimed = rows > 0
+		return err
+	})
+
+	return claimed, err
+}
+
+// Find retrieves the record for the key.
+func (s *idempotencyStore) Find(
+	ctx context.Context,
+	scope idempotency.Scope,
+	key string,
+) (*idempotency.Record, error) {
+	dbAttrs := append(defaultDBAttributes, attribute.String("idempotency.operation", scope.Operation))
+
+	var dbKey db.IdempotencyKey
+	err := storage.ExecuteAndTrace(ctx, s.tracer, "idempotencyStore.Find", dbAttrs, func(ctx context.Context) error {
+		var err error
+		dbKey, err = s.q.FindIdempotencyKey(ctx, db.FindIdempotencyKeyParams{
+			Caller:         scope.Caller,
+			Operation:      scope.Operation,
+			IdempotencyKey: key,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return idempotency.ErrKeyNotFound
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapDBIdempotencyKeyToDomain(dbKey), nil
+}
+
+// Complete records the response to the request the key was claimed for and
+// keeps the key until expiresAt.
+func (s *idempotencyStore) Complete(
+	ctx context.Context,
+	scope idempotency.Scope,
+	key string,
+	resp idempotency.Response,
+	expiresAt time.Time,
+) error {
+	dbAttrs := append(defaultDBAttributes,
+		attribute.String("idempotency.operation", scope.Operation),
+		attribute.Int("http.status_code", resp.StatusCode),
+	)
+
+	return storage.ExecuteAndTrace(ctx, s.tracer, "idempotencyStore.Complete", dbAttrs, func(ctx context.Context) error {
+		rows, err := s.q.CompleteIdempotencyKey(ctx, db.CompleteIdempotencyKeyParams{
+			Caller:         scope.Caller,
+			Operation:      scope.Operation,
+			IdempotencyKey: key,
+			StatusCode:     pgtype.Int4{Int32: int32(resp.StatusCode), Valid: true},
+			ResponseBody:   resp.Body,
+			ExpiresAt:      pgtype.Timestamptz{Time: expiresAt, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return idempotency.ErrKeyNotFound
+		}
+		return nil
+	})
+}
+
+// Release deletes the key if its request is still in progress.
+func (s *idempotencyStore) Release(ctx context.Context, scope idempotency.Scope, key string) error {
+	dbAttrs := append(defaultDBAttributes, attribute.String("idempotency.operation", scope.Operation))
+
+	return storage.ExecuteAndTrace(ctx, s.tracer, "idempotencyStore.Release", dbAttrs, func(ctx context.Context) error {
+		return s.q.ReleaseIdempotencyKey(ctx, db.ReleaseIdempotencyKeyParams{
+			Caller:         scope.Caller,
+			Operation:      scope.Operation,
+			IdempotencyKey: key,
+		})
+	})
+}
+
+// DeleteExpired deletes records that expired at or before the given time.
+func (s *idempotencyStore) DeleteExpired(ctx context.Context, before time.Time) (int64, error) {
+	var deleted int64
+	err := storage.ExecuteAndTrace(ctx, s.tracer, "idempotencyStore.DeleteExpired", defaultDBAttributes, func(ctx context.Context) error {
+		var err error
+		deleted, err = s.q.DeleteExpiredIdempotencyKeys(ctx, pgtype.Timestamptz{Time: before, Valid: true})
+		return err
+	})
+
+	return deleted, err
+}
+
+// mapDBIdempotencyKeyToDomain converts a database idempotency key to a domain record.
+func mapDBIdempotencyKeyToDomain(dbKey db.IdempotencyKey) *idempotency.Record {
+	r := &idempotency.Record{
+		Scope:       idempotency.Scope{Caller: dbKey.Caller, Operation: dbKey.Operation},
+		Key:         dbKey.IdempotencyKey,
+		Fingerprint: dbKey.Fingerprint,
+		CreatedAt:   dbKey.CreatedAt.Time,
+		ExpiresAt:   dbKey.ExpiresAt.Time,
+	}
+	if dbKey.StatusCode.Valid {
+		r.Response = &idempotency.Response{StatusCode: int(dbKey.StatusCode.Int32), Body: dbKey.ResponseBody}
+	}
+	return r
+}
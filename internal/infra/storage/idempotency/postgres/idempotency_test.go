@@ -0,0 +1,162 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/ahrav/hoglet-hub/internal/db"
+	"github.com/ahrav/hoglet-hub/internal/domain/idempotency"
+	"github.com/ahrav/hoglet-hub/internal/infra/storage/testutil"
+)
+
+func setupIdempotencyTest(t *testing.T) (context.Context, *idempotencyStore, func()) {
+	t.Helper()
+
+	pool, cleanup := testutil.SetupTestContainer(t)
+	tracer := noop.NewTracerProvider().Tracer("test")
+	store := &idempotencyStore{q: db.New(pool), pool: pool, tracer: tracer}
+
+	return context.Background(), store, cleanup
+}
+
+func TestIdempotencyStore_ClaimCompleteFind(t *testing.T) {
+	t.Parallel()
+
+	ctx, store, cleanup := setupIdempotencyTest(t)
+	defer cleanup()
+
+	scope := idempotency.Scope{Caller: "alice@example.com", Operation: "CreateTenant"}
+	record := &idempotency.Record{
+		Scope:       scope,
+		Key:         "key-1",
+		Fingerprint: "fp-1",
+		ExpiresAt:   time.Now().Add(time.Hour),
+	}
+
+	claimed, err := store.Claim(ctx, record)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	claimed, err = store.Claim(ctx, &idempotency.Record{Scope: scope, Key: "key-1", Fingerprint: "fp-2", ExpiresAt: record.ExpiresAt})
+	require.NoError(t, err)
+	assert.False(t, claimed, "an unexpired key cannot be claimed again")
+
+	found, err := store.Find(ctx, scope, "key-1")
+	require.NoError(t, err)
+	assert.Equal(t, "fp-1", found.Fingerprint)
+	assert.False(t, found.Completed())
+
+	resp := idempotency.Response{StatusCode: 202, Body: []byte(`{"operation_id": 7}`)}
+	require.NoError(t, store.Complete(ctx, scope, "key-1", resp, time.Now().Add(24*time.Hour)))
+	assert.ErrorIs(t, store.Complete(ctx, scope, "key-1", resp, time.Now().Add(24*time.Hour)), idempotency.ErrKeyNotFound,
+		"a completed key cannot be completed again")
+
+	found, err = store.Find(ctx, scope, "key-1")
+	require.NoError(t, err)
+	require.True(t, found.Completed())
+	assert.Equal(t, 202, found.Response.StatusCode)
+	assert.JSONEq(t, `{"operation_id": 7}`, string(found.Response.Body))
+
+	other := idempotency.Scope{Caller: "bob@example.com", Operation: "CreateTenant"}
+	_, err = store.Find(ctx, other, "key-1")
+	assert.ErrorIs(t, err, idempotency.ErrKeyNotFound, "keys are scoped to the caller")
+}
+
+func TestIdempotencyStore_ClaimReplacesExpired(t *testing.T) {
+	t.Parallel()
+
+	ctx, store, cleanup := setupIdempotencyTest(t)
+	defer cleanup()
+
+	scope := idempotency.Scope{Caller: "alice@example.com", Operation: "DeleteTenant"}
+	claimed, err := store.Claim(ctx, &idempotency.Record{
+		Scope: scope, Key: "key-1", Fingerprint: "fp-1", ExpiresAt: time.Now().Add(-time.Minute),
+	})
+	require.NoError(t, err)
+	require.True(t, claimed)
+	require.NoError(t, store.Complete(ctx, scope, "key-1",
+		idempotency.Response{StatusCode: 202, Body: []byte(`{}`)}, time.Now().Add(-time.Minute)))
+
+	claimed, err = store.Claim(ctx, &idempotency.Record{
+		Scope: scope, Key: "key-1", Fingerprint: "fp-2", ExpiresAt: time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	found, err := store.Find(ctx, scope, "key-1")
+	require.NoError(t, err)
+	assert.Equal(t, "fp-2", found.Fingerprint)
+	assert.False(t, found.Completed(), "the expired response is discarded")
+}
+
+func TestIdempotencyStore_LeaseAndCompletion(t *testing.T) {
+	t.Parallel()
+
+	ctx, store, cleanup := setupIdempotencyTest(t)
+	defer cleanup()
+
+	scope := idempotency.Scope{Caller: "alice@example.com", Operation: "CreateTenant"}
+	claimed, err := store.Claim(ctx, &idempotency.Record{
+		Scope: scope, Key: "key-1", Fingerprint: "fp-1", ExpiresAt: time.Now().Add(-time.Second),
+	})
+	require.NoError(t, err)
+	require.True(t, claimed)
+
+	// The request holding the key never finished, so once its lease has run out
+	// a retry takes the key over.
+	claimed, err = store.Claim(ctx, &idempotency.Record{
+		Scope: scope, Key: "key-1", Fingerprint: "fp-1", ExpiresAt: time.Now().Add(time.Minute),
+	})
+	require.NoError(t, err)
+	require.True(t, claimed)
+
+	expiresAt := time.Now().Add(24 * time.Hour).Truncate(time.Microsecond)
+	require.NoError(t, store.Complete(ctx, scope, "key-1",
+		idempotency.Response{StatusCode: 202, Body: []byte(`{}`)}, expiresAt))
+
+	found, err := store.Find(ctx, scope, "key-1")
+	require.NoError(t, err)
+	assert.True(t, found.Completed())
+	assert.True(t, expiresAt.Equal(found.ExpiresAt), "completion keeps the key for its TTL")
+}
+
+func TestIdempotencyStore_ReleaseAndDeleteExpired(t *testing.T) {
+	t.Parallel()
+
+	ctx, store, cleanup := setupIdempotencyTest(t)
+	defer cleanup()
+
+	scope := idempotency.Scope{Caller: "alice@example.com", Operation: "CreateTenant"}
+	now := time.Now()
+	for key, expiresAt := range map[string]time.Time{
+		"released": now.Add(time.Hour),
+		"expired":  now.Add(-time.Hour),
+		"live":     now.Add(time.Hour),
+	} {
+		claimed, err := store.Claim(ctx, &idempotency.Record{Scope: scope, Key: key, Fingerprint: "fp", ExpiresAt: expiresAt})
+		require.NoError(t, err)
+		require.True(t, claimed)
+	}
+	require.NoError(t, store.Complete(ctx, scope, "live",
+		idempotency.Response{StatusCode: 202, Body: []byte(`{}`)}, now.Add(time.Hour)))
+
+	require.NoError(t, store.Release(ctx, scope, "released"))
+	_, err := store.Find(ctx, scope, "released")
+	assert.ErrorIs(t, err, idempotency.ErrKeyNotFound)
+
+	require.NoError(t, store.Release(ctx, scope, "live"))
+	_, err = store.Find(ctx, scope, "live")
+	assert.NoError(t, err, "completed keys are not released")
+
+	deleted, err := store.DeleteExpired(ctx, now)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+	_, err = store.Find(ctx, scope, "expired")
+	assert.ErrorIs(t, err, idempotency.ErrKeyNotFound)
+}
@@ -0,0 +1,164 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ahrav/hoglet-hub/internal/db"
+	"github.com/ahrav/hoglet-hub/internal/domain/isolationgroup"
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+	"github.com/ahrav/hoglet-hub/internal/infra/storage"
+)
+
+var _ isolationgroup.Repository = (*groupStore)(nil)
+
+// groupStore implements isolationgroup.Repository using Postgres and sqlc-generated queries.
+type groupStore struct {
+	q      *db.Queries
+	pool   *pgxpool.Pool
+	tracer trace.Tracer
+}
+
+// NewGroupStore creates an isolationgroup.Repository backed by PostgreSQL.
+func NewGroupStore(pool *pgxpool.Pool, tracer trace.Tracer) isolationgroup.Repository {
+	return &groupStore{q: db.New(pool), pool: pool, tracer: tracer}
+}
+
+// defaultDBAttributes defines standard OpenTelemetry attributes for database operations.
+var defaultDBAttributes = []attribute.KeyValue{attribute.String("db.system", "postgresql")}
+
+// Create persists a new isolation group and returns its ID.
+// A duplicate name is reported as isolationgroup.ErrGroupAlreadyExists.
+func (s *groupStore) Create(ctx context.Context, g *isolationgroup.IsolationGroup) (int64, error) {
+	dbAttrs := append(defaultDBAttributes,
+		attribute.String("isolation_group.name", g.Name),
+		attribute.String("isolation_group.region", string(g.Region)),
+	)
+
+	var id int64
+	err := storage.ExecuteAndTrace(ctx, s.tracer, "groupStore.Create", dbAttrs, func(ctx context.Context) error {
+		var err error
+		id, err = s.q.CreateIsolationGroup(ctx, db.CreateIsolationGroupParams{
+			Name:      g.Name,
+			Region:    db.RegionType(g.Region),
+			CreatedBy: g.CreatedBy,
+		})
+		if storage.IsUniqueViolation(err) {
+			return isolationgroup.ErrGroupAlreadyExists
+		}
+		return err
+	})
+
+	return id, err
+}
+
+// FindByID retrieves an isolation group by ID.
+// Returns isolationgroup.ErrGroupNotFound if the group doesn't exist.
+func (s *groupStore) FindByID(ctx context.Context, id int64) (*isolationgroup.IsolationGroup, error) {
+	dbAttrs := append(defaultDBAttributes, attribute.Int64("isolation_group.id", id))
+
+	var dbGroup db.IsolationGroup
+	err := storage.ExecuteAndTrace(ctx, s.tracer, "groupStore.FindByID", dbAttrs, func(ctx context.Context) error {
+		var err error
+		dbGroup, err = s.q.FindIsolationGroupByID(ctx, id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return isolationgroup.ErrGroupNotFound
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapDBGroupToDomain(dbGroup), nil
+}
+
+// List retrieves the isolation groups matching the filter, ordered by name.
+func (s *groupStore) List(ctx context.Context, filter isolationgroup.ListFilter) ([]*isolationgroup.IsolationGroup, error) {
+	dbAttrs := defaultDBAttributes
+	var region db.NullRegionType
+	if filter.Region != nil {
+		region = db.NullRegionType{RegionType: db.RegionType(*filter.Region), Valid: true}
+		dbAttrs = append(dbAttrs, attribute.String("isolation_group.region", string(*filter.Region)))
+	}
+
+	var dbGroups []db.IsolationGroup
+	err := storage.ExecuteAndTrace(ctx, s.tracer, "groupStore.List", dbAttrs, func(ctx context.Context) error {
+		var err error
+		dbGroups, err = s.q.ListIsolationGroups(ctx, region)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]*isolationgroup.IsolationGroup, 0, len(dbGroups))
+	for _, dbGroup := range dbGroups {
+		groups = append(groups, mapDBGroupToDomain(dbGroup))
+	}
+
+	return groups, nil
+}
+
+// CountTenants returns the number of tenants in the group that have not been deleted.
+func (s *groupStore) CountTenants(ctx context.Context, id int64) (int64, error) {
+	dbAttrs := append(defaultDBAttributes, attribute.Int64("isolation_group.id", id))
+
+	var count int64
+	err := storage.ExecuteAndTrace(ctx, s.tracer, "groupStore.CountTenants", dbAttrs, func(ctx context.Context) error {
+		var err error
+		count, err = s.q.CountIsolationGroupTenants(ctx, pgtype.Int8{Int64: id, Valid: true})
+		return err
+	})
+
+	return count, err
+}
+
+// Delete permanently removes an isolation group.
+// Returns isolationgroup.ErrGroupNotFound if the group doesn't exist.
+func (s *groupStore) Delete(ctx context.Context, id int64) error {
+	dbAttrs := append(defaultDBAttributes, attribute.Int64("isolation_group.id", id))
+
+	return storage.ExecuteAndTrace(ctx, s.tracer, "groupStore.Delete", dbAttrs, func(ctx context.Context) error {
+		rows, err := s.q.DeleteIsolationGroup(ctx, id)
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return isolationgroup.ErrGroupNotFound
+		}
+		return nil
+	})
+}
+
+// mapDBGroupToDomain converts a database isolation group record to a domain isolation group.
+func mapDBGroupToDomain(dbGroup db.IsolationGroup) *isolationgroup.IsolationGroup {
+	var colocationID *int32
+	if dbGroup.CitusColocationID.Valid {
+		val := dbGroup.CitusColocationID.Int32
+		colocationID = &val
+	}
+
+	var updatedAt *time.Time
+	if !dbGroup.UpdatedAt.Time.Equal(dbGroup.CreatedAt.Time) {
+		val := dbGroup.UpdatedAt.Time
+		updatedAt = &val
+	}
+
+	return &isolationgroup.IsolationGroup{
+		ID:                dbGroup.ID,
+		Name:              dbGroup.Name,
+		Region:            tenant.Region(dbGroup.Region),
+		CitusColocationID: colocationID,
+		CreatedBy:         dbGroup.CreatedBy,
+		CreatedAt:         dbGroup.CreatedAt.Time,
+		UpdatedAt:         updatedAt,
+	}
+}
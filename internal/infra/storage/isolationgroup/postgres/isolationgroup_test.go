@@ -0,0 +1,106 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/ahrav/hoglet-hub/internal/db"
+	"github.com/ahrav/hoglet-hub/internal/domain/isolationgroup"
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+	tenantStore "github.com/ahrav/hoglet-hub/internal/infra/storage/tenant/postgres"
+	"github.com/ahrav/hoglet-hub/internal/infra/storage/testutil"
+)
+
+func setupGroupTest(t *testing.T) (context.Context, *groupStore, tenant.Repository, func()) {
+	t.Helper()
+
+	pool, cleanup := testutil.SetupTestContainer(t)
+	tracer := noop.NewTracerProvider().Tracer("test")
+	store := &groupStore{q: db.New(pool), pool: pool, tracer: tracer}
+	ctx := context.Background()
+
+	return ctx, store, tenantStore.NewTenantStore(pool, tracer), cleanup
+}
+
+func TestGroupStore_CreateAndFind(t *testing.T) {
+	t.Parallel()
+
+	ctx, store, _, cleanup := setupGroupTest(t)
+	defer cleanup()
+
+	group, err := isolationgroup.NewIsolationGroup("payments", tenant.RegionEU1, "ops@example.com")
+	require.NoError(t, err)
+
+	id, err := store.Create(ctx, group)
+	require.NoError(t, err)
+
+	found, err := store.FindByID(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, "payments", found.Name)
+	assert.Equal(t, tenant.RegionEU1, found.Region)
+	assert.Equal(t, "ops@example.com", found.CreatedBy)
+
+	_, err = store.Create(ctx, group)
+	assert.ErrorIs(t, err, isolationgroup.ErrGroupAlreadyExists)
+
+	_, err = store.FindByID(ctx, id+1000)
+	assert.ErrorIs(t, err, isolationgroup.ErrGroupNotFound)
+}
+
+func TestGroupStore_List(t *testing.T) {
+	t.Parallel()
+
+	ctx, store, _, cleanup := setupGroupTest(t)
+	defer cleanup()
+
+	for _, g := range []struct {
+		name   string
+		region tenant.Region
+	}{{"zeta", tenant.RegionEU1}, {"alpha", tenant.RegionEU1}, {"bravo", tenant.RegionUS1}} {
+		group, err := isolationgroup.NewIsolationGroup(g.name, g.region, "ops@example.com")
+		require.NoError(t, err)
+		_, err = store.Create(ctx, group)
+		require.NoError(t, err)
+	}
+
+	all, err := store.List(ctx, isolationgroup.ListFilter{})
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	assert.Equal(t, "alpha", all[0].Name)
+
+	region := tenant.RegionEU1
+	eu, err := store.List(ctx, isolationgroup.ListFilter{Region: &region})
+	require.NoError(t, err)
+	require.Len(t, eu, 2)
+	assert.Equal(t, "alpha", eu[0].Name)
+	assert.Equal(t, "zeta", eu[1].Name)
+}
+
+func TestGroupStore_CountTenantsAndDelete(t *testing.T) {
+	t.Parallel()
+
+	ctx, store, tenants, cleanup := setupGroupTest(t)
+	defer cleanup()
+
+	group, err := isolationgroup.NewIsolationGroup("payments", tenant.RegionEU1, "ops@example.com")
+	require.NoError(t, err)
+	groupID, err := store.Create(ctx, group)
+	require.NoError(t, err)
+
+	member, err := tenant.NewTenant("member", tenant.RegionEU1, tenant.TierPro, &groupID)
+	require.NoError(t, err)
+	_, err = tenants.Create(ctx, member)
+	require.NoError(t, err)
+
+	count, err := store.CountTenants(ctx, groupID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	require.NoError(t, store.Delete(ctx, groupID))
+	assert.ErrorIs(t, store.Delete(ctx, groupID), isolationgroup.ErrGroupNotFound)
+}
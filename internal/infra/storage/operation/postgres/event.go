@@ -0,0 +1,106 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ahrav/hoglet-hub/internal/db"
+	"github.com/ahrav/hoglet-hub/internal/domain/operation"
+	"github.com/ahrav/hoglet-hub/internal/infra/storage"
+)
+
+var _ operation.EventRepository = (*eventStore)(nil)
+
+// eventStore implements operation.EventRepository using Postgres and sqlc-generated queries.
+type eventStore struct {
+	q      *db.Queries
+	tracer trace.Tracer
+}
+
+// NewEventStore creates an operation.EventRepository backed by PostgreSQL.
+// It reads the operation events recorded by the operation and step stores.
+func NewEventStore(pool *pgxpool.Pool, tracer trace.Tracer) operation.EventRepository {
+	return &eventStore{q: db.New(pool), tracer: tracer}
+}
+
+// ListEvents retrieves up to limit events of an operation recorded after the
+// event with ID afterID, oldest first.
+func (s *eventStore) ListEvents(
+	ctx context.Context,
+	operationID, afterID int64,
+	limit int,
+) ([]*operation.Event, error) {
+	dbAttrs := append(defaultDBAttributes,
+		attribute.Int64("operation.id", operationID),
+		attribute.Int64("event.after_id", afterID),
+	)
+
+	var dbEvents []db.OperationEvent
+	err := storage.ExecuteAndTrace(ctx, s.tracer, "eventStore.ListEvents", dbAttrs, func(ctx context.Context) error {
+		var err error
+		dbEvents, err = storage.Queries(ctx, s.q).ListOperationEvents(ctx, db.ListOperationEventsParams{
+			OperationID: operationID,
+			AfterID:     afterID,
+			RowLimit:    int32(limit),
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*operation.Event, 0, len(dbEvents))
+	for _, dbEvent := range dbEvents {
+		events = append(events, mapDBEventToDomain(dbEvent))
+	}
+	return events, nil
+}
+
+// appendEvent records an operation event. The queries must be bound to the
+// transaction persisting the change the event describes, and that transaction
+// must hold the operation's row lock so that the operation's events become
+// visible in ID order.
+func appendEvent(ctx context.Context, q *db.Queries, e *operation.Event) error {
+	params := db.CreateOperationEventParams{
+		OperationID: e.OperationID,
+		EventType:   string(e.Type),
+		Status:      e.Status,
+	}
+	if e.StepIndex != nil {
+		params.StepIndex = pgtype.Int4{Int32: int32(*e.StepIndex), Valid: true}
+	}
+	if e.StepName != nil {
+		params.StepName = pgtype.Text{String: *e.StepName, Valid: true}
+	}
+	if e.ErrorMessage != nil {
+		params.ErrorMessage = pgtype.Text{String: *e.ErrorMessage, Valid: true}
+	}
+
+	return q.CreateOperationEvent(ctx, params)
+}
+
+// mapDBEventToDomain converts a database event record to a domain operation event.
+func mapDBEventToDomain(dbEvent db.OperationEvent) *operation.Event {
+	e := &operation.Event{
+		ID:          dbEvent.ID,
+		OperationID: dbEvent.OperationID,
+		Type:        operation.EventType(dbEvent.EventType),
+		Status:      dbEvent.Status,
+		OccurredAt:  dbEvent.OccurredAt.Time,
+	}
+	if dbEvent.StepIndex.Valid {
+		index := int(dbEvent.StepIndex.Int32)
+		e.StepIndex = &index
+	}
+	if dbEvent.StepName.Valid {
+		e.StepName = &dbEvent.StepName.String
+	}
+	if dbEvent.ErrorMessage.Valid {
+		e.ErrorMessage = &dbEvent.ErrorMessage.String
+	}
+	return e
+}
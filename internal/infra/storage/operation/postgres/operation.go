@@ -14,7 +14,9 @@ import (
 
 	"github.com/ahrav/hoglet-hub/internal/db"
 	"github.com/ahrav/hoglet-hub/internal/domain/operation"
+	"github.com/ahrav/hoglet-hub/internal/domain/outbox"
 	"github.com/ahrav/hoglet-hub/internal/infra/storage"
+	outboxStore "github.com/ahrav/hoglet-hub/internal/infra/storage/outbox/postgres"
 )
 
 // Package postgres provides PostgreSQL implementations of the domain repositories.
@@ -22,22 +24,35 @@ var _ operation.Repository = (*operationStore)(nil)
 
 // operationStore implements operation.Repository using Postgres and sqlc-generated queries.
 type operationStore struct {
-	q      *db.Queries
-	pool   *pgxpool.Pool
-	tracer trace.Tracer
+	q          *db.Queries
+	pool       *pgxpool.Pool
+	transactor *storage.Transactor
+	events     outbox.Repository
+	tracer     trace.Tracer
 }
 
 // defaultDBAttributes defines standard OpenTelemetry attributes for database operations.
 var defaultDBAttributes = []attribute.KeyValue{attribute.String("db.system", "postgresql")}
 
 // NewOperationStore creates an operation.Repository backed by PostgreSQL.
-// It provides persistence for operation entities and their lifecycle management.
+// It provides persistence for operation entities and their lifecycle management,
+// recording the lifecycle events of operations in the outbox.
 func NewOperationStore(pool *pgxpool.Pool, tracer trace.Tracer) operation.Repository {
-	return &operationStore{q: db.New(pool), pool: pool, tracer: tracer}
+	return &operationStore{
+		q:          db.New(pool),
+		pool:       pool,
+		transactor: storage.NewTransactor(pool, tracer),
+		events:     outboxStore.NewOutboxStore(pool, tracer),
+		tracer:     tracer,
+	}
 }
 
+// queries returns the queries to run, bound to the transaction ctx carries, if any.
+func (s *operationStore) queries(ctx context.Context) *db.Queries { return storage.Queries(ctx, s.q) }
+
 // Create persists a new operation and returns its ID.
-// It handles serialization of operation parameters and sets default values where needed.
+// It handles serialization of operation parameters and sets default values where needed,
+// and records the operation's initial status as its first event.
 func (s *operationStore) Create(ctx context.Context, op *operation.Operation) (int64, error) {
 	dbAttrs := append(defaultDBAttributes,
 		attribute.String("operation.type", string(op.Type)),
@@ -66,23 +81,71 @@ func (s *operationStore) Create(ctx context.Context, op *operation.Operation) (i
 			createdBy = *op.CreatedBy
 		}
 
-		var createErr error
-		id, createErr = s.q.CreateOperation(ctx, db.CreateOperationParams{
-			TenantID:      tenantID,
-			OperationType: string(op.Type),
-			Status:        db.OperationStatus(op.Status),
-			Parameters:    paramsJSON,
-			CreatedBy:     createdBy,
+		var parentID pgtype.Int8
+		if op.ParentID != nil {
+			parentID.Int64 = *op.ParentID
+			parentID.Valid = true
+		}
+
+		return s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			var err error
+			id, err = s.queries(ctx).CreateOperation(ctx, db.CreateOperationParams{
+				TenantID:          tenantID,
+				OperationType:     string(op.Type),
+				Status:            db.OperationStatus(op.Status),
+				Parameters:        paramsJSON,
+				CreatedBy:         createdBy,
+				ParentOperationID: parentID,
+				Attempt:           int32(max(op.Attempt, 1)),
+			})
+			if err != nil {
+				return err
+			}
+
+			// Nobody else can see the operation yet, so its first event needs no lock.
+			created := *op
+			created.ID = id
+			return appendEvent(ctx, s.queries(ctx), operation.NewStatusEvent(&created))
 		})
-		return createErr
 	})
+	// The only unique constraint on operations allows a single retry per operation.
+	if storage.IsUniqueViolation(err) {
+		return 0, operation.ErrOperationAlreadyRetried
+	}
 
 	return id, err
 }
 
 // Update modifies an existing operation with new state information.
-// This is used to track operation progress, results, and completion status.
+// This is used to track operation progress, results, and completion status, and
+// the parameters workflow steps record for later runs of the operation.
+// A change of status is recorded as an operation event, and the lifecycle events
+// it implies are appended to the outbox, in the same transaction, so they are
+// published if and only if the change is persisted. Returns ErrOperationNotFound
+// if the operation doesn't exist.
 func (s *operationStore) Update(ctx context.Context, op *operation.Operation) error {
+	_, err := s.update(ctx, "operationStore.Update", op, nil)
+	return err
+}
+
+// TransitionStatus persists the operation as Update does, provided its stored
+// status is still from. It returns false, and changes nothing, if the status has
+// moved on, e.g. because a concurrent request made the same transition first.
+func (s *operationStore) TransitionStatus(
+	ctx context.Context,
+	op *operation.Operation,
+	from operation.Status,
+) (bool, error) {
+	return s.update(ctx, "operationStore.TransitionStatus", op, &from)
+}
+
+// update persists the operation, if from is nil or matches its stored status.
+func (s *operationStore) update(
+	ctx context.Context,
+	spanName string,
+	op *operation.Operation,
+	from *operation.Status,
+) (bool, error) {
 	dbAttrs := append(defaultDBAttributes,
 		attribute.Int64("operation.id", op.ID),
 		attribute.String("operation.status", string(op.Status)),
@@ -92,18 +155,30 @@ func (s *operationStore) Update(ctx context.Context, op *operation.Operation) er
 		dbAttrs = append(dbAttrs, attribute.Int64("tenant.id", *op.TenantID))
 	}
 
-	return storage.ExecuteAndTrace(ctx, s.tracer, "operationStore.Update", dbAttrs, func(ctx context.Context) error {
+	var updated bool
+	err := storage.ExecuteAndTrace(ctx, s.tracer, spanName, dbAttrs, func(ctx context.Context) error {
 		resultJSON, err := json.Marshal(op.Result)
 		if err != nil {
 			return err
 		}
 
+		paramsJSON, err := json.Marshal(op.Parameters)
+		if err != nil {
+			return err
+		}
+
 		var errorMsg pgtype.Text
 		if op.ErrorMessage != nil {
 			errorMsg.String = *op.ErrorMessage
 			errorMsg.Valid = true
 		}
 
+		var cancelledBy pgtype.Text
+		if op.CancelledBy != nil {
+			cancelledBy.String = *op.CancelledBy
+			cancelledBy.Valid = true
+		}
+
 		var startedAt, completedAt pgtype.Timestamptz
 		if op.StartedAt != nil {
 			startedAt.Time = *op.StartedAt
@@ -114,15 +189,47 @@ func (s *operationStore) Update(ctx context.Context, op *operation.Operation) er
 			completedAt.Valid = true
 		}
 
-		return s.q.UpdateOperation(ctx, db.UpdateOperationParams{
-			ID:           op.ID,
-			Status:       db.OperationStatus(op.Status),
-			Result:       resultJSON,
-			ErrorMessage: errorMsg,
-			StartedAt:    startedAt,
-			CompletedAt:  completedAt,
+		return s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			// Locking the row orders concurrent updates, so that each change of
+			// status is seen, and its events recorded, exactly once.
+			previous, err := s.queries(ctx).LockOperationStatus(ctx, op.ID)
+			if err != nil {
+				if errors.Is(err, pgx.ErrNoRows) {
+					return operation.ErrOperationNotFound
+				}
+				return err
+			}
+			if from != nil && operation.Status(previous) != *from {
+				return nil
+			}
+
+			if err := s.queries(ctx).UpdateOperation(ctx, db.UpdateOperationParams{
+				ID:           op.ID,
+				Status:       db.OperationStatus(op.Status),
+				Result:       resultJSON,
+				ErrorMessage: errorMsg,
+				StartedAt:    startedAt,
+				CompletedAt:  completedAt,
+				CancelledBy:  cancelledBy,
+				Parameters:   paramsJSON,
+			}); err != nil {
+				return err
+			}
+
+			if operation.Status(previous) != op.Status {
+				if err := appendEvent(ctx, s.queries(ctx), operation.NewStatusEvent(op)); err != nil {
+					return err
+				}
+			}
+
+			if err := s.events.Append(ctx, outbox.OperationEvents(op, operation.Status(previous))...); err != nil {
+				return err
+			}
+			updated = true
+			return nil
 		})
 	})
+	return updated, err
 }
 
 // FindByID retrieves an operation by ID.
@@ -133,7 +240,7 @@ func (s *operationStore) FindByID(ctx context.Context, id int64) (*operation.Ope
 	var dbOp db.Operation
 	err := storage.ExecuteAndTrace(ctx, s.tracer, "operationStore.FindByID", dbAttrs, func(ctx context.Context) error {
 		var err error
-		dbOp, err = s.q.FindOperationByID(ctx, id)
+		dbOp, err = s.queries(ctx).FindOperationByID(ctx, id)
 		if err != nil {
 			if errors.Is(err, pgx.ErrNoRows) {
 				return operation.ErrOperationNotFound
@@ -159,7 +266,7 @@ func (s *operationStore) FindByTenantID(ctx context.Context, tenantID int64) ([]
 	err := storage.ExecuteAndTrace(ctx, s.tracer, "operationStore.FindByTenantID", dbAttrs, func(ctx context.Context) error {
 		tenantIDPg := pgtype.Int8{Int64: tenantID, Valid: true}
 		var err error
-		dbOps, err = s.q.FindOperationsByTenantID(ctx, tenantIDPg)
+		dbOps, err = s.queries(ctx).FindOperationsByTenantID(ctx, tenantIDPg)
 		return err
 	})
 
@@ -178,7 +285,7 @@ func (s *operationStore) FindByStatus(ctx context.Context, status operation.Stat
 	var dbOps []db.Operation
 	err := storage.ExecuteAndTrace(ctx, s.tracer, "operationStore.FindByStatus", dbAttrs, func(ctx context.Context) error {
 		var err error
-		dbOps, err = s.q.FindOperationsByStatus(ctx, db.OperationStatus(status))
+		dbOps, err = s.queries(ctx).FindOperationsByStatus(ctx, db.OperationStatus(status))
 		return err
 	})
 
@@ -197,7 +304,7 @@ func (s *operationStore) FindIncomplete(ctx context.Context) ([]*operation.Opera
 	var dbOps []db.Operation
 	err := storage.ExecuteAndTrace(ctx, s.tracer, "operationStore.FindIncomplete", dbAttrs, func(ctx context.Context) error {
 		var err error
-		dbOps, err = s.q.FindIncompleteOperations(ctx)
+		dbOps, err = s.queries(ctx).FindIncompleteOperations(ctx)
 		return err
 	})
 
@@ -208,6 +315,88 @@ func (s *operationStore) FindIncomplete(ctx context.Context) ([]*operation.Opera
 	return mapDBOperationsToDomain(dbOps)
 }
 
+// List retrieves a page of operations, newest first, using keyset pagination on
+// creation time. One extra row is fetched to determine whether a following page exists.
+func (s *operationStore) List(ctx context.Context, params operation.ListParams) (*operation.Page, error) {
+	dbAttrs := append(defaultDBAttributes, attribute.Int("operation.limit", params.Limit))
+	if params.Filter.TenantID != nil {
+		dbAttrs = append(dbAttrs, attribute.Int64("tenant.id", *params.Filter.TenantID))
+	}
+
+	var dbOps []db.Operation
+	err := storage.ExecuteAndTrace(ctx, s.tracer, "operationStore.List", dbAttrs, func(ctx context.Context) error {
+		var tenantID pgtype.Int8
+		if params.Filter.TenantID != nil {
+			tenantID = pgtype.Int8{Int64: *params.Filter.TenantID, Valid: true}
+		}
+
+		var opType pgtype.Text
+		if params.Filter.Type != nil {
+			opType = pgtype.Text{String: params.Filter.Type.String(), Valid: true}
+		}
+
+		var status db.NullOperationStatus
+		if params.Filter.Status != nil {
+			status = db.NullOperationStatus{OperationStatus: db.OperationStatus(*params.Filter.Status), Valid: true}
+		}
+
+		var createdBy pgtype.Text
+		if params.Filter.CreatedBy != "" {
+			createdBy = pgtype.Text{String: params.Filter.CreatedBy, Valid: true}
+		}
+
+		var createdAfter, createdBefore pgtype.Timestamptz
+		if params.Filter.CreatedAfter != nil {
+			createdAfter = pgtype.Timestamptz{Time: *params.Filter.CreatedAfter, Valid: true}
+		}
+		if params.Filter.CreatedBefore != nil {
+			createdBefore = pgtype.Timestamptz{Time: *params.Filter.CreatedBefore, Valid: true}
+		}
+
+		var cursorCreatedAt pgtype.Timestamptz
+		var cursorID pgtype.Int8
+		if params.After != nil {
+			cursorCreatedAt = pgtype.Timestamptz{Time: params.After.CreatedAt, Valid: true}
+			cursorID = pgtype.Int8{Int64: params.After.ID, Valid: true}
+		}
+
+		var err error
+		dbOps, err = s.queries(ctx).ListOperations(ctx, db.ListOperationsParams{
+			TenantID:        tenantID,
+			OperationType:   opType,
+			Status:          status,
+			CreatedBy:       createdBy,
+			CreatedAfter:    createdAfter,
+			CreatedBefore:   createdBefore,
+			CursorCreatedAt: cursorCreatedAt,
+			CursorID:        cursorID,
+			PageLimit:       int32(params.Limit + 1),
+		})
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	hasMore := len(dbOps) > params.Limit
+	if hasMore {
+		dbOps = dbOps[:params.Limit]
+	}
+
+	ops, err := mapDBOperationsToDomain(dbOps)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &operation.Page{Operations: ops}
+	if hasMore {
+		page.Next = operation.CursorAt(ops[len(ops)-1])
+	}
+
+	return page, nil
+}
+
 // mapDBOperationToDomain converts a database operation record to a domain operation entity.
 // It handles nullable fields and JSON deserialization of parameters and results.
 func mapDBOperationToDomain(dbOp db.Operation) (*operation.Operation, error) {
@@ -241,6 +430,18 @@ func mapDBOperationToDomain(dbOp db.Operation) (*operation.Operation, error) {
 		errorMessage = &val
 	}
 
+	var cancelledBy *string
+	if dbOp.CancelledBy.Valid {
+		val := dbOp.CancelledBy.String
+		cancelledBy = &val
+	}
+
+	var parentID *int64
+	if dbOp.ParentOperationID.Valid {
+		val := dbOp.ParentOperationID.Int64
+		parentID = &val
+	}
+
 	createdBy := "system"
 	if dbOp.CreatedBy != "" {
 		createdBy = dbOp.CreatedBy
@@ -276,9 +477,12 @@ func mapDBOperationToDomain(dbOp db.Operation) (*operation.Operation, error) {
 		CompletedAt:  completedAt,
 		UpdatedAt:    updatedAt,
 		CreatedBy:    createdByPtr,
+		CancelledBy:  cancelledBy,
 		ErrorMessage: errorMessage,
 		Parameters:   params,
 		Result:       result,
+		ParentID:     parentID,
+		Attempt:      int(dbOp.Attempt),
 	}, nil
 }
 
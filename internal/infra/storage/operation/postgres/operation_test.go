@@ -9,8 +9,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/otel/trace/noop"
 
-	"github.com/ahrav/hoglet-hub/internal/db"
 	"github.com/ahrav/hoglet-hub/internal/domain/operation"
+	"github.com/ahrav/hoglet-hub/internal/domain/outbox"
 	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
 	tenantRepo "github.com/ahrav/hoglet-hub/internal/infra/storage/tenant/postgres"
 	"github.com/ahrav/hoglet-hub/internal/infra/storage/testutil"
@@ -21,11 +21,7 @@ func setupOperationTest(t *testing.T) (context.Context, *operationStore, tenant.
 
 	pool, cleanup := testutil.SetupTestContainer(t)
 	tracer := noop.NewTracerProvider().Tracer("test")
-	opStore := &operationStore{
-		q:      db.New(pool),
-		pool:   pool,
-		tracer: tracer,
-	}
+	opStore := NewOperationStore(pool, tracer).(*operationStore)
 	tenantStore := tenantRepo.NewTenantStore(pool, tracer)
 	ctx := context.Background()
 
@@ -93,6 +89,138 @@ func TestOperationStore_Update(t *testing.T) {
 	assert.NotNil(t, updatedOp.StartedAt)
 }
 
+func TestOperationStore_Update_Parameters(t *testing.T) {
+	t.Parallel()
+
+	ctx, opStore, tenantStore, cleanup := setupOperationTest(t)
+	defer cleanup()
+
+	tenantID := createTestTenant(t, ctx, tenantStore)
+	op, err := operation.NewTenantCreateOperation(tenantID, "test-tenant", "us1", "free", nil)
+	require.NoError(t, err)
+	op.ID, err = opStore.Create(ctx, op)
+	require.NoError(t, err)
+
+	// Workflow steps record their progress in the parameters, e.g. the project
+	// quota was reserved in, and later runs rely on reading it back.
+	op.Parameters["project_id"] = "proj-us1-2"
+	op.Parameters["reserved_project_id"] = "proj-us1-2"
+	require.NoError(t, opStore.Update(ctx, op))
+
+	updatedOp, err := opStore.FindByID(ctx, op.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "proj-us1-2", updatedOp.Parameters["project_id"])
+	assert.Equal(t, "proj-us1-2", updatedOp.Parameters["reserved_project_id"])
+	assert.Equal(t, "test-tenant", updatedOp.Parameters["name"])
+
+	delete(updatedOp.Parameters, "reserved_project_id")
+	require.NoError(t, opStore.Update(ctx, updatedOp))
+
+	updatedOp, err = opStore.FindByID(ctx, op.ID)
+	require.NoError(t, err)
+	assert.NotContains(t, updatedOp.Parameters, "reserved_project_id")
+	assert.Equal(t, "proj-us1-2", updatedOp.Parameters["project_id"])
+}
+
+func TestOperationStore_TransitionStatus(t *testing.T) {
+	t.Parallel()
+
+	ctx, opStore, tenantStore, cleanup := setupOperationTest(t)
+	defer cleanup()
+
+	tenantID := createTestTenant(t, ctx, tenantStore)
+	op, err := operation.NewTenantMigrateOperation(tenantID, "us1", "eu1")
+	require.NoError(t, err)
+	op.ID, err = opStore.Create(ctx, op)
+	require.NoError(t, err)
+
+	op.Start()
+	require.NoError(t, op.Pause())
+	require.NoError(t, opStore.Update(ctx, op))
+
+	// Two requests read the paused operation and both try to resume it.
+	first, err := opStore.FindByID(ctx, op.ID)
+	require.NoError(t, err)
+	second, err := opStore.FindByID(ctx, op.ID)
+	require.NoError(t, err)
+	require.NoError(t, first.Resume())
+	require.NoError(t, second.Resume())
+
+	resumed, err := opStore.TransitionStatus(ctx, first, operation.StatusPaused)
+	require.NoError(t, err)
+	assert.True(t, resumed)
+
+	resumed, err = opStore.TransitionStatus(ctx, second, operation.StatusPaused)
+	require.NoError(t, err)
+	assert.False(t, resumed, "only one resume may win")
+
+	found, err := opStore.FindByID(ctx, op.ID)
+	require.NoError(t, err)
+	assert.Equal(t, operation.StatusInProgress, found.Status)
+
+	_, err = opStore.TransitionStatus(ctx, &operation.Operation{ID: 99999}, operation.StatusPaused)
+	assert.ErrorIs(t, err, operation.ErrOperationNotFound)
+}
+
+func TestOperationStore_Update_RecordsEvents(t *testing.T) {
+	t.Parallel()
+
+	ctx, opStore, tenantStore, cleanup := setupOperationTest(t)
+	defer cleanup()
+
+	tenantID := createTestTenant(t, ctx, tenantStore)
+
+	op, err := operation.NewTenantCreateOperation(tenantID, "test-tenant", "us1", "free", nil)
+	require.NoError(t, err)
+	op.ID, err = opStore.Create(ctx, op)
+	require.NoError(t, err)
+
+	op.Start()
+	require.NoError(t, opStore.Update(ctx, op))
+	// Updating without a change of status records nothing.
+	require.NoError(t, opStore.Update(ctx, op))
+	op.Complete(nil)
+	require.NoError(t, opStore.Update(ctx, op))
+
+	// Events about the tenant are listed one at a time, in the order they occurred.
+	var events []*outbox.Event
+	for {
+		pending, err := opStore.events.ListPending(ctx, 10)
+		require.NoError(t, err)
+		if len(pending) == 0 {
+			break
+		}
+		for _, e := range pending {
+			require.NoError(t, opStore.events.MarkPublished(ctx, e.ID))
+		}
+		events = append(events, pending...)
+	}
+	require.Len(t, events, 3)
+	assert.Equal(t, outbox.EventOperationStarted, events[0].Type)
+	assert.Equal(t, outbox.EventOperationCompleted, events[1].Type)
+	assert.Equal(t, outbox.EventTenantActivated, events[2].Type)
+	for _, e := range events {
+		assert.Equal(t, &tenantID, e.TenantID)
+		assert.Equal(t, &op.ID, e.OperationID)
+	}
+}
+
+func TestOperationStore_Update_NotFound(t *testing.T) {
+	t.Parallel()
+
+	ctx, opStore, tenantStore, cleanup := setupOperationTest(t)
+	defer cleanup()
+
+	tenantID := createTestTenant(t, ctx, tenantStore)
+	op, err := operation.NewTenantCreateOperation(tenantID, "test-tenant", "us1", "free", nil)
+	require.NoError(t, err)
+	op.ID = 99999
+	op.Start()
+
+	err = opStore.Update(ctx, op)
+	assert.ErrorIs(t, err, operation.ErrOperationNotFound)
+}
+
 func TestOperationStore_FindByTenantID(t *testing.T) {
 	t.Parallel()
 
@@ -308,3 +436,148 @@ func TestOperationStore_FailOperation(t *testing.T) {
 	assert.NotNil(t, failedOp.CompletedAt)
 	assert.Equal(t, errorMsg, *failedOp.ErrorMessage)
 }
+
+func TestOperationStore_CancelOperation(t *testing.T) {
+	t.Parallel()
+
+	ctx, opStore, tenantStore, cleanup := setupOperationTest(t)
+	defer cleanup()
+
+	tenantID := createTestTenant(t, ctx, tenantStore)
+
+	op, err := operation.NewTenantCreateOperation(tenantID, "test-tenant", "us1", "free", nil)
+	require.NoError(t, err)
+
+	id, err := opStore.Create(ctx, op)
+	require.NoError(t, err)
+
+	savedOp, err := opStore.FindByID(ctx, id)
+	require.NoError(t, err)
+	assert.Nil(t, savedOp.CancelledBy)
+
+	require.NoError(t, savedOp.CancelBy("created in the wrong region", "alice@example.com"))
+	err = opStore.Update(ctx, savedOp)
+	require.NoError(t, err)
+
+	cancelledOp, err := opStore.FindByID(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, operation.StatusCancelled, cancelledOp.Status)
+	assert.NotNil(t, cancelledOp.CompletedAt)
+	assert.Equal(t, "created in the wrong region", *cancelledOp.ErrorMessage)
+	require.NotNil(t, cancelledOp.CancelledBy)
+	assert.Equal(t, "alice@example.com", *cancelledOp.CancelledBy)
+}
+
+func TestOperationStore_CreateRetry(t *testing.T) {
+	t.Parallel()
+
+	ctx, opStore, tenantStore, cleanup := setupOperationTest(t)
+	defer cleanup()
+
+	tenantID := createTestTenant(t, ctx, tenantStore)
+
+	op, err := operation.NewTenantCreateOperation(tenantID, "test-tenant", "us1", "free", nil)
+	require.NoError(t, err)
+	parentID, err := opStore.Create(ctx, op)
+	require.NoError(t, err)
+
+	parent, err := opStore.FindByID(ctx, parentID)
+	require.NoError(t, err)
+	assert.Nil(t, parent.ParentID)
+	assert.Equal(t, 1, parent.Attempt)
+
+	parent.Start()
+	parent.Fail("database unavailable")
+	require.NoError(t, opStore.Update(ctx, parent))
+
+	retry, err := parent.NewRetry()
+	require.NoError(t, err)
+	retryID, err := opStore.Create(ctx, retry)
+	require.NoError(t, err)
+
+	savedRetry, err := opStore.FindByID(ctx, retryID)
+	require.NoError(t, err)
+	require.NotNil(t, savedRetry.ParentID)
+	assert.Equal(t, parentID, *savedRetry.ParentID)
+	assert.Equal(t, 2, savedRetry.Attempt)
+
+	// A failed operation can only be retried once.
+	duplicate, err := parent.NewRetry()
+	require.NoError(t, err)
+	_, err = opStore.Create(ctx, duplicate)
+	assert.ErrorIs(t, err, operation.ErrOperationAlreadyRetried)
+}
+
+func TestOperationStore_List(t *testing.T) {
+	t.Parallel()
+
+	ctx, opStore, tenantStore, cleanup := setupOperationTest(t)
+	defer cleanup()
+
+	tenantID := createTestTenant(t, ctx, tenantStore)
+
+	var ids []int64
+	for range 3 {
+		op, err := operation.NewTenantCreateOperation(tenantID, "test-tenant", "us1", "free", nil)
+		require.NoError(t, err)
+		id, err := opStore.Create(ctx, op)
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+
+	deleteOp, err := operation.NewTenantDeleteOperation(tenantID)
+	require.NoError(t, err)
+	deleteID, err := opStore.Create(ctx, deleteOp)
+	require.NoError(t, err)
+
+	opIDs := func(page *operation.Page) []int64 {
+		var out []int64
+		for _, op := range page.Operations {
+			out = append(out, op.ID)
+		}
+		return out
+	}
+
+	t.Run("pages newest first", func(t *testing.T) {
+		params := operation.ListParams{Filter: operation.ListFilter{TenantID: &tenantID}, Limit: 3}
+		first, err := opStore.List(ctx, params)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{deleteID, ids[2], ids[1]}, opIDs(first))
+		require.NotNil(t, first.Next)
+
+		params.After = first.Next
+		second, err := opStore.List(ctx, params)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{ids[0]}, opIDs(second))
+		assert.Nil(t, second.Next)
+	})
+
+	t.Run("filters by type", func(t *testing.T) {
+		opType := operation.OpTenantDelete
+		page, err := opStore.List(ctx, operation.ListParams{
+			Filter: operation.ListFilter{TenantID: &tenantID, Type: &opType},
+			Limit:  10,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{deleteID}, opIDs(page))
+	})
+
+	t.Run("filters by creation time", func(t *testing.T) {
+		last, err := opStore.FindByID(ctx, deleteID)
+		require.NoError(t, err)
+
+		page, err := opStore.List(ctx, operation.ListParams{
+			Filter: operation.ListFilter{TenantID: &tenantID, CreatedBefore: &last.CreatedAt},
+			Limit:  10,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{ids[2], ids[1], ids[0]}, opIDs(page))
+
+		page, err = opStore.List(ctx, operation.ListParams{
+			Filter: operation.ListFilter{TenantID: &tenantID, CreatedAfter: &last.CreatedAt},
+			Limit:  10,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{deleteID}, opIDs(page))
+	})
+}
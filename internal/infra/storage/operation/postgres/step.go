@@ -0,0 +1,234 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ahrav/hoglet-hub/internal/db"
+	"github.com/ahrav/hoglet-hub/internal/domain/operation"
+	"github.com/ahrav/hoglet-hub/internal/infra/storage"
+)
+
+var _ operation.StepRepository = (*stepStore)(nil)
+
+// stepStore implements operation.StepRepository using Postgres and sqlc-generated queries.
+type stepStore struct {
+	q          *db.Queries
+	pool       *pgxpool.Pool
+	transactor *storage.Transactor
+	tracer     trace.Tracer
+}
+
+// NewStepStore creates an operation.StepRepository backed by PostgreSQL.
+// It provides durable checkpoints of workflow step progress, recording each
+// change of a step as an event of its operation.
+func NewStepStore(pool *pgxpool.Pool, tracer trace.Tracer) operation.StepRepository {
+	return &stepStore{
+		q:          db.New(pool),
+		pool:       pool,
+		transactor: storage.NewTransactor(pool, tracer),
+		tracer:     tracer,
+	}
+}
+
+// queries returns the queries to run, bound to the transaction ctx carries, if any.
+func (s *stepStore) queries(ctx context.Context) *db.Queries { return storage.Queries(ctx, s.q) }
+
+// withEvent runs fn in a transaction that holds the operation's row lock and
+// then records the event, so that the step change and its event are persisted
+// together and the operation's events become visible in ID order.
+func (s *stepStore) withEvent(
+	ctx context.Context,
+	operationID int64,
+	fn func(ctx context.Context) error,
+	event func() *operation.Event,
+) error {
+	return s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := s.queries(ctx).LockOperationStatus(ctx, operationID); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return operation.ErrOperationNotFound
+			}
+			return err
+		}
+
+		if err := fn(ctx); err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, s.queries(ctx), event())
+	})
+}
+
+// StartStep records that a step has begun executing.
+// Re-starting an existing step resets its outcome and increments its attempt count.
+// Returns ErrOperationNotFound if the step's operation doesn't exist.
+func (s *stepStore) StartStep(ctx context.Context, step *operation.StepState) error {
+	dbAttrs := append(defaultDBAttributes,
+		attribute.Int64("operation.id", step.OperationID),
+		attribute.String("step.name", step.Name),
+	)
+
+	return storage.ExecuteAndTrace(ctx, s.tracer, "stepStore.StartStep", dbAttrs, func(ctx context.Context) error {
+		return s.withEvent(ctx, step.OperationID, func(ctx context.Context) error {
+			return s.queries(ctx).StartOperationStep(ctx, db.StartOperationStepParams{
+				OperationID: step.OperationID,
+				StepIndex:   int32(step.Index),
+				StepName:    step.Name,
+			})
+		}, func() *operation.Event {
+			return operation.NewStepEvent(operation.EventStepStarted, step)
+		})
+	})
+}
+
+// FinishStep records the terminal outcome of a step.
+// Returns ErrOperationNotFound if the step's operation doesn't exist.
+func (s *stepStore) FinishStep(ctx context.Context, step *operation.StepState) error {
+	dbAttrs := append(defaultDBAttributes,
+		attribute.Int64("operation.id", step.OperationID),
+		attribute.String("step.name", step.Name),
+		attribute.String("step.status", string(step.Status)),
+	)
+
+	return storage.ExecuteAndTrace(ctx, s.tracer, "stepStore.FinishStep", dbAttrs, func(ctx context.Context) error {
+		var errorMsg pgtype.Text
+		if step.ErrorMessage != nil {
+			errorMsg.String = *step.ErrorMessage
+			errorMsg.Valid = true
+		}
+
+		return s.withEvent(ctx, step.OperationID, func(ctx context.Context) error {
+			return s.queries(ctx).FinishOperationStep(ctx, db.FinishOperationStepParams{
+				OperationID:  step.OperationID,
+				StepName:     step.Name,
+				Status:       db.OperationStepStatus(step.Status),
+				ErrorMessage: errorMsg,
+			})
+		}, func() *operation.Event {
+			return operation.NewStepEvent(operation.EventStepFinished, step)
+		})
+	})
+}
+
+// CompensateStep marks a completed step as undone by its compensation.
+// Returns ErrOperationNotFound if the operation doesn't exist.
+func (s *stepStore) CompensateStep(ctx context.Context, operationID int64, name string) error {
+	dbAttrs := append(defaultDBAttributes,
+		attribute.Int64("operation.id", operationID),
+		attribute.String("step.name", name),
+	)
+
+	return storage.ExecuteAndTrace(ctx, s.tracer, "stepStore.CompensateStep", dbAttrs, func(ctx context.Context) error {
+		return s.withEvent(ctx, operationID, func(ctx context.Context) error {
+			return s.queries(ctx).CompensateOperationStep(ctx, db.CompensateOperationStepParams{
+				OperationID: operationID,
+				StepName:    name,
+			})
+		}, func() *operation.Event {
+			return operation.NewStepCompensatedEvent(operationID, name)
+		})
+	})
+}
+
+// CopyCompletedSteps copies the completed step checkpoints of one operation to another.
+func (s *stepStore) CopyCompletedSteps(ctx context.Context, fromOperationID, toOperationID int64) error {
+	dbAttrs := append(defaultDBAttributes,
+		attribute.Int64("operation.id", toOperationID),
+		attribute.Int64("operation.source_id", fromOperationID),
+	)
+
+	return storage.ExecuteAndTrace(ctx, s.tracer, "stepStore.CopyCompletedSteps", dbAttrs, func(ctx context.Context) error {
+		return s.q.CopyCompletedOperationSteps(ctx, db.CopyCompletedOperationStepsParams{
+			TargetOperationID: toOperationID,
+			SourceOperationID: fromOperationID,
+		})
+	})
+}
+
+// FindSteps retrieves all step checkpoints recorded for an operation.
+func (s *stepStore) FindSteps(ctx context.Context, operationID int64) ([]*operation.StepState, error) {
+	dbAttrs := append(defaultDBAttributes, attribute.Int64("operation.id", operationID))
+
+	var dbSteps []db.OperationStep
+	err := storage.ExecuteAndTrace(ctx, s.tracer, "stepStore.FindSteps", dbAttrs, func(ctx context.Context) error {
+		var err error
+		dbSteps, err = s.q.FindOperationSteps(ctx, operationID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	steps := make([]*operation.StepState, 0, len(dbSteps))
+	for _, dbStep := range dbSteps {
+		steps = append(steps, mapDBStepToDomain(dbStep))
+	}
+	return steps, nil
+}
+
+// Heartbeat refreshes the operation's update timestamp to signal liveness.
+func (s *stepStore) Heartbeat(ctx context.Context, operationID int64) error {
+	dbAttrs := append(defaultDBAttributes, attribute.Int64("operation.id", operationID))
+
+	return storage.ExecuteAndTrace(ctx, s.tracer, "stepStore.Heartbeat", dbAttrs, func(ctx context.Context) error {
+		return s.q.TouchOperation(ctx, operationID)
+	})
+}
+
+// ClaimStale atomically takes ownership of an incomplete operation whose last
+// update is older than staleBefore. The claim refreshes the update timestamp so
+// concurrent claimers lose the race.
+func (s *stepStore) ClaimStale(ctx context.Context, operationID int64, staleBefore time.Time) (bool, error) {
+	dbAttrs := append(defaultDBAttributes,
+		attribute.Int64("operation.id", operationID),
+		attribute.String("operation.stale_before", staleBefore.Format(time.RFC3339)),
+	)
+
+	var rows int64
+	err := storage.ExecuteAndTrace(ctx, s.tracer, "stepStore.ClaimStale", dbAttrs, func(ctx context.Context) error {
+		var err error
+		rows, err = s.q.ClaimStaleOperation(ctx, db.ClaimStaleOperationParams{
+			ID:        operationID,
+			UpdatedAt: pgtype.Timestamptz{Time: staleBefore, Valid: true},
+		})
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return rows == 1, nil
+}
+
+// mapDBStepToDomain converts a database step record to a domain step checkpoint.
+func mapDBStepToDomain(dbStep db.OperationStep) *operation.StepState {
+	var errorMessage *string
+	if dbStep.ErrorMessage.Valid {
+		val := dbStep.ErrorMessage.String
+		errorMessage = &val
+	}
+
+	var completedAt *time.Time
+	if dbStep.CompletedAt.Valid {
+		val := dbStep.CompletedAt.Time
+		completedAt = &val
+	}
+
+	return &operation.StepState{
+		OperationID:  dbStep.OperationID,
+		Index:        int(dbStep.StepIndex),
+		Name:         dbStep.StepName,
+		Status:       operation.StepStatus(dbStep.Status),
+		Attempts:     int(dbStep.Attempts),
+		ErrorMessage: errorMessage,
+		StartedAt:    dbStep.StartedAt.Time,
+		CompletedAt:  completedAt,
+	}
+}
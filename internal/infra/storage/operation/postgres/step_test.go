@@ -0,0 +1,191 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/ahrav/hoglet-hub/internal/domain/operation"
+	tenantRepo "github.com/ahrav/hoglet-hub/internal/infra/storage/tenant/postgres"
+	"github.com/ahrav/hoglet-hub/internal/infra/storage/testutil"
+)
+
+func setupStepTest(t *testing.T) (context.Context, *stepStore, int64, func()) {
+	t.Helper()
+
+	pool, cleanup := testutil.SetupTestContainer(t)
+	tracer := noop.NewTracerProvider().Tracer("test")
+	opStore := NewOperationStore(pool, tracer)
+	store := NewStepStore(pool, tracer).(*stepStore)
+	tenantStore := tenantRepo.NewTenantStore(pool, tracer)
+	ctx := context.Background()
+
+	tenantID := createTestTenant(t, ctx, tenantStore)
+	op, err := operation.NewTenantCreateOperation(tenantID, "test-tenant", "us1", "free", nil)
+	require.NoError(t, err)
+
+	opID, err := opStore.Create(ctx, op)
+	require.NoError(t, err)
+
+	return ctx, store, opID, cleanup
+}
+
+func TestStepStore_StartAndFinish(t *testing.T) {
+	t.Parallel()
+
+	ctx, store, opID, cleanup := setupStepTest(t)
+	defer cleanup()
+
+	first := operation.NewStepState(opID, 0, "create-namespace")
+	require.NoError(t, store.StartStep(ctx, first))
+	first.Complete()
+	require.NoError(t, store.FinishStep(ctx, first))
+
+	second := operation.NewStepState(opID, 1, "provision-database")
+	require.NoError(t, store.StartStep(ctx, second))
+	second.Fail("database unavailable")
+	require.NoError(t, store.FinishStep(ctx, second))
+
+	steps, err := store.FindSteps(ctx, opID)
+	require.NoError(t, err)
+	require.Len(t, steps, 2)
+
+	assert.Equal(t, "create-namespace", steps[0].Name)
+	assert.Equal(t, operation.StepStatusCompleted, steps[0].Status)
+	assert.NotNil(t, steps[0].CompletedAt)
+	assert.Nil(t, steps[0].ErrorMessage)
+
+	assert.Equal(t, "provision-database", steps[1].Name)
+	assert.Equal(t, operation.StepStatusFailed, steps[1].Status)
+	require.NotNil(t, steps[1].ErrorMessage)
+	assert.Equal(t, "database unavailable", *steps[1].ErrorMessage)
+}
+
+func TestStepStore_RestartIncrementsAttempts(t *testing.T) {
+	t.Parallel()
+
+	ctx, store, opID, cleanup := setupStepTest(t)
+	defer cleanup()
+
+	step := operation.NewStepState(opID, 0, "create-namespace")
+	require.NoError(t, store.StartStep(ctx, step))
+	step.Fail("timeout")
+	require.NoError(t, store.FinishStep(ctx, step))
+
+	require.NoError(t, store.StartStep(ctx, operation.NewStepState(opID, 0, "create-namespace")))
+
+	steps, err := store.FindSteps(ctx, opID)
+	require.NoError(t, err)
+	require.Len(t, steps, 1)
+	assert.Equal(t, operation.StepStatusInProgress, steps[0].Status)
+	assert.Equal(t, 2, steps[0].Attempts)
+	assert.Nil(t, steps[0].ErrorMessage)
+	assert.Nil(t, steps[0].CompletedAt)
+}
+
+func TestStepStore_RecordsEvents(t *testing.T) {
+	t.Parallel()
+
+	ctx, store, opID, cleanup := setupStepTest(t)
+	defer cleanup()
+
+	step := operation.NewStepState(opID, 0, "create-namespace")
+	require.NoError(t, store.StartStep(ctx, step))
+	step.Complete()
+	require.NoError(t, store.FinishStep(ctx, step))
+	require.NoError(t, store.CompensateStep(ctx, opID, "create-namespace"))
+
+	events, err := NewEventStore(store.pool, store.tracer).ListEvents(ctx, opID, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+
+	assert.Equal(t, operation.EventStatusChanged, events[0].Type)
+	assert.Equal(t, string(operation.StatusPending), events[0].Status)
+
+	assert.Equal(t, operation.EventStepStarted, events[1].Type)
+	require.NotNil(t, events[1].StepIndex)
+	assert.Equal(t, 0, *events[1].StepIndex)
+	assert.Equal(t, "create-namespace", *events[1].StepName)
+
+	assert.Equal(t, operation.EventStepFinished, events[2].Type)
+	assert.Equal(t, string(operation.StepStatusCompleted), events[2].Status)
+
+	assert.Equal(t, operation.EventStepCompensated, events[3].Type)
+	assert.Nil(t, events[3].StepIndex)
+
+	resumed, err := NewEventStore(store.pool, store.tracer).ListEvents(ctx, opID, events[1].ID, 10)
+	require.NoError(t, err)
+	assert.Len(t, resumed, 2)
+
+	err = store.StartStep(ctx, operation.NewStepState(opID+1000, 0, "create-namespace"))
+	assert.ErrorIs(t, err, operation.ErrOperationNotFound)
+}
+
+func TestStepStore_ClaimStale(t *testing.T) {
+	t.Parallel()
+
+	ctx, store, opID, cleanup := setupStepTest(t)
+	defer cleanup()
+
+	// The operation was just created, so it is not stale yet.
+	claimed, err := store.ClaimStale(ctx, opID, time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+	assert.False(t, claimed)
+
+	claimed, err = store.ClaimStale(ctx, opID, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	// The claim refreshed the operation, so a concurrent claimer with an older
+	// cutoff loses the race.
+	claimed, err = store.ClaimStale(ctx, opID, time.Now().Add(-time.Second))
+	require.NoError(t, err)
+	assert.False(t, claimed)
+
+	require.NoError(t, store.Heartbeat(ctx, opID))
+}
+
+func TestStepStore_CopyCompletedSteps(t *testing.T) {
+	t.Parallel()
+
+	ctx, store, opID, cleanup := setupStepTest(t)
+	defer cleanup()
+
+	for i, name := range []string{"initialize", "provision-database", "setup-secrets"} {
+		step := operation.NewStepState(opID, i, name)
+		require.NoError(t, store.StartStep(ctx, step))
+		step.Complete()
+		require.NoError(t, store.FinishStep(ctx, step))
+	}
+	failed := operation.NewStepState(opID, 3, "deploy-resources")
+	require.NoError(t, store.StartStep(ctx, failed))
+	failed.Fail("timeout")
+	require.NoError(t, store.FinishStep(ctx, failed))
+	require.NoError(t, store.CompensateStep(ctx, opID, "setup-secrets"))
+
+	opStore := NewOperationStore(store.pool, store.tracer)
+	parent, err := opStore.FindByID(ctx, opID)
+	require.NoError(t, err)
+	parent.Fail("timeout")
+	retry, err := parent.NewRetry()
+	require.NoError(t, err)
+	retryID, err := opStore.Create(ctx, retry)
+	require.NoError(t, err)
+
+	require.NoError(t, store.CopyCompletedSteps(ctx, opID, retryID))
+
+	steps, err := store.FindSteps(ctx, retryID)
+	require.NoError(t, err)
+	require.Len(t, steps, 2)
+	assert.Equal(t, "initialize", steps[0].Name)
+	assert.Equal(t, "provision-database", steps[1].Name)
+	for _, step := range steps {
+		assert.Equal(t, retryID, step.OperationID)
+		assert.True(t, step.IsCompleted())
+	}
+}
@@ -0,0 +1,213 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ahrav/hoglet-hub/internal/db"
+	"github.com/ahrav/hoglet-hub/internal/domain/outbox"
+	"github.com/ahrav/hoglet-hub/internal/infra/storage"
+)
+
+var _ outbox.Repository = (*outboxStore)(nil)
+
+// relayLockID is the Postgres advisory lock key held by the outbox relay.
+const relayLockID int64 = 0x6f7574626f78 // "outbox"
+
+// outboxStore implements outbox.Repository using Postgres and sqlc-generated queries.
+type outboxStore struct {
+	q      *db.Queries
+	pool   *pgxpool.Pool
+	tracer trace.Tracer
+}
+
+// NewOutboxStore creates an outbox.Repository backed by PostgreSQL. Events are
+// appended within the transaction the context carries, if any.
+func NewOutboxStore(pool *pgxpool.Pool, tracer trace.Tracer) outbox.Repository {
+	return &outboxStore{q: db.New(pool), pool: pool, tracer: tracer}
+}
+
+// defaultDBAttributes defines standard OpenTelemetry attributes for database operations.
+var defaultDBAttributes = []attribute.KeyValue{attribute.String("db.system", "postgresql")}
+
+// queries returns the queries to run, bound to the transaction ctx carries, if any.
+func (s *outboxStore) queries(ctx context.Context) *db.Queries { return storage.Queries(ctx, s.q) }
+
+// Append records events to be published.
+func (s *outboxStore) Append(ctx context.Context, events ...*outbox.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+	dbAttrs := append(defaultDBAttributes, attribute.Int("outbox.events", len(events)))
+
+	return storage.ExecuteAndTrace(ctx, s.tracer, "outboxStore.Append", dbAttrs, func(ctx context.Context) error {
+		for _, e := range events {
+			data := e.Data
+			if data == nil {
+				data = map[string]any{}
+			}
+			dataJSON, err := json.Marshal(data)
+			if err != nil {
+				return err
+			}
+
+			if err := s.queries(ctx).AppendOutboxEvent(ctx, db.AppendOutboxEventParams{
+				EventType:   string(e.Type),
+				TenantID:    toInt8(e.TenantID),
+				OperationID: toInt8(e.OperationID),
+				Data:        dataJSON,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ListPending retrieves up to limit due events, oldest first, at most one per
+// ordering key.
+func (s *outboxStore) ListPending(ctx context.Context, limit int) ([]*outbox.Event, error) {
+	dbAttrs := append(defaultDBAttributes, attribute.Int("limit", limit))
+
+	var dbEvents []db.OutboxEvent
+	err := storage.ExecuteAndTrace(ctx, s.tracer, "outboxStore.ListPending", dbAttrs, func(ctx context.Context) error {
+		var err error
+		dbEvents, err = s.queries(ctx).ListPendingOutboxEvents(ctx, int32(limit))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*outbox.Event, 0, len(dbEvents))
+	for _, dbEvent := range dbEvents {
+		e, err := mapDBOutboxEventToDomain(dbEvent)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+	return events, nil
+}
+
+// MarkPublished records that the event has been published.
+func (s *outboxStore) MarkPublished(ctx context.Context, id int64) error {
+	dbAttrs := append(defaultDBAttributes, attribute.Int64("outbox.event_id", id))
+
+	return storage.ExecuteAndTrace(ctx, s.tracer, "outboxStore.MarkPublished", dbAttrs, func(ctx context.Context) error {
+		return s.queries(ctx).MarkOutboxEventPublished(ctx, id)
+	})
+}
+
+// MarkFailed records a failed attempt to publish the event.
+func (s *outboxStore) MarkFailed(ctx context.Context, id int64, reason string, nextAttemptAt time.Time) error {
+	dbAttrs := append(defaultDBAttributes, attribute.Int64("outbox.event_id", id))
+
+	return storage.ExecuteAndTrace(ctx, s.tracer, "outboxStore.MarkFailed", dbAttrs, func(ctx context.Context) error {
+		return s.queries(ctx).MarkOutboxEventFailed(ctx, db.MarkOutboxEventFailedParams{
+			ID:            id,
+			LastError:     pgtype.Text{String: reason, Valid: true},
+			NextAttemptAt: pgtype.Timestamptz{Time: nextAttemptAt, Valid: true},
+		})
+	})
+}
+
+// MarkDeadLettered records a failed attempt to publish the event and gives up on it.
+func (s *outboxStore) MarkDeadLettered(ctx context.Context, id int64, reason string) error {
+	dbAttrs := append(defaultDBAttributes, attribute.Int64("outbox.event_id", id))
+
+	return storage.ExecuteAndTrace(ctx, s.tracer, "outboxStore.MarkDeadLettered", dbAttrs, func(ctx context.Context) error {
+		return s.queries(ctx).MarkOutboxEventDeadLettered(ctx, db.MarkOutboxEventDeadLetteredParams{
+			ID:        id,
+			LastError: pgtype.Text{String: reason, Valid: true},
+		})
+	})
+}
+
+// DeletePublished deletes events published before the given time.
+func (s *outboxStore) DeletePublished(ctx context.Context, before time.Time) (int64, error) {
+	var deleted int64
+	err := storage.ExecuteAndTrace(ctx, s.tracer, "outboxStore.DeletePublished", defaultDBAttributes, func(ctx context.Context) error {
+		var err error
+		deleted, err = s.queries(ctx).DeletePublishedOutboxEvents(ctx, pgtype.Timestamptz{Time: before, Valid: true})
+		return err
+	})
+
+	return deleted, err
+}
+
+// TryLock takes the relay's session-level advisory lock. The lock belongs to the
+// connection it was taken on, so the connection is held until the lock is released.
+func (s *outboxStore) TryLock(ctx context.Context) (func(), bool, error) {
+	var (
+		release func()
+		locked  bool
+	)
+	err := storage.ExecuteAndTrace(ctx, s.tracer, "outboxStore.TryLock", defaultDBAttributes, func(ctx context.Context) error {
+		conn, err := s.pool.Acquire(ctx)
+		if err != nil {
+			return err
+		}
+
+		q := db.New(conn)
+		if locked, err = q.TryAdvisoryLock(ctx, relayLockID); err != nil || !locked {
+			conn.Release()
+			return err
+		}
+
+		release = func() {
+			// Closing the connection releases the lock should unlocking fail.
+			if _, err := q.AdvisoryUnlock(context.Background(), relayLockID); err != nil {
+				_ = conn.Conn().Close(context.Background())
+			}
+			conn.Release()
+		}
+		return nil
+	})
+
+	return release, locked, err
+}
+
+// toInt8 converts an optional ID to its nullable database representation.
+func toInt8(id *int64) pgtype.Int8 {
+	if id == nil {
+		return pgtype.Int8{}
+	}
+	return pgtype.Int8{Int64: *id, Valid: true}
+}
+
+// fromInt8 converts a nullable database ID to an optional ID.
+func fromInt8(id pgtype.Int8) *int64 {
+	if !id.Valid {
+		return nil
+	}
+	val := id.Int64
+	return &val
+}
+
+// mapDBOutboxEventToDomain converts a database outbox record to a domain event.
+func mapDBOutboxEventToDomain(dbEvent db.OutboxEvent) (*outbox.Event, error) {
+	data := map[string]any{}
+	if len(dbEvent.Data) > 0 {
+		if err := json.Unmarshal(dbEvent.Data, &data); err != nil {
+			return nil, err
+		}
+	}
+
+	return &outbox.Event{
+		ID:            dbEvent.ID,
+		Type:          outbox.EventType(dbEvent.EventType),
+		TenantID:      fromInt8(dbEvent.TenantID),
+		OperationID:   fromInt8(dbEvent.OperationID),
+		Data:          data,
+		OccurredAt:    dbEvent.OccurredAt.Time,
+		Attempts:      int(dbEvent.Attempts),
+		NextAttemptAt: dbEvent.NextAttemptAt.Time,
+	}, nil
+}
@@ -0,0 +1,176 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/ahrav/hoglet-hub/internal/db"
+	"github.com/ahrav/hoglet-hub/internal/domain/outbox"
+	"github.com/ahrav/hoglet-hub/internal/infra/storage"
+	"github.com/ahrav/hoglet-hub/internal/infra/storage/testutil"
+)
+
+func setupOutboxTest(t *testing.T) (context.Context, *outboxStore, *storage.Transactor, func()) {
+	t.Helper()
+
+	pool, cleanup := testutil.SetupTestContainer(t)
+	tracer := noop.NewTracerProvider().Tracer("test")
+	store := &outboxStore{q: db.New(pool), pool: pool, tracer: tracer}
+
+	return context.Background(), store, storage.NewTransactor(pool, tracer), cleanup
+}
+
+func TestOutboxStore_AppendAndPublish(t *testing.T) {
+	t.Parallel()
+
+	ctx, store, _, cleanup := setupOutboxTest(t)
+	defer cleanup()
+
+	tenantID, opID := int64(1), int64(2)
+	require.NoError(t, store.Append(ctx,
+		&outbox.Event{Type: outbox.EventOperationCompleted, TenantID: &tenantID, OperationID: &opID,
+			Data: map[string]any{"status": "completed"}},
+		&outbox.Event{Type: outbox.EventTenantActivated, TenantID: &tenantID, OperationID: &opID},
+		&outbox.Event{Type: outbox.EventOperationStarted},
+	))
+
+	events, err := store.ListPending(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 2, "only the oldest pending event of a tenant is listed")
+	assert.Equal(t, outbox.EventOperationCompleted, events[0].Type)
+	assert.Equal(t, "completed", events[0].Data["status"])
+	assert.Equal(t, &tenantID, events[0].TenantID)
+	assert.Equal(t, &opID, events[0].OperationID)
+	assert.Equal(t, outbox.EventOperationStarted, events[1].Type)
+	assert.Empty(t, events[1].Data)
+
+	require.NoError(t, store.MarkFailed(ctx, events[0].ID, "sink unavailable", time.Now().Add(time.Minute)))
+	require.NoError(t, store.MarkPublished(ctx, events[1].ID))
+
+	events, err = store.ListPending(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, events, "an event waiting to be retried holds back its tenant")
+
+	deleted, err := store.DeletePublished(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+}
+
+func TestOutboxStore_ListPendingOrdering(t *testing.T) {
+	t.Parallel()
+
+	ctx, store, _, cleanup := setupOutboxTest(t)
+	defer cleanup()
+
+	tenantID, firstOp, secondOp := int64(1), int64(10), int64(20)
+	require.NoError(t, store.Append(ctx,
+		&outbox.Event{Type: outbox.EventOperationStarted, TenantID: &tenantID, OperationID: &firstOp},
+		&outbox.Event{Type: outbox.EventOperationStarted, OperationID: &secondOp},
+		&outbox.Event{Type: outbox.EventOperationCompleted, TenantID: &tenantID, OperationID: &firstOp},
+		&outbox.Event{Type: outbox.EventOperationFailed, OperationID: &secondOp},
+	))
+
+	events, err := store.ListPending(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, &tenantID, events[0].TenantID)
+	assert.Nil(t, events[1].TenantID)
+	assert.Equal(t, &secondOp, events[1].OperationID)
+
+	limited, err := store.ListPending(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, limited, 1)
+	assert.Equal(t, events[0].ID, limited[0].ID)
+
+	// Publishing the head of each sequence releases the next event in it.
+	require.NoError(t, store.MarkPublished(ctx, events[0].ID))
+	require.NoError(t, store.MarkPublished(ctx, events[1].ID))
+
+	next, err := store.ListPending(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, next, 2)
+	assert.Equal(t, outbox.EventOperationCompleted, next[0].Type)
+	assert.Equal(t, outbox.EventOperationFailed, next[1].Type)
+}
+
+func TestOutboxStore_MarkDeadLettered(t *testing.T) {
+	t.Parallel()
+
+	ctx, store, _, cleanup := setupOutboxTest(t)
+	defer cleanup()
+
+	tenantID := int64(1)
+	require.NoError(t, store.Append(ctx,
+		&outbox.Event{Type: outbox.EventOperationStarted, TenantID: &tenantID},
+		&outbox.Event{Type: outbox.EventOperationCompleted, TenantID: &tenantID},
+	))
+
+	events, err := store.ListPending(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.NoError(t, store.MarkFailed(ctx, events[0].ID, "sink unavailable", time.Now().Add(-time.Second)))
+
+	events, err = store.ListPending(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 1, "an event is listed again once its next attempt is due")
+	assert.Equal(t, 1, events[0].Attempts)
+	assert.Equal(t, outbox.EventOperationStarted, events[0].Type)
+
+	require.NoError(t, store.MarkDeadLettered(ctx, events[0].ID, "sink unavailable"))
+
+	events, err = store.ListPending(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 1, "a dead-lettered event no longer holds back its tenant")
+	assert.Equal(t, outbox.EventOperationCompleted, events[0].Type)
+
+	deleted, err := store.DeletePublished(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.Zero(t, deleted, "dead-lettered events are kept")
+}
+
+func TestOutboxStore_AppendRolledBack(t *testing.T) {
+	t.Parallel()
+
+	ctx, store, transactor, cleanup := setupOutboxTest(t)
+	defer cleanup()
+
+	errAbort := errors.New("abort")
+	err := transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		require.NoError(t, store.Append(ctx, &outbox.Event{Type: outbox.EventOperationStarted}))
+		return errAbort
+	})
+	require.ErrorIs(t, err, errAbort)
+
+	events, err := store.ListPending(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, events, "events appended in a rolled back transaction are discarded")
+}
+
+func TestOutboxStore_TryLock(t *testing.T) {
+	t.Parallel()
+
+	ctx, store, _, cleanup := setupOutboxTest(t)
+	defer cleanup()
+
+	release, locked, err := store.TryLock(ctx)
+	require.NoError(t, err)
+	require.True(t, locked)
+
+	_, locked, err = store.TryLock(ctx)
+	require.NoError(t, err)
+	assert.False(t, locked, "the lock is held by one relay at a time")
+
+	release()
+
+	release, locked, err = store.TryLock(ctx)
+	require.NoError(t, err)
+	assert.True(t, locked, "the lock can be taken again once released")
+	release()
+}
@@ -0,0 +1,144 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ahrav/hoglet-hub/internal/db"
+	"github.com/ahrav/hoglet-hub/internal/domain/resource"
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+	"github.com/ahrav/hoglet-hub/internal/infra/storage"
+)
+
+var _ resource.QuotaRepository = (*quotaStore)(nil)
+
+// quotaStore implements resource.QuotaRepository on the resource_counts table.
+type quotaStore struct {
+	q          *db.Queries
+	pool       *pgxpool.Pool
+	transactor *storage.Transactor
+	tracer     trace.Tracer
+}
+
+// NewQuotaStore creates a resource.QuotaRepository backed by PostgreSQL.
+func NewQuotaStore(pool *pgxpool.Pool, tracer trace.Tracer) resource.QuotaRepository {
+	return &quotaStore{
+		q:          db.New(pool),
+		pool:       pool,
+		transactor: storage.NewTransactor(pool, tracer),
+		tracer:     tracer,
+	}
+}
+
+// queries returns the queries to run, bound to the transaction ctx carries, if any.
+func (s *quotaStore) queries(ctx context.Context) *db.Queries { return storage.Queries(ctx, s.q) }
+
+// Counts returns the project's current resource counts in the region.
+func (s *quotaStore) Counts(ctx context.Context, projectID string, region tenant.Region) (resource.Footprint, error) {
+	dbAttrs := append(defaultDBAttributes,
+		attribute.String("resource.project_id", projectID),
+		attribute.String("resource.region", string(region)),
+	)
+
+	var dbCounts []db.ResourceCount
+	err := storage.ExecuteAndTrace(ctx, s.tracer, "quotaStore.Counts", dbAttrs, func(ctx context.Context) error {
+		var err error
+		dbCounts, err = s.queries(ctx).ListResourceCounts(ctx, db.ListResourceCountsParams{
+			ProjectID: projectID,
+			Region:    db.RegionType(region),
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(resource.Footprint, len(dbCounts))
+	for _, c := range dbCounts {
+		counts[resource.Type(c.ResourceType)] = c.Count
+	}
+	return counts, nil
+}
+
+// Reserve adds the footprint to the project's counts in a single transaction and
+// rolls it back if any quota would be exceeded. Within a caller's transaction it
+// runs in a savepoint, so that only this reservation is rolled back. Counts are adjusted in a fixed type
+// order so that concurrent reservations lock rows in the same order.
+func (s *quotaStore) Reserve(
+	ctx context.Context,
+	projectID string,
+	region tenant.Region,
+	footprint resource.Footprint,
+	quotas resource.Quotas,
+) error {
+	dbAttrs := append(defaultDBAttributes,
+		attribute.String("resource.project_id", projectID),
+		attribute.String("resource.region", string(region)),
+	)
+
+	return storage.ExecuteAndTrace(ctx, s.tracer, "quotaStore.Reserve", dbAttrs, func(ctx context.Context) error {
+		return s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			q := s.queries(ctx)
+
+			for _, resourceType := range slices.Sorted(maps.Keys(footprint)) {
+				count, err := q.AdjustResourceCount(ctx, db.AdjustResourceCountParams{
+					ResourceType: string(resourceType),
+					ProjectID:    projectID,
+					Region:       db.RegionType(region),
+					Delta:        footprint[resourceType],
+				})
+				if err != nil {
+					return err
+				}
+				if limit, ok := quotas[resourceType]; ok && count > limit {
+					return fmt.Errorf("%w: %s in project %s (%s) would reach %d of %d",
+						resource.ErrQuotaExhausted, resourceType, projectID, region, count, limit)
+				}
+			}
+			return nil
+		})
+	})
+}
+
+// Release subtracts the footprint from the project's counts in a single transaction,
+// which joins the caller's transaction if ctx carries one. Releasing more than the
+// project holds fails with resource.ErrQuotaOverRelease rather than hiding the leak.
+func (s *quotaStore) Release(
+	ctx context.Context,
+	projectID string,
+	region tenant.Region,
+	footprint resource.Footprint,
+) error {
+	dbAttrs := append(defaultDBAttributes,
+		attribute.String("resource.project_id", projectID),
+		attribute.String("resource.region", string(region)),
+	)
+
+	return storage.ExecuteAndTrace(ctx, s.tracer, "quotaStore.Release", dbAttrs, func(ctx context.Context) error {
+		return s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			q := s.queries(ctx)
+
+			for _, resourceType := range slices.Sorted(maps.Keys(footprint)) {
+				if _, err := q.AdjustResourceCount(ctx, db.AdjustResourceCountParams{
+					ResourceType: string(resourceType),
+					ProjectID:    projectID,
+					Region:       db.RegionType(region),
+					Delta:        -footprint[resourceType],
+				}); err != nil {
+					if storage.IsCheckViolation(err) {
+						return fmt.Errorf("%w: %s in project %s (%s)",
+							resource.ErrQuotaOverRelease, resourceType, projectID, region)
+					}
+					return err
+				}
+			}
+			return nil
+		})
+	})
+}
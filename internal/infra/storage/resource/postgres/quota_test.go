@@ -0,0 +1,71 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/ahrav/hoglet-hub/internal/domain/resource"
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+	"github.com/ahrav/hoglet-hub/internal/infra/storage/testutil"
+)
+
+func setupQuotaTest(t *testing.T) (context.Context, *quotaStore, func()) {
+	t.Helper()
+
+	pool, cleanup := testutil.SetupTestContainer(t)
+	store := NewQuotaStore(pool, noop.NewTracerProvider().Tracer("test")).(*quotaStore)
+
+	return context.Background(), store, cleanup
+}
+
+func TestQuotaStore_ReserveAndRelease(t *testing.T) {
+	t.Parallel()
+
+	ctx, store, cleanup := setupQuotaTest(t)
+	defer cleanup()
+
+	footprint := resource.Footprint{resource.TypePubSubTopic: 1, resource.TypeSecret: 2}
+	require.NoError(t, store.Reserve(ctx, "hoglet-hub-us1", tenant.RegionUS1, footprint, resource.DefaultQuotas))
+	require.NoError(t, store.Reserve(ctx, "hoglet-hub-us1", tenant.RegionUS1, footprint, resource.DefaultQuotas))
+
+	counts, err := store.Counts(ctx, "hoglet-hub-us1", tenant.RegionUS1)
+	require.NoError(t, err)
+	assert.Equal(t, resource.Footprint{resource.TypePubSubTopic: 2, resource.TypeSecret: 4}, counts)
+
+	require.NoError(t, store.Release(ctx, "hoglet-hub-us1", tenant.RegionUS1, footprint))
+
+	// Releasing more than is held fails as a whole instead of clamping at zero.
+	err = store.Release(ctx, "hoglet-hub-us1", tenant.RegionUS1,
+		resource.Footprint{resource.TypePubSubTopic: 1, resource.TypeSecret: 5})
+	assert.ErrorIs(t, err, resource.ErrQuotaOverRelease)
+
+	counts, err = store.Counts(ctx, "hoglet-hub-us1", tenant.RegionUS1)
+	require.NoError(t, err)
+	assert.Equal(t, resource.Footprint{resource.TypePubSubTopic: 1, resource.TypeSecret: 2}, counts)
+}
+
+func TestQuotaStore_ReserveIsAllOrNothing(t *testing.T) {
+	t.Parallel()
+
+	ctx, store, cleanup := setupQuotaTest(t)
+	defer cleanup()
+
+	quotas := resource.Quotas{resource.TypePubSubTopic: 1}
+	footprint := resource.Footprint{resource.TypePubSubTopic: 1, resource.TypeSecret: 2}
+	require.NoError(t, store.Reserve(ctx, "hoglet-hub-eu1", tenant.RegionEU1, footprint, quotas))
+
+	err := store.Reserve(ctx, "hoglet-hub-eu1", tenant.RegionEU1, footprint, quotas)
+	assert.ErrorIs(t, err, resource.ErrQuotaExhausted)
+
+	counts, err := store.Counts(ctx, "hoglet-hub-eu1", tenant.RegionEU1)
+	require.NoError(t, err)
+	assert.Equal(t, resource.Footprint{resource.TypePubSubTopic: 1, resource.TypeSecret: 2}, counts)
+
+	other, err := store.Counts(ctx, "hoglet-hub-eu1", tenant.RegionEU2)
+	require.NoError(t, err)
+	assert.Empty(t, other)
+}
@@ -0,0 +1,203 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ahrav/hoglet-hub/internal/db"
+	"github.com/ahrav/hoglet-hub/internal/domain/resource"
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+	"github.com/ahrav/hoglet-hub/internal/infra/storage"
+)
+
+var _ resource.Repository = (*resourceStore)(nil)
+
+// resourceStore implements resource.Repository using Postgres and sqlc-generated queries.
+type resourceStore struct {
+	q      *db.Queries
+	pool   *pgxpool.Pool
+	tracer trace.Tracer
+}
+
+// NewResourceStore creates a resource.Repository backed by PostgreSQL.
+func NewResourceStore(pool *pgxpool.Pool, tracer trace.Tracer) resource.Repository {
+	return &resourceStore{q: db.New(pool), pool: pool, tracer: tracer}
+}
+
+// queries returns the queries to run, bound to the transaction ctx carries, if any.
+func (s *resourceStore) queries(ctx context.Context) *db.Queries { return storage.Queries(ctx, s.q) }
+
+// defaultDBAttributes defines standard OpenTelemetry attributes for database operations.
+var defaultDBAttributes = []attribute.KeyValue{attribute.String("db.system", "postgresql")}
+
+// Register records a resource and returns its ID. A resource the tenant already
+// has with the same type and name keeps its ID and takes the new status.
+func (s *resourceStore) Register(ctx context.Context, r *resource.Resource) (int64, error) {
+	dbAttrs := append(defaultDBAttributes,
+		attribute.Int64("tenant.id", r.TenantID),
+		attribute.String("resource.type", string(r.Type)),
+		attribute.String("resource.name", r.Name),
+	)
+
+	var id int64
+	err := storage.ExecuteAndTrace(ctx, s.tracer, "resourceStore.Register", dbAttrs, func(ctx context.Context) error {
+		metadata, err := json.Marshal(r.Metadata)
+		if err != nil {
+			return err
+		}
+
+		var externalID pgtype.Text
+		if r.ExternalID != nil {
+			externalID = pgtype.Text{String: *r.ExternalID, Valid: true}
+		}
+
+		var operationID pgtype.Int8
+		if r.CreatedByOperationID != nil {
+			operationID = pgtype.Int8{Int64: *r.CreatedByOperationID, Valid: true}
+		}
+
+		id, err = s.queries(ctx).RegisterResource(ctx, db.RegisterResourceParams{
+			TenantID:             r.TenantID,
+			ResourceType:         string(r.Type),
+			ResourceName:         r.Name,
+			ResourceID:           externalID,
+			Region:               db.RegionType(r.Region),
+			ProjectID:            r.ProjectID,
+			Status:               db.ResourceStatus(r.Status),
+			Metadata:             metadata,
+			CreatedByOperationID: operationID,
+		})
+		return err
+	})
+
+	return id, err
+}
+
+// ListByTenant retrieves the tenant's resources matching the filter, in the order
+// they were registered.
+func (s *resourceStore) ListByTenant(
+	ctx context.Context,
+	tenantID int64,
+	filter resource.ListFilter,
+) ([]*resource.Resource, error) {
+	dbAttrs := append(defaultDBAttributes, attribute.Int64("tenant.id", tenantID))
+	params := db.ListTenantResourcesParams{TenantID: tenantID}
+	if filter.Type != nil {
+		params.ResourceType = pgtype.Text{String: string(*filter.Type), Valid: true}
+		dbAttrs = append(dbAttrs, attribute.String("resource.type", string(*filter.Type)))
+	}
+	if filter.Status != nil {
+		params.Status = db.NullResourceStatus{ResourceStatus: db.ResourceStatus(*filter.Status), Valid: true}
+		dbAttrs = append(dbAttrs, attribute.String("resource.status", string(*filter.Status)))
+	}
+
+	var dbResources []db.Resource
+	err := storage.ExecuteAndTrace(ctx, s.tracer, "resourceStore.ListByTenant", dbAttrs, func(ctx context.Context) error {
+		var err error
+		dbResources, err = s.queries(ctx).ListTenantResources(ctx, params)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]*resource.Resource, 0, len(dbResources))
+	for _, dbResource := range dbResources {
+		r, err := mapDBResourceToDomain(dbResource)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+// UpdateStatus persists the resource's current status.
+// Returns resource.ErrResourceNotFound if the resource doesn't exist.
+func (s *resourceStore) UpdateStatus(ctx context.Context, r *resource.Resource) error {
+	dbAttrs := append(defaultDBAttributes,
+		attribute.Int64("resource.id", r.ID),
+		attribute.String("resource.status", string(r.Status)),
+	)
+
+	return storage.ExecuteAndTrace(ctx, s.tracer, "resourceStore.UpdateStatus", dbAttrs, func(ctx context.Context) error {
+		rows, err := s.queries(ctx).UpdateResourceStatus(ctx, db.UpdateResourceStatusParams{
+			ID:     r.ID,
+			Status: db.ResourceStatus(r.Status),
+		})
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return resource.ErrResourceNotFound
+		}
+		return nil
+	})
+}
+
+// Delete removes a resource from the ledger.
+// Returns resource.ErrResourceNotFound if the resource doesn't exist.
+func (s *resourceStore) Delete(ctx context.Context, id int64) error {
+	dbAttrs := append(defaultDBAttributes, attribute.Int64("resource.id", id))
+
+	return storage.ExecuteAndTrace(ctx, s.tracer, "resourceStore.Delete", dbAttrs, func(ctx context.Context) error {
+		rows, err := s.queries(ctx).DeleteResource(ctx, id)
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return resource.ErrResourceNotFound
+		}
+		return nil
+	})
+}
+
+// mapDBResourceToDomain converts a database resource record to a domain resource.
+func mapDBResourceToDomain(dbResource db.Resource) (*resource.Resource, error) {
+	metadata := map[string]any{}
+	if len(dbResource.Metadata) > 0 {
+		if err := json.Unmarshal(dbResource.Metadata, &metadata); err != nil {
+			return nil, err
+		}
+	}
+
+	var externalID *string
+	if dbResource.ResourceID.Valid {
+		val := dbResource.ResourceID.String
+		externalID = &val
+	}
+
+	var operationID *int64
+	if dbResource.CreatedByOperationID.Valid {
+		val := dbResource.CreatedByOperationID.Int64
+		operationID = &val
+	}
+
+	var updatedAt *time.Time
+	if !dbResource.UpdatedAt.Time.Equal(dbResource.CreatedAt.Time) {
+		val := dbResource.UpdatedAt.Time
+		updatedAt = &val
+	}
+
+	return &resource.Resource{
+		ID:                   dbResource.ID,
+		TenantID:             dbResource.TenantID,
+		Type:                 resource.Type(dbResource.ResourceType),
+		Name:                 dbResource.ResourceName,
+		ExternalID:           externalID,
+		Region:               tenant.Region(dbResource.Region),
+		ProjectID:            dbResource.ProjectID,
+		Status:               resource.Status(dbResource.Status),
+		Metadata:             metadata,
+		CreatedByOperationID: operationID,
+		CreatedAt:            dbResource.CreatedAt.Time,
+		UpdatedAt:            updatedAt,
+	}, nil
+}
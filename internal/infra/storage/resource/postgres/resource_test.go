@@ -0,0 +1,169 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/ahrav/hoglet-hub/internal/db"
+	"github.com/ahrav/hoglet-hub/internal/domain/resource"
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+	"github.com/ahrav/hoglet-hub/internal/infra/storage"
+	tenantStore "github.com/ahrav/hoglet-hub/internal/infra/storage/tenant/postgres"
+	"github.com/ahrav/hoglet-hub/internal/infra/storage/testutil"
+)
+
+func setupResourceTest(t *testing.T) (context.Context, *resourceStore, int64, func()) {
+	t.Helper()
+
+	pool, cleanup := testutil.SetupTestContainer(t)
+	tracer := noop.NewTracerProvider().Tracer("test")
+	store := &resourceStore{q: db.New(pool), pool: pool, tracer: tracer}
+	ctx := context.Background()
+
+	owner, err := tenant.NewTenant("ledger-owner", tenant.RegionUS1, tenant.TierPro, nil)
+	require.NoError(t, err)
+	tenantID, err := tenantStore.NewTenantStore(pool, tracer).Create(ctx, owner)
+	require.NoError(t, err)
+
+	return ctx, store, tenantID, cleanup
+}
+
+func newTestResource(t *testing.T, tenantID int64, resourceType resource.Type, name string) *resource.Resource {
+	t.Helper()
+
+	r, err := resource.NewResource(tenantID, resourceType, name, tenant.RegionUS1, "hoglet-hub-us1", 0)
+	require.NoError(t, err)
+	return r
+}
+
+func TestResourceStore_RegisterIsIdempotent(t *testing.T) {
+	t.Parallel()
+
+	ctx, store, tenantID, cleanup := setupResourceTest(t)
+	defer cleanup()
+
+	topic := newTestResource(t, tenantID, resource.TypePubSubTopic, "tenant-ledger-owner-events")
+	topic.Metadata["message_retention"] = "7d"
+	id, err := store.Register(ctx, topic)
+	require.NoError(t, err)
+
+	topic.Activate()
+	again, err := store.Register(ctx, topic)
+	require.NoError(t, err)
+	assert.Equal(t, id, again)
+
+	resources, err := store.ListByTenant(ctx, tenantID, resource.ListFilter{})
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+	assert.Equal(t, resource.StatusActive, resources[0].Status)
+	assert.Equal(t, "7d", resources[0].Metadata["message_retention"])
+}
+
+func TestResourceStore_ListByTenant(t *testing.T) {
+	t.Parallel()
+
+	ctx, store, tenantID, cleanup := setupResourceTest(t)
+	defer cleanup()
+
+	for _, r := range []*resource.Resource{
+		newTestResource(t, tenantID, resource.TypeDatabaseSchema, "tenant_ledger_owner"),
+		newTestResource(t, tenantID, resource.TypeSecret, "tenant-ledger-owner-db-credentials"),
+		newTestResource(t, tenantID, resource.TypeSecret, "tenant-ledger-owner-api-key"),
+	} {
+		_, err := store.Register(ctx, r)
+		require.NoError(t, err)
+	}
+
+	all, err := store.ListByTenant(ctx, tenantID, resource.ListFilter{})
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	assert.Equal(t, resource.TypeDatabaseSchema, all[0].Type)
+
+	secretType := resource.TypeSecret
+	secrets, err := store.ListByTenant(ctx, tenantID, resource.ListFilter{Type: &secretType})
+	require.NoError(t, err)
+	require.Len(t, secrets, 2)
+	assert.Equal(t, "tenant-ledger-owner-db-credentials", secrets[0].Name)
+
+	active := resource.StatusActive
+	none, err := store.ListByTenant(ctx, tenantID, resource.ListFilter{Status: &active})
+	require.NoError(t, err)
+	assert.Empty(t, none)
+}
+
+func TestResourceStore_UpdateStatusAndDelete(t *testing.T) {
+	t.Parallel()
+
+	ctx, store, tenantID, cleanup := setupResourceTest(t)
+	defer cleanup()
+
+	schema := newTestResource(t, tenantID, resource.TypeDatabaseSchema, "tenant_ledger_owner")
+	id, err := store.Register(ctx, schema)
+	require.NoError(t, err)
+	schema.ID = id
+
+	schema.MarkDeleting()
+	require.NoError(t, store.UpdateStatus(ctx, schema))
+
+	resources, err := store.ListByTenant(ctx, tenantID, resource.ListFilter{})
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+	assert.Equal(t, resource.StatusDeleting, resources[0].Status)
+
+	require.NoError(t, store.Delete(ctx, id))
+	assert.ErrorIs(t, store.Delete(ctx, id), resource.ErrResourceNotFound)
+	assert.ErrorIs(t, store.UpdateStatus(ctx, schema), resource.ErrResourceNotFound)
+}
+
+func TestResourceStore_DeleteWithQuotaRelease(t *testing.T) {
+	t.Parallel()
+
+	ctx, store, tenantID, cleanup := setupResourceTest(t)
+	defer cleanup()
+
+	quotas := NewQuotaStore(store.pool, store.tracer)
+	transactor := storage.NewTransactor(store.pool, store.tracer)
+	footprint := resource.Footprint{resource.TypePubSubTopic: 1}
+	require.NoError(t, quotas.Reserve(ctx, "hoglet-hub-us1", tenant.RegionUS1, footprint, resource.DefaultQuotas))
+
+	topic := newTestResource(t, tenantID, resource.TypePubSubTopic, "events")
+	id, err := store.Register(ctx, topic)
+	require.NoError(t, err)
+
+	// A failure after both writes undoes them together.
+	errAbort := errors.New("abort")
+	err = transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		require.NoError(t, store.Delete(ctx, id))
+		require.NoError(t, quotas.Release(ctx, "hoglet-hub-us1", tenant.RegionUS1, footprint))
+		return errAbort
+	})
+	assert.ErrorIs(t, err, errAbort)
+
+	recorded, err := store.ListByTenant(ctx, tenantID, resource.ListFilter{})
+	require.NoError(t, err)
+	assert.Len(t, recorded, 1)
+	counts, err := quotas.Counts(ctx, "hoglet-hub-us1", tenant.RegionUS1)
+	require.NoError(t, err)
+	assert.Equal(t, resource.Footprint{resource.TypePubSubTopic: 1}, counts)
+
+	err = transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := store.Delete(ctx, id); err != nil {
+			return err
+		}
+		return quotas.Release(ctx, "hoglet-hub-us1", tenant.RegionUS1, footprint)
+	})
+	require.NoError(t, err)
+
+	recorded, err = store.ListByTenant(ctx, tenantID, resource.ListFilter{})
+	require.NoError(t, err)
+	assert.Empty(t, recorded)
+	counts, err = quotas.Counts(ctx, "hoglet-hub-us1", tenant.RegionUS1)
+	require.NoError(t, err)
+	assert.Equal(t, resource.Footprint{resource.TypePubSubTopic: 0}, counts)
+}
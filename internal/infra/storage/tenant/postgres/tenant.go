@@ -35,6 +35,9 @@ func NewTenantStore(pool *pgxpool.Pool, tracer trace.Tracer) tenant.Repository {
 // defaultDBAttributes defines standard OpenTelemetry attributes for database operations.
 var defaultDBAttributes = []attribute.KeyValue{attribute.String("db.system", "postgresql")}
 
+// queries returns the queries to run, bound to the transaction ctx carries, if any.
+func (s *tenantStore) queries(ctx context.Context) *db.Queries { return storage.Queries(ctx, s.q) }
+
 // Create persists a new tenant and returns its ID.
 // It handles the conversion between domain and database models,
 // setting appropriate default values where needed.
@@ -53,8 +56,10 @@ func (s *tenantStore) Create(ctx context.Context, t *tenant.Tenant) (int64, erro
 			isolationGroupID.Valid = true
 		}
 
-		// TODO: This should never be the system, at least not for now.
-		createdBy := "system" // Default creator when not explicitly provided
+		createdBy := t.CreatedBy
+		if createdBy == "" {
+			createdBy = "system" // Default creator when not explicitly provided
+		}
 
 		isIsolated := pgtype.Bool{
 			Bool:  t.IsolationGroupID != nil,
@@ -62,7 +67,7 @@ func (s *tenantStore) Create(ctx context.Context, t *tenant.Tenant) (int64, erro
 		}
 
 		var err error
-		id, err = s.q.CreateTenant(ctx, db.CreateTenantParams{
+		id, err = s.queries(ctx).CreateTenant(ctx, db.CreateTenantParams{
 			Name:             t.Name,
 			Region:           db.RegionType(t.Region),
 			Status:           db.TenantStatus(t.Status),
@@ -77,6 +82,11 @@ func (s *tenantStore) Create(ctx context.Context, t *tenant.Tenant) (int64, erro
 
 		return nil
 	})
+	// Only the name is unique among the columns set when a tenant is created, so a
+	// concurrent creation of the same tenant surfaces here.
+	if storage.IsUniqueViolation(err) {
+		return 0, tenant.ErrTenantAlreadyExists
+	}
 
 	return id, err
 }
@@ -88,6 +98,7 @@ func (s *tenantStore) Update(ctx context.Context, t *tenant.Tenant) error {
 		attribute.Int64("tenant.id", t.ID),
 		attribute.String("tenant.status", string(t.Status)),
 		attribute.String("tenant.tier", string(t.Tier)),
+		attribute.String("tenant.region", string(t.Region)),
 	)
 
 	return storage.ExecuteAndTrace(ctx, s.tracer, "tenantStore.Update", dbAttrs, func(ctx context.Context) error {
@@ -97,16 +108,21 @@ func (s *tenantStore) Update(ctx context.Context, t *tenant.Tenant) error {
 			isolationGroupID.Valid = true
 		}
 
+		var primaryNodeID pgtype.Int8
+		if t.PrimaryNodeID != nil {
+			primaryNodeID.Int64 = *t.PrimaryNodeID
+			primaryNodeID.Valid = true
+		}
+
 		// These fields are intentionally left as NULL since they're managed separately
 		var dbSchema, k8sNamespace pgtype.Text
-		var primaryNodeID pgtype.Int8
 
 		isIsolated := pgtype.Bool{
 			Bool:  t.IsolationGroupID != nil,
 			Valid: true,
 		}
 
-		return s.q.UpdateTenant(ctx, db.UpdateTenantParams{
+		return s.queries(ctx).UpdateTenant(ctx, db.UpdateTenantParams{
 			ID:                  t.ID,
 			Status:              db.TenantStatus(t.Status),
 			Tier:                string(t.Tier),
@@ -115,8 +131,140 @@ func (s *tenantStore) Update(ctx context.Context, t *tenant.Tenant) error {
 			DatabaseSchema:      dbSchema,
 			KubernetesNamespace: k8sNamespace,
 			PrimaryNodeID:       primaryNodeID,
+			Region:              db.RegionType(t.Region),
+		})
+	})
+}
+
+// TransitionStatus moves the tenant to the status to if its stored status is one
+// of from, and reports whether it did.
+func (s *tenantStore) TransitionStatus(
+	ctx context.Context,
+	id int64,
+	from []tenant.Status,
+	to tenant.Status,
+) (bool, error) {
+	dbAttrs := append(defaultDBAttributes,
+		attribute.Int64("tenant.id", id),
+		attribute.String("tenant.status", string(to)),
+	)
+
+	var rows int64
+	err := storage.ExecuteAndTrace(ctx, s.tracer, "tenantStore.TransitionStatus", dbAttrs, func(ctx context.Context) error {
+		var err error
+		rows, err = s.queries(ctx).TransitionTenantStatus(ctx, db.TransitionTenantStatusParams{
+			ToStatus:     db.TenantStatus(to),
+			ID:           id,
+			FromStatuses: toStatusStrings(from),
+		})
+		return err
+	})
+	return rows > 0, err
+}
+
+// ChangeTier stores the tenant's tier if its stored status is one of from, and
+// reports whether it did.
+func (s *tenantStore) ChangeTier(ctx context.Context, id int64, tier tenant.Tier, from []tenant.Status) (bool, error) {
+	dbAttrs := append(defaultDBAttributes,
+		attribute.Int64("tenant.id", id),
+		attribute.String("tenant.tier", string(tier)),
+	)
+
+	var rows int64
+	err := storage.ExecuteAndTrace(ctx, s.tracer, "tenantStore.ChangeTier", dbAttrs, func(ctx context.Context) error {
+		var err error
+		rows, err = s.queries(ctx).ChangeTenantTier(ctx, db.ChangeTenantTierParams{
+			Tier:     string(tier),
+			ID:       id,
+			Statuses: toStatusStrings(from),
+		})
+		return err
+	})
+	return rows > 0, err
+}
+
+// ChangeRegion stores the tenant's region if its stored status is one of from,
+// and reports whether it did.
+func (s *tenantStore) ChangeRegion(ctx context.Context, id int64, region tenant.Region, from []tenant.Status) (bool, error) {
+	dbAttrs := append(defaultDBAttributes,
+		attribute.Int64("tenant.id", id),
+		attribute.String("tenant.region", string(region)),
+	)
+
+	var rows int64
+	err := storage.ExecuteAndTrace(ctx, s.tracer, "tenantStore.ChangeRegion", dbAttrs, func(ctx context.Context) error {
+		var err error
+		rows, err = s.queries(ctx).ChangeTenantRegion(ctx, db.ChangeTenantRegionParams{
+			Region:   db.RegionType(region),
+			ID:       id,
+			Statuses: toStatusStrings(from),
+		})
+		return err
+	})
+	return rows > 0, err
+}
+
+// LockForOperation locks the tenant's row for the rest of the transaction ctx
+// carries and returns the tenant. Whether an operation is unfinished is checked
+// only once the lock is held, so a concurrent request that persisted one before
+// releasing the lock is seen. Returns ErrTenantNotFound if the tenant doesn't
+// exist or has been deleted.
+func (s *tenantStore) LockForOperation(ctx context.Context, id int64) (*tenant.Tenant, error) {
+	dbAttrs := append(defaultDBAttributes, attribute.Int64("tenant.id", id))
+
+	var dbTenant db.Tenant
+	err := storage.ExecuteAndTrace(ctx, s.tracer, "tenantStore.LockForOperation", dbAttrs, func(ctx context.Context) error {
+		var err error
+		dbTenant, err = s.queries(ctx).LockTenant(ctx, id)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return tenant.ErrTenantNotFound
+			}
+			return err
+		}
+
+		busy, err := s.queries(ctx).TenantHasIncompleteOperation(ctx, pgtype.Int8{Int64: id, Valid: true})
+		if err != nil {
+			return err
+		}
+		if busy {
+			return tenant.ErrOperationInProgress
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapDBTenantToDomain(dbTenant), nil
+}
+
+// ChangeIsolationGroup stores the tenant's isolation group if the tenant is still
+// active in its region and no operation on it is unfinished, and reports whether it did.
+func (s *tenantStore) ChangeIsolationGroup(ctx context.Context, t *tenant.Tenant) (bool, error) {
+	dbAttrs := append(defaultDBAttributes,
+		attribute.Int64("tenant.id", t.ID),
+		attribute.String("tenant.region", string(t.Region)),
+	)
+
+	var rows int64
+	err := storage.ExecuteAndTrace(ctx, s.tracer, "tenantStore.ChangeIsolationGroup", dbAttrs, func(ctx context.Context) error {
+		var isolationGroupID pgtype.Int8
+		if t.IsolationGroupID != nil {
+			isolationGroupID.Int64 = *t.IsolationGroupID
+			isolationGroupID.Valid = true
+		}
+
+		var err error
+		rows, err = s.queries(ctx).ChangeTenantIsolationGroup(ctx, db.ChangeTenantIsolationGroupParams{
+			ID:               t.ID,
+			IsolationGroupID: isolationGroupID,
+			IsIsolated:       pgtype.Bool{Bool: t.IsolationGroupID != nil, Valid: true},
+			Region:           db.RegionType(t.Region),
 		})
+		return err
 	})
+	return rows > 0, err
 }
 
 // FindByName retrieves a tenant by name.
@@ -127,7 +275,7 @@ func (s *tenantStore) FindByName(ctx context.Context, name string) (*tenant.Tena
 	var dbTenant db.Tenant
 	err := storage.ExecuteAndTrace(ctx, s.tracer, "tenantStore.FindByName", dbAttrs, func(ctx context.Context) error {
 		var err error
-		dbTenant, err = s.q.FindTenantByName(ctx, name)
+		dbTenant, err = s.queries(ctx).FindTenantByName(ctx, name)
 		if err != nil {
 			if errors.Is(err, pgx.ErrNoRows) {
 				return tenant.ErrTenantNotFound
@@ -154,7 +302,7 @@ func (s *tenantStore) FindByID(ctx context.Context, id int64) (*tenant.Tenant, e
 	var dbTenant db.Tenant
 	err := storage.ExecuteAndTrace(ctx, s.tracer, "tenantStore.FindByID", dbAttrs, func(ctx context.Context) error {
 		var err error
-		dbTenant, err = s.q.FindTenantByID(ctx, id)
+		dbTenant, err = s.queries(ctx).FindTenantByID(ctx, id)
 		if err != nil {
 			if errors.Is(err, pgx.ErrNoRows) {
 				return tenant.ErrTenantNotFound
@@ -171,16 +319,205 @@ func (s *tenantStore) FindByID(ctx context.Context, id int64) (*tenant.Tenant, e
 	return mapDBTenantToDomain(dbTenant), nil
 }
 
+// FindByIDIncludingDeleted retrieves a tenant by ID, whether or not it has been deleted.
+// Returns ErrTenantNotFound if the tenant doesn't exist.
+func (s *tenantStore) FindByIDIncludingDeleted(ctx context.Context, id int64) (*tenant.Tenant, error) {
+	dbAttrs := append(defaultDBAttributes,
+		attribute.Int64("tenant.id", id),
+	)
+
+	var dbTenant db.Tenant
+	err := storage.ExecuteAndTrace(ctx, s.tracer, "tenantStore.FindByIDIncludingDeleted", dbAttrs, func(ctx context.Context) error {
+		var err error
+		dbTenant, err = s.queries(ctx).FindTenantByIDIncludingDeleted(ctx, id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return tenant.ErrTenantNotFound
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapDBTenantToDomain(dbTenant), nil
+}
+
+// List retrieves a page of tenants using keyset pagination on the sort field.
+// One extra row is fetched to determine whether a following page exists.
+func (s *tenantStore) List(ctx context.Context, params tenant.ListParams) (*tenant.Page, error) {
+	dbAttrs := append(defaultDBAttributes,
+		attribute.String("tenant.sort_by", string(params.SortBy)),
+		attribute.String("tenant.order", string(params.Order)),
+		attribute.Int("tenant.limit", params.Limit),
+	)
+
+	var dbTenants []db.Tenant
+	err := storage.ExecuteAndTrace(ctx, s.tracer, "tenantStore.List", dbAttrs, func(ctx context.Context) error {
+		var status db.NullTenantStatus
+		if params.Filter.Status != nil {
+			status = db.NullTenantStatus{TenantStatus: db.TenantStatus(*params.Filter.Status), Valid: true}
+		}
+
+		var region db.NullRegionType
+		if params.Filter.Region != nil {
+			region = db.NullRegionType{RegionType: db.RegionType(*params.Filter.Region), Valid: true}
+		}
+
+		var tier pgtype.Text
+		if params.Filter.Tier != nil {
+			tier = pgtype.Text{String: string(*params.Filter.Tier), Valid: true}
+		}
+
+		var isolationGroupID pgtype.Int8
+		if params.Filter.IsolationGroupID != nil {
+			isolationGroupID = pgtype.Int8{Int64: *params.Filter.IsolationGroupID, Valid: true}
+		}
+
+		var namePrefix pgtype.Text
+		if params.Filter.NamePrefix != "" {
+			namePrefix = pgtype.Text{String: params.Filter.NamePrefix, Valid: true}
+		}
+
+		descending := params.Order == tenant.SortDesc
+		pageLimit := int32(params.Limit + 1)
+
+		var err error
+		switch params.SortBy {
+		case tenant.SortByName:
+			var cursorName pgtype.Text
+			if params.After != nil {
+				cursorName = pgtype.Text{String: params.After.Name, Valid: true}
+			}
+
+			dbTenants, err = s.queries(ctx).ListTenantsByName(ctx, db.ListTenantsByNameParams{
+				Status:           status,
+				Region:           region,
+				Tier:             tier,
+				IsolationGroupID: isolationGroupID,
+				NamePrefix:       namePrefix,
+				CursorName:       cursorName,
+				Descending:       descending,
+				PageLimit:        pageLimit,
+			})
+		default:
+			var cursorCreatedAt pgtype.Timestamptz
+			var cursorID pgtype.Int8
+			if params.After != nil {
+				cursorCreatedAt = pgtype.Timestamptz{Time: params.After.CreatedAt, Valid: true}
+				cursorID = pgtype.Int8{Int64: params.After.ID, Valid: true}
+			}
+
+			dbTenants, err = s.queries(ctx).ListTenantsByCreatedAt(ctx, db.ListTenantsByCreatedAtParams{
+				Status:           status,
+				Region:           region,
+				Tier:             tier,
+				IsolationGroupID: isolationGroupID,
+				NamePrefix:       namePrefix,
+				CursorCreatedAt:  cursorCreatedAt,
+				Descending:       descending,
+				CursorID:         cursorID,
+				PageLimit:        pageLimit,
+			})
+		}
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	page := &tenant.Page{Tenants: make([]*tenant.Tenant, 0, min(len(dbTenants), params.Limit))}
+	for i, dbTenant := range dbTenants {
+		if i == params.Limit {
+			page.Next = tenant.CursorAt(page.Tenants[len(page.Tenants)-1])
+			break
+		}
+		page.Tenants = append(page.Tenants, mapDBTenantToDomain(dbTenant))
+	}
+
+	return page, nil
+}
+
 // Delete marks a tenant for deletion.
 // This is a soft delete that changes the tenant's status rather than removing the record.
 func (s *tenantStore) Delete(ctx context.Context, id int64) error {
 	dbAttrs := append(defaultDBAttributes, attribute.Int64("tenant.id", id))
 
 	return storage.ExecuteAndTrace(ctx, s.tracer, "tenantStore.Delete", dbAttrs, func(ctx context.Context) error {
-		return s.q.DeleteTenant(ctx, id)
+		return s.queries(ctx).DeleteTenant(ctx, id)
 	})
 }
 
+// RecordSuspension adds a suspension to the tenant's suspension history.
+// The insert is skipped if the operation already recorded a suspension or the
+// tenant already has an open one.
+func (s *tenantStore) RecordSuspension(ctx context.Context, suspension *tenant.Suspension) error {
+	dbAttrs := append(defaultDBAttributes,
+		attribute.Int64("tenant.id", suspension.TenantID),
+		attribute.String("tenant.suspended_by", suspension.SuspendedBy),
+	)
+
+	return storage.ExecuteAndTrace(ctx, s.tracer, "tenantStore.RecordSuspension", dbAttrs, func(ctx context.Context) error {
+		var operationID pgtype.Int8
+		if suspension.SuspendOperationID != nil {
+			operationID = pgtype.Int8{Int64: *suspension.SuspendOperationID, Valid: true}
+		}
+
+		return s.queries(ctx).CreateTenantSuspension(ctx, db.CreateTenantSuspensionParams{
+			TenantID:           suspension.TenantID,
+			Reason:             suspension.Reason,
+			SuspendedBy:        suspension.SuspendedBy,
+			SuspendedAt:        pgtype.Timestamptz{Time: suspension.SuspendedAt, Valid: true},
+			SuspendOperationID: operationID,
+		})
+	})
+}
+
+// EndSuspension closes the tenant's open suspension, if any.
+func (s *tenantStore) EndSuspension(
+	ctx context.Context,
+	tenantID int64,
+	reason, resumedBy string,
+	operationID int64,
+) error {
+	dbAttrs := append(defaultDBAttributes,
+		attribute.Int64("tenant.id", tenantID),
+		attribute.String("tenant.resumed_by", resumedBy),
+		attribute.Int64("operation.id", operationID),
+	)
+
+	return storage.ExecuteAndTrace(ctx, s.tracer, "tenantStore.EndSuspension", dbAttrs, func(ctx context.Context) error {
+		return s.queries(ctx).EndTenantSuspension(ctx, db.EndTenantSuspensionParams{
+			TenantID:          tenantID,
+			ResumedBy:         pgtype.Text{String: resumedBy, Valid: true},
+			ResumeReason:      pgtype.Text{String: reason, Valid: true},
+			ResumeOperationID: pgtype.Int8{Int64: operationID, Valid: true},
+		})
+	})
+}
+
+// FindSuspensions retrieves the tenant's suspension history, most recent first.
+func (s *tenantStore) FindSuspensions(ctx context.Context, tenantID int64) ([]*tenant.Suspension, error) {
+	dbAttrs := append(defaultDBAttributes, attribute.Int64("tenant.id", tenantID))
+
+	var dbSuspensions []db.TenantSuspension
+	err := storage.ExecuteAndTrace(ctx, s.tracer, "tenantStore.FindSuspensions", dbAttrs, func(ctx context.Context) error {
+		var err error
+		dbSuspensions, err = s.queries(ctx).ListTenantSuspensions(ctx, tenantID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	suspensions := make([]*tenant.Suspension, 0, len(dbSuspensions))
+	for _, dbSuspension := range dbSuspensions {
+		suspensions = append(suspensions, mapDBSuspensionToDomain(dbSuspension))
+	}
+
+	return suspensions, nil
+}
+
 // mapDBTenantToDomain converts a database tenant record to a domain tenant entity.
 // It handles nullable fields and time conversions appropriately.
 func mapDBTenantToDomain(dbTenant db.Tenant) *tenant.Tenant {
@@ -190,6 +527,12 @@ func mapDBTenantToDomain(dbTenant db.Tenant) *tenant.Tenant {
 		isolationGroupID = &val
 	}
 
+	var primaryNodeID *int64
+	if dbTenant.PrimaryNodeID.Valid {
+		val := dbTenant.PrimaryNodeID.Int64
+		primaryNodeID = &val
+	}
+
 	var updatedAt *time.Time
 	if !dbTenant.UpdatedAt.Time.Equal(dbTenant.CreatedAt.Time) {
 		val := dbTenant.UpdatedAt.Time
@@ -203,7 +546,53 @@ func mapDBTenantToDomain(dbTenant db.Tenant) *tenant.Tenant {
 		Tier:             tenant.Tier(dbTenant.Tier),
 		Status:           tenant.Status(dbTenant.Status),
 		IsolationGroupID: isolationGroupID,
+		PrimaryNodeID:    primaryNodeID,
+		CreatedBy:        dbTenant.CreatedBy,
 		CreatedAt:        dbTenant.CreatedAt.Time,
 		UpdatedAt:        updatedAt,
 	}
 }
+
+// mapDBSuspensionToDomain converts a database suspension record to a domain suspension.
+func mapDBSuspensionToDomain(dbSuspension db.TenantSuspension) *tenant.Suspension {
+	suspension := &tenant.Suspension{
+		ID:          dbSuspension.ID,
+		TenantID:    dbSuspension.TenantID,
+		Reason:      dbSuspension.Reason,
+		SuspendedBy: dbSuspension.SuspendedBy,
+		SuspendedAt: dbSuspension.SuspendedAt.Time,
+	}
+
+	if dbSuspension.SuspendOperationID.Valid {
+		val := dbSuspension.SuspendOperationID.Int64
+		suspension.SuspendOperationID = &val
+	}
+	if dbSuspension.ResumedAt.Valid {
+		val := dbSuspension.ResumedAt.Time
+		suspension.ResumedAt = &val
+	}
+	if dbSuspension.ResumedBy.Valid {
+		val := dbSuspension.ResumedBy.String
+		suspension.ResumedBy = &val
+	}
+	if dbSuspension.ResumeReason.Valid {
+		val := dbSuspension.ResumeReason.String
+		suspension.ResumeReason = &val
+	}
+	if dbSuspension.ResumeOperationID.Valid {
+		val := dbSuspension.ResumeOperationID.Int64
+		suspension.ResumeOperationID = &val
+	}
+
+	return suspension
+}
+
+// toStatusStrings converts tenant statuses to the text array the queries match
+// stored statuses against.
+func toStatusStrings(statuses []tenant.Status) []string {
+	strs := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		strs = append(strs, string(status))
+	}
+	return strs
+}
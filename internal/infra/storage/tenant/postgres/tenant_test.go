@@ -2,15 +2,20 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/otel/trace/noop"
 
 	"github.com/ahrav/hoglet-hub/internal/db"
+	"github.com/ahrav/hoglet-hub/internal/domain/operation"
 	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+	"github.com/ahrav/hoglet-hub/internal/infra/storage"
+	operationStore "github.com/ahrav/hoglet-hub/internal/infra/storage/operation/postgres"
 	"github.com/ahrav/hoglet-hub/internal/infra/storage/testutil"
 )
 
@@ -89,13 +94,68 @@ func TestTenantStore_Update(t *testing.T) {
 	found, err := store.FindByID(ctx, id)
 	require.NoError(t, err)
 
-	found.Activate()
+	require.NoError(t, found.Activate())
+	require.NoError(t, found.ChangeRegion(tenant.RegionEU1))
 	err = store.Update(ctx, found)
 	require.NoError(t, err)
 
 	updated, err := store.FindByID(ctx, id)
 	require.NoError(t, err)
 	assert.Equal(t, tenant.StatusActive, updated.Status)
+	assert.Equal(t, tenant.RegionEU1, updated.Region)
+}
+
+func TestTenantStore_Suspensions(t *testing.T) {
+	t.Parallel()
+
+	ctx, store, cleanup := setupTenantTest(t)
+	defer cleanup()
+
+	newTenant, err := tenant.NewTenant("suspension-test", tenant.RegionUS1, tenant.TierFree, nil)
+	require.NoError(t, err)
+
+	tenantID, err := store.Create(ctx, newTenant)
+	require.NoError(t, err)
+
+	createOp := func(opType string) int64 {
+		id, err := store.q.CreateOperation(ctx, db.CreateOperationParams{
+			TenantID:      pgtype.Int8{Int64: tenantID, Valid: true},
+			OperationType: opType,
+			Status:        db.OperationStatusPending,
+			Parameters:    []byte("{}"),
+			CreatedBy:     "test",
+			Attempt:       1,
+		})
+		require.NoError(t, err)
+		return id
+	}
+
+	suspendOpID := createOp("tenant.suspend")
+	suspension := tenant.NewSuspension(tenantID, "unpaid invoice", "ops@example.com", suspendOpID)
+	require.NoError(t, store.RecordSuspension(ctx, suspension))
+	// Recording the same suspension again, e.g. from a retried step, is a no-op.
+	require.NoError(t, store.RecordSuspension(ctx, suspension))
+
+	suspensions, err := store.FindSuspensions(ctx, tenantID)
+	require.NoError(t, err)
+	require.Len(t, suspensions, 1)
+	assert.True(t, suspensions[0].IsOpen())
+	assert.Equal(t, "unpaid invoice", suspensions[0].Reason)
+	assert.Equal(t, "ops@example.com", suspensions[0].SuspendedBy)
+	assert.Equal(t, suspendOpID, *suspensions[0].SuspendOperationID)
+
+	resumeOpID := createOp("tenant.resume")
+	require.NoError(t, store.EndSuspension(ctx, tenantID, "invoice paid", "billing@example.com", resumeOpID))
+	// Ending a suspension when none is open is a no-op.
+	require.NoError(t, store.EndSuspension(ctx, tenantID, "invoice paid", "billing@example.com", resumeOpID))
+
+	suspensions, err = store.FindSuspensions(ctx, tenantID)
+	require.NoError(t, err)
+	require.Len(t, suspensions, 1)
+	assert.False(t, suspensions[0].IsOpen())
+	assert.Equal(t, "invoice paid", *suspensions[0].ResumeReason)
+	assert.Equal(t, "billing@example.com", *suspensions[0].ResumedBy)
+	assert.Equal(t, resumeOpID, *suspensions[0].ResumeOperationID)
 }
 
 func TestTenantStore_Delete(t *testing.T) {
@@ -119,6 +179,181 @@ func TestTenantStore_Delete(t *testing.T) {
 	require.Error(t, err)
 	assert.ErrorIs(t, err, tenant.ErrTenantNotFound)
 	assert.Nil(t, found)
+
+	// The deleted tenant can still be looked up explicitly, e.g. to authorize
+	// access to its records.
+	found, err = store.FindByIDIncludingDeleted(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, tenant.StatusDeleted, found.Status)
+	assert.Equal(t, tenant.RegionUS1, found.Region)
+
+	_, err = store.FindByIDIncludingDeleted(ctx, 99999)
+	assert.ErrorIs(t, err, tenant.ErrTenantNotFound)
+}
+
+func TestTenantStore_TransitionStatus(t *testing.T) {
+	t.Parallel()
+
+	ctx, store, cleanup := setupTenantTest(t)
+	defer cleanup()
+
+	newTenant, err := tenant.NewTenant("transition-test", tenant.RegionUS1, tenant.TierFree, nil)
+	require.NoError(t, err)
+	id, err := store.Create(ctx, newTenant)
+	require.NoError(t, err)
+
+	// A provisioning tenant cannot be claimed for deletion.
+	deletable := tenant.TransitionSources(tenant.StatusDeleting)
+	moved, err := store.TransitionStatus(ctx, id, deletable, tenant.StatusDeleting)
+	require.NoError(t, err)
+	assert.False(t, moved)
+
+	moved, err = store.TransitionStatus(ctx, id, []tenant.Status{tenant.StatusProvisioning}, tenant.StatusActive)
+	require.NoError(t, err)
+	assert.True(t, moved)
+
+	moved, err = store.TransitionStatus(ctx, id, deletable, tenant.StatusDeleting)
+	require.NoError(t, err)
+	assert.True(t, moved)
+
+	// The first deletion claimed the tenant, so a second one is turned away.
+	moved, err = store.TransitionStatus(ctx, id, deletable, tenant.StatusDeleting)
+	require.NoError(t, err)
+	assert.False(t, moved)
+
+	found, err := store.FindByID(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, tenant.StatusDeleting, found.Status)
+}
+
+func TestTenantStore_ChangeIsolationGroup(t *testing.T) {
+	t.Parallel()
+
+	ctx, store, cleanup := setupTenantTest(t)
+	defer cleanup()
+
+	operations := operationStore.NewOperationStore(store.pool, store.tracer)
+
+	newTenant, err := tenant.NewTenant("isolation-group-test", tenant.RegionUS1, tenant.TierFree, nil)
+	require.NoError(t, err)
+	id, err := store.Create(ctx, newTenant)
+	require.NoError(t, err)
+	newTenant.ID = id
+
+	op, err := operation.NewTenantCreateOperation(id, "isolation-group-test", "us1", "free", nil)
+	require.NoError(t, err)
+	op.ID, err = operations.Create(ctx, op)
+	require.NoError(t, err)
+
+	// A tenant that is still being provisioned keeps its placement.
+	changed, err := store.ChangeIsolationGroup(ctx, newTenant)
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	moved, err := store.TransitionStatus(ctx, id, []tenant.Status{tenant.StatusProvisioning}, tenant.StatusActive)
+	require.NoError(t, err)
+	require.True(t, moved)
+
+	// Active, but its create operation has not finished yet.
+	changed, err = store.ChangeIsolationGroup(ctx, newTenant)
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	op.Start()
+	op.Complete(nil)
+	require.NoError(t, operations.Update(ctx, op))
+
+	changed, err = store.ChangeIsolationGroup(ctx, newTenant)
+	require.NoError(t, err)
+	assert.True(t, changed)
+
+	found, err := store.FindByID(ctx, id)
+	require.NoError(t, err)
+	assert.Nil(t, found.IsolationGroupID)
+	assert.Equal(t, tenant.StatusActive, found.Status)
+}
+
+func TestTenantStore_LockForOperation(t *testing.T) {
+	t.Parallel()
+
+	ctx, store, cleanup := setupTenantTest(t)
+	defer cleanup()
+
+	operations := operationStore.NewOperationStore(store.pool, store.tracer)
+
+	newTenant, err := tenant.NewTenant("lock-test", tenant.RegionUS1, tenant.TierFree, nil)
+	require.NoError(t, err)
+	id, err := store.Create(ctx, newTenant)
+	require.NoError(t, err)
+
+	op, err := operation.NewTenantCreateOperation(id, "lock-test", "us1", "free", nil)
+	require.NoError(t, err)
+	op.ID, err = operations.Create(ctx, op)
+	require.NoError(t, err)
+
+	// The create operation has not finished yet.
+	_, err = store.LockForOperation(ctx, id)
+	assert.ErrorIs(t, err, tenant.ErrOperationInProgress)
+
+	op.Start()
+	op.Complete(nil)
+	require.NoError(t, operations.Update(ctx, op))
+
+	locked, err := store.LockForOperation(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, id, locked.ID)
+
+	_, err = store.LockForOperation(ctx, 99999)
+	assert.ErrorIs(t, err, tenant.ErrTenantNotFound)
+}
+
+func TestTenantStore_ChangeTierAndRegion(t *testing.T) {
+	t.Parallel()
+
+	ctx, store, cleanup := setupTenantTest(t)
+	defer cleanup()
+
+	newTenant, err := tenant.NewTenant("reconfigure-test", tenant.RegionUS1, tenant.TierFree, nil)
+	require.NoError(t, err)
+	id, err := store.Create(ctx, newTenant)
+	require.NoError(t, err)
+
+	reconfigurable := []tenant.Status{tenant.StatusActive, tenant.StatusError}
+
+	// A provisioning tenant is left alone.
+	changed, err := store.ChangeTier(ctx, id, tenant.TierPro, reconfigurable)
+	require.NoError(t, err)
+	assert.False(t, changed)
+	changed, err = store.ChangeRegion(ctx, id, tenant.RegionEU1, reconfigurable)
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	moved, err := store.TransitionStatus(ctx, id, []tenant.Status{tenant.StatusProvisioning}, tenant.StatusActive)
+	require.NoError(t, err)
+	require.True(t, moved)
+
+	changed, err = store.ChangeTier(ctx, id, tenant.TierPro, reconfigurable)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	changed, err = store.ChangeRegion(ctx, id, tenant.RegionEU1, reconfigurable)
+	require.NoError(t, err)
+	assert.True(t, changed)
+
+	// Neither write touches the tenant's status, so a deletion claimed in
+	// between is not undone.
+	moved, err = store.TransitionStatus(ctx, id, tenant.TransitionSources(tenant.StatusDeleting), tenant.StatusDeleting)
+	require.NoError(t, err)
+	require.True(t, moved)
+
+	changed, err = store.ChangeTier(ctx, id, tenant.TierFree, reconfigurable)
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	found, err := store.FindByID(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, tenant.TierPro, found.Tier)
+	assert.Equal(t, tenant.RegionEU1, found.Region)
+	assert.Equal(t, tenant.StatusDeleting, found.Status)
 }
 
 func TestTenantStore_FindByID_NotFound(t *testing.T) {
@@ -148,7 +383,7 @@ func TestTenantStore_Create_SameName(t *testing.T) {
 	require.NoError(t, err)
 
 	_, err = store.Create(ctx, duplicateTenant)
-	assert.Error(t, err)
+	assert.ErrorIs(t, err, tenant.ErrTenantAlreadyExists)
 }
 
 func TestTenantStore_UpgradeTier(t *testing.T) {
@@ -177,3 +412,155 @@ func TestTenantStore_UpgradeTier(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, tenant.TierPro, updatedTenant.Tier)
 }
+
+func TestTenantStore_List(t *testing.T) {
+	t.Parallel()
+
+	ctx, store, cleanup := setupTenantTest(t)
+	defer cleanup()
+
+	for _, tc := range []struct {
+		name   string
+		region tenant.Region
+		tier   tenant.Tier
+	}{
+		{"list-alpha", tenant.RegionUS1, tenant.TierFree},
+		{"list-bravo", tenant.RegionUS1, tenant.TierPro},
+		{"list-charlie", tenant.RegionEU1, tenant.TierFree},
+		{"other-delta", tenant.RegionUS1, tenant.TierFree},
+	} {
+		newTenant, err := tenant.NewTenant(tc.name, tc.region, tc.tier, nil)
+		require.NoError(t, err)
+		_, err = store.Create(ctx, newTenant)
+		require.NoError(t, err)
+	}
+
+	deleted, err := tenant.NewTenant("list-deleted", tenant.RegionUS1, tenant.TierFree, nil)
+	require.NoError(t, err)
+	deletedID, err := store.Create(ctx, deleted)
+	require.NoError(t, err)
+	require.NoError(t, store.Delete(ctx, deletedID))
+
+	names := func(page *tenant.Page) []string {
+		var out []string
+		for _, tn := range page.Tenants {
+			out = append(out, tn.Name)
+		}
+		return out
+	}
+
+	t.Run("pages by name excluding deleted", func(t *testing.T) {
+		params := tenant.ListParams{
+			Filter: tenant.ListFilter{NamePrefix: "list-"},
+			SortBy: tenant.SortByName,
+			Order:  tenant.SortAsc,
+			Limit:  2,
+		}
+		first, err := store.List(ctx, params)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"list-alpha", "list-bravo"}, names(first))
+		require.NotNil(t, first.Next)
+
+		params.After = first.Next
+		second, err := store.List(ctx, params)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"list-charlie"}, names(second))
+		assert.Nil(t, second.Next)
+	})
+
+	t.Run("pages by creation time descending", func(t *testing.T) {
+		params := tenant.ListParams{SortBy: tenant.SortByCreatedAt, Order: tenant.SortDesc, Limit: 3}
+		first, err := store.List(ctx, params)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"other-delta", "list-charlie", "list-bravo"}, names(first))
+		require.NotNil(t, first.Next)
+
+		params.After = first.Next
+		second, err := store.List(ctx, params)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"list-alpha"}, names(second))
+		assert.Nil(t, second.Next)
+	})
+
+	t.Run("filters combine", func(t *testing.T) {
+		region, tier := tenant.RegionUS1, tenant.TierFree
+		page, err := store.List(ctx, tenant.ListParams{
+			Filter: tenant.ListFilter{Region: &region, Tier: &tier},
+			SortBy: tenant.SortByName,
+			Order:  tenant.SortAsc,
+			Limit:  10,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"list-alpha", "other-delta"}, names(page))
+	})
+
+	t.Run("explicit status includes deleted tenants", func(t *testing.T) {
+		status := tenant.StatusDeleted
+		page, err := store.List(ctx, tenant.ListParams{
+			Filter: tenant.ListFilter{Status: &status},
+			SortBy: tenant.SortByName,
+			Order:  tenant.SortAsc,
+			Limit:  10,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"list-deleted"}, names(page))
+	})
+}
+
+func TestTenantStore_Transaction(t *testing.T) {
+	t.Parallel()
+
+	ctx, store, cleanup := setupTenantTest(t)
+	defer cleanup()
+
+	transactor := storage.NewTransactor(store.pool, store.tracer)
+	operations := operationStore.NewOperationStore(store.pool, store.tracer)
+
+	t.Run("rolls back every store on error", func(t *testing.T) {
+		errAbort := errors.New("abort")
+		var tenantID int64
+		err := transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			newTenant, err := tenant.NewTenant("rolled-back", tenant.RegionUS1, tenant.TierFree, nil)
+			require.NoError(t, err)
+			tenantID, err = store.Create(ctx, newTenant)
+			require.NoError(t, err)
+
+			op, err := operation.NewTenantCreateOperation(tenantID, "rolled-back", "us1", "free", nil)
+			require.NoError(t, err)
+			_, err = operations.Create(ctx, op)
+			require.NoError(t, err)
+
+			return errAbort
+		})
+		assert.ErrorIs(t, err, errAbort)
+
+		_, err = store.FindByID(ctx, tenantID)
+		assert.ErrorIs(t, err, tenant.ErrTenantNotFound)
+		ops, err := operations.FindByTenantID(ctx, tenantID)
+		require.NoError(t, err)
+		assert.Empty(t, ops)
+	})
+
+	t.Run("commits every store on success", func(t *testing.T) {
+		var tenantID int64
+		err := transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			newTenant, err := tenant.NewTenant("committed", tenant.RegionUS1, tenant.TierFree, nil)
+			require.NoError(t, err)
+			if tenantID, err = store.Create(ctx, newTenant); err != nil {
+				return err
+			}
+
+			op, err := operation.NewTenantCreateOperation(tenantID, "committed", "us1", "free", nil)
+			require.NoError(t, err)
+			_, err = operations.Create(ctx, op)
+			return err
+		})
+		require.NoError(t, err)
+
+		_, err = store.FindByID(ctx, tenantID)
+		assert.NoError(t, err)
+		ops, err := operations.FindByTenantID(ctx, tenantID)
+		require.NoError(t, err)
+		assert.Len(t, ops, 1)
+	})
+}
@@ -0,0 +1,60 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ahrav/hoglet-hub/internal/db"
+)
+
+// txKey is the context key of the transaction stores take part in.
+type txKey struct{}
+
+// Transactor runs units of work in Postgres transactions. Stores that obtain
+// their queries through Queries take part in the transaction when called with
+// the context passed to the unit of work.
+type Transactor struct {
+	pool   *pgxpool.Pool
+	tracer trace.Tracer
+}
+
+// NewTransactor creates a Transactor that begins transactions on the pool.
+func NewTransactor(pool *pgxpool.Pool, tracer trace.Tracer) *Transactor {
+	return &Transactor{pool: pool, tracer: tracer}
+}
+
+// WithinTransaction runs fn in a transaction, committing it if fn returns nil
+// and rolling it back otherwise. If ctx already carries a transaction, fn runs
+// in a savepoint of it, so units of work compose.
+//
+// The transaction is bound to a single connection, so fn must not use its
+// context from several goroutines at once.
+func (t *Transactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	dbAttrs := []attribute.KeyValue{attribute.String("db.system", "postgresql")}
+
+	return ExecuteAndTrace(ctx, t.tracer, "postgres.transaction", dbAttrs, func(ctx context.Context) error {
+		var beginner interface {
+			Begin(ctx context.Context) (pgx.Tx, error)
+		} = t.pool
+		if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+			beginner = tx
+		}
+
+		return pgx.BeginFunc(ctx, beginner, func(tx pgx.Tx) error {
+			return fn(context.WithValue(ctx, txKey{}, tx))
+		})
+	})
+}
+
+// Queries returns q bound to the transaction carried by ctx, or q itself when
+// ctx carries none.
+func Queries(ctx context.Context, q *db.Queries) *db.Queries {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return q.WithTx(tx)
+	}
+	return q
+}
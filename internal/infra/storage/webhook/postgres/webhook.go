@@ -0,0 +1,428 @@
+package postgres
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ahrav/hoglet-hub/internal/db"
+	"github.com/ahrav/hoglet-hub/internal/domain/outbox"
+	"github.com/ahrav/hoglet-hub/internal/domain/webhook"
+	"github.com/ahrav/hoglet-hub/internal/infra/storage"
+)
+
+var _ webhook.Repository = (*webhookStore)(nil)
+
+// webhookStore implements webhook.Repository using Postgres and sqlc-generated queries.
+type webhookStore struct {
+	q      *db.Queries
+	pool   *pgxpool.Pool
+	tracer trace.Tracer
+}
+
+// NewWebhookStore creates a webhook.Repository backed by PostgreSQL.
+func NewWebhookStore(pool *pgxpool.Pool, tracer trace.Tracer) webhook.Repository {
+	return &webhookStore{q: db.New(pool), pool: pool, tracer: tracer}
+}
+
+// defaultDBAttributes defines standard OpenTelemetry attributes for database operations.
+var defaultDBAttributes = []attribute.KeyValue{attribute.String("db.system", "postgresql")}
+
+// queries returns the queries to run, bound to the transaction ctx carries, if any.
+func (s *webhookStore) queries(ctx context.Context) *db.Queries { return storage.Queries(ctx, s.q) }
+
+// CreateSubscription persists a new subscription and returns its ID.
+func (s *webhookStore) CreateSubscription(ctx context.Context, sub *webhook.Subscription) (int64, error) {
+	dbAttrs := append(defaultDBAttributes, attribute.String("webhook.url", sub.URL))
+
+	var id int64
+	err := storage.ExecuteAndTrace(ctx, s.tracer, "webhookStore.CreateSubscription", dbAttrs, func(ctx context.Context) error {
+		var err error
+		id, err = s.queries(ctx).CreateWebhookSubscription(ctx, db.CreateWebhookSubscriptionParams{
+			Url:         sub.URL,
+			Secret:      sub.Secret,
+			EventTypes:  fromEventTypes(sub.EventTypes),
+			TenantID:    toInt8(sub.TenantID),
+			Description: sub.Description,
+			Active:      sub.Active,
+			CreatedBy:   sub.CreatedBy,
+		})
+		return err
+	})
+
+	return id, err
+}
+
+// FindSubscription retrieves a subscription by ID.
+// Returns webhook.ErrSubscriptionNotFound if the subscription doesn't exist.
+func (s *webhookStore) FindSubscription(ctx context.Context, id int64) (*webhook.Subscription, error) {
+	dbAttrs := append(defaultDBAttributes, attribute.Int64("webhook.subscription_id", id))
+
+	var dbSub db.WebhookSubscription
+	err := storage.ExecuteAndTrace(ctx, s.tracer, "webhookStore.FindSubscription", dbAttrs, func(ctx context.Context) error {
+		var err error
+		dbSub, err = s.queries(ctx).FindWebhookSubscriptionByID(ctx, id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return webhook.ErrSubscriptionNotFound
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapDBSubscriptionToDomain(dbSub), nil
+}
+
+// ListSubscriptions retrieves the subscriptions, optionally only the tenant's, ordered by ID.
+func (s *webhookStore) ListSubscriptions(ctx context.Context, tenantID *int64) ([]*webhook.Subscription, error) {
+	dbAttrs := defaultDBAttributes
+	if tenantID != nil {
+		dbAttrs = append(dbAttrs, attribute.Int64("tenant_id", *tenantID))
+	}
+
+	var dbSubs []db.WebhookSubscription
+	err := storage.ExecuteAndTrace(ctx, s.tracer, "webhookStore.ListSubscriptions", dbAttrs, func(ctx context.Context) error {
+		var err error
+		dbSubs, err = s.queries(ctx).ListWebhookSubscriptions(ctx, toInt8(tenantID))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapDBSubscriptionsToDomain(dbSubs), nil
+}
+
+// ListMatchingSubscriptions retrieves the active subscriptions events of the
+// type about the tenant are sent to.
+func (s *webhookStore) ListMatchingSubscriptions(
+	ctx context.Context,
+	eventType outbox.EventType,
+	tenantID *int64,
+) ([]*webhook.Subscription, error) {
+	dbAttrs := append(defaultDBAttributes, attribute.String("webhook.event_type", string(eventType)))
+
+	var dbSubs []db.WebhookSubscription
+	err := storage.ExecuteAndTrace(ctx, s.tracer, "webhookStore.ListMatchingSubscriptions", dbAttrs, func(ctx context.Context) error {
+		var err error
+		dbSubs, err = s.queries(ctx).ListMatchingWebhookSubscriptions(ctx, db.ListMatchingWebhookSubscriptionsParams{
+			TenantID:  toInt8(tenantID),
+			EventType: string(eventType),
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapDBSubscriptionsToDomain(dbSubs), nil
+}
+
+// UpdateSubscription persists the mutable fields of a subscription.
+// Returns webhook.ErrSubscriptionNotFound if the subscription doesn't exist.
+func (s *webhookStore) UpdateSubscription(ctx context.Context, sub *webhook.Subscription) error {
+	dbAttrs := append(defaultDBAttributes, attribute.Int64("webhook.subscription_id", sub.ID))
+
+	return storage.ExecuteAndTrace(ctx, s.tracer, "webhookStore.UpdateSubscription", dbAttrs, func(ctx context.Context) error {
+		rows, err := s.queries(ctx).UpdateWebhookSubscription(ctx, db.UpdateWebhookSubscriptionParams{
+			ID:          sub.ID,
+			Url:         sub.URL,
+			EventTypes:  fromEventTypes(sub.EventTypes),
+			Description: sub.Description,
+			Active:      sub.Active,
+		})
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return webhook.ErrSubscriptionNotFound
+		}
+		return nil
+	})
+}
+
+// DeleteSubscription permanently removes a subscription and its deliveries.
+// Returns webhook.ErrSubscriptionNotFound if the subscription doesn't exist.
+func (s *webhookStore) DeleteSubscription(ctx context.Context, id int64) error {
+	dbAttrs := append(defaultDBAttributes, attribute.Int64("webhook.subscription_id", id))
+
+	return storage.ExecuteAndTrace(ctx, s.tracer, "webhookStore.DeleteSubscription", dbAttrs, func(ctx context.Context) error {
+		rows, err := s.queries(ctx).DeleteWebhookSubscription(ctx, id)
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return webhook.ErrSubscriptionNotFound
+		}
+		return nil
+	})
+}
+
+// EnqueueDeliveries persists new pending deliveries, ignoring deliveries of
+// events their subscription was already sent.
+func (s *webhookStore) EnqueueDeliveries(ctx context.Context, deliveries ...*webhook.Delivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	dbAttrs := append(defaultDBAttributes, attribute.Int("webhook.deliveries", len(deliveries)))
+
+	return storage.ExecuteAndTrace(ctx, s.tracer, "webhookStore.EnqueueDeliveries", dbAttrs, func(ctx context.Context) error {
+		for _, d := range deliveries {
+			if err := s.queries(ctx).CreateWebhookDelivery(ctx, db.CreateWebhookDeliveryParams{
+				SubscriptionID: d.SubscriptionID,
+				EventID:        d.EventID,
+				EventType:      string(d.EventType),
+				TenantID:       toInt8(d.TenantID),
+				Payload:        d.Payload,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ClaimDueDeliveries retrieves up to limit due deliveries, oldest first, and
+// postpones their next attempt to leaseUntil.
+func (s *webhookStore) ClaimDueDeliveries(ctx context.Context, limit int, leaseUntil time.Time) ([]*webhook.Delivery, error) {
+	dbAttrs := append(defaultDBAttributes, attribute.Int("limit", limit))
+
+	var dbDeliveries []db.WebhookDelivery
+	err := storage.ExecuteAndTrace(ctx, s.tracer, "webhookStore.ClaimDueDeliveries", dbAttrs, func(ctx context.Context) error {
+		var err error
+		dbDeliveries, err = s.queries(ctx).ClaimDueWebhookDeliveries(ctx, db.ClaimDueWebhookDeliveriesParams{
+			LeaseUntil: pgtype.Timestamptz{Time: leaseUntil, Valid: true},
+			RowLimit:   int32(limit),
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := mapDBDeliveriesToDomain(dbDeliveries)
+	// UPDATE ... RETURNING does not preserve the order the rows were selected in.
+	slices.SortFunc(deliveries, func(a, b *webhook.Delivery) int { return cmp.Compare(a.ID, b.ID) })
+	return deliveries, nil
+}
+
+// FindDelivery retrieves a delivery by ID.
+// Returns webhook.ErrDeliveryNotFound if the delivery doesn't exist.
+func (s *webhookStore) FindDelivery(ctx context.Context, id int64) (*webhook.Delivery, error) {
+	dbAttrs := append(defaultDBAttributes, attribute.Int64("webhook.delivery_id", id))
+
+	var dbDelivery db.WebhookDelivery
+	err := storage.ExecuteAndTrace(ctx, s.tracer, "webhookStore.FindDelivery", dbAttrs, func(ctx context.Context) error {
+		var err error
+		dbDelivery, err = s.queries(ctx).FindWebhookDeliveryByID(ctx, id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return webhook.ErrDeliveryNotFound
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapDBDeliveryToDomain(dbDelivery), nil
+}
+
+// ListDeliveries retrieves a page of a subscription's deliveries, newest first.
+func (s *webhookStore) ListDeliveries(ctx context.Context, params webhook.DeliveryListParams) ([]*webhook.Delivery, error) {
+	dbAttrs := append(defaultDBAttributes,
+		attribute.Int64("webhook.subscription_id", params.SubscriptionID),
+		attribute.Int("limit", params.Limit),
+	)
+
+	dbParams := db.ListWebhookDeliveriesParams{
+		SubscriptionID: params.SubscriptionID,
+		RowLimit:       int32(params.Limit),
+	}
+	if params.Status != nil {
+		dbParams.Status = db.NullWebhookDeliveryStatus{
+			WebhookDeliveryStatus: db.WebhookDeliveryStatus(*params.Status),
+			Valid:                 true,
+		}
+	}
+	if params.BeforeID > 0 {
+		dbParams.BeforeID = pgtype.Int8{Int64: params.BeforeID, Valid: true}
+	}
+
+	var dbDeliveries []db.WebhookDelivery
+	err := storage.ExecuteAndTrace(ctx, s.tracer, "webhookStore.ListDeliveries", dbAttrs, func(ctx context.Context) error {
+		var err error
+		dbDeliveries, err = s.queries(ctx).ListWebhookDeliveries(ctx, dbParams)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapDBDeliveriesToDomain(dbDeliveries), nil
+}
+
+// UpdateDelivery persists the status and attempts of a delivery.
+// Returns webhook.ErrDeliveryNotFound if the delivery doesn't exist.
+func (s *webhookStore) UpdateDelivery(ctx context.Context, d *webhook.Delivery) error {
+	dbAttrs := append(defaultDBAttributes,
+		attribute.Int64("webhook.delivery_id", d.ID),
+		attribute.String("webhook.delivery_status", string(d.Status)),
+	)
+
+	var responseStatus pgtype.Int4
+	if d.ResponseStatus != nil {
+		responseStatus = pgtype.Int4{Int32: int32(*d.ResponseStatus), Valid: true}
+	}
+	var lastError pgtype.Text
+	if d.LastError != nil {
+		lastError = pgtype.Text{String: *d.LastError, Valid: true}
+	}
+
+	return storage.ExecuteAndTrace(ctx, s.tracer, "webhookStore.UpdateDelivery", dbAttrs, func(ctx context.Context) error {
+		rows, err := s.queries(ctx).UpdateWebhookDelivery(ctx, db.UpdateWebhookDeliveryParams{
+			ID:             d.ID,
+			Status:         db.WebhookDeliveryStatus(d.Status),
+			Attempts:       int32(d.Attempts),
+			NextAttemptAt:  pgtype.Timestamptz{Time: d.NextAttemptAt, Valid: true},
+			LastAttemptAt:  toTimestamptz(d.LastAttemptAt),
+			ResponseStatus: responseStatus,
+			LastError:      lastError,
+			DeliveredAt:    toTimestamptz(d.DeliveredAt),
+		})
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return webhook.ErrDeliveryNotFound
+		}
+		return nil
+	})
+}
+
+// fromEventTypes converts event types to their database representation.
+func fromEventTypes(types []outbox.EventType) []string {
+	out := make([]string, 0, len(types))
+	for _, t := range types {
+		out = append(out, string(t))
+	}
+	return out
+}
+
+// toInt8 converts an optional ID to its nullable database representation.
+func toInt8(id *int64) pgtype.Int8 {
+	if id == nil {
+		return pgtype.Int8{}
+	}
+	return pgtype.Int8{Int64: *id, Valid: true}
+}
+
+// fromInt8 converts a nullable database ID to an optional ID.
+func fromInt8(id pgtype.Int8) *int64 {
+	if !id.Valid {
+		return nil
+	}
+	val := id.Int64
+	return &val
+}
+
+// toTimestamptz converts an optional time to its nullable database representation.
+func toTimestamptz(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: *t, Valid: true}
+}
+
+// fromTimestamptz converts a nullable database time to an optional time.
+func fromTimestamptz(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	val := t.Time
+	return &val
+}
+
+// mapDBSubscriptionToDomain converts a database subscription record to a domain subscription.
+func mapDBSubscriptionToDomain(dbSub db.WebhookSubscription) *webhook.Subscription {
+	eventTypes := make([]outbox.EventType, 0, len(dbSub.EventTypes))
+	for _, t := range dbSub.EventTypes {
+		eventTypes = append(eventTypes, outbox.EventType(t))
+	}
+
+	var updatedAt *time.Time
+	if !dbSub.UpdatedAt.Time.Equal(dbSub.CreatedAt.Time) {
+		val := dbSub.UpdatedAt.Time
+		updatedAt = &val
+	}
+
+	return &webhook.Subscription{
+		ID:          dbSub.ID,
+		URL:         dbSub.Url,
+		Secret:      dbSub.Secret,
+		EventTypes:  eventTypes,
+		TenantID:    fromInt8(dbSub.TenantID),
+		Description: dbSub.Description,
+		Active:      dbSub.Active,
+		CreatedBy:   dbSub.CreatedBy,
+		CreatedAt:   dbSub.CreatedAt.Time,
+		UpdatedAt:   updatedAt,
+	}
+}
+
+// mapDBSubscriptionsToDomain converts database subscription records to domain subscriptions.
+func mapDBSubscriptionsToDomain(dbSubs []db.WebhookSubscription) []*webhook.Subscription {
+	subs := make([]*webhook.Subscription, 0, len(dbSubs))
+	for _, dbSub := range dbSubs {
+		subs = append(subs, mapDBSubscriptionToDomain(dbSub))
+	}
+	return subs
+}
+
+// mapDBDeliveryToDomain converts a database delivery record to a domain delivery.
+func mapDBDeliveryToDomain(dbDelivery db.WebhookDelivery) *webhook.Delivery {
+	var responseStatus *int
+	if dbDelivery.ResponseStatus.Valid {
+		val := int(dbDelivery.ResponseStatus.Int32)
+		responseStatus = &val
+	}
+	var lastError *string
+	if dbDelivery.LastError.Valid {
+		val := dbDelivery.LastError.String
+		lastError = &val
+	}
+
+	return &webhook.Delivery{
+		ID:             dbDelivery.ID,
+		SubscriptionID: dbDelivery.SubscriptionID,
+		EventID:        dbDelivery.EventID,
+		EventType:      outbox.EventType(dbDelivery.EventType),
+		TenantID:       fromInt8(dbDelivery.TenantID),
+		Payload:        dbDelivery.Payload,
+		Status:         webhook.DeliveryStatus(dbDelivery.Status),
+		Attempts:       int(dbDelivery.Attempts),
+		NextAttemptAt:  dbDelivery.NextAttemptAt.Time,
+		LastAttemptAt:  fromTimestamptz(dbDelivery.LastAttemptAt),
+		ResponseStatus: responseStatus,
+		LastError:      lastError,
+		CreatedAt:      dbDelivery.CreatedAt.Time,
+		DeliveredAt:    fromTimestamptz(dbDelivery.DeliveredAt),
+	}
+}
+
+// mapDBDeliveriesToDomain converts database delivery records to domain deliveries.
+func mapDBDeliveriesToDomain(dbDeliveries []db.WebhookDelivery) []*webhook.Delivery {
+	deliveries := make([]*webhook.Delivery, 0, len(dbDeliveries))
+	for _, dbDelivery := range dbDeliveries {
+		deliveries = append(deliveries, mapDBDeliveryToDomain(dbDelivery))
+	}
+	return deliveries
+}
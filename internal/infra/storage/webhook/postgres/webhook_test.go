@@ -0,0 +1,174 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/ahrav/hoglet-hub/internal/db"
+	"github.com/ahrav/hoglet-hub/internal/domain/outbox"
+	"github.com/ahrav/hoglet-hub/internal/domain/tenant"
+	"github.com/ahrav/hoglet-hub/internal/domain/webhook"
+	tenantStore "github.com/ahrav/hoglet-hub/internal/infra/storage/tenant/postgres"
+	"github.com/ahrav/hoglet-hub/internal/infra/storage/testutil"
+)
+
+const testSecret = "0123456789abcdef0123456789abcdef"
+
+func setupWebhookTest(t *testing.T) (context.Context, *webhookStore, tenant.Repository, func()) {
+	t.Helper()
+
+	pool, cleanup := testutil.SetupTestContainer(t)
+	tracer := noop.NewTracerProvider().Tracer("test")
+	store := &webhookStore{q: db.New(pool), pool: pool, tracer: tracer}
+
+	return context.Background(), store, tenantStore.NewTenantStore(pool, tracer), cleanup
+}
+
+func createSubscription(
+	t *testing.T,
+	ctx context.Context,
+	store *webhookStore,
+	eventTypes []outbox.EventType,
+	tenantID *int64,
+) *webhook.Subscription {
+	t.Helper()
+
+	sub, err := webhook.NewSubscription("https://example.com/hooks", testSecret, eventTypes, tenantID, "", "ops@example.com")
+	require.NoError(t, err)
+	sub.ID, err = store.CreateSubscription(ctx, sub)
+	require.NoError(t, err)
+	return sub
+}
+
+func TestWebhookStore_Subscriptions(t *testing.T) {
+	t.Parallel()
+
+	ctx, store, tenants, cleanup := setupWebhookTest(t)
+	defer cleanup()
+
+	tnt, err := tenant.NewTenant("acme", tenant.RegionUS1, tenant.TierFree, nil)
+	require.NoError(t, err)
+	tenantID, err := tenants.Create(ctx, tnt)
+	require.NoError(t, err)
+	otherTenantID := tenantID + 1000
+
+	global := createSubscription(t, ctx, store, nil, nil)
+	activations := createSubscription(t, ctx, store, []outbox.EventType{outbox.EventTenantActivated}, nil)
+	forTenant := createSubscription(t, ctx, store, nil, &tenantID)
+
+	found, err := store.FindSubscription(ctx, forTenant.ID)
+	require.NoError(t, err)
+	assert.Equal(t, testSecret, found.Secret)
+	assert.Equal(t, &tenantID, found.TenantID)
+	assert.Empty(t, found.EventTypes)
+	assert.True(t, found.Active)
+
+	all, err := store.ListSubscriptions(ctx, nil)
+	require.NoError(t, err)
+	assert.Len(t, all, 3)
+	tenantSubs, err := store.ListSubscriptions(ctx, &tenantID)
+	require.NoError(t, err)
+	require.Len(t, tenantSubs, 1)
+	assert.Equal(t, forTenant.ID, tenantSubs[0].ID)
+
+	ids := func(subs []*webhook.Subscription) []int64 {
+		var out []int64
+		for _, s := range subs {
+			out = append(out, s.ID)
+		}
+		return out
+	}
+	matching, err := store.ListMatchingSubscriptions(ctx, outbox.EventTenantActivated, &tenantID)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{global.ID, activations.ID, forTenant.ID}, ids(matching))
+	matching, err = store.ListMatchingSubscriptions(ctx, outbox.EventTenantDeleted, &otherTenantID)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{global.ID}, ids(matching))
+
+	global.Active = false
+	global.EventTypes = []outbox.EventType{outbox.EventTenantDeleted}
+	require.NoError(t, store.UpdateSubscription(ctx, global))
+	found, err = store.FindSubscription(ctx, global.ID)
+	require.NoError(t, err)
+	assert.False(t, found.Active)
+	assert.Equal(t, global.EventTypes, found.EventTypes)
+	matching, err = store.ListMatchingSubscriptions(ctx, outbox.EventTenantDeleted, nil)
+	require.NoError(t, err)
+	assert.Empty(t, matching, "inactive subscriptions are sent nothing")
+
+	require.NoError(t, store.DeleteSubscription(ctx, global.ID))
+	assert.ErrorIs(t, store.DeleteSubscription(ctx, global.ID), webhook.ErrSubscriptionNotFound)
+	_, err = store.FindSubscription(ctx, global.ID)
+	assert.ErrorIs(t, err, webhook.ErrSubscriptionNotFound)
+	assert.ErrorIs(t, store.UpdateSubscription(ctx, global), webhook.ErrSubscriptionNotFound)
+}
+
+func TestWebhookStore_Deliveries(t *testing.T) {
+	t.Parallel()
+
+	ctx, store, _, cleanup := setupWebhookTest(t)
+	defer cleanup()
+
+	sub := createSubscription(t, ctx, store, nil, nil)
+	first := webhook.NewDelivery(sub, &outbox.Event{ID: 1, Type: outbox.EventTenantActivated}, []byte(`{"id": 1}`))
+	second := webhook.NewDelivery(sub, &outbox.Event{ID: 2, Type: outbox.EventTenantDeleted}, []byte(`{"id": 2}`))
+	require.NoError(t, store.EnqueueDeliveries(ctx, first, second))
+	require.NoError(t, store.EnqueueDeliveries(ctx, first), "events already delivered are ignored")
+
+	claimed, err := store.ClaimDueDeliveries(ctx, 10, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	require.Len(t, claimed, 2)
+	assert.Equal(t, int64(1), claimed[0].EventID)
+	assert.JSONEq(t, `{"id": 1}`, string(claimed[0].Payload))
+
+	again, err := store.ClaimDueDeliveries(ctx, 10, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.Empty(t, again, "claimed deliveries are not claimed again until their lease expires")
+
+	now := time.Now()
+	claimed[0].RecordSuccess(204, now)
+	require.NoError(t, store.UpdateDelivery(ctx, claimed[0]))
+	claimed[1].RecordFailure(500, "internal server error", now)
+	claimed[1].DeadLetter("gave up")
+	require.NoError(t, store.UpdateDelivery(ctx, claimed[1]))
+
+	found, err := store.FindDelivery(ctx, claimed[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, webhook.DeliverySucceeded, found.Status)
+	assert.Equal(t, 1, found.Attempts)
+	assert.Equal(t, 204, *found.ResponseStatus)
+	assert.NotNil(t, found.DeliveredAt)
+
+	deliveries, err := store.ListDeliveries(ctx, webhook.DeliveryListParams{SubscriptionID: sub.ID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 2)
+	assert.Equal(t, claimed[1].ID, deliveries[0].ID, "deliveries are listed newest first")
+
+	deadLetter := webhook.DeliveryDeadLetter
+	deliveries, err = store.ListDeliveries(ctx, webhook.DeliveryListParams{
+		SubscriptionID: sub.ID,
+		Status:         &deadLetter,
+		Limit:          10,
+	})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, "gave up", *deliveries[0].LastError)
+
+	deliveries, err = store.ListDeliveries(ctx, webhook.DeliveryListParams{
+		SubscriptionID: sub.ID,
+		BeforeID:       claimed[1].ID,
+		Limit:          10,
+	})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, claimed[0].ID, deliveries[0].ID)
+
+	_, err = store.FindDelivery(ctx, 99999)
+	assert.ErrorIs(t, err, webhook.ErrDeliveryNotFound)
+}
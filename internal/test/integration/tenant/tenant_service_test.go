@@ -15,10 +15,20 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/otel/trace/noop"
 
+	auditApp "github.com/ahrav/hoglet-hub/internal/application/audit"
+	"github.com/ahrav/hoglet-hub/internal/application/dbnode"
+	resourceApp "github.com/ahrav/hoglet-hub/internal/application/resource"
 	"github.com/ahrav/hoglet-hub/internal/application/tenant"
+	dbnodeDomain "github.com/ahrav/hoglet-hub/internal/domain/dbnode"
 	"github.com/ahrav/hoglet-hub/internal/domain/operation"
+	"github.com/ahrav/hoglet-hub/internal/domain/resource"
 	tenantDomain "github.com/ahrav/hoglet-hub/internal/domain/tenant"
+	"github.com/ahrav/hoglet-hub/internal/infra/storage"
+	auditRepo "github.com/ahrav/hoglet-hub/internal/infra/storage/audit/postgres"
+	dbNodeRepo "github.com/ahrav/hoglet-hub/internal/infra/storage/dbnode/postgres"
+	isolationGroupRepo "github.com/ahrav/hoglet-hub/internal/infra/storage/isolationgroup/postgres"
 	operationRepo "github.com/ahrav/hoglet-hub/internal/infra/storage/operation/postgres"
+	resourceRepo "github.com/ahrav/hoglet-hub/internal/infra/storage/resource/postgres"
 	tenantRepo "github.com/ahrav/hoglet-hub/internal/infra/storage/tenant/postgres"
 	"github.com/ahrav/hoglet-hub/internal/infra/storage/testutil"
 	integrationTestUtil "github.com/ahrav/hoglet-hub/internal/test/integration/tesutil"
@@ -50,8 +60,9 @@ func (m *MockProvisioningMetrics) ObserveProvisioningStageDuration(
 	tenantTier string,
 	region string,
 	duration time.Duration,
+	attempts int,
 ) {
-	m.Called(ctx, stage, tenantTier, region, duration)
+	m.Called(ctx, stage, tenantTier, region, duration, attempts)
 }
 
 func (m *MockProvisioningMetrics) IncTenantDeletionSuccess(ctx context.Context, tenantTier string, region string) {
@@ -86,10 +97,40 @@ func setupTenantService(t *testing.T) (
 
 	tenantRepo := setupTenantRepository(pool)
 	operationRepo := setupOperationRepository(pool)
+	stepRepo := setupStepRepository(pool)
 
 	log := logger.Noop()
 	tracer := noop.NewTracerProvider().Tracer("test-integration")
-	service := tenant.NewService(tenantRepo, operationRepo, log, tracer, new(MockProvisioningMetrics))
+	metrics := new(MockProvisioningMetrics)
+	metrics.On("ObserveProvisioningStageDuration",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	).Maybe()
+	groupRepo := isolationGroupRepo.NewGroupStore(pool, tracer)
+	nodeRepo := dbNodeRepo.NewNodeStore(pool, tracer)
+	registerDatabaseNodes(t, nodeRepo)
+	placer := dbnode.NewPlacer(nodeRepo, log, tracer)
+	resources := resourceRepo.NewResourceStore(pool, tracer)
+	quotas := resourceApp.NewQuotaService(
+		resourceRepo.NewQuotaStore(pool, tracer),
+		resource.DefaultQuotas,
+		resource.DefaultProjectsPerRegion,
+		log,
+		tracer,
+	)
+	service := tenant.NewService(
+		tenantRepo,
+		operationRepo,
+		storage.NewTransactor(pool, tracer),
+		stepRepo,
+		groupRepo,
+		resources,
+		quotas,
+		placer,
+		auditApp.NewService(auditRepo.NewAuditStore(pool, tracer), log, tracer),
+		log,
+		tracer,
+		metrics,
+	)
 
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	t.Cleanup(cancel)
@@ -107,6 +148,28 @@ func setupOperationRepository(pool *pgxpool.Pool) operation.Repository {
 	return operationRepo.NewOperationStore(pool, tracer)
 }
 
+// registerDatabaseNodes registers a standard node in every region so that
+// created tenants can be placed.
+func registerDatabaseNodes(t *testing.T, repo dbnodeDomain.Repository) {
+	t.Helper()
+
+	for _, region := range []tenantDomain.Region{
+		tenantDomain.RegionUS1, tenantDomain.RegionUS2, tenantDomain.RegionUS3, tenantDomain.RegionUS4,
+		tenantDomain.RegionEU1, tenantDomain.RegionEU2, tenantDomain.RegionEU3, tenantDomain.RegionEU4,
+	} {
+		hostname := fmt.Sprintf("citus-worker.%s.internal", region)
+		node, err := dbnodeDomain.NewNode(hostname, 5432, region, dbnodeDomain.TypeStandard, 0, "test")
+		require.NoError(t, err)
+		_, err = repo.Create(context.Background(), node)
+		require.NoError(t, err)
+	}
+}
+
+func setupStepRepository(pool *pgxpool.Pool) operation.StepRepository {
+	tracer := noop.NewTracerProvider().Tracer("test")
+	return operationRepo.NewStepStore(pool, tracer)
+}
+
 // TestTenantCreateAndDeleteHappyPath tests the complete flow of creating and
 // deleting a tenant successfully.
 func TestTenantCreateAndDeleteHappyPath(t *testing.T) {
@@ -0,0 +1,40 @@
+// Package cursor encodes pagination positions as opaque tokens that clients
+// hand back unchanged to fetch the following page.
+package cursor
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrInvalid is returned when a token was not produced by Encode or does not
+// describe the expected position type.
+var ErrInvalid = errors.New("invalid cursor")
+
+// Encode serializes a position into a URL-safe token.
+func Encode(position any) (string, error) {
+	raw, err := json.Marshal(position)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode cursor: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// Decode parses a token produced by Encode into position.
+// Any malformed token is reported as ErrInvalid.
+func Decode(token string, position any) error {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return ErrInvalid
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(position); err != nil {
+		return ErrInvalid
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package cursor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type position struct {
+	ID        int64     `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func TestEncodeDecode_RoundTrip(t *testing.T) {
+	want := position{ID: 42, CreatedAt: time.Date(2024, 1, 1, 12, 30, 0, 123456000, time.UTC)}
+
+	token, err := Encode(want)
+	require.NoError(t, err)
+
+	var got position
+	require.NoError(t, Decode(token, &got))
+	assert.Equal(t, want, got)
+}
+
+func TestDecode_Invalid(t *testing.T) {
+	otherShape, err := Encode(map[string]string{"name": "acme"})
+	require.NoError(t, err)
+
+	for _, token := range []string{"", "not base64!", "bm90IGpzb24", otherShape} {
+		var got position
+		assert.ErrorIs(t, Decode(token, &got), ErrInvalid, "token %q", token)
+	}
+}